@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"strings"
+)
+
+// APIKeyHeader carries a static API key
+const APIKeyHeader = "X-API-Key"
+
+// APIKey maps one static key to its tenant
+type APIKey struct {
+	Name     string // Reported as the principal's subject (never the key itself)
+	Key      string
+	TenantID string
+}
+
+// APIKeyAuthenticator verifies static API keys sent in X-API-Key or "Authorization: ApiKey <key>"
+type APIKeyAuthenticator struct {
+	keys map[[sha256.Size]byte]APIKey // Keyed by digest so lookups do not leak key prefixes through timing
+}
+
+// NewAPIKeyAuthenticator creates an authenticator for the given keys
+func NewAPIKeyAuthenticator(keys []APIKey) *APIKeyAuthenticator {
+	a := &APIKeyAuthenticator{keys: make(map[[sha256.Size]byte]APIKey, len(keys))}
+	for _, k := range keys {
+		if k.Key == "" {
+			continue
+		}
+		a.keys[sha256.Sum256([]byte(k.Key))] = k
+	}
+	return a
+}
+
+// Authenticate implements Authenticator
+func (a *APIKeyAuthenticator) Authenticate(r *http.Request) (Principal, error) {
+	key := r.Header.Get(APIKeyHeader)
+	if key == "" {
+		if scheme, value, ok := strings.Cut(r.Header.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "ApiKey") {
+			key = strings.TrimSpace(value)
+		}
+	}
+	if key == "" {
+		return Principal{}, ErrNoCredentials
+	}
+
+	k, ok := a.keys[sha256.Sum256([]byte(key))]
+	if !ok {
+		return Principal{}, ErrInvalidCredentials
+	}
+	subject := k.Name
+	if subject == "" {
+		subject = "api-key"
+	}
+	return Principal{Subject: subject, TenantID: k.TenantID, Method: "api_key"}, nil
+}
@@ -0,0 +1,125 @@
+// Package auth authenticates API callers (static API keys or JWTs) and carries the caller's tenant
+// through context.Context so that stored statements, vector chunks and jobs can be scoped per tenant
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"classify/logging"
+)
+
+// DefaultTenant is used when authentication is disabled
+const DefaultTenant = "default"
+
+// Authentication errors
+var (
+	ErrNoCredentials      = errors.New("no credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Principal is an authenticated caller
+type Principal struct {
+	Subject  string // API key name or JWT "sub"
+	TenantID string // Tenant whose data the caller may access
+	Method   string // "api_key", "jwt" or "anonymous"
+}
+
+// Authenticator extracts and verifies credentials from a request
+// It returns ErrNoCredentials when the request carries none it understands
+type Authenticator interface {
+	Authenticate(r *http.Request) (Principal, error)
+}
+
+// AuthenticatorFunc adapts a function to the Authenticator interface
+type AuthenticatorFunc func(r *http.Request) (Principal, error)
+
+// Authenticate calls f(r)
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (Principal, error) {
+	return f(r)
+}
+
+// Chain tries each authenticator in order, skipping those that find no credentials
+func Chain(authenticators ...Authenticator) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (Principal, error) {
+		for _, a := range authenticators {
+			p, err := a.Authenticate(r)
+			if errors.Is(err, ErrNoCredentials) {
+				continue
+			}
+			return p, err
+		}
+		return Principal{}, ErrNoCredentials
+	})
+}
+
+// Anonymous authenticates every request as the default tenant (authentication disabled)
+func Anonymous() Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (Principal, error) {
+		return Principal{Subject: "anonymous", TenantID: DefaultTenant, Method: "anonymous"}, nil
+	})
+}
+
+type contextKey int
+
+const principalKey contextKey = iota
+
+// WithPrincipal returns a context carrying p
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey, p)
+}
+
+// PrincipalFromContext returns the authenticated caller stored in ctx
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	if ctx == nil {
+		return Principal{}, false
+	}
+	p, ok := ctx.Value(principalKey).(Principal)
+	return p, ok
+}
+
+// TenantID returns the caller's tenant ("" outside an authenticated request)
+func TenantID(ctx context.Context) string {
+	p, _ := PrincipalFromContext(ctx)
+	return p.TenantID
+}
+
+// ErrorResponse is the body of a 401 response
+type ErrorResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// Middleware rejects unauthenticated requests with 401 and stores the principal in the request context
+// CORS preflight requests pass through unauthenticated
+func Middleware(a Authenticator, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			next(w, r)
+			return
+		}
+
+		logger := logging.FromContext(r.Context())
+		p, err := a.Authenticate(r)
+		if err != nil {
+			logger.Warn("authentication failed", slog.String("path", r.URL.Path), slog.Any("error", err))
+			w.Header().Set("WWW-Authenticate", `Bearer realm="classify"`)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Success: false,
+				Error:   "Unauthorized",
+				Message: "A valid API key or bearer token is required",
+			})
+			return
+		}
+
+		ctx := WithPrincipal(r.Context(), p)
+		ctx = logging.WithLogger(ctx, logger.With(slog.String("tenant_id", p.TenantID)))
+		next(w, r.WithContext(ctx))
+	}
+}
@@ -0,0 +1,234 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2025, 12, 17, 10, 0, 0, 0, time.UTC)
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, secret []byte, claims map[string]interface{}) string {
+	t.Helper()
+	unsigned := encodeSegment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	t.Helper()
+	unsigned := encodeSegment(t, map[string]string{"alg": "RS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func bearer(token string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/classify", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	secret := []byte("test-secret")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := NewJWTAuthenticator(JWTConfig{
+		HS256Secret:    secret,
+		RS256PublicKey: &rsaKey.PublicKey,
+		Issuer:         "issuer",
+		Audience:       "classify",
+		Now:            func() time.Time { return testNow },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := map[string]interface{}{
+		"sub": "user-1", "tenant_id": "tenant-a", "iss": "issuer", "aud": []string{"classify"},
+		"exp": testNow.Add(time.Hour).Unix(),
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := make(map[string]interface{}, len(valid))
+		for k, v := range valid {
+			claims[k] = v
+		}
+		claims[key] = value
+		return claims
+	}
+	noneToken := encodeSegment(t, map[string]string{"alg": "none"}) + "." + encodeSegment(t, valid) + "."
+
+	tests := []struct {
+		name    string
+		token   string
+		tenant  string
+		wantErr bool
+	}{
+		{"HS256 valid", signHS256(t, secret, valid), "tenant-a", false},
+		{"RS256 valid", signRS256(t, rsaKey, valid), "tenant-a", false},
+		{"wrong secret", signHS256(t, []byte("other"), valid), "", true},
+		{"expired", signHS256(t, secret, with("exp", testNow.Add(-time.Hour).Unix())), "", true},
+		{"wrong issuer", signHS256(t, secret, with("iss", "someone-else")), "", true},
+		{"wrong audience", signHS256(t, secret, with("aud", "other-api")), "", true},
+		{"missing tenant", signHS256(t, secret, with("tenant_id", "")), "", true},
+		{"alg none", noneToken, "", true},
+		{"malformed", "not-a-token", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := a.Authenticate(bearer(tt.token))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidCredentials) {
+					t.Errorf("expected ErrInvalidCredentials, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.TenantID != tt.tenant || p.Subject != "user-1" {
+				t.Errorf("expected tenant %q subject user-1, got %+v", tt.tenant, p)
+			}
+		})
+	}
+}
+
+func TestChainAndMiddleware(t *testing.T) {
+	authenticator, err := New(&Config{
+		APIKeys:        []APIKey{{Name: "ci", Key: "key-123", TenantID: "tenant-b"}},
+		JWTHS256Secret: "test-secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tenant string
+	handler := Middleware(authenticator, func(w http.ResponseWriter, r *http.Request) {
+		tenant = TenantID(r.Context())
+	})
+
+	tests := []struct {
+		name       string
+		header     string
+		value      string
+		wantStatus int
+		wantTenant string
+	}{
+		{"API key header", APIKeyHeader, "key-123", http.StatusOK, "tenant-b"},
+		{"API key scheme", "Authorization", "ApiKey key-123", http.StatusOK, "tenant-b"},
+		{"unknown API key", APIKeyHeader, "wrong", http.StatusUnauthorized, ""},
+		{"no credentials", "", "", http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant = ""
+			req := httptest.NewRequest(http.MethodPost, "/classify", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tenant != tt.wantTenant {
+				t.Errorf("expected tenant %q, got %q", tt.wantTenant, tenant)
+			}
+		})
+	}
+}
+
+func TestNewRequiresConfiguration(t *testing.T) {
+	if _, err := New(&Config{}); err == nil {
+		t.Error("expected an error when no authentication is configured")
+	}
+	if _, err := New(&Config{Disabled: true}); err != nil {
+		t.Errorf("expected anonymous authenticator when disabled, got %v", err)
+	}
+}
+
+func TestParseAPIKeys(t *testing.T) {
+	keys, err := ParseAPIKeys("web:k1:acme, ,cli:k2:acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[1] != (APIKey{Name: "cli", Key: "k2", TenantID: "acme"}) {
+		t.Errorf("expected two keys, got %+v", keys)
+	}
+
+	tests := []struct {
+		spec     string
+		expected string
+	}{
+		{"web:k1:acme,s3cr3t-key", "entry 2"},
+		{"s3cr3t-key:", "entry 1"},
+		{"web:s3cr3t-key:", "entry 1"},
+	}
+	for _, tt := range tests {
+		_, err := ParseAPIKeys(tt.spec)
+		if err == nil {
+			t.Errorf("%s: expected an error", tt.spec)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.expected) || strings.Contains(err.Error(), "s3cr3t") {
+			t.Errorf("%s: expected %s without the key, got %v", tt.spec, tt.expected, err)
+		}
+	}
+}
+
+func TestConfigFromEnvDisabled(t *testing.T) {
+	tests := []struct {
+		value    string
+		disabled bool
+		valid    bool
+	}{
+		{"", false, true},
+		{"true", true, true},
+		{"1", true, true},
+		{"TRUE", true, true},
+		{"false", false, true},
+		{"yes", false, false},
+		{"on", false, false},
+		{"TRUE1", false, false},
+	}
+	t.Setenv("AUTH_API_KEYS", "")
+	for _, tt := range tests {
+		t.Setenv("AUTH_DISABLED", tt.value)
+		config, err := ConfigFromEnv()
+		if (err == nil) != tt.valid {
+			t.Errorf("%q: expected valid=%v, got %v", tt.value, tt.valid, err)
+			continue
+		}
+		if err == nil && config.Disabled != tt.disabled {
+			t.Errorf("%q: expected disabled=%v, got %v", tt.value, tt.disabled, config.Disabled)
+		}
+	}
+}
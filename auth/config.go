@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Config selects the authenticators to enable
+type Config struct {
+	Disabled          bool     // Accept every request as DefaultTenant (local development only)
+	APIKeys           []APIKey // Static API keys
+	JWTHS256Secret    string   // Shared secret for HS256 tokens
+	JWTRS256PublicKey string   // Path to a PEM RSA public key for RS256 tokens
+	JWTIssuer         string
+	JWTAudience       string
+	JWTTenantClaim    string
+}
+
+// ConfigFromEnv reads the authentication settings from the environment:
+//
+//	AUTH_DISABLED=true                    accept all requests as the default tenant
+//	AUTH_API_KEYS=name:key:tenant,...     static API keys
+//	AUTH_JWT_HS256_SECRET=...             HS256 shared secret
+//	AUTH_JWT_RS256_PUBLIC_KEY=path.pem    RS256 public key file
+//	AUTH_JWT_ISSUER, AUTH_JWT_AUDIENCE    required iss / aud
+//	AUTH_JWT_TENANT_CLAIM                 tenant claim name (default tenant_id)
+func ConfigFromEnv() (*Config, error) {
+	config := &Config{
+		JWTHS256Secret:    os.Getenv("AUTH_JWT_HS256_SECRET"),
+		JWTRS256PublicKey: os.Getenv("AUTH_JWT_RS256_PUBLIC_KEY"),
+		JWTIssuer:         os.Getenv("AUTH_JWT_ISSUER"),
+		JWTAudience:       os.Getenv("AUTH_JWT_AUDIENCE"),
+		JWTTenantClaim:    os.Getenv("AUTH_JWT_TENANT_CLAIM"),
+	}
+	// A mistyped switch must not silently leave authentication on or off
+	if v := os.Getenv("AUTH_DISABLED"); v != "" {
+		disabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_DISABLED %q: expected true or false", v)
+		}
+		config.Disabled = disabled
+	}
+
+	keys, err := ParseAPIKeys(os.Getenv("AUTH_API_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+	config.APIKeys = keys
+	return config, nil
+}
+
+// ParseAPIKeys parses "name:key:tenant" entries separated by commas
+// Errors name the entry by position only, since a malformed entry may hold a raw key
+func ParseAPIKeys(spec string) ([]APIKey, error) {
+	keys := make([]APIKey, 0)
+	for i, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid API key entry %d (want name:key:tenant)", i+1)
+		}
+		keys = append(keys, APIKey{Name: parts[0], Key: parts[1], TenantID: parts[2]})
+	}
+	return keys, nil
+}
+
+// New builds the authenticator described by config
+// It fails when nothing is configured and authentication is not explicitly disabled
+func New(config *Config) (Authenticator, error) {
+	if config.Disabled {
+		return Anonymous(), nil
+	}
+
+	authenticators := make([]Authenticator, 0, 2)
+	if len(config.APIKeys) > 0 {
+		authenticators = append(authenticators, NewAPIKeyAuthenticator(config.APIKeys))
+	}
+
+	if config.JWTHS256Secret != "" || config.JWTRS256PublicKey != "" {
+		jwtConfig := JWTConfig{
+			HS256Secret: []byte(config.JWTHS256Secret),
+			Issuer:      config.JWTIssuer,
+			Audience:    config.JWTAudience,
+			TenantClaim: config.JWTTenantClaim,
+		}
+		if config.JWTRS256PublicKey != "" {
+			pemBytes, err := os.ReadFile(config.JWTRS256PublicKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read RS256 public key: %w", err)
+			}
+			key, err := ParseRSAPublicKey(pemBytes)
+			if err != nil {
+				return nil, err
+			}
+			jwtConfig.RS256PublicKey = key
+		}
+		jwtAuth, err := NewJWTAuthenticator(jwtConfig)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, jwtAuth)
+	}
+
+	if len(authenticators) == 0 {
+		return nil, fmt.Errorf("no authentication configured: set AUTH_API_KEYS or AUTH_JWT_*, or AUTH_DISABLED=true for local development")
+	}
+	return Chain(authenticators...), nil
+}
@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// JWTConfig configures bearer token verification
+// At least one of HS256Secret or RS256PublicKey must be set
+type JWTConfig struct {
+	HS256Secret    []byte
+	RS256PublicKey *rsa.PublicKey
+	Issuer         string           // Required "iss" (optional)
+	Audience       string           // Required "aud" entry (optional)
+	TenantClaim    string           // Claim holding the tenant ID (default: "tenant_id")
+	Leeway         time.Duration    // Clock skew allowed for exp/nbf (default: 1 minute)
+	Now            func() time.Time // Clock (default: time.Now)
+}
+
+// JWTAuthenticator verifies HS256/RS256 bearer tokens against locally configured keys
+type JWTAuthenticator struct {
+	config JWTConfig
+}
+
+// NewJWTAuthenticator creates a JWT authenticator
+func NewJWTAuthenticator(config JWTConfig) (*JWTAuthenticator, error) {
+	if len(config.HS256Secret) == 0 && config.RS256PublicKey == nil {
+		return nil, fmt.Errorf("jwt: no verification key configured")
+	}
+	if config.TenantClaim == "" {
+		config.TenantClaim = "tenant_id"
+	}
+	if config.Leeway == 0 {
+		config.Leeway = time.Minute
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &JWTAuthenticator{config: config}, nil
+}
+
+// ParseRSAPublicKey parses a PEM encoded RSA public key (PKIX or PKCS#1)
+func ParseRSAPublicKey(pemBytes []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("jwt: no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: failed to parse public key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("jwt: public key is not RSA")
+	}
+	return key, nil
+}
+
+// Authenticate implements Authenticator for "Authorization: Bearer <token>"
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (Principal, error) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return Principal{}, ErrNoCredentials
+	}
+
+	claims, err := a.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	tenant, _ := claims[a.config.TenantClaim].(string)
+	if tenant == "" {
+		return Principal{}, fmt.Errorf("%w: missing %q claim", ErrInvalidCredentials, a.config.TenantClaim)
+	}
+	subject, _ := claims["sub"].(string)
+	return Principal{Subject: subject, TenantID: tenant, Method: "jwt"}, nil
+}
+
+// Verify checks the token signature and registered claims and returns all claims
+func (a *JWTAuthenticator) Verify(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+
+	// The algorithm must match a configured key; "none" and unknown algorithms are rejected
+	switch header.Alg {
+	case "HS256":
+		if len(a.config.HS256Secret) == 0 {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		mac := hmac.New(sha256.New, a.config.HS256Secret)
+		mac.Write(signed)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return nil, errors.New("signature mismatch")
+		}
+	case "RS256":
+		if a.config.RS256PublicKey == nil {
+			return nil, errors.New("RS256 tokens are not accepted")
+		}
+		digest := sha256.Sum256(signed)
+		if err := rsa.VerifyPKCS1v15(a.config.RS256PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return nil, errors.New("signature mismatch")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", header.Alg)
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid claims: %w", err)
+	}
+	if err := a.validateClaims(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// validateClaims checks exp, nbf, iss and aud
+func (a *JWTAuthenticator) validateClaims(claims map[string]interface{}) error {
+	now := a.config.Now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("missing exp claim")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(a.config.Leeway)) {
+		return errors.New("token expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(a.config.Leeway).Before(time.Unix(int64(nbf), 0)) {
+		return errors.New("token not yet valid")
+	}
+
+	if a.config.Issuer != "" {
+		if iss, _ := claims["iss"].(string); iss != a.config.Issuer {
+			return fmt.Errorf("unexpected issuer %q", iss)
+		}
+	}
+	if a.config.Audience != "" && !hasAudience(claims["aud"], a.config.Audience) {
+		return errors.New("token not issued for this audience")
+	}
+	return nil
+}
+
+// hasAudience handles "aud" as a string or an array of strings
+func hasAudience(aud interface{}, want string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == want
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
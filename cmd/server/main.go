@@ -0,0 +1,48 @@
+// Command server runs the statement classification API
+//
+// Usage:
+//
+//	server [-config server.json]
+//
+// Settings are read from the JSON config file (default: $SERVER_CONFIG) and
+// overridden by environment variables; see server.Config. SIGINT or SIGTERM
+// stops accepting connections and drains in-flight requests before exiting.
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"classify/logging"
+	"classify/server"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("SERVER_CONFIG"), "JSON config file (environment variables override it)")
+	flag.Parse()
+
+	// Structured JSON logs with PII redaction (also captures the standard log package)
+	logging.Setup()
+
+	config, err := server.LoadConfig(*configPath)
+	if err != nil {
+		slog.Error("invalid server configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	srv, err := server.New(config)
+	if err != nil {
+		slog.Error("server not configured", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	if err := srv.Run(ctx); err != nil {
+		slog.Error("server failed", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,447 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"classify/extractor"
+	"classify/statement_analysis_engine_rules/analytics"
+	"classify/statement_analysis_engine_rules/analyzer"
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/statement_analysis_engine_rules/rules"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// runParse implements "stmtctl parse"
+func runParse(args []string) error {
+	fs := flag.NewFlagSet("parse", flag.ExitOnError)
+	var opts options
+	opts.register(fs, formatJSON, formatJSON, formatCSV, formatTable)
+	fs.Parse(args)
+	if err := opts.validate(formatJSON, formatCSV, formatTable); err != nil {
+		return err
+	}
+
+	inputs, err := resolveInputs(fs.Args())
+	if err != nil {
+		return err
+	}
+	return runBatch(inputs, opts, func(name string) (result, error) {
+		statement, err := loadStatement(name)
+		if err != nil {
+			return result{}, err
+		}
+		return result{Doc: statement, Table: transactionTable(statement)}, nil
+	})
+}
+
+// runClassify implements "stmtctl classify"
+func runClassify(args []string) error {
+	fs := flag.NewFlagSet("classify", flag.ExitOnError)
+	var opts options
+	opts.register(fs, formatTable, formatTable, formatCSV, formatJSON)
+	trace := fs.Bool("trace", false, "record every classification layer's decision in classificationMetadata.trace (JSON only)")
+	fs.Parse(args)
+	if err := opts.validate(formatTable, formatCSV, formatJSON); err != nil {
+		return err
+	}
+	if *trace && opts.format != formatJSON {
+		return fmt.Errorf("-trace needs -format json")
+	}
+
+	inputs, err := resolveInputs(fs.Args())
+	if err != nil {
+		return err
+	}
+	return runBatch(inputs, opts, func(name string) (result, error) {
+		statement, err := loadStatement(name)
+		if err != nil {
+			return result{}, err
+		}
+		if *trace {
+			return result{Doc: classifier.TraceTransactions(convertTransactions(statement), opts.customerFor(statement), opts.own)}, nil
+		}
+		classified := classifyStatement(statement, opts.customerFor(statement), opts.own)
+		return result{Doc: classified, Table: classificationTable(classified)}, nil
+	})
+}
+
+// runAnalyze implements "stmtctl analyze"
+func runAnalyze(args []string) error {
+	fs := flag.NewFlagSet("analyze", flag.ExitOnError)
+	var opts options
+	opts.register(fs, formatJSON, formatJSON)
+	fs.Parse(args)
+	if err := opts.validate(formatJSON); err != nil {
+		return err
+	}
+
+	inputs, err := resolveInputs(fs.Args())
+	if err != nil {
+		return err
+	}
+	asOf, _ := opts.asOfTime()
+	paired := pairStatements(inputs, opts)
+	return runBatch(inputs, opts, func(name string) (result, error) {
+		statement, err := loadStatement(name)
+		if err != nil {
+			return result{}, err
+		}
+
+		transactions, ok := paired[name]
+		if !ok {
+			transactions = convertTransactions(statement)
+		}
+		analyzerInstance := analyzer.NewAnalyzer()
+		analyzerInstance.SetOverrides(opts.own)
+		analyzerInstance.AddTransactions(transactions)
+		analyzerInstance.SetStatementTotals(statement.Summary.TotalCredits, statement.Summary.TotalDebits)
+		if !asOf.IsZero() {
+			analyzerInstance.SetAsOf(asOf)
+		}
+
+		response := analyzerInstance.Analyze(
+			statement.AccountInfo.AccountNo,
+			opts.customerFor(statement),
+			fmt.Sprintf("%s - %s", statement.StatementPeriod.FromDate, statement.StatementPeriod.ToDate),
+			statement.Summary.OpeningBalance,
+			statement.Summary.ClosingBalance,
+		)
+		return result{Doc: response}, nil
+	})
+}
+
+// pairStatements classifies every statement when several are analyzed together and pairs the
+// transfers between their accounts (analytics.PairInternalTransfers), so each account summary
+// leaves them out of income and expense. Statements that fail to load are left to runBatch
+func pairStatements(inputs []string, opts options) map[string][]models.ClassifiedTransaction {
+	if len(inputs) < 2 {
+		return nil
+	}
+	names := make([]string, 0, len(inputs))
+	statements := make([]analytics.AccountTransactions, 0, len(inputs))
+	for _, name := range inputs {
+		statement, err := loadStatement(name)
+		if err != nil {
+			continue
+		}
+		names = append(names, name)
+		statements = append(statements, analytics.AccountTransactions{
+			AccountNo:    statement.AccountInfo.AccountNo,
+			Transactions: classifyStatement(statement, opts.customerFor(statement), opts.own),
+		})
+	}
+	if pairs := analytics.PairInternalTransfers(statements, analytics.DefaultTransferWindowDays); pairs > 0 {
+		fmt.Fprintf(os.Stderr, "stmtctl: paired %d internal transfer(s) across %d statements\n", pairs, len(statements))
+	}
+
+	paired := make(map[string][]models.ClassifiedTransaction, len(names))
+	for i, name := range names {
+		paired[name] = statements[i].Transactions
+	}
+	return paired
+}
+
+// runExplain implements "stmtctl explain <row> [input]"
+func runExplain(args []string) error {
+	fs := flag.NewFlagSet("explain", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: stmtctl explain [flags] <row> [input]")
+		fs.PrintDefaults()
+	}
+	var opts options
+	opts.register(fs, formatTable, formatTable, formatJSON)
+	fs.Parse(args)
+	if err := opts.validate(formatTable, formatJSON); err != nil {
+		return err
+	}
+
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		return fmt.Errorf("explain takes a row number and at most one input")
+	}
+	row, err := strconv.Atoi(fs.Arg(0))
+	if err != nil || row < 1 {
+		return fmt.Errorf("invalid row %q (rows are numbered from 1)", fs.Arg(0))
+	}
+
+	inputs, err := resolveInputs(fs.Args()[1:])
+	if err != nil {
+		return err
+	}
+	if len(inputs) != 1 {
+		return fmt.Errorf("explain needs exactly one input, got %d", len(inputs))
+	}
+
+	return runBatch(inputs, opts, func(name string) (result, error) {
+		statement, err := loadStatement(name)
+		if err != nil {
+			return result{}, err
+		}
+		if row > len(statement.Transactions) {
+			return result{}, fmt.Errorf("row %d out of range (statement has %d transactions)", row, len(statement.Transactions))
+		}
+		txn := convertTransactions(statement)[row-1]
+		exp := explainTransaction(row, txn, opts.customerFor(statement), opts.own)
+		return result{Doc: exp, Table: exp.table()}, nil
+	})
+}
+
+// customerFor returns the -customer override or the statement's account holder
+func (o *options) customerFor(statement *extractor.TxtAccountStatement) string {
+	if o.customer != "" {
+		return o.customer
+	}
+	return statement.AccountInfo.AccountHolderName
+}
+
+// convertTransactions converts extracted transactions to unclassified transactions
+func convertTransactions(statement *extractor.TxtAccountStatement) []models.ClassifiedTransaction {
+	transactions := make([]models.ClassifiedTransaction, 0, len(statement.Transactions))
+	for _, txn := range statement.Transactions {
+		transactions = append(transactions, classifier.ConvertFromTxtTransaction(
+			txn.Date,
+			txn.Narration,
+			txn.ChequeRefNo,
+			txn.ValueDate,
+			txn.WithdrawalAmt,
+			txn.DepositAmt,
+			txn.ClosingBalance,
+		))
+	}
+	return transactions
+}
+
+// classifyStatement converts and classifies every transaction in the statement
+// own holds the user's registered accounts (nil for none)
+func classifyStatement(statement *extractor.TxtAccountStatement, customerName string, own *overrides.Set) []models.ClassifiedTransaction {
+	return classifier.ClassifyTransactions(convertTransactions(statement), customerName, own)
+}
+
+// transactionTable renders the extracted transactions
+func transactionTable(statement *extractor.TxtAccountStatement) *table {
+	t := &table{
+		Header: []string{"Row", "Date", "Narration", "ChequeRefNo", "ValueDate", "WithdrawalAmt", "DepositAmt", "ClosingBalance"},
+		Rows:   make([][]string, 0, len(statement.Transactions)),
+	}
+	for i, txn := range statement.Transactions {
+		t.Rows = append(t.Rows, []string{
+			strconv.Itoa(i + 1),
+			txn.Date,
+			txn.Narration,
+			txn.ChequeRefNo,
+			txn.ValueDate,
+			formatAmount(txn.WithdrawalAmt),
+			formatAmount(txn.DepositAmt),
+			formatAmount(txn.ClosingBalance),
+		})
+	}
+	return t
+}
+
+// classificationTable renders classified transactions with their classification metadata
+func classificationTable(transactions []models.ClassifiedTransaction) *table {
+	t := &table{
+		Header: []string{
+			"Row", "Date", "Debit", "Credit", "Method", "Category", "Merchant", "Beneficiary",
+			"Confidence", "Gateway", "Channel", "RuleVersion", "MatchedKeywords", "Reason", "Narration",
+		},
+		Rows: make([][]string, 0, len(transactions)),
+	}
+	for i, txn := range transactions {
+		meta := txn.ClassificationMetadata
+		t.Rows = append(t.Rows, []string{
+			strconv.Itoa(i + 1),
+			txn.Date,
+			formatAmount(txn.WithdrawalAmt),
+			formatAmount(txn.DepositAmt),
+			txn.Method,
+			txn.Category,
+			txn.Merchant,
+			txn.Beneficiary,
+			strconv.FormatFloat(meta.Confidence, 'f', 2, 64),
+			meta.Gateway,
+			meta.Channel,
+			meta.RuleVersion,
+			strings.Join(meta.MatchedKeywords, ";"),
+			meta.Reason,
+			txn.Narration,
+		})
+	}
+	return t
+}
+
+// explanation shows the signals each classification layer extracted for one transaction
+type explanation struct {
+	Row                 int                           `json:"row"`
+	Date                string                        `json:"date"`
+	Narration           string                        `json:"narration"`
+	NormalizedNarration string                        `json:"normalizedNarration"`
+	Method              string                        `json:"method"`
+	Gateway             string                        `json:"gateway"`
+	RawMerchant         string                        `json:"rawMerchant"`
+	CanonicalMerchant   string                        `json:"canonicalMerchant"`
+	KnownMerchant       string                        `json:"knownMerchant"`
+	KnownMerchantCat    string                        `json:"knownMerchantCategory"`
+	IntentScores        map[string]float64            `json:"intentScores"`
+	RuleCategory        string                        `json:"ruleCategory"` // Category proposed by the category rules before overrides
+	RuleReason          string                        `json:"ruleReason"`
+	FinalCategory       string                        `json:"finalCategory"`
+	Beneficiary         string                        `json:"beneficiary"`
+	UPI                 *models.UPIDetails            `json:"upi,omitempty"`
+	NarrationFields     *models.NarrationFields       `json:"narrationFields,omitempty"`
+	Metadata            models.ClassificationMetadata `json:"classificationMetadata"` // Trace holds every layer's decision
+}
+
+// explainTransaction re-runs the classification layers for one transaction and records what each produced
+// own holds the user's registered accounts (nil for none)
+func explainTransaction(row int, txn models.ClassifiedTransaction, customerName string, own *overrides.Set) explanation {
+	normalized := utils.NormalizeNarration(txn.Narration)
+	rawMerchant := rules.ExtractMerchantName(normalized)
+	if rawMerchant == "Unknown" {
+		rawMerchant = ""
+	}
+	canonical, _ := utils.CanonicalizeMerchant(rawMerchant)
+	merchant := canonical
+	if merchant == "" {
+		merchant = rawMerchant
+	}
+	knownName, knownCategory, _ := utils.DetectKnownMerchant(normalized, merchant)
+
+	amount := txn.WithdrawalAmt
+	if txn.DepositAmt > amount {
+		amount = txn.DepositAmt
+	}
+	classified := classifier.TraceTransaction(txn, customerName, own)
+	upiKind := ""
+	if classified.UPI != nil {
+		upiKind = classified.UPI.Kind
+	}
+	ruleResult := rules.ClassifyCategoryWithUPIKind(normalized, merchant, amount, upiKind)
+	return explanation{
+		Row:                 row,
+		Date:                txn.Date,
+		Narration:           txn.Narration,
+		NormalizedNarration: normalized,
+		Method:              classified.Method,
+		Gateway:             utils.ExtractGateway(normalized),
+		RawMerchant:         rawMerchant,
+		CanonicalMerchant:   canonical,
+		KnownMerchant:       knownName,
+		KnownMerchantCat:    knownCategory,
+		IntentScores:        utils.DetectIntentKeywords(normalized),
+		RuleCategory:        ruleResult.Category,
+		RuleReason:          ruleResult.Reason,
+		FinalCategory:       classified.Category,
+		Beneficiary:         classified.Beneficiary,
+		UPI:                 classified.UPI,
+		NarrationFields:     classified.NarrationFields,
+		Metadata:            classified.ClassificationMetadata,
+	}
+}
+
+// table renders the explanation as field/value rows
+func (e explanation) table() *table {
+	intents := make([]string, 0, len(e.IntentScores))
+	for category, score := range e.IntentScores {
+		intents = append(intents, fmt.Sprintf("%s=%.2f", category, score))
+	}
+	sort.Strings(intents)
+
+	model := ""
+	if prediction := e.Metadata.Model; prediction != nil {
+		model = fmt.Sprintf("%s p=%.2f (%s) from %s", prediction.Category, prediction.Probability, prediction.Version, strings.Join(prediction.Features, ", "))
+		if !prediction.Applied {
+			model += " - not applied"
+		}
+	}
+
+	t := &table{
+		Header: []string{"Field", "Value"},
+		Rows: [][]string{
+			{"Row", strconv.Itoa(e.Row)},
+			{"Date", e.Date},
+			{"Narration", e.Narration},
+			{"Normalized", e.NormalizedNarration},
+			{"Method", e.Method},
+			{"Gateway", e.Gateway},
+			{"Merchant (raw)", e.RawMerchant},
+			{"Merchant (canonical)", e.CanonicalMerchant},
+			{"Known merchant", strings.TrimSpace(e.KnownMerchant + " " + e.KnownMerchantCat)},
+			{"Intent keywords", strings.Join(intents, ", ")},
+			{"Rule category", e.RuleCategory},
+			{"Rule reason", e.RuleReason},
+			{"Final category", e.FinalCategory},
+			{"Beneficiary", e.Beneficiary},
+			{"Confidence", strconv.FormatFloat(e.Metadata.Confidence, 'f', 2, 64)},
+			{"Matched keywords", strings.Join(e.Metadata.MatchedKeywords, ", ")},
+			{"Rule version", e.Metadata.RuleVersion},
+			{"Reason", e.Metadata.Reason},
+			{"Model", model},
+		},
+	}
+	if upi := e.UPI; upi != nil {
+		t.Rows = append(t.Rows,
+			[]string{"UPI counterparty", strings.TrimSpace(fmt.Sprintf("%s %s %s", upi.Direction, upi.Name, upi.Account))},
+			[]string{"UPI VPA", strings.TrimSpace(upi.VPA + " " + upi.PSPBank)},
+			[]string{"UPI kind", strings.TrimSpace(fmt.Sprintf("%s %s", upi.Kind, upi.KindBasis))},
+			[]string{"UPI reference", strings.TrimSpace(upi.Reference + " " + upi.IFSC)},
+			[]string{"UPI remark", upi.Remark},
+		)
+	}
+	if fields := e.NarrationFields; fields != nil {
+		t.Rows = append(t.Rows,
+			[]string{fields.Rail + " counterparty", strings.TrimSpace(fmt.Sprintf("%s %s %s", fields.Direction, fields.Name, fields.Account))},
+			[]string{fields.Rail + " bank", strings.TrimSpace(fields.IFSC + " " + fields.Bank)},
+			[]string{fields.Rail + " reference", strings.TrimSpace(fields.Reference + " " + fields.MandateID)},
+			[]string{fields.Rail + " remark", fields.Remark},
+		)
+	}
+	if trace := e.Metadata.Trace; trace != nil {
+		for i, step := range trace.Steps {
+			t.Rows = append(t.Rows, []string{fmt.Sprintf("Trace %d %s", i+1, step.Layer), traceStepValue(step)})
+		}
+		t.Rows = append(t.Rows, []string{"Decided by", trace.Layer})
+	}
+	return t
+}
+
+// traceStepValue renders a trace step as "output (overrode X) - reason [candidates]"
+func traceStepValue(step models.TraceStep) string {
+	value := step.Output
+	if step.Overrode != "" {
+		value += " (overrode " + step.Overrode + ")"
+	}
+	if step.Reason != "" {
+		value += " - " + step.Reason
+	}
+	if len(step.Candidates) > 0 {
+		candidates := make([]string, 0, len(step.Candidates))
+		for _, c := range step.Candidates {
+			candidate := c.Value
+			if c.Score != 0 {
+				candidate += fmt.Sprintf("=%.2f", c.Score)
+			}
+			if c.Source != "" {
+				candidate += " (" + c.Source + ")"
+			}
+			candidates = append(candidates, candidate)
+		}
+		value += " [" + strings.Join(candidates, "; ") + "]"
+	}
+	return strings.TrimSpace(value)
+}
+
+// formatAmount prints amounts without trailing noise (empty for zero)
+func formatAmount(amount float64) string {
+	if amount == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testAccountNo is the account number of testStatement
+const testAccountNo = "50100123456789"
+
+// testStatement is a two-row statement in the bank's text layout
+const testStatement = `HDFC BANK Ltd.                                     Page No .:   1                                        Statement of accounts
+
+MR.     TEST ACCOUNT HOLDER                                                               OFF MAIN ROAD,
+JOINT HOLDERS :                                                          Account No     : ` + testAccountNo + `   SAVINGS
+Statement From      : 01/04/2025  To: 30/04/2025                         RTGS/NEFT IFSC : HDFC0000001    MICR : 400240001
+--------  ----------------------------------------  ----------------  --------  ------------------  ------------------  ------------------
+Date      Narration                                 Chq./Ref.No.      Value Dt  Withdrawal Amt.        Deposit Amt.     Closing Balance
+--------  ----------------------------------------  ----------------  --------  ------------------  ------------------  ------------------
+
+01/04/25  UPI-SHYAM MEDICOS-PAYTMQR6AQSV7@PTYS-YES  0000102426356501  01/04/25             100.00                               9,900.00
+          B0PTMUPI-102426356501-UPI
+02/04/25  NEFT CR-HDFC0000001-ACME PAYROLL LTD-SAL  0000000000000001  02/04/25                             50,000.00           59,900.00
+
+********  ****************************************  ****************  ********  ******************  ******************  ******************
+
+         STATEMENT SUMMARY  :-
+           Opening Balance                                                      Debits              Credits          Closing Bal
+                 10,000.00                                                      100.00            50,000.00            59,900.00
+
+                                                                              Dr Count             Cr Count
+                                                                                     1                    1
+`
+
+// captureStderr returns what fn writes to stderr, including slog output
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	file, err := os.CreateTemp(t.TempDir(), "stderr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	saved, savedLogger := os.Stderr, slog.Default()
+	os.Stderr = file
+	// The plain handler at Debug is the worst case: nothing is redacted or filtered
+	slog.SetDefault(slog.New(slog.NewTextHandler(file, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	defer func() {
+		os.Stderr = saved
+		slog.SetDefault(savedLogger)
+	}()
+
+	fn()
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestAnalyzeKeepsAccountNumberOffStderr(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statement.txt")
+	if err := os.WriteFile(path, []byte(testStatement), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var out string
+	var err error
+	stderr := captureStderr(t, func() {
+		out = captureStdout(t, func() {
+			err = runAnalyze([]string{path})
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "accountSummary") {
+		t.Fatalf("expected the analysis on stdout, got %q", out)
+	}
+	if !strings.Contains(stderr, "analysis complete") {
+		t.Errorf("expected the analyzer's log on stderr, got %q", stderr)
+	}
+	if strings.Contains(stderr, testAccountNo) {
+		t.Errorf("expected no raw account number on stderr, got %q", stderr)
+	}
+}
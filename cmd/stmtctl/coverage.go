@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/coverage"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/textmodel"
+)
+
+// runCoverage implements "stmtctl coverage [flags] [inputs...]"
+//
+// The corpus is the transactions of the input statements plus the lines of a
+// -narrations file; the report covers the active rule pack (-rules) and is
+// written as JSON so successive rule releases can be diffed
+func runCoverage(args []string) error {
+	fs := flag.NewFlagSet("coverage", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: stmtctl coverage [flags] [inputs...]")
+		fs.PrintDefaults()
+	}
+	var (
+		out        = fs.String("o", "", "write the report to this file instead of stdout")
+		narrations = fs.String("narrations", "", "file of narrations, one per line, added to the corpus")
+		rulePack   = fs.String("rules", os.Getenv("RULE_PACK"), "classification rule pack, JSON (default: built-in)")
+		modelFile  = fs.String("model", os.Getenv("CATEGORY_MODEL"), "statistical fallback model from \"stmtctl train\" (default: none)")
+		customer   = fs.String("customer", "", "account holder name for self-transfer detection (default: from statement)")
+	)
+	fs.Parse(args)
+	if *narrations == "" && fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("coverage needs -narrations or statement inputs")
+	}
+	if *rulePack != "" {
+		pack, err := rulepack.LoadFile(*rulePack)
+		if err != nil {
+			return err
+		}
+		if err := rulepack.Activate(pack); err != nil {
+			return err
+		}
+	}
+	if *modelFile != "" {
+		model, err := textmodel.LoadFile(*modelFile)
+		if err != nil {
+			return err
+		}
+		if err := textmodel.Activate(model); err != nil {
+			return err
+		}
+	}
+
+	collector := coverage.NewCollector()
+	if *narrations != "" {
+		lines, err := readNarrations(*narrations)
+		if err != nil {
+			return err
+		}
+		collector.AddNarrations(lines)
+	}
+	if fs.NArg() > 0 {
+		inputs, err := resolveInputs(fs.Args())
+		if err != nil {
+			return err
+		}
+		for _, name := range inputs {
+			statement, err := loadStatement(name)
+			if err != nil {
+				return err
+			}
+			customerName := *customer
+			if customerName == "" {
+				customerName = statement.AccountInfo.AccountHolderName
+			}
+			collector.Add(convertTransactions(statement), customerName)
+		}
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed to create report: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(collector.Report())
+}
+
+// readNarrations reads one narration per non-blank line
+func readNarrations(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open narrations: %w", err)
+	}
+	defer file.Close()
+
+	var narrations []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			narrations = append(narrations, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return narrations, nil
+}
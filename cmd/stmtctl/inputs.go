@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"classify/extractor"
+)
+
+// stdinName is the input name used for statements read from stdin
+const stdinName = "-"
+
+// resolveInputs expands the command-line inputs into a sorted, de-duplicated list of statement files
+// Directories contribute every *.txt file inside them (recursively), globs are expanded,
+// and no arguments (or "-") means a single statement on stdin
+func resolveInputs(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return []string{stdinName}, nil
+	}
+
+	seen := make(map[string]bool)
+	inputs := make([]string, 0, len(args))
+	add := func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			inputs = append(inputs, path)
+		}
+	}
+
+	for _, arg := range args {
+		if arg == stdinName {
+			if len(args) > 1 {
+				return nil, fmt.Errorf("stdin (-) cannot be combined with other inputs")
+			}
+			return []string{stdinName}, nil
+		}
+
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", arg, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no input matches %q", arg)
+		}
+
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil {
+				return nil, err
+			}
+			if !info.IsDir() {
+				add(match)
+				continue
+			}
+			files, err := statementFilesIn(match)
+			if err != nil {
+				return nil, err
+			}
+			for _, file := range files {
+				add(file)
+			}
+		}
+	}
+
+	sort.Strings(inputs)
+	return inputs, nil
+}
+
+// statementFilesIn lists every *.txt file below dir
+func statementFilesIn(dir string) ([]string, error) {
+	files := make([]string, 0)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.EqualFold(filepath.Ext(path), ".txt") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan directory %s: %w", dir, err)
+	}
+	return files, nil
+}
+
+// loadStatement parses one statement from a file or stdin
+func loadStatement(name string) (*extractor.TxtAccountStatement, error) {
+	var r io.Reader = os.Stdin
+	if name != stdinName {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file: %w", err)
+		}
+		defer file.Close()
+		r = file
+	}
+
+	statement, err := extractor.ReadAccountStatement(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return statement, nil
+}
@@ -0,0 +1,79 @@
+// Command stmtctl runs the statement pipeline from the command line
+//
+// Usage:
+//
+//	stmtctl parse    [flags] [inputs...]        extracted statement (JSON or CSV)
+//	stmtctl classify [flags] [inputs...]        per-transaction classification table
+//	stmtctl analyze  [flags] [inputs...]        full ClassifyResponse (JSON)
+//	stmtctl explain  [flags] <row> [input]      classification trace for one transaction
+//	stmtctl train    -o model.json [inputs...]  train the statistical fallback model
+//	stmtctl coverage [flags] [inputs...]        rule coverage report of the rule pack (JSON)
+//	stmtctl replay   -after pack [inputs...]    classification diff between two rule packs (JSON)
+//	stmtctl merchants suggest [inputs...]       unresolved merchant strings to curate (JSON)
+//	stmtctl merchants approve -name merchant    add a merchant, alias or VPA to a knowledge base file
+//
+// Inputs may be files, glob patterns or directories (all *.txt files inside).
+// No input or "-" reads a single statement from stdin.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "parse":
+		err = runParse(os.Args[2:])
+	case "classify":
+		err = runClassify(os.Args[2:])
+	case "analyze":
+		err = runAnalyze(os.Args[2:])
+	case "explain":
+		err = runExplain(os.Args[2:])
+	case "train":
+		err = runTrain(os.Args[2:])
+	case "coverage":
+		err = runCoverage(os.Args[2:])
+	case "replay":
+		err = runReplay(os.Args[2:])
+	case "merchants":
+		err = runMerchants(os.Args[2:])
+	case "help", "-h", "--help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "stmtctl: unknown command %q\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "stmtctl:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprint(os.Stderr, `Usage: stmtctl <command> [flags] [inputs...]
+
+Commands:
+  parse     Extract the statement (account info, transactions, summary)
+  classify  Classify every transaction and print the classification metadata
+  analyze   Run the full analysis and print the ClassifyResponse
+  explain   Show how a single transaction (1-based row) was classified
+  train     Train the statistical fallback model from labels, corrections and statements
+  coverage  Report which rules and keywords fire, dead and shadowed rules and layer conflicts
+  replay    Reclassify statements under two rule packs and report what changed
+  merchants Suggest aliases for unresolved merchants (suggest) or add them to a knowledge base (approve)
+
+Inputs are files, glob patterns or directories; "-" or no input reads stdin.
+Run "stmtctl <command> -h" for command flags.
+`)
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/merchantkb"
+)
+
+// runMerchants implements "stmtctl merchants suggest|approve [flags]"
+//
+// suggest lists recurring merchant strings the knowledge base does not resolve;
+// approve adds a curated merchant, alias or VPA to a knowledge base file
+func runMerchants(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("merchants needs a subcommand: suggest or approve")
+	}
+	switch args[0] {
+	case "suggest":
+		return runMerchantsSuggest(args[1:])
+	case "approve":
+		return runMerchantsApprove(args[1:])
+	default:
+		return fmt.Errorf("unknown merchants subcommand %q (want suggest or approve)", args[0])
+	}
+}
+
+// runMerchantsSuggest implements "stmtctl merchants suggest [flags] [inputs...]"
+func runMerchantsSuggest(args []string) error {
+	flags := flag.NewFlagSet("merchants suggest", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: stmtctl merchants suggest [flags] [inputs...]")
+		flags.PrintDefaults()
+	}
+	var (
+		out      = flags.String("o", "", "write the suggestions to this file instead of stdout")
+		kbFile   = flags.String("merchants", os.Getenv("MERCHANT_KB"), "merchant knowledge base, JSON (default: built-in)")
+		minCount = flags.Int("min-count", 2, "minimum occurrences of an unresolved merchant string")
+		customer = flags.String("customer", "", "account holder name for self-transfer detection (default: from statement)")
+	)
+	flags.Parse(args)
+	if *kbFile != "" {
+		kb, err := merchantkb.LoadFile(*kbFile)
+		if err != nil {
+			return err
+		}
+		merchantkb.Activate(kb)
+	}
+
+	inputs, err := resolveInputs(flags.Args())
+	if err != nil {
+		return err
+	}
+	learner := merchantkb.NewLearner(nil)
+	for _, name := range inputs {
+		statement, err := loadStatement(name)
+		if err != nil {
+			return err
+		}
+		customerName := *customer
+		if customerName == "" {
+			customerName = statement.AccountInfo.AccountHolderName
+		}
+		learner.Add(classifier.ClassifyTransactions(convertTransactions(statement), customerName, nil))
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed to create suggestions: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(learner.Suggestions(*minCount))
+}
+
+// runMerchantsApprove implements "stmtctl merchants approve -merchants file -name merchant [flags]"
+//
+// A missing knowledge base file starts from the built-in one
+func runMerchantsApprove(args []string) error {
+	flags := flag.NewFlagSet("merchants approve", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: stmtctl merchants approve -merchants file -name merchant [flags]")
+		flags.PrintDefaults()
+	}
+	var (
+		kbFile   = flags.String("merchants", os.Getenv("MERCHANT_KB"), "merchant knowledge base to update, JSON (required)")
+		name     = flags.String("name", "", "canonical merchant name, new or existing (required)")
+		aliases  = flags.String("alias", "", "comma-separated aliases to add")
+		vpas     = flags.String("vpa", "", "comma-separated VPAs to add")
+		category = flags.String("category", "", "category of the merchant")
+		brand    = flags.String("brand", "", "brand group of the merchant")
+	)
+	flags.Parse(args)
+	if *kbFile == "" || *name == "" {
+		flags.Usage()
+		return fmt.Errorf("approve needs a knowledge base file (-merchants) and a merchant name (-name)")
+	}
+
+	kb, err := merchantkb.LoadFile(*kbFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		kb, err = merchantkb.Default(), nil
+	}
+	if err != nil {
+		return err
+	}
+	merchant := merchantkb.Merchant{
+		Name:     *name,
+		Brand:    *brand,
+		Category: *category,
+		Aliases:  splitList(*aliases),
+		VPAs:     splitList(*vpas),
+	}
+	if err := kb.AddMerchant(merchant); err != nil {
+		return err
+	}
+	return kb.Save(*kbFile)
+}
+
+// splitList splits a comma-separated flag value, dropping blank entries
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,350 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"classify/statement_analysis_engine_rules/merchantkb"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/taxonomy"
+	"classify/statement_analysis_engine_rules/textmodel"
+)
+
+// Output formats
+const (
+	formatJSON  = "json"
+	formatCSV   = "csv"
+	formatTable = "table"
+)
+
+// result is what a command produces for one statement
+type result struct {
+	Doc   interface{} // JSON document
+	Table *table      // Tabular view (nil if the command only supports JSON)
+}
+
+// table is a header plus rows of already-formatted cells
+type table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// options holds the flags shared by every command
+type options struct {
+	format   string
+	outDir   string
+	workers  int
+	customer string
+	asOf     string
+	rules    string
+	model    string
+	taxonomy string
+	kb       string
+	accounts string
+	own      *overrides.Set // The -own-accounts as account overrides
+}
+
+// register adds the shared flags to fs
+func (o *options) register(fs *flag.FlagSet, defaultFormat string, formats ...string) {
+	fs.StringVar(&o.format, "format", defaultFormat, "output format: "+strings.Join(formats, ", "))
+	fs.StringVar(&o.outDir, "o", "", "write one output file per input into this directory instead of stdout")
+	fs.IntVar(&o.workers, "j", runtime.NumCPU(), "number of statements processed in parallel")
+	fs.StringVar(&o.customer, "customer", "", "account holder name for self-transfer detection (default: from statement)")
+	fs.StringVar(&o.asOf, "as-of", "", "reference date YYYY-MM-DD for relative insights (default: statement end date)")
+	fs.StringVar(&o.rules, "rules", os.Getenv("RULE_PACK"), "classification rule pack, JSON (default: built-in)")
+	fs.StringVar(&o.model, "model", os.Getenv("CATEGORY_MODEL"), "statistical fallback model from \"stmtctl train\" (default: none)")
+	fs.StringVar(&o.taxonomy, "taxonomy", os.Getenv("CATEGORY_TAXONOMY"), "category hierarchy for the category summary, JSON (default: built-in)")
+	fs.StringVar(&o.kb, "merchants", os.Getenv("MERCHANT_KB"), "merchant knowledge base, JSON (default: built-in)")
+	fs.StringVar(&o.accounts, "own-accounts", os.Getenv("OWN_ACCOUNTS"), "your own accounts for self-transfer detection: comma-separated account numbers, last 4 digits or VPAs")
+}
+
+// validate checks the flag values after parsing
+func (o *options) validate(formats ...string) error {
+	valid := false
+	for _, f := range formats {
+		if o.format == f {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("unsupported format %q (want %s)", o.format, strings.Join(formats, ", "))
+	}
+	if o.workers < 1 {
+		o.workers = 1
+	}
+	if _, err := o.asOfTime(); err != nil {
+		return err
+	}
+	if o.accounts != "" {
+		accounts := make([]overrides.Override, 0)
+		for i, match := range splitList(o.accounts) {
+			account := overrides.Override{ID: fmt.Sprintf("account-%d", i+1), Type: overrides.TypeAccount, Match: match}
+			if err := account.Validate(); err != nil {
+				return fmt.Errorf("invalid -own-accounts: %w", err)
+			}
+			accounts = append(accounts, account)
+		}
+		o.own = overrides.NewSet(accounts)
+	}
+	if o.rules != "" {
+		pack, err := rulepack.LoadFile(o.rules)
+		if err != nil {
+			return err
+		}
+		if err := rulepack.Activate(pack); err != nil {
+			return err
+		}
+	}
+	if o.taxonomy != "" {
+		t, err := taxonomy.LoadFile(o.taxonomy)
+		if err != nil {
+			return err
+		}
+		taxonomy.Activate(t)
+	}
+	if o.kb != "" {
+		kb, err := merchantkb.LoadFile(o.kb)
+		if err != nil {
+			return err
+		}
+		merchantkb.Activate(kb)
+	}
+	if o.model != "" {
+		model, err := textmodel.LoadFile(o.model)
+		if err != nil {
+			return err
+		}
+		return textmodel.Activate(model)
+	}
+	return nil
+}
+
+// asOfTime parses the -as-of flag (zero time when unset)
+func (o *options) asOfTime() (time.Time, error) {
+	if o.asOf == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse("2006-01-02", o.asOf)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid -as-of date %q (want YYYY-MM-DD)", o.asOf)
+	}
+	return t, nil
+}
+
+// runBatch runs fn over every input on a worker pool and writes the results in input order
+// A failing input is reported on stderr and does not stop the others
+func runBatch(inputs []string, opts options, fn func(name string) (result, error)) error {
+	var names []string
+	if opts.outDir != "" {
+		// Checked up front so colliding inputs fail before any work
+		var err error
+		if names, err = outputNames(inputs); err != nil {
+			return err
+		}
+	}
+
+	results := make([]result, len(inputs))
+	errs := make([]error, len(inputs))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	workers := opts.workers
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i], errs[i] = fn(inputs[i])
+			}
+		}()
+	}
+	for i := range inputs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	failed := 0
+	ok := make([]int, 0, len(inputs))
+	for i, err := range errs {
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "stmtctl: %s: %v\n", inputs[i], err)
+			continue
+		}
+		ok = append(ok, i)
+	}
+
+	var writeErr error
+	if opts.outDir != "" {
+		writeErr = writeToDir(inputs, names, results, ok, opts)
+	} else {
+		writeErr = writeToStdout(inputs, results, ok, opts, len(inputs) > 1)
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inputs failed", failed, len(inputs))
+	}
+	return nil
+}
+
+// outputNames returns the output file name (without the format extension) of every input:
+// its base name without extension, or, when several inputs share that name, its whole path
+// with the separators replaced by "_" (a/stmt.txt -> a_stmt.txt, stmt.csv -> stmt.csv)
+// Names are compared ignoring case, as on case-insensitive file systems; inputs that still
+// collide are an error
+func outputNames(inputs []string) ([]string, error) {
+	names := make([]string, len(inputs))
+	bases := make(map[string]int)
+	for i, input := range inputs {
+		names[i] = strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+		if input == stdinName {
+			names[i] = "stdin"
+		}
+		bases[strings.ToLower(names[i])]++
+	}
+
+	owners := make(map[string]int)
+	for i, input := range inputs {
+		if bases[strings.ToLower(names[i])] > 1 {
+			path := strings.ReplaceAll(filepath.ToSlash(filepath.Clean(input)), "/", "_")
+			names[i] = strings.TrimLeft(path, "._")
+		}
+		key := strings.ToLower(names[i])
+		if j, ok := owners[key]; ok {
+			return nil, fmt.Errorf("inputs %s and %s would both be written to %s in the output directory", inputs[j], input, names[i])
+		}
+		owners[key] = i
+	}
+	return names, nil
+}
+
+// writeToDir writes one file per input named <name>.<format>, with names from outputNames
+func writeToDir(inputs, names []string, results []result, ok []int, opts options) error {
+	if err := os.MkdirAll(opts.outDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	for _, i := range ok {
+		base := names[i]
+		ext := opts.format
+		if ext == formatTable {
+			ext = "txt"
+		}
+
+		var buf bytes.Buffer
+		if err := writeResult(&buf, results[i], opts.format, "", true); err != nil {
+			return fmt.Errorf("%s: %w", inputs[i], err)
+		}
+		path := filepath.Join(opts.outDir, base+"."+ext)
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// writeToStdout writes all results to stdout
+// With several inputs JSON becomes one {"file", "result"} document per line and
+// CSV/table output gets a leading file column with a single header
+func writeToStdout(inputs []string, results []result, ok []int, opts options, multi bool) error {
+	out := os.Stdout
+	switch {
+	case !multi:
+		if len(ok) == 0 {
+			return nil
+		}
+		return writeResult(out, results[ok[0]], opts.format, "", true)
+	case opts.format == formatJSON:
+		enc := json.NewEncoder(out)
+		for _, i := range ok {
+			doc := struct {
+				File   string      `json:"file"`
+				Result interface{} `json:"result"`
+			}{inputs[i], results[i].Doc}
+			if err := enc.Encode(doc); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		var buf bytes.Buffer
+		for n, i := range ok {
+			if err := writeResult(&buf, results[i], opts.format, inputs[i], n == 0); err != nil {
+				return fmt.Errorf("%s: %w", inputs[i], err)
+			}
+		}
+		_, err := out.Write(buf.Bytes())
+		return err
+	}
+}
+
+// writeResult renders one result in the requested format
+// file, when set, is prepended as a column; header controls whether the header row is written
+func writeResult(w io.Writer, res result, format, file string, header bool) error {
+	if format == formatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(res.Doc)
+	}
+	if res.Table == nil {
+		return fmt.Errorf("format %q is not supported by this command", format)
+	}
+
+	rows := res.Table.Rows
+	head := res.Table.Header
+	if file != "" {
+		head = append([]string{"File"}, head...)
+		withFile := make([][]string, len(rows))
+		for i, row := range rows {
+			withFile[i] = append([]string{file}, row...)
+		}
+		rows = withFile
+	}
+
+	if format == formatCSV {
+		cw := csv.NewWriter(w)
+		if header {
+			if err := cw.Write(head); err != nil {
+				return err
+			}
+		}
+		if err := cw.WriteAll(rows); err != nil {
+			return err
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if header {
+		fmt.Fprintln(tw, strings.Join(head, "\t"))
+	}
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			// Tabs and newlines would break the alignment
+			cells[i] = strings.Join(strings.Fields(cell), " ")
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
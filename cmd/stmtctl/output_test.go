@@ -0,0 +1,183 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// captureStdout returns what fn writes to stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	file, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	saved := os.Stdout
+	os.Stdout = file
+	defer func() { os.Stdout = saved }()
+
+	fn()
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// tableResult is a one-row result naming its input
+func tableResult(name string) result {
+	return result{
+		Doc:   map[string]string{"name": name},
+		Table: &table{Header: []string{"Name"}, Rows: [][]string{{name}}},
+	}
+}
+
+func TestRunBatchOrderAndErrors(t *testing.T) {
+	inputs := []string{"a.txt", "b.txt", "c.txt", "d.txt"}
+	opts := options{format: formatCSV, workers: 4}
+	var err error
+	out := captureStdout(t, func() {
+		err = runBatch(inputs, opts, func(name string) (result, error) {
+			// Earlier inputs finish last, so the output order cannot follow completion
+			time.Sleep(time.Duration(len(inputs)-int(name[0]-'a')) * 5 * time.Millisecond)
+			if name == "b.txt" {
+				return result{}, errors.New("unreadable")
+			}
+			return tableResult(name), nil
+		})
+	})
+	if err == nil || err.Error() != "1 of 4 inputs failed" {
+		t.Errorf("expected the failure count, got %v", err)
+	}
+	expected := "File,Name\na.txt,a.txt\nc.txt,c.txt\nd.txt,d.txt\n"
+	if out != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+}
+
+func TestWriteToStdoutMultipleInputs(t *testing.T) {
+	inputs := []string{"a.txt", "b.txt"}
+	results := []result{tableResult("a.txt"), tableResult("b.txt")}
+
+	out := captureStdout(t, func() {
+		if err := writeToStdout(inputs, results, []int{0, 1}, options{format: formatJSON}, true); err != nil {
+			t.Fatal(err)
+		}
+	})
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one JSON line per input, got %q", out)
+	}
+	for i, line := range lines {
+		var doc struct {
+			File   string            `json:"file"`
+			Result map[string]string `json:"result"`
+		}
+		if err := json.Unmarshal([]byte(line), &doc); err != nil {
+			t.Fatalf("line %d: %v", i, err)
+		}
+		if doc.File != inputs[i] || doc.Result["name"] != inputs[i] {
+			t.Errorf("line %d: expected %s, got %+v", i, inputs[i], doc)
+		}
+	}
+
+	out = captureStdout(t, func() {
+		if err := writeToStdout(inputs, results, []int{0, 1}, options{format: formatCSV}, true); err != nil {
+			t.Fatal(err)
+		}
+	})
+	expected := "File,Name\na.txt,a.txt\nb.txt,b.txt\n"
+	if out != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+}
+
+func TestOutputNames(t *testing.T) {
+	tests := []struct {
+		inputs   []string
+		expected []string
+	}{
+		{[]string{"a/hdfc.txt", "b/icici.txt"}, []string{"hdfc", "icici"}},
+		{[]string{"a/stmt.txt", "b/stmt.txt"}, []string{"a_stmt.txt", "b_stmt.txt"}},
+		{[]string{"stmt.csv", "stmt.txt"}, []string{"stmt.csv", "stmt.txt"}},
+		{[]string{"../x/Stmt.txt", "y/stmt.txt", "z.txt"}, []string{"x_Stmt.txt", "y_stmt.txt", "z"}},
+		{[]string{stdinName}, []string{"stdin"}},
+	}
+	for _, tt := range tests {
+		got, err := outputNames(tt.inputs)
+		if err != nil {
+			t.Errorf("%v: %v", tt.inputs, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%v: expected %v, got %v", tt.inputs, tt.expected, got)
+		}
+	}
+
+	if _, err := outputNames([]string{"x/y.txt", "x_y.txt.txt", "y.txt"}); err == nil {
+		t.Error("expected an error for inputs that still collide")
+	}
+}
+
+func TestWriteToDir(t *testing.T) {
+	dir := t.TempDir()
+	inputs := []string{"a/stmt.txt", "b/stmt.txt", "c/other.txt"}
+	results := []result{tableResult("a"), tableResult("b"), tableResult("c")}
+	names, err := outputNames(inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The failed input (b) is not written
+	if err := writeToDir(inputs, names, results, []int{0, 2}, options{format: formatTable, outDir: dir}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		files = append(files, entry.Name())
+	}
+	sort.Strings(files)
+	expected := []string{"a_stmt.txt.txt", "other.txt"}
+	if !reflect.DeepEqual(files, expected) {
+		t.Fatalf("expected %v, got %v", expected, files)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "a_stmt.txt.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(data); got != "Name\na\n" {
+		t.Errorf("expected the table of a, got %q", got)
+	}
+}
+
+func TestRunBatchRejectsCollidingOutputs(t *testing.T) {
+	calls := 0
+	err := runBatch([]string{"x/y.txt", "x_y.txt.txt", "y.txt"}, options{format: formatJSON, outDir: t.TempDir(), workers: 1},
+		func(name string) (result, error) {
+			calls++
+			return tableResult(name), nil
+		})
+	if err == nil {
+		t.Error("expected an error for colliding outputs")
+	}
+	if calls != 0 {
+		t.Errorf("expected no input to be processed, got %d", calls)
+	}
+}
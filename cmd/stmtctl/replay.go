@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/replay"
+	"classify/statement_analysis_engine_rules/rulepack"
+)
+
+// runReplay implements "stmtctl replay -after pack [flags] [inputs...]"
+//
+// Inputs are statements or stored classifications (the JSON of "stmtctl classify
+// -format json"); the diff is written as JSON. With -max-recategorized the
+// command fails when more transactions change category, so it can gate a release
+func runReplay(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: stmtctl replay -after pack [flags] [inputs...]")
+		fs.PrintDefaults()
+	}
+	var (
+		out              = fs.String("o", "", "write the diff to this file instead of stdout")
+		beforePack       = fs.String("before", "", "rule pack of the before side, JSON (default: built-in)")
+		afterPack        = fs.String("after", "", "rule pack of the after side, JSON (required)")
+		stored           = fs.Bool("stored", false, "use the classifications stored in the JSON inputs as the before side")
+		maxRecategorized = fs.Int("max-recategorized", -1, "fail when more transactions change category (-1: no limit)")
+		customer         = fs.String("customer", "", "account holder name for self-transfer detection (default: from statement)")
+	)
+	fs.Parse(args)
+	if *afterPack == "" {
+		fs.Usage()
+		return fmt.Errorf("replay needs an after rule pack (-after)")
+	}
+	if *stored && *beforePack != "" {
+		return fmt.Errorf("-stored and -before are mutually exclusive")
+	}
+
+	after, err := rulepack.LoadFile(*afterPack)
+	if err != nil {
+		return err
+	}
+	var before *rulepack.Pack
+	if !*stored {
+		before = rulepack.Default()
+		if *beforePack != "" {
+			if before, err = rulepack.LoadFile(*beforePack); err != nil {
+				return err
+			}
+		}
+	}
+
+	inputs, err := resolveInputs(fs.Args())
+	if err != nil {
+		return err
+	}
+	corpus := make([]replay.Statement, 0, len(inputs))
+	for _, name := range inputs {
+		statement, err := loadReplayStatement(name, *customer, *stored)
+		if err != nil {
+			return err
+		}
+		corpus = append(corpus, statement)
+	}
+
+	diff, err := replay.Run(corpus, before, after)
+	if err != nil {
+		return err
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed to create diff: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(diff); err != nil {
+		return err
+	}
+
+	if *maxRecategorized >= 0 && diff.Recategorized > *maxRecategorized {
+		return fmt.Errorf("%d of %d transactions changed category (limit %d)", diff.Recategorized, diff.Transactions, *maxRecategorized)
+	}
+	return nil
+}
+
+// loadReplayStatement reads a statement, or stored classifications from a .json input
+func loadReplayStatement(name, customer string, stored bool) (replay.Statement, error) {
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return replay.Statement{}, fmt.Errorf("failed to read classifications: %w", err)
+		}
+		var transactions []models.ClassifiedTransaction
+		if err := json.Unmarshal(data, &transactions); err != nil {
+			return replay.Statement{}, fmt.Errorf("%s: invalid classifications: %w", name, err)
+		}
+		return replay.Statement{Name: name, CustomerName: customer, Transactions: transactions}, nil
+	}
+	if stored {
+		return replay.Statement{}, fmt.Errorf("%s: -stored needs classifications (JSON from \"stmtctl classify -format json\")", name)
+	}
+
+	statement, err := loadStatement(name)
+	if err != nil {
+		return replay.Statement{}, err
+	}
+	if customer == "" {
+		customer = statement.AccountInfo.AccountHolderName
+	}
+	return replay.Statement{Name: name, CustomerName: customer, Transactions: convertTransactions(statement)}, nil
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/review"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/rules"
+	"classify/statement_analysis_engine_rules/textmodel"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// runTrain implements "stmtctl train -o model.json [inputs...]"
+//
+// Examples come from three sources: a labels CSV, the corrections in a review
+// queue file, and the transactions in the input statements that the rules
+// classified confidently (including deliberate "Other", such as P2P transfers,
+// so the model learns what not to categorise)
+func runTrain(args []string) error {
+	fs := flag.NewFlagSet("train", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: stmtctl train -o model.json [flags] [inputs...]")
+		fs.PrintDefaults()
+	}
+	var (
+		out            = fs.String("o", "", "write the trained model to this file (required)")
+		labels         = fs.String("labels", "", "CSV of labeled transactions with columns narration, category and optionally method, amount (credits negative)")
+		corrections    = fs.String("corrections", "", "review queue file whose corrections are used as labels")
+		minConfidence  = fs.Float64("min-confidence", 0.9, "lowest rule confidence for a statement transaction to be used as a label")
+		version        = fs.String("version", "", "model version (default: training time)")
+		threshold      = fs.Float64("threshold", textmodel.DefaultThreshold, "rule confidence below which an \"Other\" transaction is passed to the model")
+		minProbability = fs.Float64("min-probability", textmodel.DefaultMinProbability, "lowest model probability that replaces the rule category")
+		rulePack       = fs.String("rules", os.Getenv("RULE_PACK"), "classification rule pack, JSON (default: built-in)")
+		customer       = fs.String("customer", "", "account holder name for self-transfer detection (default: from statement)")
+	)
+	fs.Parse(args)
+	if *out == "" {
+		fs.Usage()
+		return fmt.Errorf("train needs an output file (-o)")
+	}
+	if *rulePack != "" {
+		pack, err := rulepack.LoadFile(*rulePack)
+		if err != nil {
+			return err
+		}
+		if err := rulepack.Activate(pack); err != nil {
+			return err
+		}
+	}
+
+	var examples []textmodel.Example
+	sources := make([][2]string, 0, 3)
+	if *labels != "" {
+		labeled, err := readLabels(*labels)
+		if err != nil {
+			return err
+		}
+		examples = append(examples, labeled...)
+		sources = append(sources, [2]string{*labels, strconv.Itoa(len(labeled))})
+	}
+	if *corrections != "" {
+		list, err := review.ReadCorrections(*corrections)
+		if err != nil {
+			return err
+		}
+		for _, correction := range list {
+			examples = append(examples, textmodel.Example{
+				Narration: correction.Narration,
+				Method:    rules.ClassifyMethod(utils.NormalizeNarration(correction.Narration)),
+				Category:  correction.Category,
+			})
+		}
+		sources = append(sources, [2]string{*corrections, strconv.Itoa(len(list))})
+	}
+	if fs.NArg() > 0 {
+		inputs, err := resolveInputs(fs.Args())
+		if err != nil {
+			return err
+		}
+		count := 0
+		for _, name := range inputs {
+			statement, err := loadStatement(name)
+			if err != nil {
+				return err
+			}
+			customerName := *customer
+			if customerName == "" {
+				customerName = statement.AccountInfo.AccountHolderName
+			}
+			for _, txn := range classifyStatement(statement, customerName, nil) {
+				if txn.ClassificationMetadata.Confidence < *minConfidence {
+					continue
+				}
+				examples = append(examples, exampleFrom(txn))
+				count++
+			}
+		}
+		sources = append(sources, [2]string{fmt.Sprintf("%d statement(s)", len(inputs)), strconv.Itoa(count)})
+	}
+	if len(sources) == 0 {
+		fs.Usage()
+		return fmt.Errorf("train needs -labels, -corrections or statement inputs")
+	}
+
+	model, err := textmodel.Train(examples, *version)
+	if err != nil {
+		return err
+	}
+	model.Threshold = *threshold
+	model.MinProbability = *minProbability
+	if err := model.Validate(); err != nil {
+		return err
+	}
+	if err := model.Save(*out); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Model %s: %d examples, %d features -> %s\n\n", model.Version, model.Examples, model.Vocabulary, *out)
+	fmt.Fprintln(tw, "SOURCE\tEXAMPLES")
+	for _, source := range sources {
+		fmt.Fprintf(tw, "%s\t%s\n", source[0], source[1])
+	}
+	fmt.Fprintln(tw, "\nCATEGORY\tEXAMPLES")
+	for _, class := range model.Classes {
+		fmt.Fprintf(tw, "%s\t%d\n", class.Category, class.Examples)
+	}
+	return tw.Flush()
+}
+
+// exampleFrom turns a rule-classified transaction into a training example
+func exampleFrom(txn models.ClassifiedTransaction) textmodel.Example {
+	amount := txn.WithdrawalAmt
+	if txn.DepositAmt > txn.WithdrawalAmt {
+		amount = -txn.DepositAmt
+	}
+	return textmodel.Example{Narration: txn.Narration, Method: txn.Method, Amount: amount, Category: txn.Category}
+}
+
+// readLabels reads a labels CSV; the header names the columns
+func readLabels(path string) ([]textmodel.Example, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open labels: %w", err)
+	}
+	defer file.Close()
+
+	r := csv.NewReader(file)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to read header: %w", path, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"narration", "category"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%s: missing %q column", path, required)
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var examples []textmodel.Example
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		example := textmodel.Example{
+			Narration: field(record, "narration"),
+			Method:    field(record, "method"),
+			Category:  field(record, "category"),
+		}
+		if amount := field(record, "amount"); amount != "" {
+			example.Amount, err = strconv.ParseFloat(strings.ReplaceAll(amount, ",", ""), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid amount %q", path, line, amount)
+			}
+		}
+		if example.Method == "" {
+			example.Method = rules.ClassifyMethod(utils.NormalizeNarration(example.Narration))
+		}
+		examples = append(examples, example)
+	}
+	return examples, nil
+}
@@ -1,638 +1,20 @@
 package main
 
-import (
-	"classify/statement_analysis_engine_rules/analyzer"
-	"classify/statement_analysis_engine_rules/classifier"
-	"classify/statement_analysis_engine_rules/models"
-	"encoding/base64"
-	"encoding/json"
-	"fmt"
-	"log"
-	"net/http"
-	"os"
-	"strings"
-)
-
 // Example usage with base64 encoded string
 
 // Option 1: If you have a base64 string directly
 var base64String = `DQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KMDEvMDQvMjUgIElNUFMtNTA5MTEwNTIzMjM2LU1SICBLQUxQSVQgS1VNQVIgU0hBLUkgIDAwMDA1MDkxMTA1MjMyMzYgIDAxLzA0LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA1MCwwMDAuMDAgICAgICAgICAgIDkwLDkzOS44NCAgIA0KICAgICAgICAgIERGQi1YWFhYWFhYMjk1MC1JTVBTVFhOICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzA0LzI1ICBVUEktU0hZQU0gTUVESUNPUy1QQVlUTVFSNkFRU1Y3QFBUWVMtWUVTICAwMDAwMTAyNDI2MzU2NTAxICAwMS8wNC8yNSAgICAgICAgICAgICAxMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5MCw4MzkuODQgICANCiAgICAgICAgICBCMFBUTVVQSS0xMDI0MjYzNTY1MDEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMS8wNC8yNSAgVVBJLU1TIEFHR0FSV0FMIFNXRUVUICBDLVBBWVRNUVI2NzZPVE9AUCAgMDAwMDEwMjQzNjc3NTYxNiAgMDEvMDQvMjUgICAgICAgICAgICAgIDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTAsODE5Ljg0ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTEwMjQzNjc3NTYxNi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDEvMDQvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDI0MzgwMTg0OTYgIDAxLzA0LzI1ICAgICAgICAgICAgIDEyMi4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDkwLDY5Ny44NCAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTAyNDM4MDE4NDk2LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMS8wNC8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDEwMjQzODA2MDYzNiAgMDEvMDQvMjUgICAgICAgICAgICAgMTgwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTAsNTE3Ljg0ICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS0xMDI0MzgwNjA2MzYtVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzA0LzI1ICA1MDQwMDMzNDkxODcxMy0gUkQgSU5TVEFMTE1FTlQtQVBSIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAwMS8wNC8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4MCw1MTcuODQgICANCjAxLzA0LzI1ICA1MDQwMDMzNDkxODgzMS0gUkQgSU5TVEFMTE1FTlQtQVBSIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAwMS8wNC8yNSAgICAgICAgICAgMywwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3Nyw1MTcuODQgICANCjAzLzA0LzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3MDgxOTgyICAwMDAwMTAyNTQ1MjQ2NzY0ICAwMy8wNC8yNSAgICAgICAgICAgICAyMDIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3NywzMTUuODQgICANCiAgICAgICAgICA2NTI2QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMjU0NTI0Njc2NC1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDQvMDQvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDI1OTY2NDc1OTcgIDA0LzA0LzI1ICAgICAgICAgICAgICA3NS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDc3LDI0MC44NCAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTAyNTk2NjQ3NTk3LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNC8wNC8yNSAgVVBJLU1BSEEgR0FORVNIIFRSQURFUlMtUEFZVE1RUjY0MVE0RUBQVCAgMDAwMDEwMjU5Njg3OTczMiAgMDQvMDQvMjUgICAgICAgICAgICAgMjEwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNzcsMDMwLjg0ICAgDQogICAgICAgICAgWVMtWUVTQjBQVE1VUEktMTAyNTk2ODc5NzMyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDQvMDQvMjUgIFVQSS1BVVRPUEUgUEFZTUVOVCBTT0xVVC1QSU5FTEFCUy4xMDEwOTcgIDAwMDAxMDAzMDIyMTI3MTQgIDA0LzA0LzI1ICAgICAgICAgICAxLDcxNC41MCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDc1LDMxNi4zNCAgIA0KICAgICAgICAgIDI5QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMDMwMjIxMjcxNC1QQVkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBNRU5UIEZPUiA1NTU0MTUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNC8wNC8yNSAgVVBJLUFVVE9QRSBQQVlNRU5UIFNPTFVULVBJTkVMQUJTLjEwMTA5NyAgMDAwMDEwMDMwMjIyMDc4MSAgMDQvMDQvMjUgICAgICAgICAgIDIsMjI0LjUwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNzMsMDkxLjg0ICAgDQogICAgICAgICAgMjlASERGQ0JBTkstSERGQzBNRVJVUEktMTAwMzAyMjIwNzgxLVBBWSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIE1FTlQgRk9SIDU1NTQxNiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzA0LzI1ICBVUEktQVVUT1BFIFBBWU1FTlQgU09MVVQtUElORUxBQlMuMTAxMDk3ICAwMDAwMTAwMzA0NzI2MTYxICAwNS8wNC8yNSAgICAgICAgICAgMiw0MjQuNTAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3MCw2NjcuMzQgICANCiAgICAgICAgICAyOUBIREZDQkFOSy1IREZDME1FUlVQSS0xMDAzMDQ3MjYxNjEtUEFZICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgTUVOVCBGT1IgNTU1NjI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDUvMDQvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDI2Njk4NzI1OTEgIDA1LzA0LzI1ICAgICAgICAgICAgIDEyMi4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDcwLDU0NS4zNCAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTAyNjY5ODcyNTkxLVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzA0LzI1ICBVUEktQUdHQVJXQUwgVFJBRElORyBDT00tUEFZVE1RUjVZTFZMQUBQICAwMDAwMTAyNjcwMjE3MjYxICAwNS8wNC8yNSAgICAgICAgICAgICAzMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3MCwyNDUuMzQgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTAyNjcwMjE3MjYxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNi8wNC8yNSAgVVBJLUJJTEFMLVBBWVRNUVI2QUczSlRAUFRZUy1ZRVNCMFBUTVVQSSAgMDAwMDEwMjcxOTg5OTA4NiAgMDYvMDQvMjUgICAgICAgICAgICAgNjMwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjksNjE1LjM0ICAgDQogICAgICAgICAgLTEwMjcxOTg5OTA4Ni1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDYvMDQvMjUgIFVQSS1TQU5KQVkgS1VNQVItUEFZVE1RUjY3UjBSNUBQVFlTLVlFU0IgIDAwMDAxMDI3MjIxMzcxNjQgIDA2LzA0LzI1ICAgICAgICAgICAgICA4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDY5LDUzNS4zNCAgIA0KICAgICAgICAgIDBQVE1VUEktMTAyNzIyMTM3MTY0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA3LzA0LzI1ICAuREMgSU5UTCBQT1MgVFhOIE1BUktVUCtTVCAxNzAzMjUgMTcwMzI1ICBNSVIyNjA5MzgwNzc3MTk0ICAwNy8wNC8yNSAgICAgICAgICAgICAgMTcuOTYgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2OSw1MTcuMzggICANCiAgICAgICAgICAtTUlSMjYwOTM4MDc3NzE5NCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOC8wNC8yNSAgVVBJLVNBQ0hJTiBLVU1BUi1TQUNISU5KQU5OVTE0M0BZQkwtSU9CQSAgMDAwMDEwMjgyNDU2NzE4NCAgMDgvMDQvMjUgICAgICAgICAgICAgNDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjksMTE3LjM4ICAgDQogICAgICAgICAgMDAwMTQ1MS0xMDI4MjQ1NjcxODQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDgvMDQvMjUgIFVQSS1NUyBBR0dBUldBTCBTV0VFVCAgQy1QQVlUTVFSNkNHUkNGQFAgIDAwMDAxMDI4NDAyNDEzODkgIDA4LzA0LzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDY5LDA5Ny4zOCAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMDI4NDAyNDEzODktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA4LzA0LzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3MDgxOTgyICAwMDAwMTAyODQwNzIxNjc3ICAwOC8wNC8yNSAgICAgICAgICAgICAyMDIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2OCw4OTUuMzggICANCiAgICAgICAgICA2NTI2QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMjg0MDcyMTY3Ny1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDgvMDQvMjUgIFVQSS1NUyBTSFlBTSBNRURJQ09TLVEzODgwMDE4MDlAWUJMLVlFU0IgIDAwMDAxMDI4NDE0MjEyODcgIDA4LzA0LzI1ICAgICAgICAgICAgIDM4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDY4LDUxNS4zOCAgIA0KICAgICAgICAgIDBZQkxVUEktMTAyODQxNDIxMjg3LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA5LzA0LzI1ICBVUEktREFVSkkgU0VSVklDRSBTVEFUSU8tUEFZVE1RUjYwRzFHTEBQICAwMDAwMTAyODkyNzIxMTMwICAwOS8wNC8yNSAgICAgICAgICAgMSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2Nyw1MTUuMzggICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTAyODkyNzIxMTMwLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMC8wNC8yNSAgUE9TIDUxMjk2N1hYWFhYWDgwMjQgQU1BWk9OQVdTRVNDICAgICAgICAgMDAwMDAwMDAwMDk1MzQ2OCAgMTAvMDQvMjUgICAgICAgICAgICAgICAyLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjcsNTEzLjM4ICAgDQoxMC8wNC8yNSAgNTA0MDAzMTgxODY3ODAtIFJEIElOU1RBTExNRU5ULUFQUiAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDQvMjUgICAgICAgICAgIDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjIsNTEzLjM4ICAgDQoxMC8wNC8yNSAgNTA0MDAzMTgxODcwMjctIFJEIElOU1RBTExNRU5ULUFQUiAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDQvMjUgICAgICAgICAgNDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTcsNTEzLjM4ICAgDQoxMS8wNC8yNSAgVVBJLUdPT0dMRSBJTkRJQSBESUdJVEFMLVBMQVlTVE9SRUBBWElTQiAgMDAwMDEwMzAwODM1MDI1MiAgMTEvMDQvMjUgICAgICAgICAgICAgMTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTcsNDEzLjM4ICAgDQogICAgICAgICAgQU5LLVVUSUIwMDAwNTUzLTEwMzAwODM1MDI1Mi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTIvMDQvMjUgIFVQSS1NUyBBR0dBUldBTCBTV0VFVCAgQy1QQVlUTVFSNkNHUkNGQFAgIDAwMDAxMDMwNjg5NjU2NjggIDEyLzA0LzI1ICAgICAgICAgICAgIDEyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE3LDI5My4zOCAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMDMwNjg5NjU2NjgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEyLzA0LzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3MDgxOTgyICAwMDAwMTAzMDcwMDI2OTcyICAxMi8wNC8yNSAgICAgICAgICAgICAyMDYuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNywwODcuMzggICANCiAgICAgICAgICA2NTI2QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMzA3MDAyNjk3Mi1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTIvMDQvMjUgIENSViBQT1MgNTEyOTY3KioqKioqODAyNCBBTUFaT05BV1NFU0MgICAgIDAwMDAwMDAwMDAwMDAwMCAgIDEyLzA0LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIuMDAgICAgICAgICAgIDE3LDA4OS4zOCAgIA0KMTUvMDQvMjUgIFVQSS1NUyBBR0dBUldBTCBTV0VFVCAgQy1QQVlUTVFSNkNHUkNGQFAgIDAwMDA1MTA1Mzk4OTU4MzAgIDE1LzA0LzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE3LDA2OS4zOCAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS01MTA1Mzk4OTU4MzAtVVBJIFNFTkQgTU8gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIE5FWSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE1LzA0LzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3MDgxOTgyICAwMDAwMTAzMjM3NDYxMDQ4ICAxNS8wNC8yNSAgICAgICAgICAgICAzMjkuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNiw3NDAuMzggICANCiAgICAgICAgICA2NTI2QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMzIzNzQ2MTA0OC1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTYvMDQvMjUgIFVQSS1HT09HTEUgSU5ESUEgRElHSVRBTC1ZT1VUVUJFQEFYSVNCQU4gIDAwMDAxMDMyODkzMDIwMDYgIDE2LzA0LzI1ICAgICAgICAgICAgICA0MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE2LDcwMC4zOCAgIA0KICAgICAgICAgIEstVVRJQjAwMDA1NTMtMTAzMjg5MzAyMDA2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE3LzA0LzI1ICBJTVBTLTUxMDcxMzkzNTgzMi1NUiAgU1VESElSICBLVU1BUi1TQklOICAwMDAwNTEwNzEzOTM1ODMyICAxNy8wNC8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTAsMDAwLjAwICAgICAgICAgIDE2Niw3MDAuMzggICANCiAgICAgICAgICAtWFhYWFhYWFhYWFhYWDgxMjEtUkVRUEFZICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNy8wNC8yNSAgVVBJLVJBSkVORFJBIFNJTkdILVBBWVRNUVI2NThWT0hAUFRZUy1ZRSAgMDAwMDEwMzM0MjU3ODc5MyAgMTcvMDQvMjUgICAgICAgICAgICAgMjcwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNjYsNDMwLjM4ICAgDQogICAgICAgICAgU0IwUFRNVVBJLTEwMzM0MjU3ODc5My1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTcvMDQvMjUgIFVQSS1LVUxERUVQLVBBWVRNUVI2OVlWNFZAUFRZUy1ZRVNCMFBUTVUgIDAwMDAxMDMzNDMzMDIxMzkgIDE3LzA0LzI1ICAgICAgICAgICAgIDEwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTY2LDMzMC4zOCAgIA0KICAgICAgICAgIFBJLTEwMzM0MzMwMjEzOS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE3LzA0LzI1ICBVUEktQlJBSkVTSCBLVU1BUi1RMTU5NDkyMjkzQFlCTC1ZRVNCMFlCICAwMDAwMTAzMzQzNTgzMzQ1ICAxNy8wNC8yNSAgICAgICAgICAgICAgODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE2NiwyNTAuMzggICANCiAgICAgICAgICBMVVBJLTEwMzM0MzU4MzM0NS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNy8wNC8yNSAgVVBJLUdBWUFSU1VERElOLTk2NDM2NDM3MzYtMkBZQkwtUFVOQjA5OCAgMDAwMDEwMzM0MzY0MDA0OSAgMTcvMDQvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNjYsMjAwLjM4ICAgDQogICAgICAgICAgNzMwMC0xMDMzNDM2NDAwNDktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTcvMDQvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDMzNDM4OTcyNjEgIDE3LzA0LzI1ICAgICAgICAgICAgIDIyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTY1LDk4MC4zOCAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTAzMzQzODk3MjYxLVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOC8wNC8yNSAgVVBJLVNQT1RJRlkgSU5ESUEtU1BPVElGWS5CRFNJQElDSUNJLUlDSSAgMDAwMDUxMDgxMDgxMDUxNCAgMTgvMDQvMjUgICAgICAgICAgICAgIDU5LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNjUsOTIxLjM4ICAgDQogICAgICAgICAgQzBEQzAwOTktNTEwODEwODEwNTE0LU1BTkRBVEVSRVFVRVNUICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTkvMDQvMjUgIE1FIERDIFNJIDUxMjk2N1hYWFhYWDgwMjQgTUVESVVNIE1PTlRITFkgIDAwMDAwMDAwMDA1MjUyNDQgIDE5LzA0LzI1ICAgICAgICAgICAgIDQyNy42NSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTY1LDQ5My43MyAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE5LzA0LzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3MDgxOTgyICAwMDAwMTAzNDU1Njk5NTY5ICAxOS8wNC8yNSAgICAgICAgICAgICAzMzUuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE2NSwxNTguNzMgICANCiAgICAgICAgICA2NTI2QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMzQ1NTY5OTU2OS1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjAvMDQvMjUgIFVQSS1YWFhYWFgyMDM1LUNOUkIwMDE4OTA5LTEwMzQ5MzIwODMzNi0gIDAwMDAxMDM0OTMyMDgzMzYgIDIwLzA0LzI1ICAgICAgICAgIDUwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE1LDE1OC43MyAgIA0KICAgICAgICAgIFVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIxLzA0LzI1ICA1MDIwMDAwMDAwMzQwMiAgIDIwODE1OTUzX0hMSUNfSU5TVCAgICAgICAwMDAwMDAwMDAwMDAwMDAgICAyMS8wNC8yNSAgICAgICAgICAgICA4MTcuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExNCwzNDEuNzMgICANCjIxLzA0LzI1ICBVUEktWFhYWFhYMjAzNS1DTlJCMDAxODkwOS0xMDM1Njg2MDc2MDQtICAwMDAwMTAzNTY4NjA3NjA0ICAyMi8wNC8yNSAgICAgICAgICA1MCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2NCwzNDEuNzMgICANCiAgICAgICAgICBVUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMi8wNC8yNSAgVVBJLVNPTkEgRlVFTCBDRU5UUkUtUEFZVE1RUjYwR0I3R0BQVFlTLSAgMDAwMDEwMzU5Njc0NTUxNyAgMjIvMDQvMjUgICAgICAgICAgIDIsNTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjEsODQxLjczICAgDQogICAgICAgICAgWUVTQjBQVE1VUEktMTAzNTk2NzQ1NTE3LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICoqQ29udGludWUqKiANCg0KDQoNCkhERkMgQkFOSyBMdGQuICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBhZ2UgTm8gLjogICA0ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQoyMi8wNC8yNSAgVVBJLU1TIEFHR0FSV0FMIFNXRUVUICBDLVBBWVRNUVI2Q0dSQ0ZAUCAgMDAwMDEwMzYxNDU5MDI1MSAgMjIvMDQvMjUgICAgICAgICAgICAgIDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjEsODIxLjczICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTEwMzYxNDU5MDI1MS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjIvMDQvMjUgIFVQSS1EQVJTSEFOIFRFT1RJQS1QQVlUTS5TMTdVM1ZCQFBUWS1ZRVMgIDAwMDAxMDM2MTk3NDMzNTcgIDIyLzA0LzI1ICAgICAgICAgICAgICA0MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDYxLDc4MS43MyAgIA0KICAgICAgICAgIEIwTUNIVVBJLTEwMzYxOTc0MzM1Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI0LzA0LzI1ICBVUEktVVRUQVIgUFJBREVTSCBQT1dFUiAtQklMTERFU0tQRy5VUFBDICAwMDAwMTAzNjk0NjU2NzkwICAyNC8wNC8yNSAgICAgICAgICAgMiw4NDguMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA1OCw5MzMuNzMgICANCiAgICAgICAgICBMQEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMzY5NDY1Njc5MC1QQVkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNC8wNC8yNSAgVVBJLUdPT0dMRSBJTkRJQSBESUdJVEFMLVBMQVlTVE9SRUBBWElTQiAgMDAwMDEwMzcyMDUxNTIxOSAgMjQvMDQvMjUgICAgICAgICAgICAgIDQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNTgsODkzLjczICAgDQogICAgICAgICAgQU5LLVVUSUIwMDAwNTUzLTEwMzcyMDUxNTIxOS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjUvMDQvMjUgIFVQSS1HT09HTEUgSU5ESUEgRElHSVRBTC1ZT1VUVUJFQEFYSVNCQU4gIDAwMDAxMDM3NzA5OTk0OTYgIDI1LzA0LzI1ICAgICAgICAgICAgIDEwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDU4LDc5My43MyAgIA0KICAgICAgICAgIEstVVRJQjAwMDA1NTMtMTAzNzcwOTk5NDk2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI1LzA0LzI1ICBQOksxNjY3NSBIREZDIEJBTksgU0FMQVJZIEZPUiBBUFIgMjAyNSAgICAwMDAwMDAwMDAwMDAwMDAgICAyNS8wNC8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNjMsMTQ2LjAwICAgICAgICAgIDMyMSw5MzkuNzMgICANCjI1LzA0LzI1ICBJTVBTLTUxMTUyMTk3NDQxMi1LQUxQSVQgS1VNQVIgU0hBUk1BLUlEICAwMDAwNTExNTIxOTc0NDEyICAyNS8wNC8yNSAgICAgICAgIDIwMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyMSw5MzkuNzMgICANCiAgICAgICAgICBGQi1YWFhYWFhYMjk1MC0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNy8wNC8yNSAgQUNIIEQtIFRQIEFDSCBNQVhMSUZFSU5TVVJBLTE3ODg5ODI0MjggICAgMDAwMDAwNzU5MzM0NDc0MSAgMjcvMDQvMjUgICAgICAgICAgIDEsNDgzLjA2ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjAsNDU2LjY3ICAgDQoyNy8wNC8yNSAgVVBJLVhYWFhYWDIwMzUtQ05SQjAwMTg5MDktMTAzODUwNDE2OTk3LSAgMDAwMDEwMzg1MDQxNjk5NyAgMjcvMDQvMjUgICAgICAgICAgNTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNzAsNDU2LjY3ICAgDQogICAgICAgICAgVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjcvMDQvMjUgIFVQSS1YWFhYWFgzMjg2LUlDSUMwMDAzNDU4LTEwMzg1MDQ3MTM3My0gIDAwMDAxMDM4NTA0NzEzNzMgIDI3LzA0LzI1ICAgICAgICAgICAgICAgMS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDcwLDQ1NS42NyAgIA0KICAgICAgICAgIFVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI3LzA0LzI1ICBVUEktWFhYWFhYMzI4Ni1JQ0lDMDAwMzQ1OC0xMDM4NTA1MDM2MDYtICAwMDAwMTAzODUwNTAzNjA2ICAyNy8wNC8yNSAgICAgICAgICAgNSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2NSw0NTUuNjcgICANCiAgICAgICAgICBVUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNy8wNC8yNSAgVVBJLUlDQ0wgWkVST0RIQSBDT0lOLVpFUk9ESEFNRkBIREZDQkFOSyAgMDAwMDEwMDQzNjU3NjA2NSAgMjcvMDQvMjUgICAgICAgICAgIDEsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjQsNDU1LjY3ICAgDQogICAgICAgICAgLUhERkMwTUVSVVBJLTEwMDQzNjU3NjA2NS1QM1VSVEVLVVBOSjZUOSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIENJNzUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI3LzA0LzI1ICBVUEktSUNDTCBaRVJPREhBIENPSU4tWkVST0RIQU1GQEhERkNCQU5LICAwMDAwMTAwNDM2NTg5OTcxICAyNy8wNC8yNSAgICAgICAgICAgMSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2Myw0NTUuNjcgICANCiAgICAgICAgICAtSERGQzBNRVJVUEktMTAwNDM2NTg5OTcxLUpNSVpJR0VJSDg4UUlQICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgVElQUiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMDQvMjUgIFVQSS1HT09HTEUgUExBWS1QTEFZU1RPUkVAQVhJU0JBTkstVVRJQjAgIDAwMDA3Mjk0Nzk1MDExODUgIDI4LzA0LzI1ICAgICAgICAgICAgIDE5OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDYzLDI1Ni42NyAgIA0KICAgICAgICAgIDAwMDU1My03Mjk0Nzk1MDExODUtTUFOREFURUVYRUNVVEUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzA0LzI1ICBVUEktWkVST0RIQSBCUk9LSU5HIExURC1aRVJPREhBQlJPS0lOR0BIICAwMDAwMTAzOTA3ODI0MDcyICAyOC8wNC8yNSAgICAgICAgICA1MCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMywyNTYuNjcgICANCiAgICAgICAgICBERkNCQU5LLUhERkMwTUVSVVBJLTEwMzkwNzgyNDA3Mi0yMDM5MDc1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgOTc2NDgxMTQ4IFogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjkvMDQvMjUgIFVQSS1CSEFSVEkgQUlSVEVMIExJTUlURS1BSVJURUwxUEFZVE1ASEQgIDAwMDAxMDM5NzA4NjM2NTggIDI5LzA0LzI1ICAgICAgICAgICAgIDc0Ni44MiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyLDUwOS44NSAgIA0KICAgICAgICAgIEZDQkFOSy1IREZDME1FUlVQSS0xMDM5NzA4NjM2NTgtQUlSVEVMUE8gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIFNUUEFJREJJTEwgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI5LzA0LzI1ICBVUEktR09PR0xFIElORElBIERJR0lUQUwtWU9VVFVCRUBBWElTQkFOICAwMDAwMTAzOTg2OTEwMzk5ICAyOS8wNC8yNSAgICAgICAgICAgICAgNDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMiw0NjkuODUgICANCiAgICAgICAgICBLLVVUSUIwMDAwNTUzLTEwMzk4NjkxMDM5OS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOS8wNC8yNSAgVVBJLU1TIEFHR0FSV0FMIFNXRUVUICBDLVBBWVRNUVI2RzMwRzBAUCAgMDAwMDEwMzk5MDU2NDE0NSAgMjkvMDQvMjUgICAgICAgICAgICAgIDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTIsNDQ5Ljg1ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTEwMzk5MDU2NDE0NS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjkvMDQvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDM5OTEwODQ1MjkgIDI5LzA0LzI1ICAgICAgICAgICAgIDIyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyLDIyOS44NSAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTAzOTkxMDg0NTI5LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMS8wNS8yNSAgSU1QUy01MTIxMTk4MzQ0NjItTVIgS0FMUElUIEtVTUFSIFNIQVItSSAgMDAwMDUxMjExOTgzNDQ2MiAgMDEvMDUvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTAwLDAwMC4wMCAgICAgICAgICAxMTIsMjI5Ljg1ICAgDQogICAgICAgICAgREZCLVhYWFhYWFgyOTUwLUlNUFNUWE4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDEvMDUvMjUgIDUwNDAwMzM0OTE4NzEzLSBSRCBJTlNUQUxMTUVOVC1NQVkgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDAxLzA1LzI1ICAgICAgICAgIDEwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTAyLDIyOS44NSAgIA0KMDEvMDUvMjUgIDUwNDAwMzM0OTE4ODMxLSBSRCBJTlNUQUxMTUVOVC1NQVkgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDAxLzA1LzI1ICAgICAgICAgICAzLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk5LDIyOS44NSAgIA0KMDIvMDUvMjUgIFVQSS1JQ0NMIFpFUk9ESEEgQ09JTi1aRVJPREhBTUZASERGQ0JBTksgIDAwMDAxMDA0NjE5NTMzMzUgIDAyLzA1LzI1ICAgICAgICAgICAyLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk3LDIyOS44NSAgIA0KICAgICAgICAgIC1IREZDME1FUlVQSS0xMDA0NjE5NTMzMzUtS0RNNlFUM0xYNVJFOVQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBMRlVZICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMi8wNS8yNSAgVVBJLVZJLVBBWUJJTDMwNjZAUFRZQkwtWUVTQjBQVE1VUEktNTEyMiAgMDAwMDUxMjI0NDk3NDk4NiAgMDIvMDUvMjUgICAgICAgICAgICAgODYyLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTYsMzY3Ljg1ICAgDQogICAgICAgICAgNDQ5NzQ5ODYtU0VOVCBVU0lORyBQQVlUTSBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDIvMDUvMjUgIFVQSS1TT05BIEZVRUwgQ0VOVFJFLVBBWVRNUVIxNU5JQ0hXMUZZQFAgIDAwMDAxMDQxMzc5NzMwOTUgIDAyLzA1LzI1ICAgICAgICAgICAzLDgwMS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDkyLDU2Ni44NSAgIA0KICAgICAgICAgIEFZVE0tWUVTQjBQVE1VUEktMTA0MTM3OTczMDk1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAzLzA1LzI1ICBVUEktU0hJVkFOSSBHT0VMLTc2NjgwOTA1NDBAUFRBWElTLVVUSUIwICAwMDAwMTA0MTgwMTA3Mzg1ICAwMy8wNS8yNSAgICAgICAgICAgMiw1MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5MCwwNjYuODUgICANCiAgICAgICAgICAwMDI2NzgtMTA0MTgwMTA3Mzg1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMy8wNS8yNSAgVVBJLUhJVEtBUkkgU1RPUkUtR1BBWS0xMTI0NDU0NTY1OEBPS0JJWiAgMDAwMDEwNDIxODk5Nzc1NyAgMDMvMDUvMjUgICAgICAgICAgIDUsMDgwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODQsOTg2Ljg1ICAgDQogICAgICAgICAgQVhJUy1VVElCMDAwMDU1My0xMDQyMTg5OTc3NTctVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDQvMDUvMjUgIE1FIERDIFNJIDUxMjk2N1hYWFhYWDgwMjQgQU1BWk9OIFdFQlNFUlYgIDAwMDAwMDAwMDA0MDUyOTQgIDA0LzA1LzI1ICAgICAgICAgICA1LDQyMy42OSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDc5LDU2My4xNiAgIA0KICAgICAgICAgIElDRVMgQyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA0LzA1LzI1ICBVUEktU09OQSBGVUVMIENFTlRSRS1QQVlUTVFSNjBHQVVMQFBUWVMtICAwMDAwMTA0MjQ0NzI2Njk0ICAwNC8wNS8yNSAgICAgICAgICAgMSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3OCw1NjMuMTYgICANCiAgICAgICAgICBZRVNCMFBUTVVQSS0xMDQyNDQ3MjY2OTQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNS8wNS8yNSAgVVBJLVlPR0VORFJBIFNJTkdILVEyNzE2NDQxNjNAWUJMLVlFU0IwWSAgMDAwMDEwNDMxNDMwOTI3MiAgMDUvMDUvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNzgsNTEzLjE2ICAgDQogICAgICAgICAgQkxVUEktMTA0MzE0MzA5MjcyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDUvMDUvMjUgIFVQSS1ZT0dFTkRSQSBTSU5HSC1RMjcxNjQ0MTYzQFlCTC1ZRVNCMFkgIDAwMDAxMDQzMTgzMDkwMTkgIDA1LzA1LzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDc4LDQ5My4xNiAgIA0KICAgICAgICAgIEJMVVBJLTEwNDMxODMwOTAxOS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzA1LzI1ICBVUEktUkFKRU5EUkEgU0lOR0gtUEFZVE1RUjY1OFZPSEBQVFlTLVlFICAwMDAwMTA0MzE5MDMxNjE2ICAwNS8wNS8yNSAgICAgICAgICAgICA0MjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3OCwwNzMuMTYgICANCiAgICAgICAgICBTQjBQVE1VUEktMTA0MzE5MDMxNjE2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDYgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjA2LzA1LzI1ICBVUEktQUlSVEVMLVBBWUFJUjc2NzNAUFRZQkwtWUVTQjBQVE1VUEktICAwMDAwNTEyNjcwMDQ0NzY5ICAwNi8wNS8yNSAgICAgICAgICAgICA1ODAuODAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3Nyw0OTIuMzYgICANCiAgICAgICAgICA1MTI2NzAwNDQ3NjktU0VOVCBVU0lORyBQQVlUTSBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNi8wNS8yNSAgSU1QUy01MTI2MTI4NzA5NDItTVIgS0FMUElUIEtVTUFSIFNIQVItSSAgMDAwMDUxMjYxMjg3MDk0MiAgMDYvMDUvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTAwLDAwMC4wMCAgICAgICAgICAxNzcsNDkyLjM2ICAgDQogICAgICAgICAgREZCLVhYWFhYWFgyOTUwLUlNUFNUWE4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDYvMDUvMjUgIFVQSS1aRVJPREhBIEJST0tJTkcgTFRELVpFUk9ESEFCUk9LSU5HQEggIDAwMDAxMDQzNTI3OTUxNDIgIDA2LzA1LzI1ICAgICAgICAgMTAwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDc3LDQ5Mi4zNiAgIA0KICAgICAgICAgIERGQ0JBTkstSERGQzBNRVJVUEktMTA0MzUyNzk1MTQyLTc2NjQ2NjYgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA3NTc1MDg0MjUgWiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNi8wNS8yNSAgQ1JWIFBPUyA1MTI5NjcqKioqKio4MDI0IEFNQVpPTiBXRUJTRVJWSSAgMDAwMDAwMDAwMDAwMDAwICAgMDYvMDUvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA1LDQyMy42OSAgICAgICAgICAgODIsOTE2LjA1ICAgDQowNy8wNS8yNSAgVVBJLVNBVVJBQkgtOTY2Nzg1NjYyM0BJQkwtQ05SQjAwMDAwMzMtMSAgMDAwMDEwNDQwODM2Mzk5NyAgMDcvMDUvMjUgICAgICAgICAgICAgMTUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODIsNzY2LjA1ICAgDQogICAgICAgICAgMDQ0MDgzNjM5OTctVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDgvMDUvMjUgIFVQSS1BSVJURUwtUEFZQUlSNzY3M0BQVFlCTC1ZRVNCMFBUTVVQSS0gIDAwMDA1MTI4ODY1NzQxMjYgIDA4LzA1LzI1ICAgICAgICAgICAgICAzMy4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgyLDczMy4wNSAgIA0KICAgICAgICAgIDUxMjg4NjU3NDEyNi1TRU5UIFVTSU5HIFBBWVRNIFUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA5LzA1LzI1ICAuREMgSU5UTCBQT1MgVFhOIE1BUktVUCtTVCAxODA0MjUgMTgwNDI1ICBNSVIyNjEyODU4NDc2NzY0ICAwOS8wNS8yNSAgICAgICAgICAgICAgMTcuNjYgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4Miw3MTUuMzkgICANCiAgICAgICAgICAtTUlSMjYxMjg1ODQ3Njc2NCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOS8wNS8yNSAgVVBJLUdPT0dMRSBJTkRJQSBESUdJVEFMLVlPVVRVQkVAQVhJU0JBTiAgMDAwMDEwNDUzOTE4MTc5NiAgMDkvMDUvMjUgICAgICAgICAgICAgMTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODIsNjE1LjM5ICAgDQogICAgICAgICAgSy1VVElCMDAwMDU1My0xMDQ1MzkxODE3OTYtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTAvMDUvMjUgIFVQSS1CSFVQRU5ERVIgS1VNQVItOTk1MzE5NzU2MkBLT1RBSzgxMS0gIDAwMDAxMDQ1ODI5MzM5OTcgIDEwLzA1LzI1ICAgICAgICAgICAgIDE1MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgyLDQ2NS4zOSAgIA0KICAgICAgICAgIEtLQkswMDAwOTU4LTEwNDU4MjkzMzk5Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEwLzA1LzI1ICBVUEktTkFSRU5ERVIgS1VNQVItOTcxNzU1OTA3QElCTC1QVU5CMDQwICAwMDAwMTA0NTgzMTY2MjYyICAxMC8wNS8yNSAgICAgICAgICAgICAxMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4MiwzNjUuMzkgICANCiAgICAgICAgICAzOTAwLTEwNDU4MzE2NjI2Mi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMC8wNS8yNSAgNTA0MDAzMTgxODY3ODAtIFJEIElOU1RBTExNRU5ULU1BWSAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDUvMjUgICAgICAgICAgIDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNzcsMzY1LjM5ICAgDQoxMC8wNS8yNSAgNTA0MDAzMTgxODcwMjctIFJEIElOU1RBTExNRU5ULU1BWSAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDUvMjUgICAgICAgICAgNDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzIsMzY1LjM5ICAgDQoxMS8wNS8yNSAgVVBJLUFHR0FSV0FMIFRSQURJTkcgQ09NLVBBWVRNUVI1WUxWTEFAUCAgMDAwMDEwNDY0NTM5MjM3OSAgMTEvMDUvMjUgICAgICAgICAgICAgIDcwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzIsMjk1LjM5ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTEwNDY0NTM5MjM3OS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTEvMDUvMjUgIFVQSS1TSElWIEJPT0sgU0VMTEVSLUdQQVktMTEyMzMyODg0NjdAT0sgIDAwMDAxMDQ2NDU2OTI3MzkgIDExLzA1LzI1ICAgICAgICAgICAgICAzMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDMyLDI2NS4zOSAgIA0KICAgICAgICAgIEJJWkFYSVMtVVRJQjAwMDA1NTMtMTA0NjQ1NjkyNzM5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjExLzA1LzI1ICBVUEktU09OQSBGVUVMIENFTlRSRS1QQVlUTVFSMTVOSUNIVzFGWUBQICAwMDAwMTA0NjY5NzM1Nzk4ICAxMS8wNS8yNSAgICAgICAgICAgMSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzMSwyNjUuMzkgICANCiAgICAgICAgICBBWVRNLVlFU0IwUFRNVVBJLTEwNDY2OTczNTc5OC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMy8wNS8yNSAgVVBJLUlDQ0wgWkVST0RIQSBDT0lOLVpFUk9ESEFNRkBIREZDQkFOSyAgMDAwMDEwMDU0MjkzMTg2MyAgMTMvMDUvMjUgICAgICAgICAgIDEsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzAsMjY1LjM5ICAgDQogICAgICAgICAgLUhERkMwTUVSVVBJLTEwMDU0MjkzMTg2My00MVA4U1RFNFdIQVoxSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFFIUDUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEzLzA1LzI1ICBVUEktSUNDTCBaRVJPREhBIENPSU4tWkVST0RIQU1GQEhERkNCQU5LICAwMDAwMTAwNTQ2Njk4MDU5ICAxMy8wNS8yNSAgICAgICAgICAgNSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNSwyNjUuMzkgICANCiAgICAgICAgICAtSERGQzBNRVJVUEktMTAwNTQ2Njk4MDU5LUVDVVYwMklHSU1XT1BRICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDcgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCiAgICAgICAgICBDREgyICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMy8wNS8yNSAgVVBJLUlDQ0wgWkVST0RIQSBDT0lOLVpFUk9ESEFNRkBIREZDQkFOSyAgMDAwMDEwMDU0NjcyODM2OCAgMTMvMDUvMjUgICAgICAgICAgIDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjAsMjY1LjM5ICAgDQogICAgICAgICAgLUhERkMwTUVSVVBJLTEwMDU0NjcyODM2OC1VUFVRQ0VMMUJTSVdHSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFJBWUogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEzLzA1LzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZGWkYxOUBQICAwMDAwMTA0NzY5MjE5ODEwICAxMy8wNS8yNSAgICAgICAgICAgICAgNTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMCwyMTUuMzkgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTA0NzY5MjE5ODEwLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMy8wNS8yNSAgVVBJLUFHR0FSV0FMIFRSQURJTkcgQ09NLVBBWVRNUVI1WUxWTEFAUCAgMDAwMDEwNDc3MDE5MzY2NiAgMTMvMDUvMjUgICAgICAgICAgICAgMTgwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjAsMDM1LjM5ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTEwNDc3MDE5MzY2Ni1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTMvMDUvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDQ3NzAzNzUxMDcgIDEzLzA1LzI1ICAgICAgICAgICAgICA4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE5LDk1NS4zOSAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTA0NzcwMzc1MTA3LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMy8wNS8yNSAgVVBJLVVSTUlMQS1RMjUzMDI0MjUzQFlCTC1ZRVNCMFlCTFVQSS0xMCAgMDAwMDEwNDc3MDk0MDgwOCAgMTMvMDUvMjUgICAgICAgICAgICAgIDgwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTksODc1LjM5ICAgDQogICAgICAgICAgNDc3MDk0MDgwOC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTQvMDUvMjUgIFVQSS1BVFVMIEtVTUFSIE1JVFRBTCBIVS05ODEwMDIwNjE5LTJAWUIgIDAwMDAxMDQ4MDM4NjcxNTIgIDE0LzA1LzI1ICAgICAgICAgICA5LDEwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwLDc3NS4zOSAgIA0KICAgICAgICAgIEwtWUVTQjAwMDEyNzMtMTA0ODAzODY3MTUyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE1LzA1LzI1ICBVUEktVVRUQVIgUFJBREVTSCBQT1dFUiAtQklMTERFU0tQRy5VUFBDICAwMDAwMTA0ODMxMDM0MDQ5ICAxNS8wNS8yNSAgICAgICAgICAgNiwyMzkuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNCw1MzYuMzkgICANCiAgICAgICAgICBMQEhERkNCQU5LLUhERkMwTUVSVVBJLTEwNDgzMTAzNDA0OS1QQVkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOC8wNS8yNSAgUE9TIDUxMjk2N1hYWFhYWDgwMjQgVVBHKlBBWU1FTlRJQ08uQyAgICAgMDAwMDAwMDAwMDcyOTMzOCAgMTgvMDUvMjUgICAgICAgICAgIDEsNzk3LjcwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIsNzM4LjY5ICAgDQoxOC8wNS8yNSAgVVBJLURJTFNIQUQgQkVHLVBBWVRNUVI1WjU0MExAUFRZUy1ZRVNCMCAgMDAwMDEwNDk5MjI4MDE4MiAgMTgvMDUvMjUgICAgICAgICAgICAgIDcwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIsNjY4LjY5ICAgDQogICAgICAgICAgUFRNVVBJLTEwNDk5MjI4MDE4Mi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTgvMDUvMjUgIFVQSS1JTlRBSkFSLUJIQVJBVFBFLjkwMDcxNDcyNzM0QEZCUEUtRkQgIDAwMDAxMDQ5OTI3MDEwMDggIDE4LzA1LzI1ICAgICAgICAgICAgICA1MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyLDYxOC42OSAgIA0KICAgICAgICAgIFJMMDAwMTM4Mi0xMDQ5OTI3MDEwMDgtUEFZIFRPIEJIQVJBVFBFIE0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBFICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOC8wNS8yNSAgVVBJLU1BREFOIFNXRUVUUyBBTkQgUkVTLVZZQVBBUi4xNzIyNTQxNiAgMDAwMDEwNTAyODc1NzI5OCAgMTgvMDUvMjUgICAgICAgICAgICAgNTQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIsMDc4LjY5ICAgDQogICAgICAgICAgNTQyMUBIREZDQkFOSy1IREZDME1FUlVQSS0xMDUwMjg3NTcyOTgtVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE4LzA1LzI1ICBVUEktQU1BTiAgU0lOR0gtUTMzMzMwMDkwM0BZQkwtWUVTQjBZQkxVICAwMDAwMTA1MDI5MTUyNDE4ICAxOC8wNS8yNSAgICAgICAgICAgICA3NTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMSwzMjguNjkgICANCiAgICAgICAgICBQSS0xMDUwMjkxNTI0MTgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOS8wNS8yNSAgVVBJLVNIQUhSVUtIIEtIQU4tOTM2ODY0NjQxOS0yQElCTC1JTkRCMCAgMDAwMDEwNTA3NzQ3NzM1NiAgMTkvMDUvMjUgICAgICAgICAgICAgNzUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNTc4LjY5ICAgDQogICAgICAgICAgMDAwNjcyLTEwNTA3NzQ3NzM1Ni1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjAvMDUvMjUgIFVQSS1LQUxQSVQgS1VNQVIgU0hBUk1BLUtBTFBJVC5DT09MMjAwNkAgIDAwMDA1MTQwMDE2NjgxNTEgIDIwLzA1LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMiwwMDAuMDAgICAgICAgICAgICAyLDU3OC42OSAgIA0KICAgICAgICAgIE9LSUNJQ0ktSUNJQzAwMDM0NTgtNTE0MDAxNjY4MTUxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIwLzA1LzI1ICBVUEktREVWRU5EUkEgS1VNQVItUks0MzMwNjg4QE9LU0JJLUFJUlAwICAwMDAwMTA1MTI1MjQxNTkwICAyMC8wNS8yNSAgICAgICAgICAgICA1MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMiwwNzguNjkgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgOCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIDAwMDAwMS0xMDUxMjUyNDE1OTAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIxLzA1LzI1ICA1MDIwMDAwMDAwMzQwMiAgIDIwODE1OTUzX0hMSUNfSU5TVCAgICAgICAwMDAwMDAwMDAwMDAwMDAgICAyMS8wNS8yNSAgICAgICAgICAgICA4MTcuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMSwyNjEuNjkgICANCjIxLzA1LzI1ICBVUEktWU9HRU5EUkEgU0lOR0gtUTI3MTY0NDE2M0BZQkwtWUVTQjBZICAwMDAwMTA1MTYwMTQyNjE2ICAyMS8wNS8yNSAgICAgICAgICAgICAxNTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMSwxMTEuNjkgICANCiAgICAgICAgICBCTFVQSS0xMDUxNjAxNDI2MTYtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMS8wNS8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDEwNTE4NDU2NDA3NiAgMjEvMDUvMjUgICAgICAgICAgICAgMTUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTYxLjY5ICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS0xMDUxODQ1NjQwNzYtVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIyLzA1LzI1ICBVUEktREFSU0hBTiBURU9USUEtUTY5NTU0NTE5N0BZQkwtWUVTQjBZICAwMDAwMTA1MjQ4NzU0MTU5ICAyMi8wNS8yNSAgICAgICAgICAgICAxOTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3NzEuNjkgICANCiAgICAgICAgICBCTFVQSS0xMDUyNDg3NTQxNTktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMy8wNS8yNSAgVVBJLUFHR0FSV0FMIFRSQURJTkcgQ09NLVBBWVRNUVI1WUxWTEFAUCAgMDAwMDEwNTI5NTQzNTQwMiAgMjMvMDUvMjUgICAgICAgICAgICAgMjMwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNTQxLjY5ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTEwNTI5NTQzNTQwMi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjMvMDUvMjUgIFVQSS1BR0dBUldBTCBGT09ELVBBWVRNLlMxRTZHUUFAUFRZLVlFU0IgIDAwMDAxMDUyOTU0OTUxNzYgIDIzLzA1LzI1ICAgICAgICAgICAgICA2MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDQ4MS42OSAgIA0KICAgICAgICAgIDBNQ0hVUEktMTA1Mjk1NDk1MTc2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIzLzA1LzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZGWkYxOUBQICAwMDAwMTA1Mjk1Nzc1NTAwICAyMy8wNS8yNSAgICAgICAgICAgICAyMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNjEuNjkgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTA1Mjk1Nzc1NTAwLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMy8wNS8yNSAgUDpLMTY2NzUgSERGQyBCQU5LIFNBTEFSWSBGT1IgTUFZIDIwMjUgICAgMDAwMDAwMDAwMDAwMDAwMSAgMjMvMDUvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjU5LDc0Ny4wMCAgICAgICAgICAyNjAsMDA4LjY5ICAgDQoyNC8wNS8yNSAgVVBJLVlPR0VORFJBIFNJTkdILVEyNzE2NDQxNjNAWUJMLVlFU0IwWSAgMDAwMDEwNTMyMTYyNTg1MiAgMjQvMDUvMjUgICAgICAgICAgICAgMTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNTksOTA4LjY5ICAgDQogICAgICAgICAgQkxVUEktMTA1MzIxNjI1ODUyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjQvMDUvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDUzMzU1OTY3OTkgIDI0LzA1LzI1ICAgICAgICAgICAgIDIwNS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjU5LDcwMy42OSAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTA1MzM1NTk2Nzk5LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8wNS8yNSAgSU1QUy01MTQ1MDE5MjkxNTMtS0FMUElUIEtVTUFSIFNIQVJNQS1JRCAgMDAwMDUxNDUwMTkyOTE1MyAgMjUvMDUvMjUgICAgICAgICAxMDAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTksNzAzLjY5ICAgDQogICAgICAgICAgRkItWFhYWFhYWDI5NTAtICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjYvMDUvMjUgIFVQSS1aRVBUTyBNQVJLRVRQTEFDRSBQUi1aRVBUT01BUktFVFBMQUMgIDAwMDAxMDU0MTU5NTYyNzUgIDI2LzA1LzI1ICAgICAgICAgICAgIDExOS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU5LDU4NC42OSAgIA0KICAgICAgICAgIDg0NjI5OS5SWlBAUlhBSVJURUwtQUlSUDAwMDAwMTEtMTA1NDE1OTUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA2Mjc1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNy8wNS8yNSAgQUNIIEQtIFRQIEFDSCBNQVhMSUZFSU5TVVJBLTE4MjA2OTI3MDkgICAgMDAwMDAwODgyMDE3NDQzMyAgMjcvMDUvMjUgICAgICAgICAgIDEsNDgzLjA2ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTgsMTAxLjYzICAgDQoyNy8wNS8yNSAgVVBJLU1TIEFHR0FSV0FMIFNXRUVUICBDLVBBWVRNUVI2RlpGMTlAUCAgMDAwMDEwNTQ5NjQwODk1MSAgMjcvMDUvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTgsMDUxLjYzICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTEwNTQ5NjQwODk1MS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMDUvMjUgIFVQSS1HT09HTEUgUExBWS1QTEFZU1RPUkVAQVhJU0JBTkstVVRJQjAgIDAwMDAyNjg1NDE0MjE0ODUgIDI4LzA1LzI1ICAgICAgICAgICAgIDE5OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU3LDg1Mi42MyAgIA0KICAgICAgICAgIDAwMDU1My0yNjg1NDE0MjE0ODUtTUFOREFURUVYRUNVVEUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzA1LzI1ICBVUEktTU9MRUNVTEFSIElNQUdJTkcgQU4tNzA0MjkyODg4MUBPS0JJICAwMDAwMTA1NTA3NzkzNzI4ICAyOC8wNS8yNSAgICAgICAgICAgMiwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NSw4NTIuNjMgICANCiAgICAgICAgICBaQVhJUy1VVElCMDAwMDU1My0xMDU1MDc3OTM3MjgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjI5LzA1LzI1ICBVUEktQU1JVCBTT0hBTiBMQUwgREVFV0EtUEFZVE1RUjVLR0NMSkBQICAwMDAwMTA1NTg1NzEzNzIxICAyOS8wNS8yNSAgICAgICAgICAgICA0ODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NSwzNzIuNjMgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTA1NTg1NzEzNzIxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOS8wNS8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDEwNTU4NzYzOTc1NCAgMjkvMDUvMjUgICAgICAgICAgICAgIDkwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTUsMjgyLjYzICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS0xMDU1ODc2Mzk3NTQtVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjMwLzA1LzI1ICBVUEktQUlSVEVMLVBBWUFJUjc2NzNAUFRZQkwtWUVTQjBQVE1VUEktICAwMDAwNTE1MDM4NDMzMDQ5ICAzMC8wNS8yNSAgICAgICAgICAgICA5MzIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NCwzNTAuNjMgICANCiAgICAgICAgICA1MTUwMzg0MzMwNDktU0VOVCBVU0lORyBQQVlUTSBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMS8wNi8yNSAgNTA0MDAzMzQ5MTg3MTMtIFJEIElOU1RBTExNRU5ULUpVTiAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMDEvMDYvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNDQsMzUwLjYzICAgDQowMS8wNi8yNSAgNTA0MDAzMzQ5MTg4MzEtIFJEIElOU1RBTExNRU5ULUpVTiAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMDEvMDYvMjUgICAgICAgICAgIDMsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNDEsMzUwLjYzICAgDQowMy8wNi8yNSAgVVBJLVNJTVBMLVNJTVBMUEFZQFBUWUJMLVlFU0IwUFRNVVBJLTEwNSAgMDAwMDEwNTg3MDUzNDQyNiAgMDMvMDYvMjUgICAgICAgICAgICAgNTEwLjU1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNDAsODQwLjA4ICAgDQogICAgICAgICAgODcwNTM0NDI2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDMvMDYvMjUgIFVQSS1BTUlUIFNPSEFOIExBTCBERUVXQS1QQVlUTVFSNUtHQ0xKQFAgIDAwMDAxMDU4NzQwNDQxNDYgIDAzLzA2LzI1ICAgICAgICAgICAgIDU5MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTQwLDI1MC4wOCAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMDU4NzQwNDQxNDYtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA0LzA2LzI1ICBQT1MgNTEyOTY3WFhYWFhYODAyNCBDTEFVREUuQUkgU1VCU0NSICAgICAwMDAwMDAwMDAwMTk5NDY1ICAwNC8wNi8yNSAgICAgICAgICAgMiwwMjMuMzcgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEzOCwyMjYuNzEgICANCjA1LzA2LzI1ICBVUEktQVNIT0sgS1VNQVIgR0FVUi1BU0hPS0dBVVIxODEyQE9LU0JJICAwMDAwNTE1NjMzNjMyNTk4ICAwNS8wNi8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxLjAwICAgICAgICAgIDEzOCwyMjcuNzEgICANCiAgICAgICAgICAtU0JJTjAwMTYxMDktNTE1NjMzNjMyNTk4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNy8wNi8yNSAgTkVGVCBDUi1ZRVNCMDAwMDAwMS1aRVJPREhBIEJST0tJTkcgTFRELSAgWUVTQk4xMjAyNTA2MDcwNiAgMDcvMDYvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxLDQwMy45OSAgICAgICAgICAxMzksNjMxLjcwICAgDQogICAgICAgICAgRFNDTkIgQS9DLUtBTFBJVCBLVU1BUiBTSEFSTUEtWUVTQk4xMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDA2MDcwNjI4MzUzNCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA3LzA2LzI1ICBVUEktQUlSVEVMLVBBWUFJUjc2NzNAUFRZQkwtWUVTQjBQVE1VUEktICAwMDAwNTE1ODAwOTc1NTIwICAwNy8wNi8yNSAgICAgICAgICAgICA5ODIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEzOCw2NDkuNzAgICANCiAgICAgICAgICA1MTU4MDA5NzU1MjAtU0VOVCBVU0lORyBQQVlUTSBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNy8wNi8yNSAgUE9TIDUxMjk2N1hYWFhYWDgwMjQgQU5USFJPUElDICAgICAgICAgICAgMDAwMDAwMDAwMDU2MDY1NyAgMDcvMDYvMjUgICAgICAgICAgICAgNTA2Ljc5ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMzgsMTQyLjkxICAgDQowOC8wNi8yNSAgVVBJLUFJUlRFTCBQQVlNRU5UUyBCQU5LLUFJUlRFTC1CSUxMUEFZTSAgMDAwMDEwNjE0NzMxNDkwMyAgMDgvMDYvMjUgICAgICAgICAgICAgNzQ2LjgyICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMzcsMzk2LjA5ICAgDQogICAgICAgICAgRU5ULlBBWVRNQFBUWUJMLVlFU0IwUFRNVVBJLTEwNjE0NzMxNDkwMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIC1BSVJURUxQT1NUUEFJREJJTEwgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA4LzA2LzI1ICBVUEktTUFEQU4gU1dFRVRTIEFORCBSRVMtVllBUEFSLjE3MDA1MDg2ICAwMDAwMTA2MTU1ODc2OTc4ICAwOC8wNi8yNSAgICAgICAgICAgICA5ODUuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEzNiw0MTEuMDkgICANCiAgICAgICAgICA5ODc5QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwNjE1NTg3Njk3OC1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDgvMDYvMjUgIFVQSS1NUiBBTUFOICBTSU5HSC1RMzMzMzAwOTAzQFlCTC1ZRVNCMFkgIDAwMDAxMDYxNTgzNjgyMDcgIDA4LzA2LzI1ICAgICAgICAgICAgIDI1MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTM2LDE2MS4wOSAgIA0KICAgICAgICAgIEJMVVBJLTEwNjE1ODM2ODIwNy1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEwLzA2LzI1ICBVUEktR0FSSU1BIFNIQVJNQS1HQVJJTUFTSEFSTUExOTkzLTJAT0tBICAwMDAwMTA2MjQ2Mzc5NzU2ICAxMC8wNi8yNSAgICAgICAgICAyMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExNiwxNjEuMDkgICANCiAgICAgICAgICBYSVMtVVRJQjAwMDE4MTItMTA2MjQ2Mzc5NzU2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMC8wNi8yNSAgVVBJLU1TIEFHR0FSV0FMIFNXRUVUICBDLVBBWVRNUVI2R1NVT1ZAUCAgMDAwMDEwNjI2MTA1MTI3MyAgMTAvMDYvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTYsMTExLjA5ICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDEwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTEwNjI2MTA1MTI3My1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTAvMDYvMjUgIFVQSS1TSFlBTSBNRURJQ09TLVBBWVRNUVI2M1NIT1ZAUFRZUy1ZRVMgIDAwMDAxMDYyNjI5MzQzODUgIDEwLzA2LzI1ICAgICAgICAgICAgIDE4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE1LDkzMS4wOSAgIA0KICAgICAgICAgIEIwUFRNVVBJLTEwNjI2MjkzNDM4NS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEwLzA2LzI1ICA1MDQwMDMxODE4Njc4MC0gUkQgSU5TVEFMTE1FTlQtSlVOIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAxMC8wNi8yNSAgICAgICAgICAgNSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExMCw5MzEuMDkgICANCjEwLzA2LzI1ICA1MDQwMDMxODE4NzAyNy0gUkQgSU5TVEFMTE1FTlQtSlVOIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAxMC8wNi8yNSAgICAgICAgICA0NSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2NSw5MzEuMDkgICANCjExLzA2LzI1ICBVUEktQkxJTktJVC1CTElOS0lULlBBWVVASERGQ0JBTkstSERGQzBNICAwMDAwMTA2Mjk5OTA2NTYyICAxMS8wNi8yNSAgICAgICAgICAgICAxNTguMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2NSw3NzMuMDkgICANCiAgICAgICAgICBFUlVQSS0xMDYyOTk5MDY1NjItVVBJSU5URU5UICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNC8wNi8yNSAgTkVGVCBDUi1ZRVNCMDAwMDAwMS1aRVJPREhBIEJST0tJTkcgTFRELSAgWUVTQk4xMjAyNTA2MTQwMSAgMTQvMDYvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU0LDkyNy43NSAgICAgICAgICAyMjAsNzAwLjg0ICAgDQogICAgICAgICAgRFNDTkIgQS9DLUtBTFBJVCBLVU1BUiBTSEFSTUEtWUVTQk4xMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDA2MTQwMTQxNTM5MSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE0LzA2LzI1ICBVUEktQU1BWk9OIElORElBLUFNQVpPTkBSQVBMLVJBVE4wMDBSQVBMICAwMDAwMTA2NDM4MzIyNzA4ICAxNC8wNi8yNSAgICAgICAgICAgNiw4ODguMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIxMyw4MTIuODQgICANCiAgICAgICAgICAtMTA2NDM4MzIyNzA4LVlPVSBBUkUgUEFZSU5HIEZPUiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNC8wNi8yNSAgVVBJLUZVUktBTiBCRUctQkhBUkFUUEUuOUgwTTBCMFMxRDUxNzQ3MSAgMDAwMDEwNjQ1ODgzMjcxNSAgMTQvMDYvMjUgICAgICAgICAgICAgMTUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMTMsNjYyLjg0ICAgDQogICAgICAgICAgQFVOSVRZUEUtVU5CQTAwMEJIUEUtMTA2NDU4ODMyNzE1LVBBWSBUTyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIEJIQVJBVFBFIE1FICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE0LzA2LzI1ICBVUEktQVVUTyBDQVJFLVBBWVRNUVIyODEwMDUwNTAxMDFYMlc2UlkzICAwMDAwMTA2NDU5MTc1MTAwICAxNC8wNi8yNSAgICAgICAgICAgMSwwMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIxMiw2NDIuODQgICANCiAgICAgICAgICBUQUNCUkBQQVlUTS1ZRVNCMFBUTVVQSS0xMDY0NTkxNzUxMDAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNC8wNi8yNSAgVVBJLUFOVUogWUFEQVYtUEFZVE1RUjYxUDAxQkBQVFlTLVlFU0IwUCAgMDAwMDEwNjQ4MTE4NzgyMSAgMTQvMDYvMjUgICAgICAgICAgICAgMTY1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMTIsNDc3Ljg0ICAgDQogICAgICAgICAgVE1VUEktMTA2NDgxMTg3ODIxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTQvMDYvMjUgIFVQSS1BTlVKIFlBREFWLVBBWVRNUVI2MVAwMUJAUFRZUy1ZRVNCMFAgIDAwMDAxMDY0ODEyODg4NDggIDE0LzA2LzI1ICAgICAgICAgICAgICA0MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjEyLDQzNy44NCAgIA0KICAgICAgICAgIFRNVVBJLTEwNjQ4MTI4ODg0OC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE1LzA2LzI1ICBVUEktVVJNSUxBLVEyNTMwMjQyNTNAWUJMLVlFU0IwWUJMVVBJLTEwICAwMDAwMTA2NTMwMzQ5MDM0ICAxNS8wNi8yNSAgICAgICAgICAgICAyODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIxMiwxNTcuODQgICANCiAgICAgICAgICA2NTMwMzQ5MDM0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNS8wNi8yNSAgVVBJLUJIQVJBVCBDSEFVSEFOLVBBWVRNUVI2Q0YzWjlAUFRZUy1ZRSAgMDAwMDEwNjUzMDk4MzkxMiAgMTUvMDYvMjUgICAgICAgICAgICAgMTQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMTIsMDE3Ljg0ICAgDQogICAgICAgICAgU0IwUFRNVVBJLTEwNjUzMDk4MzkxMi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTUvMDYvMjUgIFVQSS1YWFhYWFhYWDMyODYtSUNJQzAwMDM0NTgtMTA2NTMyNDkzNTUgIDAwMDAxMDY1MzI0OTM1NTIgIDE1LzA2LzI1ICAgICAgICAgIDUwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTYyLDAxNy44NCAgIA0KICAgICAgICAgIDItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE2LzA2LzI1ICBVUEktU09OQSBGVUVMIENFTlRSRS1QQVlUTVFSMUhQUlNQRkVOR0BQICAwMDAwMTA2NTUzNjM3ODEwICAxNi8wNi8yNSAgICAgICAgICAgMywwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1OSwwMTcuODQgICANCiAgICAgICAgICBBWVRNLVlFU0IwUFRNVVBJLTEwNjU1MzYzNzgxMC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNy8wNi8yNSAgVVBJLVBXQ1VCQ1BVUEktQ1JFRFBBWS5VQkNQQEFYSVNCLVVUSUIwMCAgMDAwMDUxNjg0Mzc1MjU0OSAgMTcvMDYvMjUgICAgICAgICAgICAgMzM4LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTgsNjc5Ljg0ICAgDQogICAgICAgICAgMDAxMTQtNTE2ODQzNzUyNTQ5LVBBWU1FTlQgT04gQ1JFRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTgvMDYvMjUgIFVQSS1TUE9USUZZIElORElBLVNQT1RJRlkuQkRTSUBJQ0lDSS1JQ0kgIDAwMDA1MTY5MTQwMjc2MjcgIDE4LzA2LzI1ICAgICAgICAgICAgICA1OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU4LDYyMC44NCAgIA0KICAgICAgICAgIEMwREMwMDk5LTUxNjkxNDAyNzYyNy1NQU5EQVRFUkVRVUVTVCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMTEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjE4LzA2LzI1ICBVUEktREFSU0hBTiBURU9USUEtUEFZVE0uUzE1QVNMNUBQVFktWUVTICAwMDAwMTA2NjkyNDA3NTg4ICAxOC8wNi8yNSAgICAgICAgICAgICAgMjguMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1OCw1OTIuODQgICANCiAgICAgICAgICBCME1DSFVQSS0xMDY2OTI0MDc1ODgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOS8wNi8yNSAgVVBJLVBPT05BTSBUUkFESU5HIENPTVBBLVExODk0MTc5NjRAWUJMLSAgMDAwMDEwNjczNTg1ODY4OCAgMTkvMDYvMjUgICAgICAgICAgICAgOTkwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTcsNjAyLjg0ICAgDQogICAgICAgICAgWUVTQjBZQkxVUEktMTA2NzM1ODU4Njg4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjAvMDYvMjUgIFVQSS1BTUFaT04gSU5ESUEtQU1BWk9OVVBJQEFQTC1VVElCMDAwMDEgIDAwMDAxMDY3NzgxMDE5NjggIDIwLzA2LzI1ICAgICAgICAgICAgIDQ3OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU3LDEyMy44NCAgIA0KICAgICAgICAgIDAwLTEwNjc3ODEwMTk2OC1ZT1UgQVJFIFBBWUlORyBGT1IgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIxLzA2LzI1ICA1MDIwMDAwMDAwMzQwMiAgIDIwODE1OTUzX0hMSUNfSU5TVCAgICAgICAwMDAwMDAwMDAwMDAwMDAgICAyMS8wNi8yNSAgICAgICAgICAgICA4MTcuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NiwzMDYuODQgICANCjIxLzA2LzI1ICBVUEktTk9MQU4gRURVVEVDSCBQUklWQVQtTk9MQU5FRFVURUNIUFJJICAwMDAwMTA2ODIyNjYyMTg4ICAyMS8wNi8yNSAgICAgICAgICAgICAgOTkuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NiwyMDcuODQgICANCiAgICAgICAgICA0NTkxMjIuUlpQQFJYQUlSVEVMLUFJUlAwMDAwMDExLTEwNjgyMjY2ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgMjE4OC1OT0xBTkVEVVRFQ0hQUklWQVQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjEvMDYvMjUgIEFDSCBDLSBJQ0lDSSBTRUNVUklUSUVTIExJTS0zODE3ODA2ICAgICAgIDAwMDAwMDk4OTY1MzE2MTIgIDIxLzA2LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2NjEuMzkgICAgICAgICAgMTU2LDg2OS4yMyAgIA0KMjMvMDYvMjUgIFVQSS1OQVJFTkRFUiBLVU1BUi05NzE3NTU5MDdASUJMLVBVTkIwNDAgIDAwMDAxMDY5MTk1NjM2MTAgIDIzLzA2LzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU2LDg0OS4yMyAgIA0KICAgICAgICAgIDM5MDAtMTA2OTE5NTYzNjEwLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIzLzA2LzI1ICBVUEktU0hBSElEIEFMSSBTTyBBS0FCQVItODI4NzEzMDE2OFNIQUBBICAwMDAwMTA2OTM0OTYyNDU4ICAyMy8wNi8yNSAgICAgICAgICAgMSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NSw4NDkuMjMgICANCiAgICAgICAgICBYTC1DTlJCMDAwMDAzMy0xMDY5MzQ5NjI0NTgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNC8wNi8yNSAgVVBJLVlBU0hPREFQTFVTWUFTSE9EQVBMLVE5OTU3MDYyNTlAWUJMLSAgMDAwMDEwNjk3MTQ3MjU0NiAgMjQvMDYvMjUgICAgICAgICAgICAgICA4LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTUsODQxLjIzICAgDQogICAgICAgICAgWUVTQjBZQkxVUEktMTA2OTcxNDcyNTQ2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjQvMDYvMjUgIFVQSS1TVVJFU0gtUEFZVE1RUjZHOENFRUBQVFlTLVlFU0IwUFRNVVAgIDAwMDAxMDY5NzE2Mzc3NzMgIDI0LzA2LzI1ICAgICAgICAgICAgIDE2MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU1LDY4MS4yMyAgIA0KICAgICAgICAgIEktMTA2OTcxNjM3NzczLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI1LzA2LzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3MDgxOTgyICAwMDAwMTA3MDQ2NTQ2ODExICAyNS8wNi8yNSAgICAgICAgICAgICA2MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NSwwODEuMjMgICANCiAgICAgICAgICA2NTI2QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwNzA0NjU0NjgxMS1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjUvMDYvMjUgIFA6SzE2Njc1IEhERkMgQkFOSyBTQUxBUlkgRk9SIEpVTiAyMDI1ICAgIDAwMDAwMDAwMDAwMDAwMDEgIDI1LzA2LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI1OSw3NDcuMDAgICAgICAgICAgNDE0LDgyOC4yMyAgIA0KMjUvMDYvMjUgIElNUFMtNTE3NjIyOTU1MTQyLUtBTFBJVCBLVU1BUiBTSEFSTUEtSUQgIDAwMDA1MTc2MjI5NTUxNDIgIDI1LzA2LzI1ICAgICAgICAgMjUwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTY0LDgyOC4yMyAgIA0KICAgICAgICAgIEZCLVhYWFhYWFgyOTUwLSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI2LzA2LzI1ICBVUEktU0FOSkFZIFNIQVJNQS1TU0hBUk1BSU9CQElCTC1JT0JBMDAwICAwMDAwMTA3MDkwMzA2NTI1ICAyNi8wNi8yNSAgICAgICAgICAxMSw3MzAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1MywwOTguMjMgICANCiAgICAgICAgICAwOTMyLTEwNzA5MDMwNjUyNS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNy8wNi8yNSAgQUNIIEQtIFRQIEFDSCBNQVhMSUZFSU5TVVJBLTE4NTI0MjM1NjEgICAgMDAwMDAwMDA0MTE3ODIyOCAgMjcvMDYvMjUgICAgICAgICAgIDEsNDgzLjA2ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTEsNjE1LjE3ICAgDQoyNy8wNi8yNSAgLkRDIElOVEwgUE9TIFRYTiBNQVJLVVArU1QgMDQwNjI1IDA0MDYyNSAgTUlSMjYxNzM4MjExOTk1NSAgMjcvMDYvMjUgICAgICAgICAgICAgIDgzLjU3ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTEsNTMxLjYwICAgDQogICAgICAgICAgLU1JUjI2MTczODIxMTk5NTUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjcvMDYvMjUgIFVQSS1QQVdBTiBBVVRPV0hFRUxTIFBSSS1NQUIuMDM3MjEzMDQ0OTYgIDAwMDAxMDcxMjQwMjY5NTYgIDI3LzA2LzI1ICAgICAgICAgICA2LDM3OC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTQ1LDE1My42MCAgIA0KICAgICAgICAgIDAwMDZAQVhJU0JBTkstVVRJQjAwMDAxMDAtMTA3MTI0MDI2OTU2LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDEyICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQoyNy8wNi8yNSAgSUIgRkQgUFJFTUFUIFBSSU5DSVBBTC01MDMwMDgwNjY3MDc1OSAgICAgMDAwMDAwMDAwMDAwMDAwICAgMjcvMDYvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE2LDYzNi40MCAgICAgICAgICAyNjEsNzkwLjAwICAgDQoyNy8wNi8yNSAgSUIgU1MgRlVORFMgVFJBTlNGRVIgRFItNTUwMDAwMDY2MDQ3ODEgICAgSERGQ0ZCRkNEQkNENjVEQSAgMjcvMDYvMjUgICAgICAgICAxNTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTEsNzkwLjAwICAgDQoyNy8wNi8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDEwNzE1NTUwMDgzNyAgMjcvMDYvMjUgICAgICAgICAgICAgNTkwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTEsMjAwLjAwICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS0xMDcxNTU1MDA4MzctVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzA2LzI1ICBVUEktR09PR0xFIFBMQVktUExBWVNUT1JFQEFYSVNCQU5LLVVUSUIwICAwMDAwMjAyNjkxODkxNzk1ICAyOC8wNi8yNSAgICAgICAgICAgICAxOTkuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExMSwwMDEuMDAgICANCiAgICAgICAgICAwMDA1NTMtMjAyNjkxODkxNzk1LU1BTkRBVEVFWEVDVVRFICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOC8wNi8yNSAgVVBJLVBSQUJIQVQgU0hBUk1BLVBBWVRNUVI2R1NWMENAUFRZUy1ZRSAgMDAwMDEwNzE3OTQwNTk3NyAgMjgvMDYvMjUgICAgICAgICAgICAgIDcwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTAsOTMxLjAwICAgDQogICAgICAgICAgU0IwUFRNVVBJLTEwNzE3OTQwNTk3Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMDYvMjUgIFVQSS1TT05VIEtVTUFSIFNPIFNVS0ggUC05NjkwODY0MTUxQFBUWUUgIDAwMDAxMDcxOTE0NzM0MDEgIDI4LzA2LzI1ICAgICAgICAgICAgICAzMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTEwLDkwMS4wMCAgIA0KICAgICAgICAgIFMtQ05SQjAwMDAwMzMtMTA3MTkxNDczNDAxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzA2LzI1ICBVUEktTUlOQUtTSEkgU0lOR0ggV08gUFItNzk4MjU5MTA3NkBZQkwtICAwMDAwMTA3MTk5OTUwNjQyICAyOC8wNi8yNSAgICAgICAgICAgICA0NTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExMCw0NTEuMDAgICANCiAgICAgICAgICBCS0lEMDAwMDIwMC0xMDcxOTk5NTA2NDItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOC8wNi8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDEwNzIwMzQ0MTk4NSAgMjgvMDYvMjUgICAgICAgICAgICAgMjU1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTAsMTk2LjAwICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS0xMDcyMDM0NDE5ODUtVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI5LzA2LzI1ICBVUEktSU5UQUpBUi1CSEFSQVRQRS45MDA3MTQ3MjczNEBGQlBFLUZEICAwMDAwMTA3MjU2OTkwNzIxICAyOS8wNi8yNSAgICAgICAgICAgICAgNTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExMCwxNDYuMDAgICANCiAgICAgICAgICBSTDAwMDEzODItMTA3MjU2OTkwNzIxLVBBWSBUTyBCSEFSQVRQRSBNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgRSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMzAvMDYvMjUgIEFDSCBDLSBJTkZPU1lTIExJTUlURUQtNDYxNDgyNDYgICAgICAgICAgIDAwMDAwMDAwNjUwMzIxNDEgIDMwLzA2LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA0ODQuMDAgICAgICAgICAgMTEwLDYzMC4wMCAgIA0KMzAvMDYvMjUgIEhERkMgQVNTRVQgTUFOQUcgQ09NIERJVjI0IDI1ICAgICAgICAgICAgIDAwMDAwMDAwMDAwMDAwMCAgIDMwLzA2LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5MDAuMDAgICAgICAgICAgMTExLDUzMC4wMCAgIA0KMzAvMDYvMjUgIEFDSCBDLSBJQ0lDSSBQUlVERU5USUFMIExJRi03MDQyNTA4ICAgICAgIDAwMDAwMDAxMzczNDYyMzcgIDMwLzA2LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEuNzAgICAgICAgICAgMTExLDUzMS43MCAgIA0KMzAvMDYvMjUgIFA6SzE2Njc1IEJPTlVTICBGSU5BTkNJQUwgWUVBUiAyMDI0LTI1ICAgIDAwMDAwMDAwMDAwMDAwMDEgIDMwLzA2LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NSwyNTAuMDAgICAgICAgICAgMjY2LDc4MS43MCAgIA0KMzAvMDYvMjUgIElNUFMtNTE4MTE5OTY3Mjc5LUtBTFBJVCBLVU1BUiBTSEFSTUEtSUQgIDAwMDA1MTgxMTk5NjcyNzkgIDMwLzA2LzI1ICAgICAgICAgMTUwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE2LDc4MS43MCAgIA0KICAgICAgICAgIEZCLVhYWFhYWFgyOTUwLSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjMwLzA2LzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3MDgxOTgyICAwMDAwMTA3MzA3MTkyMjk3ICAzMC8wNi8yNSAgICAgICAgICAgICAxMzQuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExNiw2NDcuNzAgICANCiAgICAgICAgICA2NTI2QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwNzMwNzE5MjI5Ny1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDEvMDcvMjUgIElOVEVSRVNUIFBBSUQgVElMTCAzMC1KVU4tMjAyNSAgICAgICAgICAgIDAwMDAwMDAwMDAwMDAwMCAgIDMwLzA2LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4ODQuMDAgICAgICAgICAgMTE3LDUzMS43MCAgIA0KMDEvMDcvMjUgIEFDSCBDLSBJQ0lDSSBMT01CQVJEIEdFTkVSQS01ODI2MDQ3ICAgICAgIDAwMDAwMDAxNjIyMzQ5MTQgIDAxLzA3LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTQuMDAgICAgICAgICAgMTE3LDY4NS43MCAgIA0KMDEvMDcvMjUgIDUwNDAwMzM0OTE4NzEzLSBSRCBJTlNUQUxMTUVOVC1KVUwgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDAxLzA3LzI1ICAgICAgICAgIDEwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA3LDY4NS43MCAgIA0KMDEvMDcvMjUgIDUwNDAwMzM0OTE4ODMxLSBSRCBJTlNUQUxMTUVOVC1KVUwgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDAxLzA3LzI1ICAgICAgICAgICAzLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA0LDY4NS43MCAgIA0KMDIvMDcvMjUgIFVQSS1HT09HTEUgSU5ESUEgRElHSVRBTC1QTEFZU1RPUkVAQVhJU0IgIDAwMDAxMDczOTM3MjY0MDkgIDAyLzA3LzI1ICAgICAgICAgICAgICA0MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA0LDY0NS43MCAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICoqQ29udGludWUqKiANCg0KDQoNCkhERkMgQkFOSyBMdGQuICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBhZ2UgTm8gLjogICAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIEFOSy1VVElCMDAwMDU1My0xMDczOTM3MjY0MDktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAyLzA3LzI1ICBVUEktR09PR0xFIFBBWS1HT09HTEVQQVlAQVhJU0JBTkstVVRJQjAwICAwMDAwNzA5MjQxMTcxODM1ICAwMi8wNy8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDQwLjAwICAgICAgICAgIDEwNCw2ODUuNzAgICANCiAgICAgICAgICAwMDAwNC03MDkyNDExNzE4MzUtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMi8wNy8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDEwNzQyODk2MjQ0NyAgMDIvMDcvMjUgICAgICAgICAgICAgMTM0LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDQsNTUxLjcwICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS0xMDc0Mjg5NjI0NDctVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAzLzA3LzI1ICBVUEktUklERSBOIFJFUEFJUi1SSURFTlJFUEFJUjcyMjgwMi5SWlBAICAwMDAwMTA3NDUzNzY4OTMyICAwMy8wNy8yNSAgICAgICAgICAgICA0MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwNCwxNTEuNzAgICANCiAgICAgICAgICBBWElTQkFOSy1VVElCMDAwMDEwMC0xMDc0NTM3Njg5MzItUU9VVEdPICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgSDZSTk1aWEwgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDQvMDcvMjUgIFVQSS1BTUFaT04gSU5ESUEtQU1BWk9OVVBJQEFQTC1VVElCMDAwMDEgIDAwMDAxMDc1MTY4MDI5ODggIDA0LzA3LzI1ICAgICAgICAgICAxLDg5NC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTAyLDI1Ny43MCAgIA0KICAgICAgICAgIDAwLTEwNzUxNjgwMjk4OC1ZT1UgQVJFIFBBWUlORyBGT1IgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzA3LzI1ICBVUEktQUpBWSBTT05ESEktR1BBWS0xMTIwOTQyOTAyNkBPS0JJWkFYICAwMDAwMTA3NTc3ODIwNDg3ICAwNS8wNy8yNSAgICAgICAgICAgICAgNTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwMiwyMDcuNzAgICANCiAgICAgICAgICBJUy1VVElCMDAwMDU1My0xMDc1Nzc4MjA0ODctVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNS8wNy8yNSAgVVBJLUxBTE1BTiBMQVNTSSBXQUxFLVBBWVRNUVI2R1FLMEdAUFRZUyAgMDAwMDEwNzU3OTUyMjQzNyAgMDUvMDcvMjUgICAgICAgICAgICAgMjUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDEsOTU3LjcwICAgDQogICAgICAgICAgLVlFU0IwUFRNVVBJLTEwNzU3OTUyMjQzNy1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDUvMDcvMjUgIFVQSS1EQVVKSSBTRVJWSUNFIFNUQVRJTy1QQVlUTVFSNjBHMVoyQFAgIDAwMDAxMDc1OTM3ODQwMTAgIDA1LzA3LzI1ICAgICAgICAgICAyLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk5LDk1Ny43MCAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMDc1OTM3ODQwMTAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzA3LzI1ICBVUEktU09OQSBGVUVMIENFTlRSRS1QQVlUTVFSMjgxMDA1MDUwMTAxICAwMDAwMTA3NTk2MTY4Mjk4ICAwNS8wNy8yNSAgICAgICAgICAgICAxMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5OSw4NTcuNzAgICANCiAgICAgICAgICAxVE1TMzFHNUhEOEVAUEFZVE0tWUVTQjBQVE1VUEktMTA3NTk2MTY4ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgMjk4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDYvMDcvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDc2NTQ1MDkxNjUgIDA2LzA3LzI1ICAgICAgICAgICAgIDEzNC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk5LDcyMy43MCAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTA3NjU0NTA5MTY1LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMC8wNy8yNSAgNTA0MDAzMTgxODY3ODAtIFJEIElOU1RBTExNRU5ULUpVTCAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDcvMjUgICAgICAgICAgIDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTQsNzIzLjcwICAgDQoxMC8wNy8yNSAgNTA0MDAzMTgxODcwMjctIFJEIElOU1RBTExNRU5ULUpVTCAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDcvMjUgICAgICAgICAgNDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNDksNzIzLjcwICAgDQoxMi8wNy8yNSAgVVBJLUFOVUogWUFEQVYtUEFZVE1RUjYxUDAxQkBQVFlTLVlFU0IwUCAgMDAwMDEwNzk2NjA5OTYyMyAgMTIvMDcvMjUgICAgICAgICAgICAgIDYwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNDksNjYzLjcwICAgDQogICAgICAgICAgVE1VUEktMTA3OTY2MDk5NjIzLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTIvMDcvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTcwODE5ODIgIDAwMDAxMDc5OTcwMzM5NzQgIDEyLzA3LzI1ICAgICAgICAgICAgICA4NS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDQ5LDU3OC43MCAgIA0KICAgICAgICAgIDY1MjZASERGQ0JBTkstSERGQzBNRVJVUEktMTA3OTk3MDMzOTc0LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMi8wNy8yNSAgVVBJLTEwOEdPVklORFBVUkFNIEdIQVpJLVBBWVRNUVIyODEwMDUwNSAgMDAwMDEwNzk5ODg1MDgwMiAgMTIvMDcvMjUgICAgICAgICAgICAgMjc1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNDksMzAzLjcwICAgDQogICAgICAgICAgMDEwMTFQNEU4WTVURTVHR0BQQVlUTS1ZRVNCMFBUTVVQSS0xMDc5OSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDg4NTA4MDItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMTQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjE1LzA3LzI1ICBVUEktQVJTSEFEIC05ODcwOTk1Mzc0QElERkNGSVJTVC1JREZCMDA0ICAwMDAwMTA4MTU2MzA3MTI1ICAxNS8wNy8yNSAgICAgICAgICAgICAyMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA0OSwxMDMuNzAgICANCiAgICAgICAgICAwMTAxLTEwODE1NjMwNzEyNS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOS8wNy8yNSAgVVBJLVVUVEFSIFBSQURFU0ggUE9XRVIgLUJJTExERVNLUEcuVVBQQyAgMDAwMDEwMTAxMjIxMjY5MCAgMTkvMDcvMjUgICAgICAgICAgMTIsMjIzLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzYsODgwLjcwICAgDQogICAgICAgICAgTEBIREZDQkFOSy1IREZDME1FUlVQSS0xMDEwMTIyMTI2OTAtVVBQQyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIExSICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIwLzA3LzI1ICBVUEktTVIgQU1BTiAgU0lOR0gtUTMzMzMwMDkwM0BZQkwtWUVTQjBZICAwMDAwMTA4NDMyMjI3Mzg0ICAyMC8wNy8yNSAgICAgICAgICAgICA3NTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzNiwxMzAuNzAgICANCiAgICAgICAgICBCTFVQSS0xMDg0MzIyMjczODQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMC8wNy8yNSAgVVBJLVRBVEEgMU1HIEhFQUxUSENBUkUgLTFNR1RFQ0hOT0xPR0lFUyAgMDAwMDEwODQ0MzU5NTQ2NCAgMjAvMDcvMjUgICAgICAgICAgICAgMTg2LjYwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzUsOTQ0LjEwICAgDQogICAgICAgICAgQFBUWUJMLVlFU0IwUFRNVVBJLTEwODQ0MzU5NTQ2NC1VUEkgSU5URSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIE5UICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIwLzA3LzI1ICBVUEktVEFUQSAxTUcgSEVBTFRIQ0FSRSAtMU1HVEVDSE5PTE9HSUVTICAwMDAwNTU2NzA2NTgwMTA3ICAyMC8wNy8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTg2LjYwICAgICAgICAgICAzNiwxMzAuNzAgICANCiAgICAgICAgICBAUFRZQkwtWUVTQjBQVE1VUEktNTU2NzA2NTgwMTA3LUVYUFJFU1MgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMC8wNy8yNSAgVVBJLURBUlNIQU4gVEVPVElBLVBBWVRNLlMxNUFTTDVAUFRZLVlFUyAgMDAwMDEwODQ0NzA4MjUxNCAgMjEvMDcvMjUgICAgICAgICAgICAgMTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzYsMDMwLjcwICAgDQogICAgICAgICAgQjBNQ0hVUEktMTA4NDQ3MDgyNTE0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjAvMDcvMjUgIFVQSS1TT05BIEZVRUwgQ0VOVFJFLVBBWVRNUVIyODEwMDUwNTAxMDEgIDAwMDAxMDg0NDcxNTQ4OTUgIDIxLzA3LzI1ICAgICAgICAgICAxLDUyNy4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDM0LDUwMy43MCAgIA0KICAgICAgICAgIDFTN0hQMUxHNE1XMkBQQVlUTS1ZRVNCMFBUTVVQSS0xMDg0NDcxNTQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA4OTUtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMS8wNy8yNSAgNTAyMDAwMDAwMDM0MDIgICAyMDgxNTk1M19ITElDX0lOU1QgICAgICAgMDAwMDAwMDAwMDAwMDAwICAgMjEvMDcvMjUgICAgICAgICAgICAgODE3LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzMsNjg2LjcwICAgDQoyMS8wNy8yNSAgRlQtIDE5ODM1MTE0ODgtNTc1MDAwMDAzODkxNTkgLSBJTkRJQU4gQyAgMDAwMDAwMTE1NjMxOTQ5MiAgMjEvMDcvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTc4LDQ3Mi4zOSAgICAgICAgICAyMTIsMTU5LjA5ICAgDQogICAgICAgICAgTEVBUklORyBDT1JQT1JBVElPTiBMSU1JVEVEIC0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjEvMDcvMjUgIEZULSAxOTgzNTEyNDcwLTU3NTAwMDAwMzg5MTU5IC0gSU5ESUFOIEMgIDAwMDAwMDExNTYzMTk0OTMgIDIxLzA3LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA1NSw0NjMuMzUgICAgICAgICAgMjY3LDYyMi40NCAgIA0KICAgICAgICAgIExFQVJJTkcgQ09SUE9SQVRJT04gTElNSVRFRCAtICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIxLzA3LzI1ICBGVC0gMTk4MzUxMTM3MC01NzUwMDAwMDM4OTE1OSAtIElORElBTiBDICAwMDAwMDAxMTU2MzMyNTIxICAyMS8wNy8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAxODcsODE3Ljg5ICAgICAgICAgIDQ1NSw0NDAuMzMgICANCiAgICAgICAgICBMRUFSSU5HIENPUlBPUkFUSU9OIExJTUlURUQgLSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMS8wNy8yNSAgRlQtIDE5ODM1MTIzMzQtNTc1MDAwMDAzODkxNTkgLSBJTkRJQU4gQyAgMDAwMDAwMTE1NjMyNTQyMCAgMjEvMDcvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgyLDIyMi40OSAgICAgICAgICA1MzcsNjYyLjgyICAgDQogICAgICAgICAgTEVBUklORyBDT1JQT1JBVElPTiBMSU1JVEVEIC0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjEvMDcvMjUgIEZULSAxOTgzNTEyNjQ2LTU3NTAwMDAwMzg5MTU5IC0gSU5ESUFOIEMgIDAwMDAwMDExNTYzMjczMDggIDIxLzA3LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA1NCwwNDcuMzYgICAgICAgICAgNTkxLDcxMC4xOCAgIA0KICAgICAgICAgIExFQVJJTkcgQ09SUE9SQVRJT04gTElNSVRFRCAtICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIxLzA3LzI1ICBGVC0gMTk4MzUxMDYyMC01NzUwMDAwMDM4OTE1OSAtIElORElBTiBDICAwMDAwMDAxMTU2MzI3MzA5ICAyMS8wNy8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNzUsNjMyLjM2ICAgICAgICAgIDg2NywzNDIuNTQgICANCiAgICAgICAgICBMRUFSSU5HIENPUlBPUkFUSU9OIExJTUlURUQgLSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMS8wNy8yNSAgRlQtIDE5ODM1MTEwOTEtNTc1MDAwMDAzODkxNTkgLSBJTkRJQU4gQyAgMDAwMDAwMTE1NjMyNzMxMCAgMjEvMDcvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjIzLDQ0Ny42NCAgICAgICAgMSwwOTAsNzkwLjE4ICAgDQogICAgICAgICAgTEVBUklORyBDT1JQT1JBVElPTiBMSU1JVEVEIC0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjEvMDcvMjUgIFJUR1MgRFItSURGQjAwMjE0MTItS0FMUElUIEtVTUFSIFNIQVJNQS0gIEhERkNSNTIwMjUwNzIxOTIgIDIxLzA3LzI1ICAgICAgIDEsMDAwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDkwLDc5MC4xOCAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICoqQ29udGludWUqKiANCg0KDQoNCkhERkMgQkFOSyBMdGQuICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBhZ2UgTm8gLjogICAxNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIE5FVEJBTkssIE1VTS1IREZDUjUyMDI1MDcyMTkyODMyMTU0LUhERkMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA2RjNENzVFQjlENjQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8wNy8yNSAgVVBJLVZJLVBBWUJJTDMwNjZAUFRZQkwtWUVTQjBQVE1VUEktNTIwNiAgMDAwMDUyMDY0ODI5MTUzNCAgMjUvMDcvMjUgICAgICAgICAgICAgODYyLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODksOTI4LjE4ICAgDQogICAgICAgICAgNDgyOTE1MzQtU0VOVCBVU0lORyBQQVlUTSBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjUvMDcvMjUgIFVQSS1NUyBTSFlBTSBNRURJQ09TLVEzODgwMDE4MDlAWUJMLVlFU0IgIDAwMDAxMDg2Nzk3MDI1MzUgIDI1LzA3LzI1ICAgICAgICAgICAgIDIyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDg5LDcwOC4xOCAgIA0KICAgICAgICAgIDBZQkxVUEktMTA4Njc5NzAyNTM1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI1LzA3LzI1ICBJTVBTLTUyMDYxNjk5Mjk4Ni1LQUxQSVQgS1VNQVIgU0hBUk1BLUlEICAwMDAwNTIwNjE2OTkyOTg2ICAyNS8wNy8yNSAgICAgICAgICA1MCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzOSw3MDguMTggICANCiAgICAgICAgICBGQi1YWFhYWFhYMjk1MC0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8wNy8yNSAgUDpLMTY2NzUgSERGQyBCQU5LIFNBTEFSWSBGT1IgSlVMIDIwMjUgICAgMDAwMDAwMDAwMDAwMDAwMSAgMjUvMDcvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjczLDI1OS4wMCAgICAgICAgICAzMTIsOTY3LjE4ICAgDQoyNS8wNy8yNSAgSU1QUy01MjA2MjM5MjAwNzQtS0FMUElUIEtVTUFSIFNIQVJNQS1JRCAgMDAwMDUyMDYyMzkyMDA3NCAgMjUvMDcvMjUgICAgICAgICAyMDAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTIsOTY3LjE4ICAgDQogICAgICAgICAgRkItWFhYWFhYWDI5NTAtICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjcvMDcvMjUgIEFDSCBELSBUUCBBQ0ggTUFYTElGRUlOU1VSQS0xODg0Njc1MjE0ICAgIDAwMDAwMDEyMjU1OTQ4ODUgIDI3LzA3LzI1ICAgICAgICAgICAxLDQ4My4wNiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTExLDQ4NC4xMiAgIA0KMjcvMDcvMjUgIFVQSS1LQU5OVSBTSFJFRSBHQVVSLTg1Mjc2MTA0NTZAUFRBWElTLUsgIDAwMDA1MjA4NDY1NDY4MDkgIDI3LzA3LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMSwwMDAuMDAgICAgICAgICAgMTEyLDQ4NC4xMiAgIA0KICAgICAgICAgIEtCSzAwMDUzNDAtNTIwODQ2NTQ2ODA5LVNFTlQgVVNJTkcgUEFZVE0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNy8wNy8yNSAgVVBJLVJBSkVORFJBIFNJTkdILVBBWVRNUVI2NThWT0hAUFRZUy1ZRSAgMDAwMDEwODgwNTIyNDM0NyAgMjcvMDcvMjUgICAgICAgICAgICAgNTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTEsOTg0LjEyICAgDQogICAgICAgICAgU0IwUFRNVVBJLTEwODgwNTIyNDM0Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMDcvMjUgIFVQSS1HT09HTEUgUExBWS1QTEFZU1RPUkVAQVhJU0JBTkstVVRJQjAgIDAwMDAxMjc2NDI0NjIwOTUgIDI4LzA3LzI1ICAgICAgICAgICAgIDE5OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTExLDc4NS4xMiAgIA0KICAgICAgICAgIDAwMDU1My0xMjc2NDI0NjIwOTUtTUFOREFURUVYRUNVVEUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzA3LzI1ICBVUEktTUFIQSBHQU5FU0ggVFJBREVSUy1QQVlUTVFSNkQ0S0g5QFBUICAwMDAwMTA4ODUyNDgwMTEzICAyOC8wNy8yNSAgICAgICAgICAgICA0ODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExMSwzMDUuMTIgICANCiAgICAgICAgICBZUy1ZRVNCMFBUTVVQSS0xMDg4NTI0ODAxMTMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOC8wNy8yNSAgVVBJLUNIQVJBTiBTSU5HSCBTTyBBVEFSLVE5OTYzMjA4ODFAWUJMLSAgMDAwMDEwODg1MzI0MjYwNSAgMjgvMDcvMjUgICAgICAgICAgICAgIDgwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTEsMjI1LjEyICAgDQogICAgICAgICAgWUVTQjBZQkxVUEktMTA4ODUzMjQyNjA1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMDcvMjUgIFVQSS1TSFlBTSBNRURJQ09TLVBBWVRNUVI2M1NIT1ZAUFRZUy1ZRVMgIDAwMDAxMDg4NTM3Mzg0MjUgIDI4LzA3LzI1ICAgICAgICAgICAgIDEwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTExLDEyNS4xMiAgIA0KICAgICAgICAgIEIwUFRNVVBJLTEwODg1MzczODQyNS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI5LzA3LzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZENEtIN0BQICAwMDAwMTA4OTA5ODUxNzMyICAyOS8wNy8yNSAgICAgICAgICAgICAgMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExMSwxMDUuMTIgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTA4OTA5ODUxNzMyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQozMS8wNy8yNSAgVVBJLUtBVVNIQUwgS1VNQVIgIFNIQVJNLTk1NjAzOTMyNDFAUFRTQiAgMDAwMDEwODk3ODc3MDIzNCAgMzEvMDcvMjUgICAgICAgICAgIDMsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDgsMTA1LjEyICAgDQogICAgICAgICAgSS1TQklOMDAxNzYyMi0xMDg5Nzg3NzAyMzQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMzEvMDcvMjUgIFVQSS1JTkRSQVBSQVNUSEEgR0FTIExJTS1CSUxMREVTS1BHLklORFIgIDAwMDAxMDEwODg5NDUyODkgIDMxLzA3LzI1ICAgICAgICAgICAxLDEzNi40MCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA2LDk2OC43MiAgIA0KICAgICAgICAgIEFQUkFTVEhBQEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMTA4ODk0NTIgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA4OS1JR0wgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQozMS8wNy8yNSAgVVBJLUlORFJBUFJBU1RIQSBHQVMgTElNLUJJTExERVNLUEcuSU5EUiAgMDAwMDEwMTA4OTA4OTM1MiAgMzEvMDcvMjUgICAgICAgICAgIDMsNDgwLjEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDMsNDg4LjU5ICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDE2ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgQVBSQVNUSEFASERGQ0JBTkstSERGQzBNRVJVUEktMTAxMDg5MDg5MyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDUyLUlHTCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjMxLzA3LzI1ICBVUEktQUlSVEVMIERJUkVDVCBVUEkgUE8tQUlSVEVMRElSRUNUVVBJICAwMDAwMTA5MDE3OTUwNjIzICAzMS8wNy8yNSAgICAgICAgICAgMSw3MjkuNjQgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwMSw3NTguOTUgICANCiAgICAgICAgICA2OTcyNTkuUlpQQFJYQUlSVEVMLUFJUlAwMDAwMDExLTEwOTAxNzk1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgMDYyMy1BSVJURUxQT1NUUEFJREJJTEwgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDEvMDgvMjUgIFVQSS1ESEFSTUVORFJBIEtVTUFSLVBBWVRNUVI2SEo1SUVAUFRZUy0gIDAwMDA1NTc5NDQ1NjY5NTggIDAxLzA4LzI1ICAgICAgICAgICAgIDEyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTAxLDYzOC45NSAgIA0KICAgICAgICAgIFlFU0IwUFRNVVBJLTU1Nzk0NDU2Njk1OC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzA4LzI1ICBVUEktTUFIQSBHQU5FU0ggVFJBREVSUy1QQVlUTVFSNkQ0S0g5QFBUICAwMDAwNTU3OTMzODcwMTMwICAwMS8wOC8yNSAgICAgICAgICAgICAgMzAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwMSw2MDguOTUgICANCiAgICAgICAgICBZUy1ZRVNCMFBUTVVQSS01NTc5MzM4NzAxMzAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMS8wOC8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDU1Nzk3ODM2NzMyMSAgMDEvMDgvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDEsNTU4Ljk1ICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS01NTc5NzgzNjczMjEtVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzA4LzI1ICA1MDQwMDMzNDkxODcxMy0gUkQgSU5TVEFMTE1FTlQtQVVHIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAwMS8wOC8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5MSw1NTguOTUgICANCjAxLzA4LzI1ICA1MDQwMDMzNDkxODgzMS0gUkQgSU5TVEFMTE1FTlQtQVVHIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAwMS8wOC8yNSAgICAgICAgICAgMywwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4OCw1NTguOTUgICANCjAyLzA4LzI1ICBVUEktSElUS0FSSSBTVE9SRS1HUEFZLTExMjU2NDM5MzYyQE9LQklaICAwMDAwMTA5MTA3ODIxNjUwICAwMi8wOC8yNSAgICAgICAgICAxNiw5MDkuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3MSw2NDkuOTUgICANCiAgICAgICAgICBBWElTLVVUSUIwMDAwNTUzLTEwOTEwNzgyMTY1MC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMy8wOC8yNSAgVVBJLURSIE5JU0hBTlQgR1VQVEEgREFXLURSTklPTkxJTkVAWUJMLSAgMDAwMDUyMTUyMDQ3NjkwMSAgMDMvMDgvMjUgICAgICAgICAgIDEsMjU1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNzAsMzk0Ljk1ICAgDQogICAgICAgICAgWUVTQjBZQkxVUEktNTIxNTIwNDc2OTAxLUNPTExFQ1QgUkVRVUVTVCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIEZSICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzA4LzI1ICBVUEktUkFWSU5EUkEgS1VNQVIgR1VQVEEtUEFZVE1RUjI4MTAwNTA1ICAwMDAwMTA5Mjk0Nzc3MjIxICAwNS8wOC8yNSAgICAgICAgICAgICAxNDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3MCwyNTQuOTUgICANCiAgICAgICAgICAwMTAxVURDRDFWQUxESzY3QFBBWVRNLVlFU0IwUFRNVVBJLTEwOTI5ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgNDc3NzIyMS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDUvMDgvMjUgIFVQSS1NUyBBR0dBUldBTCBTV0VFVCAgQy1QQVlUTVFSNkQ0S0g3QFAgIDAwMDAxMDkzMDc3OTUxNTMgIDA1LzA4LzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDcwLDIzNC45NSAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMDkzMDc3OTUxNTMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA2LzA4LzI1ICBVUEktR0FSSU1BIFNIQVJNQS1HQVJJTUFTSEFSTUExOTkzLTJAT0tBICAwMDAwMTA5Mzc1ODE2NjMyICAwNi8wOC8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2MCwyMzQuOTUgICANCiAgICAgICAgICBYSVMtVVRJQjAwMDE4MTItMTA5Mzc1ODE2NjMyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOC8wOC8yNSAgLkRDIElOVEwgUE9TIFRYTiBNQVJLVVArU1QgMTcwNTI1IDIwMDUyNSAgTUlSMjYyMTk4Mjg2Mzg0NiAgMDgvMDgvMjUgICAgICAgICAgICAgIDc0LjI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjAsMTYwLjcwICAgDQogICAgICAgICAgLU1JUjI2MjE5ODI4NjM4NDYgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDkvMDgvMjUgIFVQSS1NQURBTiBTV0VFVFMgQU5EIFJFUy1WWUFQQVIuMTcyMjU0MTYgIDAwMDAxMDk1MTY1MTg1NTEgIDA5LzA4LzI1ICAgICAgICAgICAyLDU1MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDU3LDYxMC43MCAgIA0KICAgICAgICAgIDU0MjFASERGQ0JBTkstSERGQzBNRVJVUEktMTA5NTE2NTE4NTUxLVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMC8wOC8yNSAgNTA0MDAzMTgxODY3ODAtIFJEIElOU1RBTExNRU5ULUFVRyAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDgvMjUgICAgICAgICAgIDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNTIsNjEwLjcwICAgDQoxMC8wOC8yNSAgNTA0MDAzMTgxODcwMjctIFJEIElOU1RBTExNRU5ULUFVRyAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDgvMjUgICAgICAgICAgNDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDcsNjEwLjcwICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDE3ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQoxMS8wOC8yNSAgSERGQyBCQU5LIFNQTCBJTlQgRElWIDIwMjUtMjYgICAgICAgICAgICAgMDAwMDA2MDgyNTE0MTYwOSAgMTEvMDgvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNS4wMCAgICAgICAgICAgIDcsNjE1LjcwICAgDQoxMS8wOC8yNSAgMDgwODQxNTE1NiBIREZDIEJBTksgRklOQUwgRElWIDIwMjQtMjAyNSAgMDAwMDA4MDgyNTE0MTc1NCAgMTEvMDgvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMi4wMCAgICAgICAgICAgIDcsNjM3LjcwICAgDQoxMS8wOC8yNSAgTkVGVCBDUi1JQ0lDMDA5OTk5OS1DRU5UUkFMIERFUE9TSVRPUlkgUyAgSUNJQ04yMjAyNTA4MTEyOCAgMTEvMDgvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxLDEyNS4wMCAgICAgICAgICAgIDgsNzYyLjcwICAgDQogICAgICAgICAgRVJWSUNFUyAgSU5ESUEgIExJTUlURUQgICAgIEctS0FMUElUIEtVTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIEFSIFNIQVJNQS1JQ0lDTjIyMDI1MDgxMTI4NDAzNjg1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEyLzA4LzI1ICBBQ0ggQy0gSEVST01PVE9DT1JQTFRELTAwMDAwMDAwMDAwMDA4MTcwICAwMDAwMDA3MTY0MDMwMjI0ICAxMi8wOC8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDY1LjAwICAgICAgICAgICAgOCw4MjcuNzAgICANCjEyLzA4LzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZENEtIN0BQICAwMDAwMTA5NzEzMTEwMzIyICAxMi8wOC8yNSAgICAgICAgICAgICAgMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOCw4MDcuNzAgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTA5NzEzMTEwMzIyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNC8wOC8yNSAgVVBJLVNPTkEgRlVFTCBDRU5UUkUtUEFZVE1RUjE1TklDSFcxRllAUCAgMDAwMDEwOTgxOTAxMDU0MiAgMTQvMDgvMjUgICAgICAgICAgICAgNzAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgsMTA3LjcwICAgDQogICAgICAgICAgQVlUTS1ZRVNCMFBUTVVQSS0xMDk4MTkwMTA1NDItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTUvMDgvMjUgIFVQSS1QUkFCSEFUIFNIQVJNQS1QQVlUTVFSNjgzT0EyQFBUWVMtWUUgIDAwMDAxMDk4Njk1ODQxMjIgIDE1LzA4LzI1ICAgICAgICAgICAgIDEwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4LDAwNy43MCAgIA0KICAgICAgICAgIFNCMFBUTVVQSS0xMDk4Njk1ODQxMjItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE1LzA4LzI1ICBVUEktQU5JTCBLVU1BUi1QQVlUTVFSMTZQN0tZWUpYSkBQQVlUTS1ZICAwMDAwMTA5ODY5OTk0MzM3ICAxNS8wOC8yNSAgICAgICAgICAgICAgMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNyw5ODcuNzAgICANCiAgICAgICAgICBFU0IwUFRNVVBJLTEwOTg2OTk5NDMzNy1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNi8wOC8yNSAgTkVGVCBDUi1JQ0lDMDA5OTk5OS1DRU5UUkFMIERFUE9TSVRPUlkgUyAgSUNJQ04yMjAyNTA4MTYzMiAgMTYvMDgvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDQxMi4wMCAgICAgICAgICAgIDgsMzk5LjcwICAgDQogICAgICAgICAgRVJWSUNFUyAgSU5ESUEgIExJTUlURUQgICAgIEctS0FMUElUIEtVTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIEFSIFNIQVJNQS1JQ0lDTjIyMDI1MDgxNjMyNDgxNjczICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE2LzA4LzI1ICBVUEktU1VSQkhJIFNPTEFOS0ktUEFZVE1RUjFLSTBOSFFMN1lAUEFZICAwMDAwMTA5OTA3MDcxMDU3ICAxNi8wOC8yNSAgICAgICAgICAgICA1MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNyw4OTkuNzAgICANCiAgICAgICAgICBUTS1ZRVNCMFBUTVVQSS0xMDk5MDcwNzEwNTctVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNi8wOC8yNSAgVVBJLUFSQ0hBTkEtUEFZVE1RUk9JTDVTTzBUR0NAUEFZVE0tWUVTQiAgMDAwMDEwOTkxMDM0ODQ2MyAgMTYvMDgvMjUgICAgICAgICAgICAgNzAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDcsMTk5LjcwICAgDQogICAgICAgICAgMFBUTVVQSS0xMDk5MTAzNDg0NjMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTYvMDgvMjUgIFVQSS1NQUhBIEdBTkVTSCBUUkFERVJTLVBBWVRNUVI2RDRLR1pAUFQgIDAwMDAxMDk5MTIzOTY1NjcgIDE2LzA4LzI1ICAgICAgICAgICAgIDI5MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2LDkwOS43MCAgIA0KICAgICAgICAgIFlTLVlFU0IwUFRNVVBJLTEwOTkxMjM5NjU2Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE2LzA4LzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3MDgxOTgyICAwMDAwMTA5OTEzMDQ0MTQxICAxNi8wOC8yNSAgICAgICAgICAgICAxMzQuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNiw3NzUuNzAgICANCiAgICAgICAgICA2NTI2QEhERkNCQU5LLUhERkMwTUVSVVBJLTEwOTkxMzA0NDE0MS1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTcvMDgvMjUgIFVQSS1ESU5FU0ggIEtVTUFSLTkwNDU4NjQ3NzFAUFRTQkktU0JJTjAgIDAwMDAxMDk5NDQxNTg1NjIgIDE3LzA4LzI1ICAgICAgICAgICAgIDExMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2LDY2NS43MCAgIA0KICAgICAgICAgIDAwNDUzOS0xMDk5NDQxNTg1NjItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE3LzA4LzI1ICBVUEktMTA4R09WSU5EUFVSQU0gR0hBWkktUEFZVE1RUjI4MTAwNTA1ICAwMDAwMTA5OTU4NjY2Nzk5ICAxNy8wOC8yNSAgICAgICAgICAgICAgNjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNiw2MDUuNzAgICANCiAgICAgICAgICAwMTAxMVA0RThZNVRFNUdHQFBBWVRNLVlFU0IwUFRNVVBJLTEwOTk1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgODY2Njc5OS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTgvMDgvMjUgIFVQSS1LRVNIQVYgR1VQVEEtUEFZVE1RUjFYR01PSUtKSlVAUEFZVE0gIDAwMDAxMDk5OTA5MjQ2MTIgIDE4LzA4LzI1ICAgICAgICAgICAgIDUwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2LDEwNS43MCAgIA0KICAgICAgICAgIC1ZRVNCMFBUTVVQSS0xMDk5OTA5MjQ2MTItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMTggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjE4LzA4LzI1ICBVUEktRlVSS0FOIEJFRy1CSEFSQVRQRS45SDBNMEIwUzFENTE3NDcxICAwMDAwMTA5OTk1MTQyODkzICAxOC8wOC8yNSAgICAgICAgICAgICA0NTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNSw2NTUuNzAgICANCiAgICAgICAgICBAVU5JVFlQRS1VTkJBMDAwQkhQRS0xMDk5OTUxNDI4OTMtUEFZIFRPICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgQkhBUkFUUEUgTUUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTgvMDgvMjUgIFVQSS1LSEFMSUQgQUxJIFNPIFNIQUhJRC1RMDM0Mzg5NjE5QFlCTC0gIDAwMDAxMTAwMDU3Nzg0NDggIDE4LzA4LzI1ICAgICAgICAgICAxLDQwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA0LDI1NS43MCAgIA0KICAgICAgICAgIFlFU0IwWUJMVVBJLTExMDAwNTc3ODQ0OC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE5LzA4LzI1ICBVUEktS0FMUElUIEtVTUFSIFNIQVJNQS1LQUxQSVQuQ09PTDIwMDZAICAwMDAwNTIzMTA3MjcwMTEwICAxOS8wOC8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTUsMDAwLjAwICAgICAgICAgICAxOSwyNTUuNzAgICANCiAgICAgICAgICBPS0lDSUNJLUlDSUMwMDAzNDU4LTUyMzEwNzI3MDExMC1TRUxGICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOS8wOC8yNSAgVVBJLVVUVEFSIFBSQURFU0ggUE9XRVIgLUJJTExERVNLUEcuVVBQQyAgMDAwMDEwMTI0NDY0NTc5NSAgMTkvMDgvMjUgICAgICAgICAgMTEsNjA2LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDcsNjQ5LjcwICAgDQogICAgICAgICAgTEBIREZDQkFOSy1IREZDME1FUlVQSS0xMDEyNDQ2NDU3OTUtVVBQQyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIExSICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIwLzA4LzI1ICBVUEktR09PR0xFIElORElBIERJR0lUQUwtUExBWVNUT1JFQEFYSVNCICAwMDAwMTEwMDg1MTA5MDc1ICAyMC8wOC8yNSAgICAgICAgICAgICAgNDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNyw2MDkuNzAgICANCiAgICAgICAgICBBTkstVVRJQjAwMDA1NTMtMTEwMDg1MTA5MDc1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMS8wOC8yNSAgNTAyMDAwMDAwMDM0MDIgICAyMDgxNTk1M19ITElDX0lOU1QgICAgICAgMDAwMDAwMDAwMDAwMDAwICAgMjEvMDgvMjUgICAgICAgICAgICAgODE3LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDYsNzkyLjcwICAgDQoyMy8wOC8yNSAgVVBJLVNIWUFNIE1FRElDT1MtUEFZVE1RUjYzU0hPVkBQVFlTLVlFUyAgMDAwMDExMDIzMTkyNjMzMyAgMjMvMDgvMjUgICAgICAgICAgICAgMjkwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDYsNTAyLjcwICAgDQogICAgICAgICAgQjBQVE1VUEktMTEwMjMxOTI2MzMzLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjQvMDgvMjUgIFVQSS1ESUxJUCAgS1VNQVItVklQSU5WSVBJTkE3MkBPS1NCSS1TQkkgIDAwMDAxMTAyNTEzMzEyMjggIDI0LzA4LzI1ICAgICAgICAgICAgIDEwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA2LDQwMi43MCAgIA0KICAgICAgICAgIE4wMDE1ODMzLTExMDI1MTMzMTIyOC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI0LzA4LzI1ICBVUEktQkxJTktJVC1QQVlUTS1CTElOS0lUQFBUWUJMLVlFU0IwUFRNICAwMDAwMTEwMjc4MjM2OTQwICAyNC8wOC8yNSAgICAgICAgICAgMSw5MDMuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNCw0OTkuNzAgICANCiAgICAgICAgICBVUEktMTEwMjc4MjM2OTQwLUJMSU5LSVQgUEFZTUVOVCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8wOC8yNSAgUDpLMTY2NzUgSERGQyBCQU5LIFNBTEFSWSBGT1IgQVVHIDIwMjUgICAgMDAwMDAwMDAwMDAwMDAwICAgMjUvMDgvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjY2LDU1My4wMCAgICAgICAgICAyNzEsMDUyLjcwICAgDQoyNS8wOC8yNSAgVVBJLUNSRUQgQ0xVQi1DUkVELkNMVUJAQVhJU0ItVVRJQjAwMDAxMSAgMDAwMDU2MDMxMDUxNzY0MiAgMjUvMDgvMjUgICAgICAgICAxMzksOTk4LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMzEsMDU0LjcwICAgDQogICAgICAgICAgNC01NjAzMTA1MTc2NDItUEFZTUVOVCBPTiBDUkVEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjUvMDgvMjUgIFVQSS1SSVNISSBQQUwgU0lOR0gtUTUzNDY0ODE0MkBZQkwtWUVTQjAgIDAwMDAxMTAzMjMwNjg1ODEgIDI1LzA4LzI1ICAgICAgICAgICAgICA1MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTMxLDAwNC43MCAgIA0KICAgICAgICAgIFlCTFVQSS0xMTAzMjMwNjg1ODEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI1LzA4LzI1ICBVUEktUkFKRU5EUkEgU0lOR0gtUEFZVE1RUjY1OFZPSEBQVFlTLVlFICAwMDAwMTEwMzI0NDM0ODk0ICAyNS8wOC8yNSAgICAgICAgICAgICA0MjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEzMCw1ODQuNzAgICANCiAgICAgICAgICBTQjBQVE1VUEktMTEwMzI0NDM0ODk0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8wOC8yNSAgVVBJLVZJLVZJTFBSRU1QQFBUWUJMLVlFU0IwUFRNVVBJLTExMDMzMiAgMDAwMDExMDMzMjc0NjEyOCAgMjUvMDgvMjUgICAgICAgICAgIDQsNjk1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjUsODg5LjcwICAgDQogICAgICAgICAgNzQ2MTI4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjYvMDgvMjUgIFVQSS1JQ0NMIFpFUk9ESEEgQ09JTi1aRVJPREhBTUZASERGQ0JBTksgIDAwMDAxMDEyOTIwMTQ5NDcgIDI2LzA4LzI1ICAgICAgICAgICAxLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTI0LDg4OS43MCAgIA0KICAgICAgICAgIC1IREZDME1FUlVQSS0xMDEyOTIwMTQ5NDctSkpKSEtKQU5GMENQWkkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBNRVczICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNi8wOC8yNSAgVVBJLUlORElBTkNMRUFSSU5HQ09SUE9SLUJTRVNUQVJNRlJaUEBJQyAgMDAwMDUyMzg4NzEwNzM2MCAgMjYvMDgvMjUgICAgICAgICAgICAgNTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjQsMzg5LjcwICAgDQogICAgICAgICAgSUNJLUlDSUMwREMwMDk5LTUyMzg4NzEwNzM2MC0zSUdUWUJMVFFEUyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICoqQ29udGludWUqKiANCg0KDQoNCkhERkMgQkFOSyBMdGQuICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBhZ2UgTm8gLjogICAxOSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIEkwS09HV0wgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI2LzA4LzI1ICBVUEktSUNDTCBaRVJPREhBIENPSU4tWkVST0RIQU1GQEhERkNCQU5LICAwMDAwMTAxMjkyMDIwODUzICAyNi8wOC8yNSAgICAgICAgICAgICA1MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyMyw4ODkuNzAgICANCiAgICAgICAgICAtSERGQzBNRVJVUEktMTAxMjkyMDIwODUzLTZFTUQwRlhMRExRVE1MICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgVzQyRyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjYvMDgvMjUgIFVQSS1BSVJURUwtUEFZQUlSNzY3M0BQVFlCTC1ZRVNCMFBUTVVQSS0gIDAwMDA1MjM4NTkzNTQzOTMgIDI2LzA4LzI1ICAgICAgICAgICAgIDM1MC44MCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTIzLDUzOC45MCAgIA0KICAgICAgICAgIDUyMzg1OTM1NDM5My1TRU5UIFVTSU5HIFBBWVRNIFUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI2LzA4LzI1ICBVUEktQUlSVEVMLVBBWUFJUjc2NzNAUFRZQkwtWUVTQjBQVE1VUEktICAwMDAwNTIzODU5NDk0Mjk0ICAyNi8wOC8yNSAgICAgICAgICAgICA5ODIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyMiw1NTYuOTAgICANCiAgICAgICAgICA1MjM4NTk0OTQyOTQtU0VOVCBVU0lORyBQQVlUTSBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNi8wOC8yNSAgREVQT1NJVE9SWSBDSEFSR0VTIEpVTDI1IC0gMTAzMTAwNCAgICAgICAgMDAwMDAwMDAwMDAwMDAwICAgMjYvMDgvMjUgICAgICAgICAgICAgIDI4LjkxICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjIsNTI3Ljk5ICAgDQoyNi8wOC8yNSAgVVBJLU1TIEFHR0FSV0FMIFNXRUVUICBDLVBBWVRNUVI2TkpWQ1BAUCAgMDAwMDExMDM3MTg4MDc2NCAgMjYvMDgvMjUgICAgICAgICAgICAgIDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjIsNTA3Ljk5ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTExMDM3MTg4MDc2NC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjYvMDgvMjUgIFVQSS1BR0dBUldBTCBUUkFESU5HIENPTS1QQVlUTVFSNkQ0S0hCQFAgIDAwMDAxMTAzNzI1MjYzNTMgIDI2LzA4LzI1ICAgICAgICAgICAgIDE4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTIyLDMyNy45OSAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTAzNzI1MjYzNTMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI2LzA4LzI1ICBVUEktTSBTIE5BUlNJTkdIIE1FRElDT1MtTVNOQVJTSU5HSE1FRElDICAwMDAwNTIzODk0MDc4MDg0ICAyNi8wOC8yNSAgICAgICAgICAgICAzNDcuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyMSw5ODAuOTkgICANCiAgICAgICAgICBPU0UuRUFaWVBBWUBJQ0lDSS1JQ0lDMERDMDA5OS01MjM4OTQwNzgwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgODQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjYvMDgvMjUgIFVQSS1TT05BIEZVRUwgQ0VOVFJFLVBBWVRNUVI2MEdCN0dAUFRZUy0gIDAwMDAxMTAzODA0NDAxOTcgIDI2LzA4LzI1ICAgICAgICAgICAgIDk0Ny4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTIxLDAzMy45OSAgIA0KICAgICAgICAgIFlFU0IwUFRNVVBJLTExMDM4MDQ0MDE5Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI2LzA4LzI1ICBVUEktUkFKVSBNRURJQ0FMIFNUT1JFLVBBWVRNUVI2UDBCOEtAUFRZICAwMDAwMTEwMzgwNzI4MTM0ICAyNi8wOC8yNSAgICAgICAgICAgICAxNDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyMCw4OTMuOTkgICANCiAgICAgICAgICBTLVlFU0IwUFRNVVBJLTExMDM4MDcyODEzNC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNi8wOC8yNSAgVVBJLUNIQVJBTiBTSU5HSCBTTyBBVEFSLVEwNDI4OTUzMTlAWUJMLSAgMDAwMDExMDM4MDgzMzM0MSAgMjYvMDgvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjAsODQzLjk5ICAgDQogICAgICAgICAgWUVTQjBZQkxVUEktMTEwMzgwODMzMzQxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjcvMDgvMjUgIEFDSCBELSBUUCBBQ0ggTUFYTElGRUlOU1VSQS0xOTE2NTMwOTU2ICAgIDAwMDAwMDI3MTcwMTI0MzcgIDI3LzA4LzI1ICAgICAgICAgICAxLDQ4My4wNiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE5LDM2MC45MyAgIA0KMjcvMDgvMjUgIFVQSS1OQVRJT05BTCBISUdIV0FZUyBBVS1OQVRJT05BTEhJR0hXQVkgIDAwMDA1MjM5MTIzNjg2NjQgIDI3LzA4LzI1ICAgICAgICAgICAzLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE2LDM2MC45MyAgIA0KICAgICAgICAgIFNBVVRILlJaUEBNQUlSVEVMLUFJUlAwMDAwMDAxLTUyMzkxMjM2ODYgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA2NC1QQVkgVklBIFJBWk9SUEFZICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNy8wOC8yNSAgTUUgREMgU0kgNTEyOTY3WFhYWFhYODAyNCBDVVJTT1IsIEFJIFBPVyAgMDAwMDAwMDAwMDIyMzc5MiAgMjcvMDgvMjUgICAgICAgICAgIDEsNzU0LjEwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTQsNjA2LjgzICAgDQogICAgICAgICAgRVJFRCBJREUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMDgvMjUgIFVQSS1HT09HTEUgUExBWS1QTEFZU1RPUkVAQVhJU0JBTkstVVRJQjAgIDAwMDA1NzAxMDMyOTI0MDUgIDI4LzA4LzI1ICAgICAgICAgICAgIDE5OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE0LDQwNy44MyAgIA0KICAgICAgICAgIDAwMDU1My01NzAxMDMyOTI0MDUtTUFOREFURUVYRUNVVEUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzA4LzI1ICBVUEktQUlSVEVMLVBBWUFJUjc2NzNAUFRZQkwtWUVTQjBQVE1VUEktICAwMDAwNTI0MDg5MDMwODIxICAyOC8wOC8yNSAgICAgICAgICAgICA5MzIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExMyw0NzUuODMgICANCiAgICAgICAgICA1MjQwODkwMzA4MjEtU0VOVCBVU0lORyBQQVlUTSBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQozMS8wOC8yNSAgVVBJLUdPT0dMRSBJTkRJQSBESUdJVEFMLVBMQVlTVE9SRUBBWElTQiAgMDAwMDU2MDkyODA2ODQ2OSAgMzEvMDgvMjUgICAgICAgICAgICAgMjAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTMsMjc1LjgzICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDIwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgQU5LLVVUSUIwMDAwNTUzLTU2MDkyODA2ODQ2OS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMzEvMDgvMjUgIFVQSS05NzYxMTg1ODgyUE9TVEJBTkstOTc2MTE4NTg4MkBQT1NUQkEgIDAwMDA1NjA5NTIxNzM1NjQgIDMxLzA4LzI1ICAgICAgICAgICAgIDUwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTEyLDc3NS44MyAgIA0KICAgICAgICAgIE5LLUlQT1MwMDAwMDAxLTU2MDk1MjE3MzU2NC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzA5LzI1ICBVUEktOTc2MTE4NTg4MlBPU1RCQU5LLTk3NjExODU4ODJAUE9TVEJBICAwMDAwNTYxMDcxMjg0ODg5ICAwMS8wOS8yNSAgICAgICAgICAgMSw3MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExMSwwNzUuODMgICANCiAgICAgICAgICBOSy1JUE9TMDAwMDAwMS01NjEwNzEyODQ4ODktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMS8wOS8yNSAgVVBJLUJJS0FORVJWQUxBLVBBWVRNLTY4MTAwNTExQFBUWVMtWUVTQiAgMDAwMDExMDYyNDgwMzg5OSAgMDEvMDkvMjUgICAgICAgICAgICAgMTQ3LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTAsOTI4LjgzICAgDQogICAgICAgICAgMFBUTVVQSS0xMTA2MjQ4MDM4OTktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDEvMDkvMjUgIFVQSS1TSEVLSEFSIFRPTUVSLTk4NzMxOTYzNDdAQVhMLUhERkMwMDAgIDAwMDAxMTA2MzAwMDQ3MDYgIDAxLzA5LzI1ICAgICAgICAgICAxLDYxNS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA5LDMxMy44MyAgIA0KICAgICAgICAgIDk2OTEtMTEwNjMwMDA0NzA2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzA5LzI1ICBVUEktU0hJVkEgU0NPT1RFUiBBR0VOQ1ktU0hJVkFTQ09PVEVSQUdFICAwMDAwMTEwNjMwMDc5NTU4ICAwMS8wOS8yNSAgICAgICAgICAgMSw4MzYuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwNyw0NzcuODMgICANCiAgICAgICAgICBOQ1kuNDI2NzA0MjJASERGQ0JBTkstSERGQzBNRVJVUEktMTEwNjMwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgMDc5NTU4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDEvMDkvMjUgIDUwNDAwMzM0OTE4NzEzLSBSRCBJTlNUQUxMTUVOVC1TRVAgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDAxLzA5LzI1ICAgICAgICAgIDEwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk3LDQ3Ny44MyAgIA0KMDEvMDkvMjUgIDUwNDAwMzM0OTE4ODMxLSBSRCBJTlNUQUxMTUVOVC1TRVAgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDAxLzA5LzI1ICAgICAgICAgICAzLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk0LDQ3Ny44MyAgIA0KMDMvMDkvMjUgIFBPUyA1MTI5NjdYWFhYWFg4MDI0IFVQRypQQVlNRU5USUNPLkMgICAgIDAwMDAwMDAwMDAyNTIwMjAgIDAzLzA5LzI1ICAgICAgICAgICAxLDg1MS4wMSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDkyLDYyNi44MiAgIA0KMDMvMDkvMjUgIEFDSCBDLSBJQ0lDSSBCQU5LIExURC05MjY1ODAyICAgICAgICAgICAgIDAwMDAwMDI4NjQwNDIxODAgIDAzLzA5LzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMSw1MjkuMDAgICAgICAgICAgIDk0LDE1NS44MiAgIA0KMDMvMDkvMjUgIFVQSS1BSVJURUwgRElSRUNUIFVQSSBQTy1BSVJURUxESVJFQ1RVUEkgIDAwMDAxMTA3MTI2MzkxNTUgIDAzLzA5LzI1ICAgICAgICAgICAgIDc0Ny4xMSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDkzLDQwOC43MSAgIA0KICAgICAgICAgIDY5NzI1OS5SWlBAUlhBSVJURUwtQUlSUDAwMDAwMTEtMTEwNzEyNjMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA5MTU1LUFJUlRFTFBPU1RQQUlEQklMTCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMy8wOS8yNSAgVVBJLUFUVUwgS1VNQVIgU0lOR0hBTC05NzE4NjYzMjIzQFBUQVhJUyAgMDAwMDU2MTI2Mzk5OTIxOCAgMDMvMDkvMjUgICAgICAgICAgIDEsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTIsNDA4LjcxICAgDQogICAgICAgICAgLUtLQkswMDAwNTYzLTU2MTI2Mzk5OTIxOC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDUvMDkvMjUgIFVQSS1TT05BIEZVRUwgQ0VOVFJFLVBBWVRNUVI2RFNVTTdAUFRZUy0gIDAwMDAxMTA4MTU4ODAxNzIgIDA1LzA5LzI1ICAgICAgICAgICAgIDk0Ny4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDkxLDQ2MS43MSAgIA0KICAgICAgICAgIFlFU0IwUFRNVVBJLTExMDgxNTg4MDE3Mi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzA5LzI1ICBVUEktTVVOTkEtODU4Nzg3MTAyMUBBWEwtUFVOQjA0MDM5MDAtNTI0ICAwMDAwNTI0ODEwODAxNDgzICAwNS8wOS8yNSAgICAgICAgICAgICAgMzAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5MSw0MzEuNzEgICANCiAgICAgICAgICA4MTA4MDE0ODMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNS8wOS8yNSAgVVBJLU1VTk5BLTg1ODc4NzEwMjFAQVhMLVBVTkIwNDAzOTAwLTUyNCAgMDAwMDUyNDgzMzYwMDkzNyAgMDUvMDkvMjUgICAgICAgICAgICAgIDEwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTEsNDIxLjcxICAgDQogICAgICAgICAgODMzNjAwOTM3LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDYvMDkvMjUgIC5EQyBJTlRMIFBPUyBUWE4gTUFSS1VQK1NUIDA3MDYyNSAwOTA2MjUgIE1JUjI2MjQ2NDczNDUzMzkgIDA2LzA5LzI1ICAgICAgICAgICAgICAyMC45MyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDkxLDQwMC43OCAgIA0KICAgICAgICAgIC1NSVIyNjI0NjQ3MzQ1MzM5ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA2LzA5LzI1ICBVUEktQVRVTCBLVU1BUiBTSU5HSEFMLTk3MTg2NjMyMjNAUFRBWElTICAwMDAwNTYxNTM5MDAyMjUyICAwNi8wOS8yNSAgICAgICAgICAgMywwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4OCw0MDAuNzggICANCiAgICAgICAgICAtS0tCSzAwMDA1NjMtNTYxNTM5MDAyMjUyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNi8wOS8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDExMDg3ODU0Njk2MiAgMDYvMDkvMjUgICAgICAgICAgIDIsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODYsNDAwLjc4ICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS0xMTA4Nzg1NDY5NjItVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICoqQ29udGludWUqKiANCg0KDQoNCkhERkMgQkFOSyBMdGQuICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBhZ2UgTm8gLjogICAyMSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA5LzA5LzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZQNkgwSEBQICAwMDAwMTExMDI5NzA5MTgxICAwOS8wOS8yNSAgICAgICAgICAgICAgNTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4NiwzNTAuNzggICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTExMDI5NzA5MTgxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMC8wOS8yNSAgNTA0MDAzMTgxODY3ODAtIFJEIElOU1RBTExNRU5ULVNFUCAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDkvMjUgICAgICAgICAgIDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODEsMzUwLjc4ICAgDQoxMC8wOS8yNSAgNTA0MDAzMTgxODcwMjctIFJEIElOU1RBTExNRU5ULVNFUCAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMDkvMjUgICAgICAgICAgNDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzYsMzUwLjc4ICAgDQoxMS8wOS8yNSAgLkRDIElOVEwgUE9TIFRYTiBNQVJLVVArU1QgMjcwODI1IDI3MDgyNSAgTUlSMjYyNTM2MjUzMDc0MiAgMTEvMDkvMjUgICAgICAgICAgICAgIDcyLjQ0ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzYsMjc4LjM0ICAgDQogICAgICAgICAgLU1JUjI2MjUzNjI1MzA3NDIgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTEvMDkvMjUgIFVQSS1BTUlUIFNPSEFOIExBTCBERUVXQS1QQVlUTVFSNkowM1lSQFAgIDAwMDAxMTExMjE3NzQxODcgIDExLzA5LzI1ICAgICAgICAgICAxLDMwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDM0LDk3OC4zNCAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTExMjE3NzQxODctVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjExLzA5LzI1ICBVUEktQU1JVCBTT0hBTiBMQUwgREVFV0EtUEFZVE1RUjZKMDNZUkBQICAwMDAwMTExMTIxOTUzNjY0ICAxMS8wOS8yNSAgICAgICAgICAgICAgNjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzNCw5MTguMzQgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTExMTIxOTUzNjY0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMS8wOS8yNSAgVVBJLUFOS0lUIERBSVJZIEFORCBTV0VFLVZZQVBBUi4xNzA4MTk4MiAgMDAwMDExMTEyNDE0MDkzNSAgMTEvMDkvMjUgICAgICAgICAgICAgMjQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzQsNjc4LjM0ICAgDQogICAgICAgICAgNjUyNkBIREZDQkFOSy1IREZDME1FUlVQSS0xMTExMjQxNDA5MzUtVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIFBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjExLzA5LzI1ICBVUEktQVNISVNILVE3MjI2MjE4MDlAWUJMLVlFU0IwWUJMVVBJLTExICAwMDAwMTExMTI0Njc3MjA5ICAxMS8wOS8yNSAgICAgICAgICAgICAyMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzNCw0NzguMzQgICANCiAgICAgICAgICAxMTI0Njc3MjA5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMS8wOS8yNSAgVVBJLUZJUk9aLVBBWVRNUVI2RDdQVjBAUFRZUy1ZRVNCMFBUTVVQSSAgMDAwMDExMTEyNDk0NDUyMyAgMTEvMDkvMjUgICAgICAgICAgICAgMTUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzQsMzI4LjM0ICAgDQogICAgICAgICAgLTExMTEyNDk0NDUyMy1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTEvMDkvMjUgIFVQSS1LQVJBTiBTSU5HSC1CSEFSQVRQRS45MDA3MTg3NTA4NEBGQlAgIDAwMDAxMTExMjUyNTg3MzEgIDExLzA5LzI1ICAgICAgICAgICAgIDMwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDM0LDAyOC4zNCAgIA0KICAgICAgICAgIEUtRkRSTDAwMDEzODItMTExMTI1MjU4NzMxLVBBWSBUTyBCSEFSQVQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQRSBNRSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMS8wOS8yNSAgVVBJLVJBSkVORFJBIFNJTkdILVBBWVRNUVI2NThWT0hAUFRZUy1ZRSAgMDAwMDExMTEyNTUwMDM2MiAgMTEvMDkvMjUgICAgICAgICAgICAgNDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzMsNjA4LjM0ICAgDQogICAgICAgICAgU0IwUFRNVVBJLTExMTEyNTUwMDM2Mi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTIvMDkvMjUgIFVQSS1TT05BIEZVRUwgQ0VOVFJFLVBBWVRNUVIxNU5JQ0hXMUZZQFAgIDAwMDAxMTExODEyMTIzNzMgIDEyLzA5LzI1ICAgICAgICAgICAgIDg1Mi4zMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDMyLDc1Ni4wNCAgIA0KICAgICAgICAgIEFZVE0tWUVTQjBQVE1VUEktMTExMTgxMjEyMzczLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEzLzA5LzI1ICBVUEktUkFNIEtVTUFSLVExNTU0MTc2MDlAWUJMLVlFU0IwWUJMVVBJICAwMDAwMTExMjIzMzQ5OTQ2ICAxMy8wOS8yNSAgICAgICAgICAgICAgNDIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzMiw3MTQuMDQgICANCiAgICAgICAgICAtMTExMjIzMzQ5OTQ2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNC8wOS8yNSAgVVBJLUlSQ1RDIENGLUlSQ1RDLkNGQEhERkNCQU5LLUhERkMwTUVSVSAgMDAwMDEwMTQ1Njg3MTA1MSAgMTQvMDkvMjUgICAgICAgICAgIDIsNDQ3LjIwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzAsMjY2Ljg0ICAgDQogICAgICAgICAgUEktMTAxNDU2ODcxMDUxLUlSQ1RDIFRJQ0tFVCBCT09LSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTQvMDkvMjUgIFVQSS1YWFhYWFhYWDc5ODktVUJJTjA1NDQ4NDEtNTYyMzI2MTAxNjMgIDAwMDA1NjIzMjYxMDE2MzMgIDE0LzA5LzI1ICAgICAgICAgICAgICAxMS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDMwLDI1NS44NCAgIA0KICAgICAgICAgIDMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE0LzA5LzI1ICBSRVYtVVBJLTA4ODIxMTMwMDAxNzI1LUtBTFBJVC5DT09MMjAwNi0xICAwMDAwNTYyMzI2MTAxNjMzICAxNC8wOS8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExLjAwICAgICAgICAgICAzMCwyNjYuODQgICANCiAgICAgICAgICBAT0tBWElTLTU2MjMyNjEwMTYzMy1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDIyICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQoxNC8wOS8yNSAgVVBJLVhYWFhYWFhYWFhYNzk4OS1VQklOMDU0NDg0MS0xMTEyNDcyMiAgMDAwMDExMTI0NzIyMjI5NiAgMTQvMDkvMjUgICAgICAgICAgICAgIDExLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzAsMjU1Ljg0ICAgDQogICAgICAgICAgMjI5Ni1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTQvMDkvMjUgIFVQSS1YWFhYWFhYWFhYWDc5ODktVUJJTjA1NDQ4NDEtNTYyMzM5NTAgIDAwMDA1NjIzMzk1MDgyNDcgIDE0LzA5LzI1ICAgICAgICAgIDE1LDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1LDI1NS44NCAgIA0KICAgICAgICAgIDgyNDctVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE1LzA5LzI1ICBVUEktSVJDVEMgQ0YtSVJDVEMuQ0ZASERGQ0JBTkstSERGQzBNRVJVICAwMDAwMTAxNDY3NDYwODA2ICAxNS8wOS8yNSAgICAgICAgICAgMSwzOTQuODYgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMyw4NjAuOTggICANCiAgICAgICAgICBQSS0xMDE0Njc0NjA4MDYtSVJDVEMgVElDS0VUIEJPT0tJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNS8wOS8yNSAgVVBJLUlSQ1RDIENGLUlSQ1RDLkNGQEhERkNCQU5LLUhERkMwTUVSVSAgMDAwMDEwMTQ2NzQ4MTgwMiAgMTUvMDkvMjUgICAgICAgICAgIDEsMzU0LjA1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTIsNTA2LjkzICAgDQogICAgICAgICAgUEktMTAxNDY3NDgxODAyLUlSQ1RDIFRJQ0tFVCBCT09LSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTYvMDkvMjUgIFVQSS1HT09HTEUgQ0xPVUQtR09PR0xFQ0xPVURAQVhJU0JBTkstVVQgIDAwMDA1NzkxMzM2MjI1OTUgIDE2LzA5LzI1ICAgICAgICAgICAgICAgMi4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyLDUwNC45MyAgIA0KICAgICAgICAgIElCMDAwMDU1My01NzkxMzM2MjI1OTUtTUFOREFURUVYRUNVVEUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE2LzA5LzI1ICBVUEktR09PR0xFIElORElBIERJR0lUQUwtR09PR0xFQ0xPVURAQVhJICAwMDAwMTExMzM0MzI3MDQxICAxNi8wOS8yNSAgICAgICAgICAgMSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMSw1MDQuOTMgICANCiAgICAgICAgICBTQkFOSy1VVElCMDAwMDU1My0xMTEzMzQzMjcwNDEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNi8wOS8yNSAgVVBJLVJBREhBIFNJTkdIQUwtUTYwMzgwMzU2MEBZQkwtWUVTQjBZQiAgMDAwMDU2MjU4OTIxNDM0OSAgMTYvMDkvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTEsNDU0LjkzICAgDQogICAgICAgICAgTFVQSS01NjI1ODkyMTQzNDktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTgvMDkvMjUgIFVQSS1LQVJBTiBTSU5HSC1CSEFSQVRQRS45MDA3MTg3NTA4NEBGQlAgIDAwMDAxMTE0MzYzNTk2NTkgIDE4LzA5LzI1ICAgICAgICAgICAgIDQwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExLDA1NC45MyAgIA0KICAgICAgICAgIEUtRkRSTDAwMDEzODItMTExNDM2MzU5NjU5LVBBWSBUTyBCSEFSQVQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQRSBNRSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOC8wOS8yNSAgVVBJLVRFSiBTSU5HSC02MzkzMDgyMDI2QFBUQVhJUy1CQVJCMEJBUiAgMDAwMDUyNjE3Mjk4MDE0MyAgMTgvMDkvMjUgICAgICAgICAgICAgMTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTAsOTU0LjkzICAgDQogICAgICAgICAgQU5QLTUyNjE3Mjk4MDE0My1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTkvMDkvMjUgIFVQSS1JTkRSQVBSQVNUSEEgR0FTIExJTS1CSUxMREVTS1BHLklORFIgIDAwMDAxMDE0OTgxNTQ0NzMgIDE5LzA5LzI1ICAgICAgICAgICAgIDQ5Ni4zOCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwLDQ1OC41NSAgIA0KICAgICAgICAgIEFQUkFTVEhBQEhERkNCQU5LLUhERkMwTUVSVVBJLTEwMTQ5ODE1NDQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA3My1JR0wgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOS8wOS8yNSAgVVBJLUlORFJBUFJBU1RIQSBHQVMgTElNLUJJTExERVNLUEcuSU5EUiAgMDAwMDEwMTQ5ODMyMjYwNyAgMTkvMDkvMjUgICAgICAgICAgIDEsNTg3LjkyICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgsODcwLjYzICAgDQogICAgICAgICAgQVBSQVNUSEFASERGQ0JBTkstSERGQzBNRVJVUEktMTAxNDk4MzIyNiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDA3LUlHTCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE5LzA5LzI1ICBVUEktS0FMUElUIEtVTUFSIFNIQVJNQS1LQUxQSVQuQ09PTDIwMDZAICAwMDAwNTI2MjY4MzIzNTg0ICAxOS8wOS8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAxOCw4NzAuNjMgICANCiAgICAgICAgICBPS0lDSUNJLUlDSUMwMDAzNDU4LTUyNjI2ODMyMzU4NC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOS8wOS8yNSAgVVBJLVVUVEFSIFBSQURFU0ggUE9XRVIgLUJJTExERVNLUEcuVVBQQyAgMDAwMDEwMTQ5ODMzNjcyNyAgMTkvMDkvMjUgICAgICAgICAgMTEsNjMwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDcsMjQwLjYzICAgDQogICAgICAgICAgTEBIREZDQkFOSy1IREZDME1FUlVQSS0xMDE0OTgzMzY3MjctVVBQQyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIExSICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIwLzA5LzI1ICBVUEktREFVSkkgU0VSVklDRSBTVEFUSU8tUEFZVE1RUjYwRzFZS0BQICAwMDAwMTExNDkxMDIxNjE4ICAyMC8wOS8yNSAgICAgICAgICAgMiwwMjEuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNSwyMTkuNjMgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTExNDkxMDIxNjE4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMC8wOS8yNSAgVVBJLVRIRSBERUxJR0hUIERBSVJZLVZZQVBBUi4xNzQ4NjMzODMxNSAgMDAwMDExMTQ5MTQ3OTA5OSAgMjAvMDkvMjUgICAgICAgICAgICAgNDgwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDQsNzM5LjYzICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDIzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgMkBIREZDQkFOSy1IREZDME1FUlVQSS0xMTE0OTE0NzkwOTktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjEvMDkvMjUgIDUwMjAwMDAwMDAzNDAyICAgMjA4MTU5NTNfSExJQ19JTlNUICAgICAgIDAwMDAwMDAwMDAwMDAwMCAgIDIxLzA5LzI1ICAgICAgICAgICAgIDgxNy4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzLDkyMi42MyAgIA0KMjEvMDkvMjUgIFVQSS1NT0hJVCAgS1VNQVItNzkwNjI1NTA1MUBQVFNCSS1TQklOMDAgIDAwMDAxMTE1NTE2MzMzMjUgIDIxLzA5LzI1ICAgICAgICAgICAgIDQwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzLDUyMi42MyAgIA0KICAgICAgICAgIDEyOTgzLTExMTU1MTYzMzMyNS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIyLzA5LzI1ICBVUEktQU5JTCBLVU1BUi1QQVlUTVFSNjc3MTY5QFBUWVMtWUVTQjBQICAwMDAwMTExNTkxODQyMDMxICAyMi8wOS8yNSAgICAgICAgICAgICAgMTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMyw1MTIuNjMgICANCiAgICAgICAgICBUTVVQSS0xMTE1OTE4NDIwMzEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMi8wOS8yNSAgVVBJLUFLQVNILVBBWVRNUVI2TjZJRFBAUFRZUy1ZRVNCMFBUTVVQSSAgMDAwMDExMTU5MTkzMTA5MSAgMjIvMDkvMjUgICAgICAgICAgICAgIDYwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDMsNDUyLjYzICAgDQogICAgICAgICAgLTExMTU5MTkzMTA5MS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjIvMDkvMjUgIFVQSS1WQU5TSCBBR0dBUldBTC1QQVlUTVFSNkFJT0ZHQFBUWVMtWUUgIDAwMDAxMTE1OTE5NzQ0MTYgIDIyLzA5LzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzLDQzMi42MyAgIA0KICAgICAgICAgIFNCMFBUTVVQSS0xMTE1OTE5NzQ0MTYtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIyLzA5LzI1ICBVUEktS0FTTUlSSSBERVZJLVE5NzE1NTAwMDVAWUJMLVlFU0IwWUJMICAwMDAwMTExNTkyMjAyNTQ1ICAyMi8wOS8yNSAgICAgICAgICAgICAgNTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMywzODIuNjMgICANCiAgICAgICAgICBVUEktMTExNTkyMjAyNTQ1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMy8wOS8yNSAgVVBJLUdPT0dMRSBQQVktR09PR0xFUEFZQEFYSVNCQU5LLVVUSUIwMCAgMDAwMDcyNjY2MTc1MjY2NSAgMjMvMDkvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMi4wMCAgICAgICAgICAgIDMsMzg0LjYzICAgDQogICAgICAgICAgMDAwMDQtNzI2NjYxNzUyNjY1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjMvMDkvMjUgIFVQSS1NUyBBR0dBUldBTCBTV0VFVCAgQy1QQVlUTVFSNlA2SDBIQFAgIDAwMDAxMTE2NjcwOTkyMjYgIDIzLzA5LzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzLDM2NC42MyAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTE2NjcwOTkyMjYtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI1LzA5LzI1ICBQOksxNjY3NSBIREZDIEJBTksgU0FMQVJZIEZPUiBTRVAgMjAyNSAgICAwMDAwMDAwMDAwMDAwMDAgICAyNS8wOS8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNjYsNTUzLjAwICAgICAgICAgIDI2OSw5MTcuNjMgICANCjI3LzA5LzI1ICBBQ0ggRC0gVFAgQUNIIE1BWExJRkVJTlNVUkEtMTk0OTgyNzY0MCAgICAwMDAwMDAzMTQ2ODI2MTc4ICAyNy8wOS8yNSAgICAgICAgICAgMSwyNTYuODIgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI2OCw2NjAuODEgICANCjI3LzA5LzI1ICAuREMgSU5UTCBQT1MgVFhOIE1BUktVUCtTVCAwMjA5MjUgMDIwOTI1ICBNSVIyNjI2NTAyOTU5NTMyICAyNy8wOS8yNSAgICAgICAgICAgICAgNzYuNDUgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI2OCw1ODQuMzYgICANCiAgICAgICAgICAtTUlSMjYyNjUwMjk1OTUzMiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNy8wOS8yNSAgVVBJLU0gUyBQSU5OQUNMRSBGLUlCS1BPUy5FUDAyNTY3MEBJQ0lDSSAgMDAwMDU2MzY2NDc4Njc2NyAgMjcvMDkvMjUgICAgICAgICAgICAgNTYwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNjgsMDI0LjM2ICAgDQogICAgICAgICAgLUlDSUMwMDAwMDA0LTU2MzY2NDc4Njc2Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjcvMDkvMjUgIFVQSS1NIFMgUElOTkFDTEUgRiBCIFNFUi1NU1BJTk5BQ0xFRkJTRVIgIDAwMDA1NjM2NDU1ODMxOTQgIDI3LzA5LzI1ICAgICAgICAgICAgIDExMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjY3LDkxNC4zNiAgIA0KICAgICAgICAgIFZJQ0VTUFJJVkFURUxJTUlURUQuRUFaWVBBWUBJQ0lDSS1JQ0lDMEQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBDMDA5OS01NjM2NDU1ODMxOTQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNy8wOS8yNSAgVVBJLUpZT1RJIEFQSUFSSUVTLVBBWVRNLlMxNFBLUkJAUFRZLVlFUyAgMDAwMDExMTgzNDU1MzYwNSAgMjcvMDkvMjUgICAgICAgICAgICAgNDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNjcsNTE0LjM2ICAgDQogICAgICAgICAgQjBNQ0hVUEktMTExODM0NTUzNjA1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjcvMDkvMjUgIFVQSS1NIFMgUElOTkFDTEUgRi1JQktQT1MuRVAwODY4NjhASUNJQ0kgIDAwMDA1MjcwMTc2MTI0MjMgIDI3LzA5LzI1ICAgICAgICAgICAgIDE2MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjY3LDM1NC4zNiAgIA0KICAgICAgICAgIC1JQ0lDMDAwMDAwNC01MjcwMTc2MTI0MjMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI3LzA5LzI1ICBDQkRDIEZBSUxFRCBUT0tFTiBTQUxBUlkgQ1IgU0VQIDI1ICAgICAgICAwMDAwMDAwMDAwMDAwMDAgICAyNy8wOS8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEsMDAwLjAwICAgICAgICAgIDI2OCwzNTQuMzYgICANCjI4LzA5LzI1ICBVUEktR09PR0xFIFBMQVktUExBWVNUT1JFQEFYSVNCQU5LLVVUSUIwICAwMDAwNTE1NzU4NTAyNzE1ICAyOC8wOS8yNSAgICAgICAgICAgICAxOTkuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI2OCwxNTUuMzYgICANCiAgICAgICAgICAwMDA1NTMtNTE1NzU4NTAyNzE1LU1BTkRBVEVFWEVDVVRFICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOC8wOS8yNSAgTUUgREMgU0kgNTEyOTY3WFhYWFhYODAyNCBDVVJTT1IsIEFJIFBPVyAgMDAwMDAwMDAwMDI4NTU5MiAgMjgvMDkvMjUgICAgICAgICAgIDEsNzczLjkwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNjYsMzgxLjQ2ICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDI0ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgRVJFRCBJREUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMDkvMjUgIFVQSS1OQVdBTCBQUkFLQVNIIFJBSS1RMjE5NTE5NjYxQFlCTC1ZRVMgIDAwMDAxMTE5MDA0Mzk2MjEgIDI4LzA5LzI1ICAgICAgICAgICAgICA4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjY2LDMwMS40NiAgIA0KICAgICAgICAgIEIwWUJMVVBJLTExMTkwMDQzOTYyMS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzA5LzI1ICBVUEktUElZVVNIICBLQVNIWUFQLVE1NzA3NTM1MDhAWUJMLVlFU0IwICAwMDAwMTExOTAwNTc4ODM5ICAyOC8wOS8yNSAgICAgICAgICAgICA0ODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI2NSw4MjEuNDYgICANCiAgICAgICAgICBZQkxVUEktMTExOTAwNTc4ODM5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOS8wOS8yNSAgVVBJLUFTSE9LIEtVTUFSLVBBWVRNUVI2NlFXOUNAUFRZUy1ZRVNCMCAgMDAwMDExMTkxMTU3MjYwMCAgMjkvMDkvMjUgICAgICAgICAgICAgIDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNjUsODAxLjQ2ICAgDQogICAgICAgICAgUFRNVVBJLTExMTkxMTU3MjYwMC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjkvMDkvMjUgIFVQSS1DSEFSQU4gU0lOR0ggU08gQVRBUi1ROTk2MzIwODgxQFlCTC0gIDAwMDAxMTE5Mzk4Mzc0ODQgIDI5LzA5LzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjY1LDc4MS40NiAgIA0KICAgICAgICAgIFlFU0IwWUJMVVBJLTExMTkzOTgzNzQ4NC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjMwLzA5LzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZQNkgwSEBQICAwMDAwMTExOTg2NjE2NzIyICAzMC8wOS8yNSAgICAgICAgICAgICAgMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI2NSw3NjEuNDYgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTExOTg2NjE2NzIyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQozMC8wOS8yNSAgVVBJLUJMSU5LSVQtQkxJTktJVEpLQi5SWlBATUFJUlRFTC1BSVJQMCAgMDAwMDExMTk4ODI0MTk3MyAgMzAvMDkvMjUgICAgICAgICAgICAgMzg0LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNjUsMzc3LjQ2ICAgDQogICAgICAgICAgMDAwMDAxLTExMTk4ODI0MTk3My1QQVlWSUFSQVpPUlBBWSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMzAvMDkvMjUgIFVQSS1BUlZJTkQgVkVHRVRBQkxFLVBBWVRNUVI2UUlGM1hAUFRZUy0gIDAwMDAxMTE5ODkyMTUyNDYgIDMwLzA5LzI1ICAgICAgICAgICAgICA3MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjY1LDMwNy40NiAgIA0KICAgICAgICAgIFlFU0IwUFRNVVBJLTExMTk4OTIxNTI0Ni1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzEwLzI1ICBJTlRFUkVTVCBQQUlEIFRJTEwgMzAtU0VQLTIwMjUgICAgICAgICAgICAwMDAwMDAwMDAwMDAwMDAgICAzMC8wOS8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNjQ2LjAwICAgICAgICAgIDI2NSw5NTMuNDYgICANCjAxLzEwLzI1ICA1MDQwMDMzNDkxODcxMy0gUkQgSU5TVEFMTE1FTlQtT0NUIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAwMS8xMC8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI1NSw5NTMuNDYgICANCjAxLzEwLzI1ICA1MDQwMDMzNDkxODgzMS0gUkQgSU5TVEFMTE1FTlQtT0NUIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAwMS8xMC8yNSAgICAgICAgICAgMywwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI1Miw5NTMuNDYgICANCjAyLzEwLzI1ICBVUEktREFVSkkgU0VSVklDRSBTVEFUSU8tUEFZVE1RUjYwRzFZS0BQICAwMDAwMTEyMDUyMjIwODkyICAwMi8xMC8yNSAgICAgICAgICAgNCwyNzkuNDcgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI0OCw2NzMuOTkgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTEyMDUyMjIwODkyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMi8xMC8yNSAgVVBJLU0gSiBTVE9SRS1HUEFZLTExMjQ0MTg1OTg1QE9LQklaQVhJUyAgMDAwMDExMjA2NjQ5Mjc0NSAgMDIvMTAvMjUgICAgICAgICAgICAgIDExLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNDgsNjYyLjk5ICAgDQogICAgICAgICAgLVVUSUIwMDAwNTUzLTExMjA2NjQ5Mjc0NS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDIvMTAvMjUgIFVQSS1NIEogU1RPUkUtR1BBWS0xMTI0NDE4NTk4NUBPS0JJWkFYSVMgIDAwMDAxMTIwNjY2NDQ3MTQgIDAyLzEwLzI1ICAgICAgICAgICAgICAgNS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjQ4LDY1Ny45OSAgIA0KICAgICAgICAgIC1VVElCMDAwMDU1My0xMTIwNjY2NDQ3MTQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA0LzEwLzI1ICBVUEktQVNCIEFVVE9NT0JJTEVTIFBSSVYtQVNCQVU5OTU4NTMyMUBCICAwMDAwMTEyMTU4NjQyNTcwICAwNC8xMC8yNSAgICAgICAgICAxNiw2NDIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIzMiwwMTUuOTkgICANCiAgICAgICAgICBBUk9EQU1QQVktQkFSQjBTT0hOQVItMTEyMTU4NjQyNTcwLVZFUklGICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgSUVEIE1FUkNIQU5UICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDUvMTAvMjUgIFVQSS1JTlRBSkFSLVEyODM4ODkwODVAWUJMLVlFU0IwWUJMVVBJLTEgIDAwMDAxMTIxOTczMzkwNjUgIDA1LzEwLzI1ICAgICAgICAgICAgIDMwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjMxLDcxNS45OSAgIA0KICAgICAgICAgIDEyMTk3MzM5MDY1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzEwLzI1ICBVUEktU0hPUFBFUlMgU1RPUCBMSU1JVEUtU0hPUFBFUlNUT1BVVFRBICAwMDAwMTEyMjA5NzAyOTMyICAwNS8xMC8yNSAgICAgICAgICAgOCwzMzMuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIyMywzODIuOTkgICANCiAgICAgICAgICBSUFJBREVTSEBZQkwtWUVTQjBZQkxVUEktMTEyMjA5NzAyOTMyLVBBICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgWU1FTlQgRk9SIFBJTkU3NyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDUvMTAvMjUgIFVQSS1DT05OQVVHSFQgUExBWkEgUkVTVC1BTVpOUExNQ0RDMDAwMTMgIDAwMDAxMTIyMTA1MjE1OTkgIDA1LzEwLzI1ICAgICAgICAgICAgIDI3MC45MCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjIzLDExMi4wOSAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICoqQ29udGludWUqKiANCg0KDQoNCkhERkMgQkFOSyBMdGQuICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBhZ2UgTm8gLjogICAyNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KICAgICAgICAgIDEyQFJBUEwtUkFUTjAwMFJBUEwtMTEyMjEwNTIxNTk5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzEwLzI1ICBVUEktQ09OTkFVR0hUIFBMQVpBIFJFU1QtQU1aTlBMTUNEQzAwMDcxICAwMDAwMTEyMjExOTAzMTM4ICAwNS8xMC8yNSAgICAgICAgICAgICAxMzUuNDYgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIyMiw5NzYuNjMgICANCiAgICAgICAgICAzQFJBUEwtUkFUTjAwMFJBUEwtMTEyMjExOTAzMTM4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNS8xMC8yNSAgVVBJLU1SUyBTQVVSQVYgIE1BTElLLVNBVVJBVk1BTElLMTc2QE9LQSAgMDAwMDExMjIxNDIzODY4NyAgMDUvMTAvMjUgICAgICAgICAgICAgIDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMjIsOTU2LjYzICAgDQogICAgICAgICAgWElTLUNCSU4wMjgwMjY1LTExMjIxNDIzODY4Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDYvMTAvMjUgIFVQSS0xMDExMkFQT0xMTyBQSEFSTUFDWS1QQVlUTS5EMTk1ODEwMjQgIDAwMDAxMTIyMzI4ODMxNTQgIDA2LzEwLzI1ICAgICAgICAgICAgIDM1OC42MSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjIyLDU5OC4wMiAgIA0KICAgICAgICAgIDQ4M0BQVFktWUVTQjBNQ0hVUEktMTEyMjMyODgzMTU0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA2LzEwLzI1ICBVUEktVUJFUiBJTkRJQSBTWVNURU1TIFAtVUJFUklORElBU1lTVEVNICAwMDAwMTEyMjMzNjE4OTc4ICAwNi8xMC8yNSAgICAgICAgICAgICAgIDEuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIyMiw1OTcuMDIgICANCiAgICAgICAgICAxODcyMDQuUlpQQFJYQUlSVEVMLUFJUlAwMDAwMDExLTExMjIzMzYxICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgODk3OC1VQkVSUklERSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDYvMTAvMjUgIFVQSS1TRUJVIEtIQU4tNjMwNjgzMTcwMkBQVFNCSS1CQVJCMElTQVUgIDAwMDAxMTIyMzQyNTM3NTIgIDA2LzEwLzI1ICAgICAgICAgICAgIDUzOC4yOSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjIyLDA1OC43MyAgIA0KICAgICAgICAgIExJLTExMjIzNDI1Mzc1Mi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA2LzEwLzI1ICBVUEktREFSU0hBTiBEQVVMQVQgTU9SRS1ETU9SRTQ2MDFAQVhMLUFVICAwMDAwMTEyMjQ4MDk1NTU5ICAwNi8xMC8yNSAgICAgICAgICAgICAyOTIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIyMSw3NjYuNzMgICANCiAgICAgICAgICBCTDAwMDIzNDYtMTEyMjQ4MDk1NTU5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNi8xMC8yNSAgVVBJLU1BTklTIENBRkUtUEFZVE0uUzFNWUdXUEBQVFktWUVTQjBNQyAgMDAwMDExMjI2MzMzOTIyMSAgMDYvMTAvMjUgICAgICAgICAgICAgMTQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMjEsNjI2LjczICAgDQogICAgICAgICAgSFVQSS0xMTIyNjMzMzkyMjEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDcvMTAvMjUgIFBPUyA1MTI5NjdYWFhYWFg4MDI0IFVQRypQQVlNRU5USUNPLkMgICAgIDAwMDAwMDAwMDAzMzA4NjQgIDA3LzEwLzI1ICAgICAgICAgICAxLDg2Mi40NCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjE5LDc2NC4yOSAgIA0KMDcvMTAvMjUgIFVQSS1NQVFTT09EIEtIQUxJTCBBTlNBUi1CSEFJTUFRU09PRDk3MC0gIDAwMDA1MjgwMjczMTU5NTQgIDA3LzEwLzI1ICAgICAgICAgICAxLDI3NS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjE4LDQ4OS4yOSAgIA0KICAgICAgICAgIDFAT0tTQkktU0JJTjAwNjE1MjgtNTI4MDI3MzE1OTU0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA3LzEwLzI1ICBVUEktWk9NQVRPLVBBWVpPTUFUT0BIREZDQkFOSy1IREZDME1FUlVQICAwMDAwMTEyMzE4MDczNjc2ICAwNy8xMC8yNSAgICAgICAgICAgICA4NDYuNjggICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIxNyw2NDIuNjEgICANCiAgICAgICAgICBJLTExMjMxODA3MzY3Ni1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOC8xMC8yNSAgVVBJLUhLMjExMjk2N09LQVhJUy1ISzIxMTI5NjdAT0tBWElTLVVCSSAgMDAwMDUyODEyMTQ3Mzc0NyAgMDgvMTAvMjUgICAgICAgICAgIDEsMDg1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMTYsNTU3LjYxICAgDQogICAgICAgICAgTjA1MzE1ODgtNTI4MTIxNDczNzQ3LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDgvMTAvMjUgIFVQSS1FTkdBVEkgVEVDSE5PTE9HSUVTIC1FTkdBVElURUNITk9MT0cgIDAwMDAxMTIzMzM2MjYyMzAgIDA4LzEwLzI1ICAgICAgICAgICAgICAgMS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjE2LDU1Ni42MSAgIA0KICAgICAgICAgIDk3MTA2Ny5SWlBAUlhBSVJURUwtQUlSUDAwMDAwMTEtMTEyMzMzNjIgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA2MjMwLVBBWSBCWSBXSEFUU0FQUCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOC8xMC8yNSAgVVBJLUpJTyBXT1JMRCBDRU5UUkUtMTQ0NTU1MzZASklPUEFSVE5FUiAgMDAwMDUyODExNDMwMDgzMiAgMDgvMTAvMjUgICAgICAgICAgIDIsNjI1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMTMsOTMxLjYxICAgDQogICAgICAgICAgLUpJT1AwMDAwMDAxLTUyODExNDMwMDgzMi1QQVlNRU5UICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDgvMTAvMjUgIFVQSS1TQUNISU4gQkhVTExBUiBHVVBUQS1TQUNISU5ST0NLTVVNQkEgIDAwMDA1NjQ3MTU4NTEwOTAgIDA4LzEwLzI1ICAgICAgICAgICAgIDUxMi4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjEzLDQxOS42MSAgIA0KICAgICAgICAgIEkzNTgxLTNAT0tJQ0lDSS1LS0JLMDAwMTQyMC01NjQ3MTU4NTEwOTAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOS8xMC8yNSAgVVBJLUJMSU5LSVQtQkxJTktJVC5QQVlVQEhERkNCQU5LLUhERkMwTSAgMDAwMDExMjM2OTEyNzIyOSAgMDkvMTAvMjUgICAgICAgICAgICAgNjgyLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMTIsNzM3LjYxICAgDQogICAgICAgICAgRVJVUEktMTEyMzY5MTI3MjI5LVVQSUlOVEVOVCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICoqQ29udGludWUqKiANCg0KDQoNCkhERkMgQkFOSyBMdGQuICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBhZ2UgTm8gLjogICAyNiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KMDkvMTAvMjUgIFVQSS1KSU8gV09STEQgQ0VOVFJFLTE0NDU1NTM2QEpJT1BBUlRORVIgIDAwMDAxMTIzODk1MjcxNjEgIDA5LzEwLzI1ICAgICAgICAgICAxLDM3NS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjExLDM2Mi42MSAgIA0KICAgICAgICAgIC1KSU9QMDAwMDAwMS0xMTIzODk1MjcxNjEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEwLzEwLzI1ICBVUEktQUlSVEVMLUFJUlRFTC1CSUxMUEFZTUVOVC5QQVlUTUBQVFlCICAwMDAwMTEyNDE3MTU3NzAzICAxMC8xMC8yNSAgICAgICAgICAgICA4NjQuODIgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIxMCw0OTcuNzkgICANCiAgICAgICAgICBMLVlFU0IwUFRNVVBJLTExMjQxNzE1NzcwMy1BSVJURUxQT1NUUEFJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgREJJTEwgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTAvMTAvMjUgIFVQSS1SQU1SQVRISSBTQU1CSEFMIFBBTC1SQU1SQVRISVBBTDQzMEAgIDAwMDA1NjQ5OTUxMjAwOTYgIDEwLzEwLzI1ICAgICAgICAgICAgIDI0MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjEwLDI1Ny43OSAgIA0KICAgICAgICAgIE9LU0JJLVVCSU4wNTU1ODQzLTU2NDk5NTEyMDA5Ni1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEwLzEwLzI1ICBVUEktUkFUQU5BLTk2NTQzMzIwNzMtMkBZQkwtQkFSQjBWQVNVTkQtICAwMDAwNTY0OTU3MDI3MTY4ICAxMC8xMC8yNSAgICAgICAgICAgMSwwNTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIwOSwyMDcuNzkgICANCiAgICAgICAgICA1NjQ5NTcwMjcxNjgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMC8xMC8yNSAgNTA0MDAzMTgxODY3ODAtIFJEIElOU1RBTExNRU5ULU9DVCAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMTAvMjUgICAgICAgICAgIDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMDQsMjA3Ljc5ICAgDQoxMC8xMC8yNSAgNTA0MDAzMTgxODcwMjctIFJEIElOU1RBTExNRU5ULU9DVCAyMDI1ICAgMDAwMDAwMDAwMDAwMDAwICAgMTAvMTAvMjUgICAgICAgICAgNDUsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTksMjA3Ljc5ICAgDQoxMS8xMC8yNSAgVVBJLURBVUpJIFNFUlZJQ0UgU1RBVElPLVBBWVRNUVI2MEcxWUdAUCAgMDAwMDExMjUwNTI4MDMzOSAgMTEvMTAvMjUgICAgICAgICAgIDIsMDIxLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTcsMTg2Ljc5ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTExMjUwNTI4MDMzOS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTIvMTAvMjUgIFVQSS1NIFMgTkFSU0lOR0ggTUVESUNPUy1JQktQT1MuRVAxNjAyNjEgIDAwMDA1Mjg1NzI2ODEyMjkgIDEyLzEwLzI1ICAgICAgICAgICAgIDE0NC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU3LDA0Mi43OSAgIA0KICAgICAgICAgIEBJQ0lDSS1JQ0lDMDAwMDAwNC01Mjg1NzI2ODEyMjktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEzLzEwLzI1ICBVUEktTSBTIE5BUlNJTkdIIE1FRElDT1MtSUJLUE9TLkVQMTYwMjYxICAwMDAwNTY1MjYyMzI5NTkxICAxMy8xMC8yNSAgICAgICAgICAgICAxMTUuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1Niw5MjcuNzkgICANCiAgICAgICAgICBASUNJQ0ktSUNJQzAwMDAwMDQtNTY1MjYyMzI5NTkxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMy8xMC8yNSAgVVBJLVBBV0FOIEZMT1dFUiBTSE9QLUdQQVktMTEyMDM0ODkxNzZATyAgMDAwMDExMjU4MjQ0OTg2NCAgMTMvMTAvMjUgICAgICAgICAgICAgIDEwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTYsOTE3Ljc5ICAgDQogICAgICAgICAgS0JJWkFYSVMtVVRJQjAwMDA1NTMtMTEyNTgyNDQ5ODY0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTMvMTAvMjUgIFVQSS1GVVJLQU4gQkVHLUJIQVJBVFBFLjlIME0wQjBTMUQ1MTc0NzEgIDAwMDAxMTI1OTk3MjQ1NjkgIDEzLzEwLzI1ICAgICAgICAgICAgIDM1MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU2LDU2Ny43OSAgIA0KICAgICAgICAgIEBVTklUWVBFLVVOQkEwMDBCSFBFLTExMjU5OTcyNDU2OS1QQVkgVE8gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBCSEFSQVRQRSBNRSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNS8xMC8yNSAgVVBJLUNIQVJBTiBTSU5HSC1QQVlUTVFSNlFMQzk5QFBUWVMtWUVTQiAgMDAwMDExMjcwOTUyNTU4NCAgMTUvMTAvMjUgICAgICAgICAgICAgIDMwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTYsNTM3Ljc5ICAgDQogICAgICAgICAgMFBUTVVQSS0xMTI3MDk1MjU1ODQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTYvMTAvMjUgIFVQSS1TSFlBTU1FRElDT1MtUEFZVE1RUjZBUVNWN0BQVFlTLVlFU0IgIDAwMDAxMTI3NDQ3OTUwMDEgIDE2LzEwLzI1ICAgICAgICAgICAgICA4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU2LDQ1Ny43OSAgIA0KICAgICAgICAgIDBQVE1VUEktMTEyNzQ0Nzk1MDAxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE2LzEwLzI1ICBVUEktRlVSS0FOIEJFRy1CSEFSQVRQRS45SDBNMEIwUzFENTE3NDcxICAwMDAwMTEyNzQ1MTExODY3ICAxNi8xMC8yNSAgICAgICAgICAgICA0MjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NiwwMzcuNzkgICANCiAgICAgICAgICBAVU5JVFlQRS1VTkJBMDAwQkhQRS0xMTI3NDUxMTE4NjctUEFZIFRPICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgQkhBUkFUUEUgTUUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTYvMTAvMjUgIFVQSS1QUkFTSEFOVCAgS0FVU0hJSy1RNzkwNDkyMjk1QFlCTC1ZRVMgIDAwMDAxMTI3NDU5NTQyNDEgIDE2LzEwLzI1ICAgICAgICAgICAgIDkwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU1LDEzNy43OSAgIA0KICAgICAgICAgIEIwWUJMVVBJLTExMjc0NTk1NDI0MS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE2LzEwLzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3NDg2MzM4ICAwMDAwMTEyNzU0MjI4NzA5ICAxNi8xMC8yNSAgICAgICAgICAgICAzNzQuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE1NCw3NjMuNzkgICANCiAgICAgICAgICAzMTcwQEhERkNCQU5LLUhERkMwTUVSVVBJLTExMjc1NDIyODcwOS1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDI3ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTYvMTAvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTc0ODYzMzggIDAwMDAxMTI3NTQ3ODc3MTAgIDE2LzEwLzI1ICAgICAgICAgICAgICA0NS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTU0LDcxOC43OSAgIA0KICAgICAgICAgIDMxNzBASERGQ0JBTkstSERGQzBNRVJVUEktMTEyNzU0Nzg3NzEwLVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNy8xMC8yNSAgLkRDIElOVEwgUE9TIFRYTiBNQVJLVVArU1QgMjgwOTI1IDI4MDkyNSAgTUlSMjYyODg2NjAzODc4MiAgMTcvMTAvMjUgICAgICAgICAgICAgIDczLjI3ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTQsNjQ1LjUyICAgDQogICAgICAgICAgLU1JUjI2Mjg4NjYwMzg3ODIgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTcvMTAvMjUgIFVQSS1OSVNIQU5ULTk4NzA1MDM2MTdAWUJMLUlPQkEwMDAwOTMyLTEgIDAwMDAxMTI4MDY2MDc0MTIgIDE3LzEwLzI1ICAgICAgICAgIDEwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTQ0LDY0NS41MiAgIA0KICAgICAgICAgIDEyODA2NjA3NDEyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE4LzEwLzI1ICBORUZUIENSLVNCSU4wMDExNDY5LU1SICBTVURISVIgIEtVTUFSLUtBICBTQklOTjUyMDI1MTAxODc2ICAxOC8xMC8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDAsMDAwLjAwICAgICAgICAgIDI0NCw2NDUuNTIgICANCiAgICAgICAgICBMUElUIFNIQVJNQS1TQklOTjUyMDI1MTAxODc2MjcxMDIzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOC8xMC8yNSAgVVBJLU1SIEJPQlktNjM5NTkyNTAyMUBZQkwtSURJQjAwME4wNDctMSAgMDAwMDExMjg1MzI0NDg1MiAgMTgvMTAvMjUgICAgICAgICAgICAgNjAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNDQsMDQ1LjUyICAgDQogICAgICAgICAgMTI4NTMyNDQ4NTItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTgvMTAvMjUgIE5XRC01MTI5NjdYWFhYWFg4MDI0LTAwNjQyNDA1LUdIQVpJQUJBRCAgIDAwMDA1MjkxMTkwMDE4MTcgIDE4LzEwLzI1ICAgICAgICAgIDEwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjM0LDA0NS41MiAgIA0KMTgvMTAvMjUgIFVQSS1USEUgREVMSUdIVCBEQUlSWS1WWUFQQVIuMTc0ODYzMzgzMTUgIDAwMDAxMTI4NTkzNjI4NTEgIDE4LzEwLzI1ICAgICAgICAgICAxLDQ2MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjMyLDU4NS41MiAgIA0KICAgICAgICAgIDJASERGQ0JBTkstSERGQzBNRVJVUEktMTEyODU5MzYyODUxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE4LzEwLzI1ICBVUEktU0hBR1VOIFNIQVJNQS1QQVlUTVFSMjgxMDA1MDUwMTAxQjFLICAwMDAwMTEyODYxMjE1NTg5ICAxOC8xMC8yNSAgICAgICAgICAgMiwyMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIzMCwzODUuNTIgICANCiAgICAgICAgICBIU1g1WUhRTUNAUEFZVE0tWUVTQjBQVE1VUEktMTEyODYxMjE1NTg5ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTgvMTAvMjUgIFVQSS1WSS1QQVlCSUwzMDY2QFBUWUJMLVlFU0IwUFRNVVBJLTUyOTEgIDAwMDA1MjkxNzQ5MDEwMDggIDE4LzEwLzI1ICAgICAgICAgICAgIDg2Mi4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjI5LDUyMy41MiAgIA0KICAgICAgICAgIDc0OTAxMDA4LVNFTlQgVVNJTkcgUEFZVE0gVSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE5LzEwLzI1ICBBVFctNTEyOTY3WFhYWFhYODAyNC1DSFVUUDA1MS1HSEFaSUFCQUQgICAwMDAwMDAwMDAwMDA2ODczICAxOS8xMC8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIxOSw1MjMuNTIgICANCjE5LzEwLzI1ICBBVFctNTEyOTY3WFhYWFhYODAyNC1DSFVUUDA1MS1HSEFaSUFCQUQgICAwMDAwMDAwMDAwMDA2ODc0ICAxOS8xMC8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIwOSw1MjMuNTIgICANCjE5LzEwLzI1ICBFQVctNTEyOTY3WFhYWFhYODAyNC1EUFJINDkzMDAxLUdIQVpJQUJBICAwMDAwMDAwMDAwMDA1MTY2ICAxOS8xMC8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE5OSw1MjMuNTIgICANCiAgICAgICAgICBEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOS8xMC8yNSAgTldELTUxMjk2N1hYWFhYWDgwMjQtNk5HT1ZHSEEtR0hBWklBQkFEICAgMDAwMDUyOTIxMzAwMzg1NSAgMTkvMTAvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxODksNTIzLjUyICAgDQoxOS8xMC8yNSAgTldELTUxMjk2N1hYWFhYWDgwMjQtNk5HT1ZHSEEtR0hBWklBQkFEICAgMDAwMDUyOTIxMzAwNDIyNSAgMTkvMTAvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNzksNTIzLjUyICAgDQoxOS8xMC8yNSAgTldELTUxMjk2N1hYWFhYWDgwMjQtNk5HT1ZHSEEtR0hBWklBQkFEICAgMDAwMDUyOTIxMzAwNDU4MCAgMTkvMTAvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNjksNTIzLjUyICAgDQoxOS8xMC8yNSAgTldELTUxMjk2N1hYWFhYWDgwMjQtNk5HT1ZHSEEtR0hBWklBQkFEICAgMDAwMDUyOTIxMzAwNDk3NiAgMTkvMTAvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTksNTIzLjUyICAgDQoxOS8xMC8yNSAgTldELTUxMjk2N1hYWFhYWDgwMjQtNk5HT1ZHSEEtR0hBWklBQkFEICAgMDAwMDUyOTIxMzAwNDk3NiAgMTkvMTAvMjUgICAgICAgICAtMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNjksNTIzLjUyICAgDQoxOS8xMC8yNSAgQVRXLTUxMjk2N1hYWFhYWDgwMjQtQ0hVVFAwNTEtR0hBWklBQkFEICAgMDAwMDAwMDAwMDAwNjg4MyAgMTkvMTAvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNTksNTIzLjUyICAgDQoxOS8xMC8yNSAgQVRXLTUxMjk2N1hYWFhYWDgwMjQtQ0hVVFAwNTEtR0hBWklBQkFEICAgMDAwMDAwMDAwMDAwNjg4NCAgMTkvMTAvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNDksNTIzLjUyICAgDQoxOS8xMC8yNSAgQVRXLTUxMjk2N1hYWFhYWDgwMjQtQ0hVVFAwNTEtR0hBWklBQkFEICAgMDAwMDAwMDAwMDAwNjg4NiAgMTkvMTAvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMzksNTIzLjUyICAgDQoxOS8xMC8yNSAgQVRXLTUxMjk2N1hYWFhYWDgwMjQtQ0hVVFAwNTEtR0hBWklBQkFEICAgMDAwMDAwMDAwMDAwNjg4NyAgMTkvMTAvMjUgICAgICAgICAgMTAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjksNTIzLjUyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDI4ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQoxOS8xMC8yNSAgVVBJLURBVUpJIFNFUlZJQ0UgU1RBVElPLVBBWVRNUVIyODEwMDUwNSAgMDAwMDExMjg5NTY3MjAzNSAgMTkvMTAvMjUgICAgICAgICAgIDEsMDEwLjUwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjgsNTEzLjAyICAgDQogICAgICAgICAgMDEwMTFSMExNMlo2WDRFV0BQQVlUTS1ZRVNCMFBUTVVQSS0xMTI4OSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDU2NzIwMzUtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE5LzEwLzI1ICBVUEktUkFWSVNIIFlBREFWLVBBWVRNUVI2VDU3WVBAUFRZUy1ZRVNCICAwMDAwMTEyOTA5ODE1ODgwICAxOS8xMC8yNSAgICAgICAgICAgICAxNjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyOCwzNTMuMDIgICANCiAgICAgICAgICAwUFRNVVBJLTExMjkwOTgxNTg4MC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxOS8xMC8yNSAgVVBJLVNBTkpBWSBSQVRIT1JFLVBBWVRNUVI2OEpFT0FAUFRZUy1ZRSAgMDAwMDExMjkwOTg1NDEyNyAgMTkvMTAvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjgsMzAzLjAyICAgDQogICAgICAgICAgU0IwUFRNVVBJLTExMjkwOTg1NDEyNy1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTkvMTAvMjUgIFVQSS1TQU5KQVkgUkFUSE9SRS1QQVlUTVFSNjhKRU9BQFBUWVMtWUUgIDAwMDAxMTI5MTA2Nzg1ODAgIDE5LzEwLzI1ICAgICAgICAgICAgICAzMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTI4LDI3My4wMiAgIA0KICAgICAgICAgIFNCMFBUTVVQSS0xMTI5MTA2Nzg1ODAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIwLzEwLzI1ICBVUEktU0FOSkVFViBLVU1BUiBBR0FSV0EtUEFZVE1RUjZGMkZFVkBQICAwMDAwMTEyOTQyNDAxNjgxICAyMC8xMC8yNSAgICAgICAgICAgICA0NTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyNyw4MjMuMDIgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTEyOTQyNDAxNjgxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMC8xMC8yNSAgVVBJLVBBUkFTIE5BR1BBTC03ODM4MzMzOTI0QEFYTC1VQklOMDU0MiAgMDAwMDU2NTkyNzUyOTMzOSAgMjAvMTAvMjUgICAgICAgICAgICAgMjAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjcsNjIzLjAyICAgDQogICAgICAgICAgODU3LTU2NTkyNzUyOTMzOS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjAvMTAvMjUgIFVQSS1TSElWQU0gIEdVUFRBLUdVUFRBMjcxMTE5OTgtMUBPS0hERkMgIDAwMDAxMTI5NTcyMTQ3MDYgIDIwLzEwLzI1ICAgICAgICAgICAzLDUwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTI0LDEyMy4wMiAgIA0KICAgICAgICAgIEJBTkstU0JJTjAwMTY2MDYtMTEyOTU3MjE0NzA2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIwLzEwLzI1ICBVUEktTVIgSEVNQU5UIFNJTkdILVBBWVRNUVI2Ukw1WU5AUFRZUy1ZICAwMDAwMTEyOTU4OTU4Nzc4ICAyMC8xMC8yNSAgICAgICAgICAgMiw4MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyMSwzMjMuMDIgICANCiAgICAgICAgICBFU0IwUFRNVVBJLTExMjk1ODk1ODc3OC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMS8xMC8yNSAgNTAyMDAwMDAwMDM0MDIgICAyMDgxNTk1M19ITElDX0lOU1QgICAgICAgMDAwMDAwMDAwMDAwMDAwICAgMjEvMTAvMjUgICAgICAgICAgICAgNjkyLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjAsNjMxLjAyICAgDQoyMS8xMC8yNSAgVVBJLU1BSEEgR0FORVNIIFRSQURFUlMtUEFZVE1RUjZENEtIOUBQVCAgMDAwMDExMjk5MjIzNTA4OSAgMjEvMTAvMjUgICAgICAgICAgICAgMTQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjAsNDkxLjAyICAgDQogICAgICAgICAgWVMtWUVTQjBQVE1VUEktMTEyOTkyMjM1MDg5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjEvMTAvMjUgIFVQSS1TSFlBTU1FRElDT1MtUEFZVE1RUjZUSkhBSkBQVFlTLVlFU0IgIDAwMDAxMTI5OTI0MzUxNzEgIDIxLzEwLzI1ICAgICAgICAgICAgICA2MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTIwLDQzMS4wMiAgIA0KICAgICAgICAgIDBQVE1VUEktMTEyOTkyNDM1MTcxLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIxLzEwLzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZQNkgwSEBQICAwMDAwMTEzMDA1ODAxNzg4ICAyMS8xMC8yNSAgICAgICAgICAgICAgODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyMCwzNTEuMDIgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTEzMDA1ODAxNzg4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMS8xMC8yNSAgVVBJLVRIRSBERUxJR0hUIERBSVJZLVZZQVBBUi4xNzQ4NjMzODMxNSAgMDAwMDExMzAwNjg4NTk4MCAgMjEvMTAvMjUgICAgICAgICAgICAgMjcwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMjAsMDgxLjAyICAgDQogICAgICAgICAgMkBIREZDQkFOSy1IREZDME1FUlVQSS0xMTMwMDY4ODU5ODAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjIvMTAvMjUgIFVQSS1GVVJLQU4gQkVHLUJIQVJBVFBFLjlIME0wQjBTMUQ1MTc0NzEgIDAwMDAxMTMwMjQwOTkyMDIgIDIyLzEwLzI1ICAgICAgICAgICAgIDQyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE5LDY2MS4wMiAgIA0KICAgICAgICAgIEBVTklUWVBFLVVOQkEwMDBCSFBFLTExMzAyNDA5OTIwMi1QQVkgVE8gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBCSEFSQVRQRSBNRSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMi8xMC8yNSAgVVBJLU0gUyBOQVJTSU5HSCBNRURJQ09TLUlCS1BPUy5FUDE2MDI2MSAgMDAwMDU2NjE2OTg1MTQwNiAgMjIvMTAvMjUgICAgICAgICAgICAgMjQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTksNDIxLjAyICAgDQogICAgICAgICAgQElDSUNJLUlDSUMwMDAwMDA0LTU2NjE2OTg1MTQwNi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjIvMTAvMjUgIFVQSS1NIFMgTkFSU0lOR0ggTUVESUNPUy1JQktQT1MuRVAxNjAyNjEgIDAwMDA1NjYxNDk3ODAyNzIgIDIyLzEwLzI1ICAgICAgICAgICAgICA5NS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTE5LDMyNi4wMiAgIA0KICAgICAgICAgIEBJQ0lDSS1JQ0lDMDAwMDAwNC01NjYxNDk3ODAyNzItVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMjkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjIzLzEwLzI1ICBVUEktTUFIQSBHQU5FU0ggVFJBREVSUy1QQVlUTVFSNkQ0S0daQFBUICAwMDAwMTEzMDc0NTMxNjQwICAyMy8xMC8yNSAgICAgICAgICAgMSwzMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDExOCwwMjYuMDIgICANCiAgICAgICAgICBZUy1ZRVNCMFBUTVVQSS0xMTMwNzQ1MzE2NDAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNC8xMC8yNSAgUDpLMTY2NzUgSERGQyBCQU5LIFNBTEFSWSBGT1IgT0NUIDIwMjUgICAgMDAwMDAwMDAwMDAwMDAwICAgMjQvMTAvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjY2LDU1My4wMCAgICAgICAgICAzODQsNTc5LjAyICAgDQoyNC8xMC8yNSAgSU1QUy01Mjk3MTY5MjEyMTgtS0FMUElUIEtVTUFSIFNIQVJNQS1JRCAgMDAwMDUyOTcxNjkyMTIxOCAgMjQvMTAvMjUgICAgICAgICAyMDAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxODQsNTc5LjAyICAgDQogICAgICAgICAgRkItWFhYWFhYWDI5NTAtICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjQvMTAvMjUgIFVQSS1BU0IgQVVUT01PQklMRVMgUFJJVi1BU0JBVTk5NTg1ODIxQEIgIDAwMDAxMTMxMzc0NDU2MDAgIDI0LzEwLzI1ICAgICAgICAgICA1LDY0OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTc4LDkzMC4wMiAgIA0KICAgICAgICAgIEFST0RBTVBBWS1CQVJCMEdIQVpJQS0xMTMxMzc0NDU2MDAtVkVSSUYgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBJRUQgTUVSQ0hBTlQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNC8xMC8yNSAgVVBJLUVQSVNDQU4gUEhBUk1BQ1ktUEFZVE1RUjY3RVZRRUBQVFlTLSAgMDAwMDExMzE0NTAzOTM4OSAgMjQvMTAvMjUgICAgICAgICAgICAgNzAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNzgsMjMwLjAyICAgDQogICAgICAgICAgWUVTQjBQVE1VUEktMTEzMTQ1MDM5Mzg5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjQvMTAvMjUgIFVQSS1FUElTQ0FOIFBIQVJNQUNZLVBBWVRNUVI2N0VWUUVAUFRZUy0gIDAwMDAxMTMxNDU5MzEwMTggIDI0LzEwLzI1ICAgICAgICAgICAgIDM4My4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTc3LDg0Ny4wMiAgIA0KICAgICAgICAgIFlFU0IwUFRNVVBJLTExMzE0NTkzMTAxOC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI0LzEwLzI1ICBVUEktREFVSkkgU0VSVklDRSBTVEFUSU8tUEFZVE1RUjYwRzFZR0BQICAwMDAwMTEzMTQ2Mjc0MDAyICAyNC8xMC8yNSAgICAgICAgICAgMiw1MjYuMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE3NSwzMjAuNzcgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTEzMTQ2Mjc0MDAyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNC8xMC8yNSAgVVBJLVNPTkEgRlVFTCBDRU5UUkUtUEFZVE1RUjI4MTAwNTA1MDEwMSAgMDAwMDExMzE0NzA4MDQ0MyAgMjQvMTAvMjUgICAgICAgICAgICAgMTg5LjQwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNzUsMTMxLjM3ICAgDQogICAgICAgICAgMVRNUzMxRzVIRDhFQFBBWVRNLVlFU0IwUFRNVVBJLTExMzE0NzA4MCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDQ0My1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI1LzEwLzI1ICBVUEktS0VXQUwgS1JJU0hBTi1LRVdBTFNISVZBTUBBWEwtS0tCSzAwICAwMDAwNTI5ODgxNDM0MTM5ICAyNS8xMC8yNSAgICAgICAgICAgICAyNTAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE3NCw4ODEuMzcgICANCiAgICAgICAgICAwMDIwOS01Mjk4ODE0MzQxMzktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8xMC8yNSAgSU1QUy01Mjk4MTM5MDUxODQtS0FMUElUIFNIQVJNQS1TQklOLVhYWCAgMDAwMDUyOTgxMzkwNTE4NCAgMjUvMTAvMjUgICAgICAgICAgICAgMTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNzQsNzgxLjM3ICAgDQogICAgICAgICAgWFhYWDI1ODgtICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjUvMTAvMjUgIFVQSS1LQUxQSVQgS1VNQVIgU0hBUk1BLUtBTFBJVC5DT09MMjAwNkAgIDAwMDA1Mjk4NjU0NDAwOTkgIDI1LzEwLzI1ICAgICAgICAgIDUwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTI0LDc4MS4zNyAgIA0KICAgICAgICAgIE9LSUNJQ0ktSUNJQzAwMDM0NTgtNTI5ODY1NDQwMDk5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI1LzEwLzI1ICBVUEktS0FMUElUIEtVTUFSIFNIQVJNQS1LQUxQSVQuQ09PTDIwMDZAICAwMDAwMTEzMTcwNDg1NDQ2ICAyNS8xMC8yNSAgICAgICAgICA0MCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4NCw3ODEuMzcgICANCiAgICAgICAgICBPS0lDSUNJLUlDSUMwMDAzNDU4LTExMzE3MDQ4NTQ0Ni1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8xMC8yNSAgVVBJLVNVTklMIFBST1ZJU0lPTiBTVE9SLVEzMDc3NTYxMzRAWUJMLSAgMDAwMDExMzE5MDA0ODg0NCAgMjUvMTAvMjUgICAgICAgICAgICAgMjM1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODQsNTQ2LjM3ICAgDQogICAgICAgICAgWUVTQjBZQkxVUEktMTEzMTkwMDQ4ODQ0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjUvMTAvMjUgIFVQSS1TVUpJVCBQQUwgU0lOR0ggUyBPIC1RMDgyMTI2Mjc3QFlCTC0gIDAwMDAxMTMxOTAyMzg1MDQgIDI1LzEwLzI1ICAgICAgICAgICAgIDYwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgzLDk0Ni4zNyAgIA0KICAgICAgICAgIFlFU0IwWUJMVVBJLTExMzE5MDIzODUwNC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI1LzEwLzI1ICBVUEktU1VKSVQgUEFMIFNJTkdIIFMgTyAtUTA4MjEyNjI3N0BZQkwtICAwMDAwMTEzMTkwMjQ3MTM0ICAyNS8xMC8yNSAgICAgICAgICAgICA2MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4MywzNDYuMzcgICANCiAgICAgICAgICBZRVNCMFlCTFVQSS0xMTMxOTAyNDcxMzQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8xMC8yNSAgVVBJLU1BSEEgR0FORVNIIFRSQURFUlMtUEFZVE1RUjZENEtHWkBQVCAgMDAwMDExMzE5MDQzODM4NCAgMjUvMTAvMjUgICAgICAgICAgICAgIDYwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODMsMjg2LjM3ICAgDQogICAgICAgICAgWVMtWUVTQjBQVE1VUEktMTEzMTkwNDM4Mzg0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICoqQ29udGludWUqKiANCg0KDQoNCkhERkMgQkFOSyBMdGQuICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBhZ2UgTm8gLjogICAzMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZW1lbnQgb2YgYWNjb3VudHMNCg0KDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBCcmFuY2ggOiBWSU1BTiBOQUdBUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFkZHJlc3MgICAgICAgIDogU0hPUCBOTy4yICYgMyxGTE9SRU5DRSBCVUlMRElORywNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFZJTUFOIE5BR0FSLA0KTVIuICAgICBLQUxQSVQgS1VNQVIgU0hBUk1BICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0ZGIFBVTkUgTkFHQVIgSElHSFdBWSwNCkhOTyA4My82NiBDIEJMT0NLIEdPVklORFBVUkFNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDaXR5ICAgICAgICAgICA6IFBVTkU0MTEwMTQNCjgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gR0hBWklBQkFEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBTdGF0ZSAgICAgICAgICA6IE1BSEFSQVNIVFJBDQpHSEFaSUFCQUQgMjAxMDEzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGhvbmUgbm8uICAgICAgOiAxODAwMjYwMC8xODAwMTYwMA0KVVRUQVIgUFJBREVTSCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEVtYWlsICAgICAgICAgIDogS0FMUElULkNPT0wyMDA2QEdNQUlMLkNPTQ0KR09WSU5EUFVSQU0gTkVBUiBPVkVSSEVBRCBXQVRFUlRBTksgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9EIExpbWl0ICAgICAgIDogMCAgQ3VycmVuY3kgOiBJTlINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBDdXN0IElEICAgICAgICA6IDQzNzM3NzQ4DQpKT0lOVCBIT0xERVJTIDogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBObyAgICAgOiAwODgyMTEzMDAwMTcyNSAgIFBSSU1FIFBPVEVOVElBTA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEEvQyBPcGVuIERhdGUgIDogMjEvMDMvMjAxNA0KTm9taW5hdGlvbiA6IFJlZ2lzdGVyZWQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgU3RhdHVzIDogUmVndWxhciAgDQpTdGF0ZW1lbnQgRnJvbSAgICAgIDogMDEvMDQvMjAyNSAgVG86IDE3LzEyLzIwMjUgICAgICAgICAgICAgICAgICAgICAgICAgUlRHUy9ORUZUIElGU0MgOiBIREZDMDAwMDg4MiAgICBNSUNSIDogNDExMjQwMDIyICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQnJhbmNoIENvZGUgICAgOiA4ODIgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFR5cGUgICA6IFNBVklOR1MgQS9DIC0gRU1QTE9ZRUVTICgxMDIpDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCkRhdGUgICAgICBOYXJyYXRpb24JCQkJICAgIENocS4vUmVmLk5vLiAgICAgIFZhbHVlIER0ICBXaXRoZHJhd2FsIEFtdC4gICAgICAgIERlcG9zaXQgQW10LiAgICAgQ2xvc2luZyBCYWxhbmNlDQotLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0NCg0KMjYvMTAvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTc0ODYzMzggIDAwMDAxMTMyMDg5MzA4NDQgIDI2LzEwLzI1ICAgICAgICAgICAgIDQ2MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgyLDgyNi4zNyAgIA0KICAgICAgICAgIDMxNzBASERGQ0JBTkstSERGQzBNRVJVUEktMTEzMjA4OTMwODQ0LVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNi8xMC8yNSAgVVBJLU1BTk9KIEtVTUFSIFNJTkdILVE1MDY1NzE3NjZAWUJMLVlFUyAgMDAwMDExMzIyNzcxMjk1OCAgMjYvMTAvMjUgICAgICAgICAgICAgNTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODIsMzI2LjM3ICAgDQogICAgICAgICAgQjBZQkxVUEktMTEzMjI3NzEyOTU4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjYvMTAvMjUgIFVQSS1BTlVCSFVUSSBNRURJQ0FMIFNUTy04NzAwNDUyMjE4TTFAUE4gIDAwMDAxMTMyMjgyNTIzMjcgIDI2LzEwLzI1ICAgICAgICAgICAgIDI3MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgyLDA1Ni4zNyAgIA0KICAgICAgICAgIEItUFVOQjA0MDM5MDAtMTEzMjI4MjUyMzI3LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI2LzEwLzI1ICBVUEktQ0hBUkFOIFNJTkdILVBBWVRNUVI2UUxDOTlAUFRZUy1ZRVNCICAwMDAwMTEzMjI4NjgxOTU3ICAyNi8xMC8yNSAgICAgICAgICAgICAgMzUuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4MiwwMjEuMzcgICANCiAgICAgICAgICAwUFRNVVBJLTExMzIyODY4MTk1Ny1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNi8xMC8yNSAgVVBJLVNBTkpBWUtVTUFSLUdQQVktMTEyNjM2NTY1MzRAT0tCSVpBWCAgMDAwMDExMzIyOTc2NTM3MyAgMjYvMTAvMjUgICAgICAgICAgICAgMTQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODEsODgxLjM3ICAgDQogICAgICAgICAgSVMtVVRJQjAwMDA1NTMtMTEzMjI5NzY1MzczLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjcvMTAvMjUgIEFDSCBELSBUUCBBQ0ggTUFYTElGRUlOU1VSQS0xOTgzOTI2MTUwICAgIDAwMDAwMDMzMzI0NDg0MDEgIDI3LzEwLzI1ICAgICAgICAgICAxLDI1Ni44MiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgwLDYyNC41NSAgIA0KMjcvMTAvMjUgIEFDSCBDLSBDT0NISU4gU0hJUFlBUkQgTFRELTI1MDE4MiAgICAgICAgIDAwMDAwMDIzMjk3OTUzMzYgIDI3LzEwLzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDQuNTAgICAgICAgICAgIDgwLDYyOS4wNSAgIA0KMjgvMTAvMjUgIFVQSS1HT09HTEUgUExBWS1QTEFZU1RPUkVAQVhJU0JBTkstVVRJQjAgIDAwMDA0OTU4MTIyNDMwMTUgIDI4LzEwLzI1ICAgICAgICAgICAgIDE5OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgwLDQzMC4wNSAgIA0KICAgICAgICAgIDAwMDU1My00OTU4MTIyNDMwMTUtTUFOREFURUVYRUNVVEUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzEwLzI1ICBVUEktSElORFVTVEFOIE5BUklZQUwgUEEtQkFKQUpQQVkuNjg3OTcyICAwMDAwMTEzMjgzNjg1MDU1ICAyOC8xMC8yNSAgICAgICAgICAgICAgNzAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4MCwzNjAuMDUgICANCiAgICAgICAgICA5LjFBTjY2MzQ0OTZASU5EVVMtSU5EQjBNRVJDSEEtMTEzMjgzNjg1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgMDU1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMTAvMjUgIFVQSS1ISU5EVVNUQU4gTkFSSVlBTCBQQS1CQUpBSlBBWS42ODc5NzIgIDAwMDAxMTMyODM3MjUxNDkgIDI4LzEwLzI1ICAgICAgICAgICAgIDI4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgwLDA4MC4wNSAgIA0KICAgICAgICAgIDkuMUFONjYzNDQ5NkBJTkRVUy1JTkRCME1FUkNIQS0xMTMyODM3MjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAxNDktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOC8xMC8yNSAgTUUgREMgU0kgNTEyOTY3WFhYWFhYODAyNCBDVVJTT1IsIEFJIFBPVyAgMDAwMDAwMDAwMDEwOTUwMSAgMjgvMTAvMjUgICAgICAgICAgIDEsNzY2LjM4ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNzgsMzEzLjY3ICAgDQogICAgICAgICAgRVJFRCBJREUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMTAvMjUgIC5EQyBJTlRMIFBPUyBUWE4gTUFSS1VQK1NUIDA2MTAyNSAwNjEwMjUgIE1JUjI2Mjk3MDYyMDc3ODEgIDI4LzEwLzI1ICAgICAgICAgICAgICA3Ni45MiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDc4LDIzNi43NSAgIA0KICAgICAgICAgIC1NSVIyNjI5NzA2MjA3NzgxICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzEwLzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZQNkgwSEBQICAwMDAwMTEzMzE3NzI4NTU4ICAyOC8xMC8yNSAgICAgICAgICAgICAgMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA3OCwyMTYuNzUgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTEzMzE3NzI4NTU4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOC8xMC8yNSAgVVBJLUFHR0FSV0FMIFRSQURJTkcgQ09NLVBBWVRNUVI2RDRLSEJAUCAgMDAwMDExMzMxODEwMTc1MCAgMjgvMTAvMjUgICAgICAgICAgICAgMTgwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgNzgsMDM2Ljc1ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTExMzMxODEwMTc1MC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMTAvMjUgIFVQSS1BTktJVCBEQUlSWSBBTkQgU1dFRS1WWUFQQVIuMTc0ODYzMzggIDAwMDAxMTMzMTgxNjkzNTMgIDI4LzEwLzI1ICAgICAgICAgICAyLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDc2LDAzNi43NSAgIA0KICAgICAgICAgIDMxNzBASERGQ0JBTkstSERGQzBNRVJVUEktMTEzMzE4MTY5MzUzLVUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQozMC8xMC8yNSAgVVBJLUNSRUQgQ0xVQi1DUkVELkNMVUJAQVhJU0ItVVRJQjAwMDAxMSAgMDAwMDU2NjkyMDA4MzQzMCAgMzAvMTAvMjUgICAgICAgICAgNTEsMTM3LjY0ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjQsODk5LjExICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDMxICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgNC01NjY5MjAwODM0MzAtUEFZTUVOVCBPTiBDUkVEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMzAvMTAvMjUgIFVQSS1USEUgREVMSUdIVCBEQUlSWS1WWUFQQVIuMTc0ODYzMzgzMTUgIDAwMDAxMTM0MDgyNjQwODYgIDMwLzEwLzI1ICAgICAgICAgICAgIDMzNS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI0LDU2NC4xMSAgIA0KICAgICAgICAgIDJASERGQ0JBTkstSERGQzBNRVJVUEktMTEzNDA4MjY0MDg2LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjMxLzEwLzI1ICBVUEktVEhFIERFTElHSFQgREFJUlktVllBUEFSLjE3NDg2MzM4MzE1ICAwMDAwMTEzNDI4NjcxNjI0ICAzMS8xMC8yNSAgICAgICAgICAgMSwzNjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMywyMDQuMTEgICANCiAgICAgICAgICAyQEhERkNCQU5LLUhERkMwTUVSVVBJLTExMzQyODY3MTYyNC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQozMS8xMC8yNSAgVVBJLVRIRSBERUxJR0hUIERBSVJZLVZZQVBBUi4xNzQ4NjMzODMxNSAgMDAwMDExMzQzMTM1NTYxOCAgMzEvMTAvMjUgICAgICAgICAgICAgMjU1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjIsOTQ5LjExICAgDQogICAgICAgICAgMkBIREZDQkFOSy1IREZDME1FUlVQSS0xMTM0MzEzNTU2MTgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMzEvMTAvMjUgIEFDSCBDLSBJQ0lDSSBMT01CQVJEIEdFTkVSQS02MTI1MjM0ICAgICAgIDAwMDAwMDIzNTg2OTc3ODggIDMxLzEwLzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNDMuMDAgICAgICAgICAgIDIzLDA5Mi4xMSAgIA0KMzEvMTAvMjUgIFVQSS1BR0dBUldBTCBUUkFESU5HIENPTS1QQVlUTVFSNkQ0S0hCQFAgIDAwMDAxMTM0NTMwNzU5ODEgIDMxLzEwLzI1ICAgICAgICAgICAgIDU4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIyLDUxMi4xMSAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTM0NTMwNzU5ODEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzExLzI1ICBJTVBTLTUzMDUxMDg4NjY5My1NUiBLQUxQSVQgS1VNQVIgU0hBUi1JICAwMDAwNTMwNTEwODg2NjkzICAwMS8xMS8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDAsMDAwLjAwICAgICAgICAgIDEyMiw1MTIuMTEgICANCiAgICAgICAgICBERkItWFhYWFhYWDI5NTAtSU1QU1RYTiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMS8xMS8yNSAgVVBJLURBVUpJIFNFUlZJQ0UgU1RBVElPLVBBWVRNUVI2MEcxWUVAUCAgMDAwMDExMzQ3NTc2NDMwMiAgMDEvMTEvMjUgICAgICAgICAgIDIsNTI2LjI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMTksOTg1Ljg2ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTExMzQ3NTc2NDMwMi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDEvMTEvMjUgIDUwNDAwMzM0OTE4NzEzLSBSRCBJTlNUQUxMTUVOVC1OT1YgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDAxLzExLzI1ICAgICAgICAgIDEwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA5LDk4NS44NiAgIA0KMDEvMTEvMjUgIDUwNDAwMzM0OTE4ODMxLSBSRCBJTlNUQUxMTUVOVC1OT1YgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDAxLzExLzI1ICAgICAgICAgICAzLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA2LDk4NS44NiAgIA0KMDIvMTEvMjUgIFVQSS1VIFAgUE9XRVIgQ09SUE9SQVRJTy1VUFBPV0VSQ09SUE9SQVQgIDAwMDA1MzA1MjM0Nzc2MjQgIDAyLzExLzI1ICAgICAgICAgICA4LDk5My4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk3LDk5Mi44NiAgIA0KICAgICAgICAgIElPTkxURC5QQVlVQE1BSVJURUwtQUlSUDAwMDAwMDEtNTMwNTIzNDcgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICA3NjI0LVVQSSBUUkFOU0FDVElPTiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMi8xMS8yNSAgVVBJLUFJUlRFTCBQQVlNRU5UUyBCQU5LLUFJUlRFTERJUkVDVFVQSSAgMDAwMDExMzUzMDk3MjIzMiAgMDIvMTEvMjUgICAgICAgICAgICAgODY0LjgyICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTcsMTI4LjA0ICAgDQogICAgICAgICAgMTYwNzM5LlJaUEBSWEFYSVMtVVRJQjAwMDBSWlAtMTEzNTMwOTcyMiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDMyLUFJUlRFTFBPU1RQQUlEQklMTCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAyLzExLzI1ICBVUEktQUdHQVJXQUwgVFJBRElORyBDT00tUEFZVE1RUjZENEtIQkBQICAwMDAwMTEzNTUzMzI4Nzc4ICAwMi8xMS8yNSAgICAgICAgICAgICAgNDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5NywwODguMDQgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTEzNTUzMzI4Nzc4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMi8xMS8yNSAgVVBJLUFHR0FSV0FMIFRSQURJTkcgQ09NLVBBWVRNUVI2RDRLSEJAUCAgMDAwMDExMzU1MzM1NzYzNSAgMDIvMTEvMjUgICAgICAgICAgICAgIDQwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTcsMDQ4LjA0ICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTExMzU1MzM1NzYzNS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDIvMTEvMjUgIFVQSS1DSEFSQU4gU0lOR0gtUEFZVE1RUjZRTEM5OUBQVFlTLVlFU0IgIDAwMDAxMTM1NTQ2MTEwODggIDAyLzExLzI1ICAgICAgICAgICAgICA0MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk3LDAwOC4wNCAgIA0KICAgICAgICAgIDBQVE1VUEktMTEzNTU0NjExMDg4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAyLzExLzI1ICBVUEktQUFLQVNIICBTSU5HSC1CSEFSQVRQRTlNME43VjNNMEE0NjY0ICAwMDAwMTEzNTU1NjIxOTIwICAwMi8xMS8yNSAgICAgICAgICAgICAyNzAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5Niw3MzguMDQgICANCiAgICAgICAgICA4MUBZRVNCQU5LTFRELVlFU0IwWUVTVVBJLTExMzU1NTYyMTkyMC1QICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgQVkgVE8gQkhBUkFUUEUgTUUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDIvMTEvMjUgIFVQSS1NUyAgUEFUQU5KQUxJIENISUtJVC1QQVlUTVFSNlRKSjA2QFAgIDAwMDAxMTM1NTYxMTAzMDQgIDAyLzExLzI1ICAgICAgICAgICAgICA4MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk2LDY1OC4wNCAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTM1NTYxMTAzMDQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMzIgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjAyLzExLzI1ICBVUEktUFJBQkhBVCBTSEFSTUEtUEFZVE1RUjZHU1YwQ0BQVFlTLVlFICAwMDAwMTEzNTU2MzkyODYyICAwMi8xMS8yNSAgICAgICAgICAgICAgNDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5Niw2MTguMDQgICANCiAgICAgICAgICBTQjBQVE1VUEktMTEzNTU2MzkyODYyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMi8xMS8yNSAgVVBJLVRVU0hBUiAgR09FTC1UVVNIQVJHT0VMMjlKQU5AT0tTQkktUyAgMDAwMDExMzU1NjU4MzMyMCAgMDIvMTEvMjUgICAgICAgICAgICAgNDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTYsMjE4LjA0ICAgDQogICAgICAgICAgQklOMDAxMTQ2OS0xMTM1NTY1ODMzMjAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDQvMTEvMjUgIFVQSS1NUyBBR0dBUldBTCBTV0VFVCAgQy1QQVlUTVFSNlA2SDBIQFAgIDAwMDAxMTM2NTQxODM2MDAgIDA0LzExLzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDk2LDE5OC4wNCAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTM2NTQxODM2MDAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA2LzExLzI1ICBVUEktV1dXIEVYQ0lURUwgQ09NLUVYQ0lURUwuUEFZVUBIREZDQkFOICAwMDAwMTAxOTAwNTY3NDQ4ICAwNi8xMS8yNSAgICAgICAgICAgNywwNjEuMTIgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4OSwxMzYuOTIgICANCiAgICAgICAgICBLLUhERkMwTUVSVVBJLTEwMTkwMDU2NzQ0OC1VUEkgVFJBTlNBQ1RJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgT04gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDcvMTEvMjUgIEFDSCBDLSBJTkZPU1lTIExJTUlURUQtNDkwNTIwMjIgICAgICAgICAgIDAwMDAwMDI0MTA3OTU1MjcgIDA3LzExLzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA1MDYuMDAgICAgICAgICAgIDg5LDY0Mi45MiAgIA0KMDgvMTEvMjUgIFVQSS1EQVVKSSBTRVJWSUNFIFNUQVRJTy1QQVlUTVFSMjgxMDA1MDUgIDAwMDAxMTM4MjM1NDQ0NDQgIDA4LzExLzI1ICAgICAgICAgICAxLDAxMC41MCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDg4LDYzMi40MiAgIA0KICAgICAgICAgIDAxMDExUjBMTTJaNlg0RVdAUEFZVE0tWUVTQjBQVE1VUEktMTEzODIgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAzNTQ0NDQ0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOS8xMS8yNSAgVVBJLVNBTkpBWUtVTUFSLUdQQVktMTEyNjM2NTY1MzRAT0tCSVpBWCAgMDAwMDExMzkxNTEyNzUzOSAgMDkvMTEvMjUgICAgICAgICAgICAgIDYwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgODgsNTcyLjQyICAgDQogICAgICAgICAgSVMtVVRJQjAwMDA1NTMtMTEzOTE1MTI3NTM5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTAvMTEvMjUgIDUwNDAwMzE4MTg2NzgwLSBSRCBJTlNUQUxMTUVOVC1OT1YgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDEwLzExLzI1ICAgICAgICAgICA1LDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDgzLDU3Mi40MiAgIA0KMTAvMTEvMjUgIDUwNDAwMzE4MTg3MDI3LSBSRCBJTlNUQUxMTUVOVC1OT1YgMjAyNSAgIDAwMDAwMDAwMDAwMDAwMCAgIDEwLzExLzI1ICAgICAgICAgIDQ1LDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDM4LDU3Mi40MiAgIA0KMTEvMTEvMjUgIFVQSS1SQUogS1VNQVItOTk5OTY2NDQ2MEBQVEhERkMtSklPUDAwMDAgIDAwMDA2OTM3MDA5OTA3OTggIDExLzExLzI1ICAgICAgICAgICAxLDUwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDM3LDA3Mi40MiAgIA0KICAgICAgICAgIDAwMS02OTM3MDA5OTA3OTgtU0VOVCBGUk9NIFBBWVRNICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjExLzExLzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZQNkgwSEBQICAwMDAwMTE0MDEzNDkxOTc3ICAxMS8xMS8yNSAgICAgICAgICAgICAgMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzNywwNTIuNDIgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTE0MDEzNDkxOTc3LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMS8xMS8yNSAgVVBJLVJBVklORFJBIEtVTUFSIEdVUFRBLVBBWVRNUVIyODEwMDUwNSAgMDAwMDExNDAxNTIxNjY5MyAgMTEvMTEvMjUgICAgICAgICAgICAgMTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzYsOTUyLjQyICAgDQogICAgICAgICAgMDEwMVVEQ0QxVkFMREs2N0BQQVlUTS1ZRVNCMFBUTVVQSS0xMTQwMSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIDUyMTY2OTMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEyLzExLzI1ICBVUEktS1VLVUZNLUtVS1VGTU9OTElORUBZQkwtWUVTQjBZQkxVUEktICAwMDAwNTMxNjk0MjM1MjA4ICAxMi8xMS8yNSAgICAgICAgICAgICAgIDIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzNiw5NTAuNDIgICANCiAgICAgICAgICA1MzE2OTQyMzUyMDgtQ09MTEVDVCBSRVFVRVNUIEZSICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMi8xMS8yNSAgVVBJLVBIT05FUEUtUEhPTkVQRU1FUkNIQU5UQFlFU0JBTkstWUVTQiAgMDAwMDUzMTY0MTE3NzIwOSAgMTIvMTEvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMi4wMCAgICAgICAgICAgMzYsOTUyLjQyICAgDQogICAgICAgICAgMDAwMDAyMi01MzE2NDExNzcyMDktUjAyIFBIT05FUEUgUkVWRVJTICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTMvMTEvMjUgIC5EQyBJTlRMIFBPUyBUWE4gTUFSS1VQK1NUIDI4MTAyNSAyODEwMjUgIE1JUjI2MzEyMzUyMzIxNjIgIDEzLzExLzI1ICAgICAgICAgICAgICA3Mi45NSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDM2LDg3OS40NyAgIA0KICAgICAgICAgIC1NSVIyNjMxMjM1MjMyMTYyICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEzLzExLzI1ICBVUEktU0hJVkEgS1VNQVItUTg2OTMxOTczNUBZQkwtWUVTQjBZQkxVICAwMDAwMTE0MTE3OTM4MTI0ICAxMy8xMS8yNSAgICAgICAgICAgICAxMzAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzNiw3NDkuNDcgICANCiAgICAgICAgICBQSS0xMTQxMTc5MzgxMjQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNC8xMS8yNSAgVVBJLVNIWUFNTUVESUNPUy1QQVlUTVFSNkRNREZIQFBUWVMtWUVTQiAgMDAwMDExNDE1ODgwMTM4NyAgMTQvMTEvMjUgICAgICAgICAgICAgMTY1LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzYsNTg0LjQ3ICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDMzICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgMFBUTVVQSS0xMTQxNTg4MDEzODctVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTQvMTEvMjUgIFVQSS1TSFlBTU1FRElDT1MtUEFZVE1RUjZETURGSEBQVFlTLVlFU0IgIDAwMDAxMTQxNTg4Njk0MDUgIDE0LzExLzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDM2LDU2NC40NyAgIA0KICAgICAgICAgIDBQVE1VUEktMTE0MTU4ODY5NDA1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE1LzExLzI1ICBVUEktTVMgREVWIEVMRUNUUklDQUxTLVE1MTc0MzkxMThAWUJMLVlFICAwMDAwMTE0MTgzNzQ1MDg4ICAxNS8xMS8yNSAgICAgICAgICAgICA0NjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzNiwxMDQuNDcgICANCiAgICAgICAgICBTQjBZQkxVUEktMTE0MTgzNzQ1MDg4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNS8xMS8yNSAgVVBJLVNIWUFNTUVESUNPUy1QQVlUTVFSNlVTT1VaQFBUWVMtWUVTQiAgMDAwMDExNDIwNDQ5NzU3MSAgMTUvMTEvMjUgICAgICAgICAgICAgMjIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzUsODg0LjQ3ICAgDQogICAgICAgICAgMFBUTVVQSS0xMTQyMDQ0OTc1NzEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTUvMTEvMjUgIFVQSS1BUk9HWUFMQVhNSS1NMjI0QUQ3QjZaVEhHQFlCTC1ZRVNCMFkgIDAwMDA1Njg1MTMxMTMyNTAgIDE1LzExLzI1ICAgICAgICAgICAxLDk4OS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDMzLDg5NS40NyAgIA0KICAgICAgICAgIEJMVVBJLTU2ODUxMzExMzI1MC1QQVlNRU5UIEZPUiBSTVk4UzAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE2LzExLzI1ICBVUEktU0FVUkFCSCBCSEFSVEktUEFZVE0uUzFSSkFNREBQVFktWUVTICAwMDAwMTE0MjQ5ODkzMTkxICAxNi8xMS8yNSAgICAgICAgICAgICAxMjAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAzMyw3NzUuNDcgICANCiAgICAgICAgICBCME1DSFVQSS0xMTQyNDk4OTMxOTEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNy8xMS8yNSAgVVBJLVNPTkEgRlVFTCBDRU5UUkUtUEFZVE1RUlJCVEQ2QlpXRTJAUCAgMDAwMDExNDI2MDM5MDAyNCAgMTcvMTEvMjUgICAgICAgICAgICAgMTg5LjQwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMzMsNTg2LjA3ICAgDQogICAgICAgICAgQVlUTS1ZRVNCMFBUTVVQSS0xMTQyNjAzOTAwMjQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTcvMTEvMjUgIFVQSS1JUkNUQyBDRi1JUkNUQy5DRkBIREZDQkFOSy1IREZDME1FUlUgIDAwMDAxMDE5OTI0ODA0MTUgIDE3LzExLzI1ICAgICAgICAgICAxLDg4NS41MiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDMxLDcwMC41NSAgIA0KICAgICAgICAgIFBJLTEwMTk5MjQ4MDQxNS1JUkNUQyBUSUNLRVQgQk9PS0kgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE3LzExLzI1ICBVUEktQVVUT1BFIFBBWU1FTlQgU09MVVQtSVJDVENBVVRPUEVAWUVTICAwMDAwNTMyMTU4NjM3MzU4ICAxNy8xMS8yNSAgICAgICAgICAgMyw2MDMuNjAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyOCwwOTYuOTUgICANCiAgICAgICAgICBCQU5LLVlFU0IwMDAwMDE2LTUzMjE1ODYzNzM1OC1QQVkgV0lUSCBVICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTgvMTEvMjUgIFVQSS1NUyBBR0dBUldBTCBTV0VFVCAgQy1QQVlUTVFSNlA2SDBIQFAgIDAwMDAxMTQzNDQ1MjcwNDQgIDE4LzExLzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI4LDA3Ni45NSAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTQzNDQ1MjcwNDQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIxLzExLzI1ICA1MDIwMDAwMDAwMzQwMiAgIDIwODE1OTUzX0hMSUNfSU5TVCAgICAgICAwMDAwMDAwMDAwMDAwMDAgICAyMS8xMS8yNSAgICAgICAgICAgICA2OTIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNywzODQuOTUgICANCjIyLzExLzI1ICBVUEktSElORFVTVEFOIE5BUklZQUwgUEEtQkFKQUpQQVkuNjg3OTcyICAwMDAwMTE0NTAwMzIwOTMwICAyMi8xMS8yNSAgICAgICAgICAgICAyMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNywxODQuOTUgICANCiAgICAgICAgICA5LjFBTjY2MzQ0OTZASU5EVVMtSU5EQjBNRVJDSEEtMTE0NTAwMzIwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgOTMwLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjIvMTEvMjUgIFVQSS1CTElOS0lULUJMSU5LSVRKS0IuUlpQQE1BSVJURUwtQUlSUDAgIDAwMDA1MzI2ODExNDA2NTAgIDIyLzExLzI1ICAgICAgICAgICAgIDI2MS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI2LDkyMy45NSAgIA0KICAgICAgICAgIDAwMDAwMS01MzI2ODExNDA2NTAtUEFZVklBUkFaT1JQQVkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjIyLzExLzI1ICBOV0QtNTEyOTY3WFhYWFhYODAyNC04OTA5QktBMS1HSEFaSUFCQUQgICAwMDAwNTMyNjIxMDAxNDEzICAyMi8xMS8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNiw5MjMuOTUgICANCjIzLzExLzI1ICBVUEktS1VOV0FSIFBBTCBTSU5HSC1RNTgzMTI1MDQ4QFlCTC1LS0JLICAwMDAwMTE0NTY3OTU2OTk0ICAyMy8xMS8yNSAgICAgICAgICAgICA3MjIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNiwyMDEuOTUgICANCiAgICAgICAgICAwMDA0NjE4LTExNDU2Nzk1Njk5NC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyMy8xMS8yNSAgVVBJLUlOVEFKQVItUTI4Mzg4OTA4NUBZQkwtWUVTQjBZQkxVUEktMSAgMDAwMDExNDU3MDY1NjM5MyAgMjMvMTEvMjUgICAgICAgICAgICAgMTAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTYsMTAxLjk1ICAgDQogICAgICAgICAgMTQ1NzA2NTYzOTMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjMvMTEvMjUgIFVQSS1OQU5EIExBTCBHVVJKQVItUEFZVE1RUjZUS01EQUBQVFlTLVkgIDAwMDAxMTQ1OTIxNTE5MTUgIDIzLzExLzI1ICAgICAgICAgICAgICA0MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE2LDA2MS45NSAgIA0KICAgICAgICAgIEVTQjBQVE1VUEktMTE0NTkyMTUxOTE1LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMzQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjIzLzExLzI1ICBVUEktTVIgUkFLRVNIIEtVTUFSIFNIQVItUEFZVE1RUjZITTlINUBQICAwMDAwMTE0NTkyNTQ2NDc5ICAyMy8xMS8yNSAgICAgICAgICAgICAxODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxNSw4ODEuOTUgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTE0NTkyNTQ2NDc5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyNS8xMS8yNSAgUDpLMTY2NzUgSERGQyBCQU5LIFNBTEFSWSBGT1IgTk9WIDIwMjUgICAgMDAwMDAwMDAwMDAwMDAwICAgMjUvMTEvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjY2LDU1My4wMCAgICAgICAgICAyODIsNDM0Ljk1ICAgDQoyNy8xMS8yNSAgQUNIIEQtIFRQIEFDSCBNQVhMSUZFSU5TVVJBLTIwMTU0MjczMzggICAgMDAwMDAwMzUxODM2ODUwMCAgMjcvMTEvMjUgICAgICAgICAgIDEsMjU2LjgyICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyODEsMTc4LjEzICAgDQoyOC8xMS8yNSAgVVBJLUdPT0dMRSBQTEFZLVBMQVlTVE9SRUBBWElTQkFOSy1VVElCMCAgMDAwMDY2NzU3NTI4MzMyNSAgMjgvMTEvMjUgICAgICAgICAgICAgMTk5LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyODAsOTc5LjEzICAgDQogICAgICAgICAgMDAwNTUzLTY2NzU3NTI4MzMyNS1NQU5EQVRFRVhFQ1VURSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjgvMTEvMjUgIFVQSS1OSVNIQU5ULU5QNzU3Njk4OEBPS0lDSUNJLUlPQkEwMDAwOTMgIDAwMDA1MzMyMzU4ODgzODQgIDI4LzExLzI1ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgMjkwLDk3OS4xMyAgIA0KICAgICAgICAgIDItNTMzMjM1ODg4Mzg0LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI4LzExLzI1ICBNRSBEQyBTSSA1MTI5NjdYWFhYWFg4MDI0IENVUlNPUiwgQUkgUE9XICAwMDAwMDAwMDAwMTk0MTIyICAyOC8xMS8yNSAgICAgICAgICAgMSw3ODguMzIgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI4OSwxOTAuODEgICANCiAgICAgICAgICBFUkVEIElERSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOC8xMS8yNSAgVVBJLUJMSU5LSVQtQkxJTktJVC5QQVlVQEhERkNCQU5LLUhERkMwTSAgMDAwMDUzMzI2MjQ3NTY1MiAgMjgvMTEvMjUgICAgICAgICAgICAgIDc2LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyODksMTE0LjgxICAgDQogICAgICAgICAgRVJVUEktNTMzMjYyNDc1NjUyLVVQSUlOVEVOVCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjkvMTEvMjUgIFVQSS1BQiBFTlRFUlBSSVNFUy03NTAzMzQ0MzQ0QE9LQklaQVhJUy0gIDAwMDAxMTQ4NjAzMzgwNDIgIDI5LzExLzI1ICAgICAgICAgICAxLDU1MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjg3LDU2NC44MSAgIA0KICAgICAgICAgIFVUSUIwMDAwNTUzLTExNDg2MDMzODA0Mi1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjI5LzExLzI1ICBVUEktREFVSkkgU0VSVklDRSBTVEFUSU8tUEFZVE1RUjZEU1VNVEBQICAwMDAwMTE0ODcyMTYwODczICAyOS8xMS8yNSAgICAgICAgICAgMSwyMTIuNjAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI4NiwzNTIuMjEgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTE0ODcyMTYwODczLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoyOS8xMS8yNSAgVVBJLUNIRVRBTi1RMzk1NzU5NDAzQFlCTC1ZRVNCMFlCTFVQSS0xMSAgMDAwMDExNDg3ODg1MzczNyAgMjkvMTEvMjUgICAgICAgICAgICAgIDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyODYsMzMyLjIxICAgDQogICAgICAgICAgNDg3ODg1MzczNy1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMjkvMTEvMjUgIFVQSS1OQVJFU0ggS1VNQVItUEFZVE1RUjYyQ0hNV0BQVFlTLVlFU0IgIDAwMDAxMTQ4Nzk3NTA1MjggIDI5LzExLzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjg2LDMxMi4yMSAgIA0KICAgICAgICAgIDBQVE1VUEktMTE0ODc5NzUwNTI4LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjMwLzExLzI1ICBVUEktQU5LSVQgREFJUlkgQU5EIFNXRUUtVllBUEFSLjE3NDg2MzM4ICAwMDAwMTE0OTIzMzk4OTM4ICAzMC8xMS8yNSAgICAgICAgICAgICAzMDIuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI4NiwwMTAuMjEgICANCiAgICAgICAgICAzMTcwQEhERkNCQU5LLUhERkMwTUVSVVBJLTExNDkyMzM5ODkzOC1VICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQogICAgICAgICAgUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMzAvMTEvMjUgIFVQSS1LSVJUSS1QQVlUTVFSNlJXNkJPQFBUWVMtWUVTQjBQVE1VUEkgIDAwMDAxMTQ5MjU1NTMzOTcgIDMwLzExLzI1ICAgICAgICAgICAgIDUwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjg1LDUxMC4yMSAgIA0KICAgICAgICAgIC0xMTQ5MjU1NTMzOTctVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjMwLzExLzI1ICBVUEktQUdHQVJXQUwgVFJBRElORyBDT00tUEFZVE1RUjZENEtIQkBQICAwMDAwMTE0OTI1OTY2NDYyICAzMC8xMS8yNSAgICAgICAgICAgICAzMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI4NSwyMTAuMjEgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTE0OTI1OTY2NDYyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMS8xMi8yNSAgVVBJLUNSRUQgQ0xVQi1DUkVELkNMVUJAQVhJU0ItVVRJQjAwMDAxMSAgMDAwMDU3MDEyMTI3MDIwMCAgMDEvMTIvMjUgICAgICAgICAgMjYsNTEwLjU4ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNTgsNjk5LjYzICAgDQogICAgICAgICAgNC01NzAxMjEyNzAyMDAtUEFZTUVOVCBPTiBDUkVEICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDEvMTIvMjUgIFVQSS1HT09HTEUgSU5ESUEgRElHSVRBTC1QTEFZU1RPUkVAQVhJU0IgIDAwMDAxMTQ5ODA2ODE0ODQgIDAxLzEyLzI1ICAgICAgICAgICAgIDEwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjU4LDU5OS42MyAgIA0KICAgICAgICAgIEFOSy1VVElCMDAwMDU1My0xMTQ5ODA2ODE0ODQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjAxLzEyLzI1ICA1MDQwMDMzNDkxODcxMy0gUkQgSU5TVEFMTE1FTlQtREVDIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAwMS8xMi8yNSAgICAgICAgICAxMCwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI0OCw1OTkuNjMgICANCjAxLzEyLzI1ICA1MDQwMDMzNDkxODgzMS0gUkQgSU5TVEFMTE1FTlQtREVDIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAwMS8xMi8yNSAgICAgICAgICAgMywwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI0NSw1OTkuNjMgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMzUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjAyLzEyLzI1ICBVUEktTVMgQUdHQVJXQUwgU1dFRVQgIEMtUEFZVE1RUjZQNkgwSEBQICAwMDAwMTE1MDMwMzY0ODMyICAwMi8xMi8yNSAgICAgICAgICAgICAgMzAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI0NSw1NjkuNjMgICANCiAgICAgICAgICBUWVMtWUVTQjBQVE1VUEktMTE1MDMwMzY0ODMyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowMi8xMi8yNSAgVVBJLVBSQUJIQVQgU0hBUk1BLVBBWVRNUVI2R1NWMENAUFRZUy1ZRSAgMDAwMDExNTAzMDg4NzY2MSAgMDIvMTIvMjUgICAgICAgICAgICAgIDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNDUsNTE5LjYzICAgDQogICAgICAgICAgU0IwUFRNVVBJLTExNTAzMDg4NzY2MS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDIvMTIvMjUgIFVQSS1BR0dBUldBTCBUUkFESU5HIENPTS1QQVlUTVFSNkQ0S0hCQFAgIDAwMDAxMTUwMzE0MDM1MzggIDAyLzEyLzI1ICAgICAgICAgICAgIDM5MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjQ1LDEyOS42MyAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTUwMzE0MDM1MzgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzEyLzI1ICBVUEktUVVJQ0sgVFYtUEFZVE0tODM4NTU5MTdAUFRZQkwtWUVTQjBQICAwMDAwNTcwNTA3NDk3MDYzICAwNS8xMi8yNSAgICAgICAgICAgICAgIDEuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI0NSwxMjguNjMgICANCiAgICAgICAgICBUTVVQSS01NzA1MDc0OTcwNjMtVVBJIE1BTkRBVCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNS8xMi8yNSAgVVBJLVFVSUNLIFRWLVBBWVRNLTgzODU1OTE3QFBUWUJMLVlFU0IwUCAgMDAwMDU3MDUwNzUyMDExMSAgMDUvMTIvMjUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMS4wMCAgICAgICAgICAyNDUsMTI5LjYzICAgDQogICAgICAgICAgVE1VUEktNTcwNTA3NTIwMTExLUVYUFJFU1MgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDUvMTIvMjUgIFVQSS1BTUlUIFNPSEFOIExBTCBERUVXQS1QQVlUTVFSNlNRWkhNQFAgIDAwMDAxMTUxODM0OTk3NjMgIDA1LzEyLzI1ICAgICAgICAgICAyLDA3MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjQzLDA1OS42MyAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTUxODM0OTk3NjMtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA1LzEyLzI1ICBVUEktU0FVUkFCSCBCSEFSVEktUEFZVE0uUzFSSkFNREBQVFktWUVTICAwMDAwMTE1MTg3MjQ5ODQ5ICAwNS8xMi8yNSAgICAgICAgICAgICAgODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDI0Miw5NzkuNjMgICANCiAgICAgICAgICBCME1DSFVQSS0xMTUxODcyNDk4NDktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNi8xMi8yNSAgVVBJLUFHR0FSV0FMIFRSQURJTkcgQ09NLVBBWVRNUVI2RDRLSEJAUCAgMDAwMDExNTIzMDQxNjE0OSAgMDYvMTIvMjUgICAgICAgICAgICAgMjEzLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyNDIsNzY2LjYzICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTExNTIzMDQxNjE0OS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDcvMTIvMjUgIFVQSS1ISVRLQVJJIFNUT1JFLUdQQVktMTEyNTY0MzkzNjJAT0tCSVogIDAwMDAxMTUyNjI0NTg1MzAgIDA3LzEyLzI1ICAgICAgICAgICA3LDQ4Mi4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjM1LDI4NC42MyAgIA0KICAgICAgICAgIEFYSVMtVVRJQjAwMDA1NTMtMTE1MjYyNDU4NTMwLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA3LzEyLzI1ICBVUEktVFVMU0kgSU5URVJJT1JTLVRVTFNJSU5URVJJT1JTMDA3NEBBICAwMDAwMTE1MjY2MDA1MDgxICAwNy8xMi8yNSAgICAgICAgICAgICA1MDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIzNCw3ODQuNjMgICANCiAgICAgICAgICBVQkFOSy1BVUJMMDAwMjAxMS0xMTUyNjYwMDUwODEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowNy8xMi8yNSAgVVBJLUFTSE9LIEtVTUFSIEtIRVJBLVBBWVRNUVI2SEdMWktAUFRZUyAgMDAwMDExNTI2NzA3MDMzMSAgMDcvMTIvMjUgICAgICAgICAgICAgIDEwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMzQsNzc0LjYzICAgDQogICAgICAgICAgLVlFU0IwUFRNVVBJLTExNTI2NzA3MDMzMS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDcvMTIvMjUgIFVQSS1CSE9MQSBOQVRIIFBBTlNBUkktUEFZVE1RUkdUUEhKNTlHVkwgIDAwMDAxMTUyNjc0OTg0MTYgIDA3LzEyLzI1ICAgICAgICAgICAgIDMyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjM0LDQ1NC42MyAgIA0KICAgICAgICAgIEBQQVlUTS1ZRVNCMFBUTVVQSS0xMTUyNjc0OTg0MTYtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA3LzEyLzI1ICBVUEktQkhPTEEgTkFUSCBQQU5TQVJJLVBBWVRNUVJHVFBISjU5R1ZMICAwMDAwMTE1MjY3NTYyODc3ICAwNy8xMi8yNSAgICAgICAgICAgICAgNzAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIzNCwzODQuNjMgICANCiAgICAgICAgICBAUEFZVE0tWUVTQjBQVE1VUEktMTE1MjY3NTYyODc3LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOC8xMi8yNSAgVVBJLVZJSkFZIEJIQURIVVIgUkFKQUstUTcwOTg0NzkwMEBZQkwtWSAgMDAwMDExNTMzMzQyNTE2OCAgMDgvMTIvMjUgICAgICAgICAgICAgNDUwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMzMsOTM0LjYzICAgDQogICAgICAgICAgRVNCMFlCTFVQSS0xMTUzMzM0MjUxNjgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDgvMTIvMjUgIFVQSS1EQVVKSSBTRVJWSUNFIFNUQVRJTy1QQVlUTVFSNkRUNk5DQFAgIDAwMDAxMTUzMzQzNDU2MDQgIDA4LzEyLzI1ICAgICAgICAgICAgIDc3MS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMjMzLDE2My42MyAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTUzMzQzNDU2MDQtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjA4LzEyLzI1ICBVUEktUE9PUkFOIFNJTkdILTk5NTMxNTczOTRAUFRBWElTLUlQT1MwICAwMDAwNTcwODIxODU0NDA5ICAwOC8xMi8yNSAgICAgICAgICAgICAxMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDIzMywwNjMuNjMgICANCiAgICAgICAgICAwMDAwMDEtNTcwODIxODU0NDA5LVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOC8xMi8yNSAgVVBJLVBSQVNIQU5UICBLQVVTSElLLVE3OTA0OTIyOTVAWUJMLVlFUyAgMDAwMDExNTMzNjAwNjk4MiAgMDgvMTIvMjUgICAgICAgICAgICAgMzAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAyMzIsNzYzLjYzICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgKipDb250aW51ZSoqIA0KDQoNCg0KSERGQyBCQU5LIEx0ZC4gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgUGFnZSBObyAuOiAgIDM2ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlbWVudCBvZiBhY2NvdW50cw0KDQoNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IEJyYW5jaCA6IFZJTUFOIE5BR0FSDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWRkcmVzcyAgICAgICAgOiBTSE9QIE5PLjIgJiAzLEZMT1JFTkNFIEJVSUxESU5HLA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgVklNQU4gTkFHQVIsDQpNUi4gICAgIEtBTFBJVCBLVU1BUiBTSEFSTUEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRkYgUFVORSBOQUdBUiBISUdIV0FZLA0KSE5PIDgzLzY2IEMgQkxPQ0sgR09WSU5EUFVSQU0gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIENpdHkgICAgICAgICAgIDogUFVORTQxMTAxNA0KODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSBHSEFaSUFCQUQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFN0YXRlICAgICAgICAgIDogTUFIQVJBU0hUUkENCkdIQVpJQUJBRCAyMDEwMTMgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQaG9uZSBuby4gICAgICA6IDE4MDAyNjAwLzE4MDAxNjAwDQpVVFRBUiBQUkFERVNIICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRW1haWwgICAgICAgICAgOiBLQUxQSVQuQ09PTDIwMDZAR01BSUwuQ09NDQpHT1ZJTkRQVVJBTSBORUFSIE9WRVJIRUFEIFdBVEVSVEFOSyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgT0QgTGltaXQgICAgICAgOiAwICBDdXJyZW5jeSA6IElOUg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEN1c3QgSUQgICAgICAgIDogNDM3Mzc3NDgNCkpPSU5UIEhPTERFUlMgOiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IE5vICAgICA6IDA4ODIxMTMwMDAxNzI1ICAgUFJJTUUgUE9URU5USUFMDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQS9DIE9wZW4gRGF0ZSAgOiAyMS8wMy8yMDE0DQpOb21pbmF0aW9uIDogUmVnaXN0ZXJlZCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBTdGF0dXMgOiBSZWd1bGFyICANClN0YXRlbWVudCBGcm9tICAgICAgOiAwMS8wNC8yMDI1ICBUbzogMTcvMTIvMjAyNSAgICAgICAgICAgICAgICAgICAgICAgICBSVEdTL05FRlQgSUZTQyA6IEhERkMwMDAwODgyICAgIE1JQ1IgOiA0MTEyNDAwMjIgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBCcmFuY2ggQ29kZSAgICA6IDg4MiAgICAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgVHlwZSAgIDogU0FWSU5HUyBBL0MgLSBFTVBMT1lFRVMgKDEwMikNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KRGF0ZSAgICAgIE5hcnJhdGlvbgkJCQkgICAgQ2hxLi9SZWYuTm8uICAgICAgVmFsdWUgRHQgIFdpdGhkcmF3YWwgQW10LiAgICAgICAgRGVwb3NpdCBBbXQuICAgICBDbG9zaW5nIEJhbGFuY2UNCi0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLQ0KDQogICAgICAgICAgQjBZQkxVUEktMTE1MzM2MDA2OTgyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDkvMTIvMjUgIE5FRlQgRFItSURGQjAwMjE0MTItS0FMUElUIEtVTUFSIFNIQVJNQS0gIEhERkNIMDA2NjQwNzYwMjEgIDA5LzEyLzI1ICAgICAgICAgIDUwLDAwMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTgyLDc2My42MyAgIA0KICAgICAgICAgIE5FVEJBTkssIE1VTS1IREZDSDAwNjY0MDc2MDIxLUhERkMzNENBQzIgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAyM0Q2RTQgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQowOS8xMi8yNSAgVVBJLU1TIEFHR0FSV0FMIFNXRUVUICBDLVBBWVRNUVI2UDZIMEhAUCAgMDAwMDExNTM4MzIyMzQzNCAgMDkvMTIvMjUgICAgICAgICAgICAgIDIwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxODIsNzQzLjYzICAgDQogICAgICAgICAgVFlTLVlFU0IwUFRNVVBJLTExNTM4MzIyMzQzNC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMDkvMTIvMjUgIFVQSS1EQVVKSSBTRVJWSUNFIFNUQVRJTy1QQVlUTVFSNkRTVU1UQFAgIDAwMDAxMTUzODQ1MzUwNDkgIDA5LzEyLzI1ICAgICAgICAgICAyLDAyMS4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTgwLDcyMi42MyAgIA0KICAgICAgICAgIFRZUy1ZRVNCMFBUTVVQSS0xMTUzODQ1MzUwNDktVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjEwLzEyLzI1ICBBQ0ggQy0gQ09DSElOIFNISVBZQVJELTI0NTQwNiAgICAgICAgICAgICAwMDAwMDAyNTg2OTg2ODkyICAxMC8xMi8yNSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA4LjAwICAgICAgICAgIDE4MCw3MzAuNjMgICANCjEwLzEyLzI1ICA1MDQwMDMxODE4Njc4MC0gUkQgSU5TVEFMTE1FTlQtREVDIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAxMC8xMi8yNSAgICAgICAgICAgNSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDE3NSw3MzAuNjMgICANCjEwLzEyLzI1ICA1MDQwMDMxODE4NzAyNy0gUkQgSU5TVEFMTE1FTlQtREVDIDIwMjUgICAwMDAwMDAwMDAwMDAwMDAgICAxMC8xMi8yNSAgICAgICAgICA0NSwwMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEzMCw3MzAuNjMgICANCjExLzEyLzI1ICBVUEktQ0hPS0hFTEFMIEJIQVJUSS1QQVlUTVFSNlJXUFpDQFBUWVMtICAwMDAwMTE1NDkzNjExOTY4ICAxMS8xMi8yNSAgICAgICAgICAgMSwxMDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEyOSw2MzAuNjMgICANCiAgICAgICAgICBZRVNCMFBUTVVQSS0xMTU0OTM2MTE5NjgtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMi8xMi8yNSAgVVBJLUtBTFBJVCBLVU1BUiBTSEFSTUEtS0FMUElULkNPT0wyMDA2QCAgMDAwMDExNTQ5NjUzNDU5MCAgMTIvMTIvMjUgICAgICAgICAgMjAsMDAwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDksNjMwLjYzICAgDQogICAgICAgICAgT0tJQ0lDSS1JQ0lDMDAwMzQ1OC0xMTU0OTY1MzQ1OTAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTMvMTIvMjUgIFVQSS1BSVJURUwtQUlSVEVMLUJJTExQQVlNRU5ULlBBWVRNQFBUWUIgIDAwMDAxMTU1ODY1NjY0MzMgIDEzLzEyLzI1ICAgICAgICAgICAxLDcyOS42NCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA3LDkwMC45OSAgIA0KICAgICAgICAgIEwtWUVTQjBQVE1VUEktMTE1NTg2NTY2NDMzLUFJUlRFTFBPU1RQQUkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICBEQklMTCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxMy8xMi8yNSAgVVBJLVZFRVIgU0lOR0gtUTQ3MDU4Mjk1MEBZQkwtWUVTQjBZQkxVUCAgMDAwMDExNTU5MTAyMTU3MCAgMTMvMTIvMjUgICAgICAgICAgICAgMzEwLjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDcsNTkwLjk5ICAgDQogICAgICAgICAgSS0xMTU1OTEwMjE1NzAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTQvMTIvMjUgIFVQSS1WSUpBWVBBTCBTSU5HSC1ROTczMDc5MDk5QFlCTC1ZRVNCMFkgIDAwMDAxMTU2MTUxNDU0NjkgIDE0LzEyLzI1ICAgICAgICAgICAgICA3MC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA3LDUyMC45OSAgIA0KICAgICAgICAgIEJMVVBJLTExNTYxNTE0NTQ2OS1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE0LzEyLzI1ICBVUEktU0FOSkFZS1VNQVItR1BBWS0xMTI2MzY1NjUzNEBPS0JJWkFYICAwMDAwMTE1NjM2MDQzMzUxICAxNC8xMi8yNSAgICAgICAgICAgICAxODAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwNywzNDAuOTkgICANCiAgICAgICAgICBJUy1VVElCMDAwMDU1My0xMTU2MzYwNDMzNTEtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNS8xMi8yNSAgLkRDIElOVEwgUE9TIFRYTiBNQVJLVVArU1QgMjgxMTI1IDI4MTEyNSAgTUlSMjYzNDQyMzg1MzAxNSAgMTUvMTIvMjUgICAgICAgICAgICAgIDczLjg2ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAxMDcsMjY3LjEzICAgDQogICAgICAgICAgLU1JUjI2MzQ0MjM4NTMwMTUgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KMTYvMTIvMjUgIFVQSS1TSU5HSEFMIFNXRUVUUy1QQVlUTVFSNlVFM1VMQFBUWVMtWUUgIDAwMDAxMTU3Mzk2MTczNzAgIDE2LzEyLzI1ICAgICAgICAgICAgICAyMC4wMCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgMTA3LDI0Ny4xMyAgIA0KICAgICAgICAgIFNCMFBUTVVQSS0xMTU3Mzk2MTczNzAtVVBJICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCjE3LzEyLzI1ICBVUEktQUJISVNIRUsgR1JPVkVSLUFCSElTSEVLLkdST1ZFUjEyLTJAICAwMDAwNTcxNzgwNTgxNDkwICAxNy8xMi8yNSAgICAgICAgICAgICAgIDEuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDEwNywyNDYuMTMgICANCiAgICAgICAgICBPS0hERkNCQU5LLUhERkMwMDAwNzk0LTU3MTc4MDU4MTQ5MC1VUEkgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoxNy8xMi8yNSAgVVBJLVVUVEFSIFBSQURFU0ggUE9XRVIgLUJJTExERVNLUEcuVVBQQyAgMDAwMDEwMjI0MDk2NjcwMCAgMTcvMTIvMjUgICAgICAgICAgIDcsMzY0LjAwICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgOTksODgyLjEzICAgDQogICAgICAgICAgTEBIREZDQkFOSy1IREZDME1FUlVQSS0xMDIyNDA5NjY3MDAtVVBQQyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIA0KICAgICAgICAgIExSICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAqKkNvbnRpbnVlKiogDQoNCg0KDQpIREZDIEJBTksgTHRkLiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBQYWdlIE5vIC46ICAgMzcgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGVtZW50IG9mIGFjY291bnRzDQoNCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgQnJhbmNoIDogVklNQU4gTkFHQVINCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBZGRyZXNzICAgICAgICA6IFNIT1AgTk8uMiAmIDMsRkxPUkVOQ0UgQlVJTERJTkcsDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBWSU1BTiBOQUdBUiwNCk1SLiAgICAgS0FMUElUIEtVTUFSIFNIQVJNQSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIE9GRiBQVU5FIE5BR0FSIEhJR0hXQVksDQpITk8gODMvNjYgQyBCTE9DSyBHT1ZJTkRQVVJBTSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ2l0eSAgICAgICAgICAgOiBQVU5FNDExMDE0DQo4My82NiBDIEJMT0NLIEdPVklORFBVUkFNIEdIQVpJQUJBRCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgU3RhdGUgICAgICAgICAgOiBNQUhBUkFTSFRSQQ0KR0hBWklBQkFEIDIwMTAxMyAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIFBob25lIG5vLiAgICAgIDogMTgwMDI2MDAvMTgwMDE2MDANClVUVEFSIFBSQURFU0ggICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBFbWFpbCAgICAgICAgICA6IEtBTFBJVC5DT09MMjAwNkBHTUFJTC5DT00NCkdPVklORFBVUkFNIE5FQVIgT1ZFUkhFQUQgV0FURVJUQU5LICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBPRCBMaW1pdCAgICAgICA6IDAgIEN1cnJlbmN5IDogSU5SDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQ3VzdCBJRCAgICAgICAgOiA0MzczNzc0OA0KSk9JTlQgSE9MREVSUyA6ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEFjY291bnQgTm8gICAgIDogMDg4MjExMzAwMDE3MjUgICBQUklNRSBQT1RFTlRJQUwNCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBL0MgT3BlbiBEYXRlICA6IDIxLzAzLzIwMTQNCk5vbWluYXRpb24gOiBSZWdpc3RlcmVkICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBBY2NvdW50IFN0YXR1cyA6IFJlZ3VsYXIgIA0KU3RhdGVtZW50IEZyb20gICAgICA6IDAxLzA0LzIwMjUgIFRvOiAxNy8xMi8yMDI1ICAgICAgICAgICAgICAgICAgICAgICAgIFJUR1MvTkVGVCBJRlNDIDogSERGQzAwMDA4ODIgICAgTUlDUiA6IDQxMTI0MDAyMiAgIA0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIEJyYW5jaCBDb2RlICAgIDogODgyICAgICAgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgQWNjb3VudCBUeXBlICAgOiBTQVZJTkdTIEEvQyAtIEVNUExPWUVFUyAoMTAyKQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQpEYXRlICAgICAgTmFycmF0aW9uCQkJCSAgICBDaHEuL1JlZi5Oby4gICAgICBWYWx1ZSBEdCAgV2l0aGRyYXdhbCBBbXQuICAgICAgICBEZXBvc2l0IEFtdC4gICAgIENsb3NpbmcgQmFsYW5jZQ0KLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tICAtLS0tLS0tLS0tLS0tLS0tLS0gIC0tLS0tLS0tLS0tLS0tLS0tLSAgLS0tLS0tLS0tLS0tLS0tLS0tDQoNCjE3LzEyLzI1ICBVUEktVEFSVU4gS1VNQVItUEFZVE1RUjZXTElaUUBQVFlTLVlFU0IwICAwMDAwMTE1Nzk1NTkyMDQyICAxNy8xMi8yNSAgICAgICAgICAgICAgNDAuMDAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA5OSw4NDIuMTMgICANCiAgICAgICAgICBQVE1VUEktMTE1Nzk1NTkyMDQyLVVQSSAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgDQoNCg0KKioqKioqKiogICoqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKiogICoqKioqKioqKioqKioqKiogICoqKioqKioqICAqKioqKioqKioqKioqKioqKiogICoqKioqKioqKioqKioqKioqKiAgKioqKioqKioqKioqKioqKioqDQoqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioqKioNCg0KICAgICAgICAgU1RBVEVNRU5UIFNVTU1BUlkgIDotIA0KICAgICAgICAgICBPcGVuaW5nIEJhbGFuY2UgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICBEZWJpdHMgICAgICAgICAgICAgIENyZWRpdHMgICAgICAgICAgQ2xvc2luZyBCYWwgDQogICAgICAgICAgICAgICAgIDQwLDkzOS44NCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDQsMjAwLDc1MC4yMSAgICAgICAgIDQsMjU5LDY1Mi41MCAgICAgICAgICAgIDk5LDg0Mi4xMyANCg0KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgRHIgQ291bnQgICAgICAgICAgICAgQ3IgQ291bnQgDQogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDUzMiAgICAgICAgICAgICAgICAgICA1NyANCg0KDQogICAgICAgICAgICAgR2VuZXJhdGVkIE9uOiAxOC1ERUMtMjAyNSAxMjozNTozMSAgICAgICAgICAgICAgICAgIEdlbmVyYXRlZCBCeTogIDQzNzM3NzQ4ICAgICAgICAgICAgIFJlcXVlc3RpbmcgQnJhbmNoIENvZGU6IE5FVCANCg0KDQpUaGlzIGlzIGEgY29tcHV0ZXIgZ2VuZXJhdGVkIHN0YXRlbWVudCBhbmQgZG9lcyBub3QgcmVxdWlyZSBzaWduYXR1cmUNCg0KSERGQyBCQU5LIExJTUlURUQuDQoNCipDbG9zaW5nIGJhbGFuY2UgaW5jbHVkZXMgZnVuZHMgZWFybWFya2VkIGZvciBob2xkIGFuZCB1bmNsZWFyZWQgZnVuZHMNCg0KQ29udGVudHMgb2YgdGhpcyBzdGF0ZW1lbnQgd2lsbCBiZSBjb25zaWRlcmVkIGNvcnJlY3QgaWYgbm8gZXJyb3IgaXMgcmVwb3J0ZWQgd2l0aGluIDMwIGRheXMgb2YgcmVjZWlwdCBvZiBzdGF0ZW1lbnQuVGhlIGFkZHJlc3Mgb24gdGhpcyBzdGF0ZW1lbnQgaXMgdGhhdCBvbiByZWNvcmQgd2l0aCB0aGUgQmFuayBhcyBhdCB0aGUgZGF5IG9mIHJlcXVlc3RpbmcgdGhpcyBzdGF0ZW1lbnQNCg0KDQpTdGF0ZSBhY2NvdW50IGJyYW5jaCBHU1ROOjI3QUFBQ0gyNzAySDFaMA0KIEhERkMgQmFuayBHU1RJTiBudW1iZXIgZGV0YWlscyBhcmUgYXZhaWxhYmxlIGF0IGh0dHBzOi8vd3d3LmhkZmNiYW5rLmNvbS9wZXJzb25hbC9tYWtpbmctcGF5bWVudHMvb25saW5lLXRheC1wYXltZW50L2dvb2RzLWFuZC1zZXJ2aWNlLXRheA0KICAgICAgICAgICBSZWdpc3RlcmVkIE9mZmljZSBBZGRyZXNzOiBIREZDIEJhbmsgSG91c2UsIFNlbmFwYXRpIEJhcGF0IE1hcmcsIExvd2VyIFBhcmVsLCBNdW1iYWkgNDAwMDEzCSANCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgLS0tICBFbmQgT2YgU3RhdGVtZW50IC0tLSAgDQoNCg==`
 
-func main() {
-	// Register the handlers
-	http.HandleFunc("/classify", classifyHandler)
-	http.HandleFunc("/api/chat", chatHandler)
-	http.HandleFunc("/api/health", healthHandler)
-
-	// Start the server
-	log.Println("Server starting on :8080")
-	log.Println("POST endpoint available at: http://localhost:8080/classify")
-	log.Println("POST endpoint available at: http://localhost:8080/api/chat")
-	log.Println("GET endpoint available at: http://localhost:8080/api/health")
-	if err := http.ListenAndServe(":8080", nil); err != nil {
-		log.Fatal("Server failed to start:", err)
-	}
-}
-
-func enableCORS(w http.ResponseWriter, r *http.Request) {
-	origin := r.Header.Get("Origin")
-	// Allow requests from localhost (development)
-	if origin == "http://localhost:5173" || origin == "http://127.0.0.1:5173" {
-		w.Header().Set("Access-Control-Allow-Origin", origin)
-	} else {
-		// For production, you might want to restrict this to specific origins
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-	}
-	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-	w.Header().Set("Access-Control-Max-Age", "3600")
-}
-
-// classifyHandler handles POST requests to /classify
-func classifyHandler(w http.ResponseWriter, r *http.Request) {
-	// Enable CORS
-	enableCORS(w, r)
-
-	// Handle preflight OPTIONS request
-	if r.Method == http.MethodOptions {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
-
-	// Only allow POST method
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	// Set content type to JSON
-	w.Header().Set("Content-Type", "application/json")
-
-	// Read the actual statement file and encode it
-	statementBytes, err := os.ReadFile("Acct_Statement_XXXXXXXX1725_17122025.txt")
-	if err != nil {
-		log.Fatal("Failed to read statement file:", err)
-	}
-	actualBase64String := base64.StdEncoding.EncodeToString(statementBytes)
-
-	statement, err := ReadAccountStatementFromBase64(actualBase64String)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// Access extracted data
-	fmt.Printf("Account Number: %s\n", statement.AccountInfo.AccountNo)
-	fmt.Printf("Account Holder: %s\n", statement.AccountInfo.AccountHolderName)
-	fmt.Printf("Total Transactions: %d\n", len(statement.Transactions))
-	fmt.Printf("Opening Balance: %.2f\n", statement.Summary.OpeningBalance)
-	fmt.Printf("Closing Balance: %.2f\n", statement.Summary.ClosingBalance)
-	fmt.Printf("Credits Balance: %.2f\n", statement.Summary.TotalCredits)
-	fmt.Printf("Debits Balance: %.2f\n", statement.Summary.TotalDebits)
-
-	// Step 2: Convert extracted transactions to classified transactions
-	classifiedTransactions := make([]models.ClassifiedTransaction, 0, len(statement.Transactions))
-	for _, txn := range statement.Transactions {
-		classifiedTxn := classifier.ConvertFromTxtTransaction(
-			txn.Date,
-			txn.Narration,
-			txn.ChequeRefNo,
-			txn.ValueDate,
-			txn.WithdrawalAmt,
-			txn.DepositAmt,
-			txn.ClosingBalance,
-		)
-		classifiedTransactions = append(classifiedTransactions, classifiedTxn)
-	}
-
-	// Step 3: Classify all transactions first (pass customerName for self-transfer detection)
-	classifiedTransactions = classifier.ClassifyTransactions(classifiedTransactions, statement.AccountInfo.AccountHolderName)
-
-	// Step 3.5: Create analyzer instance
-	analyzerInstance := analyzer.NewAnalyzer()
-	analyzerInstance.AddTransactions(classifiedTransactions)
-
-	// Step 3.6: Set statement totals for accurate income/expense calculation
-	// Use the official totals from the statement (more accurate than summing transactions)
-	analyzerInstance.SetStatementTotals(
-		statement.Summary.TotalCredits, // Total Credits = Total Income
-		statement.Summary.TotalDebits,  // Total Debits = Total Expense
-	)
-
-	// Step 4: Format statement period
-	statementPeriod := fmt.Sprintf("%s - %s",
-		statement.StatementPeriod.FromDate,
-		statement.StatementPeriod.ToDate,
-	)
-
-	// Step 5: Run analysis
-	response := analyzerInstance.Analyze(
-		statement.AccountInfo.AccountNo,
-		statement.AccountInfo.AccountHolderName,
-		statementPeriod,
-		statement.Summary.OpeningBalance,
-		statement.Summary.ClosingBalance,
-	)
-
-	// Step 6: Output results as JSON
-	jsonData, err := json.MarshalIndent(response, "", "  ")
-	if err != nil {
-		log.Fatal("Failed to marshal response:", err)
-	}
-
-	fmt.Println("=== Classification Analysis Complete ===")
-	fmt.Println(string(jsonData))
-
-	// Step 7: Access specific data
-	fmt.Println("\n=== Summary ===")
-	fmt.Printf("Total Transactions: %d\n", len(classifiedTransactions))
-	fmt.Printf("Account: %s\n", response.AccountSummary.AccountNumberMasked)
-	fmt.Printf("Customer: %s\n", response.AccountSummary.CustomerName)
-	fmt.Printf("Opening Balance: %.2f\n", response.AccountSummary.OpeningBalance)
-	fmt.Printf("Closing Balance: %.2f\n", response.AccountSummary.ClosingBalance)
-	fmt.Printf("Total Income: %.2f\n", response.AccountSummary.TotalIncome)
-	fmt.Printf("Total Expense: %.2f\n", response.AccountSummary.TotalExpense)
-	fmt.Printf("Net Savings: %.2f\n", response.AccountSummary.NetSavings)
-	fmt.Printf("Savings Rate: %.2f%%\n", response.AccountSummary.SavingsRatePercent)
-
-	fmt.Println("\n=== Transaction Breakdown ===")
-	fmt.Printf("UPI: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.UPI.Amount,
-		response.TransactionBreakdown.UPI.Count)
-	fmt.Printf("IMPS: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.IMPS.Amount,
-		response.TransactionBreakdown.IMPS.Count)
-	fmt.Printf("NEFT: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.NEFT.Amount,
-		response.TransactionBreakdown.NEFT.Count)
-	fmt.Printf("RTGS: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.RTGS.Amount,
-		response.TransactionBreakdown.RTGS.Count)
-	fmt.Printf("EMI: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.EMI.Amount,
-		response.TransactionBreakdown.EMI.Count)
-	fmt.Printf("BillPaid: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.BillPaid.Amount,
-		response.TransactionBreakdown.BillPaid.Count)
-	fmt.Printf("DebitCard: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.DebitCard.Amount,
-		response.TransactionBreakdown.DebitCard.Count)
-	fmt.Printf("ATMWithdrawal: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.ATMWithdrawal.Amount,
-		response.TransactionBreakdown.ATMWithdrawal.Count)
-	fmt.Printf("NetBanking: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.NetBanking.Amount,
-		response.TransactionBreakdown.NetBanking.Count)
-	fmt.Printf("Salary: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.Salary.Amount,
-		response.TransactionBreakdown.Salary.Count)
-	fmt.Printf("RD: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.RD.Amount,
-		response.TransactionBreakdown.RD.Count)
-	fmt.Printf("FD: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.FD.Amount,
-		response.TransactionBreakdown.FD.Count)
-	fmt.Printf("SIP: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.SIP.Amount,
-		response.TransactionBreakdown.SIP.Count)
-	fmt.Printf("Interest: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.Interest.Amount,
-		response.TransactionBreakdown.Interest.Count)
-	fmt.Printf("Cheque: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.Cheque.Amount,
-		response.TransactionBreakdown.Cheque.Count)
-	fmt.Printf("Dividend: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.Dividend.Amount,
-		response.TransactionBreakdown.Dividend.Count)
-	fmt.Printf("Investment: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.Investment.Amount,
-		response.TransactionBreakdown.Investment.Count)
-	fmt.Printf("Other: %.2f (%d transactions)\n",
-		response.TransactionBreakdown.Other.Amount,
-		response.TransactionBreakdown.Other.Count)
-
-	// Verify total
-	totalBreakdown := response.TransactionBreakdown.UPI.Count +
-		response.TransactionBreakdown.IMPS.Count +
-		response.TransactionBreakdown.NEFT.Count +
-		response.TransactionBreakdown.RTGS.Count +
-		response.TransactionBreakdown.EMI.Count +
-		response.TransactionBreakdown.BillPaid.Count +
-		response.TransactionBreakdown.DebitCard.Count +
-		response.TransactionBreakdown.ATMWithdrawal.Count +
-		response.TransactionBreakdown.NetBanking.Count +
-		response.TransactionBreakdown.Salary.Count +
-		response.TransactionBreakdown.RD.Count +
-		response.TransactionBreakdown.FD.Count +
-		response.TransactionBreakdown.SIP.Count +
-		response.TransactionBreakdown.Interest.Count +
-		response.TransactionBreakdown.Cheque.Count +
-		response.TransactionBreakdown.Dividend.Count +
-		response.TransactionBreakdown.Investment.Count +
-		response.TransactionBreakdown.Other.Count
-	fmt.Printf("\nTotal in Breakdown: %d (should match Total Transactions: %d)\n",
-		totalBreakdown, len(classifiedTransactions))
-
-	// Print all "Other" transactions with their narrations
-	// Re-classify to ensure we have the latest classification (pass customerName for self-transfer detection)
-	classifiedTransactions = classifier.ClassifyTransactions(classifiedTransactions, statement.AccountInfo.AccountHolderName)
-
-	fmt.Println("\n=== Other Transactions (with Narrations) ===")
-	otherCount := 0
-	for _, txn := range classifiedTransactions {
-		// Skip transactions with zero amount
-		if txn.DepositAmt == 0 && txn.WithdrawalAmt == 0 {
-			continue
-		}
-
-		// Check if this transaction is classified as "Other"
-		// Only truly "Other" transactions (explicitly classified as "Other" or empty/unknown methods)
-		isOther := false
-		method := txn.Method
-		if method == "" {
-			method = "Other"
-			isOther = true
-		} else if method == "Other" {
-			isOther = true
-		} else {
-			isOther = false
-		}
-
-		if isOther {
-			otherCount++
-			amount := txn.DepositAmt
-			if txn.WithdrawalAmt > 0 {
-				amount = txn.WithdrawalAmt
-			}
-			if txn.DepositAmt > 0 && txn.WithdrawalAmt > 0 {
-				if txn.DepositAmt > txn.WithdrawalAmt {
-					amount = txn.DepositAmt
-				} else {
-					amount = txn.WithdrawalAmt
-				}
-			}
-
-			// Truncate narration if too long
-			narration := txn.Narration
-			if len(narration) > 120 {
-				narration = narration[:120] + "..."
-			}
-
-			// Clean up narration (remove extra whitespace)
-			narration = strings.TrimSpace(narration)
-			narration = strings.ReplaceAll(narration, "\n", " ")
-			narration = strings.ReplaceAll(narration, "\r", " ")
-
-			fmt.Printf("%d. Date: %s | Method: %s | Amount: %.2f\n",
-				otherCount, txn.Date, method, amount)
-			fmt.Printf("   Narration: %s\n", narration)
-			fmt.Println()
-		}
-	}
-	fmt.Printf("Total Other transactions printed: %d\n", otherCount)
-
-	fmt.Println("\n=== Top Expenses ===")
-	for i, expense := range response.TopExpenses {
-		if i >= 5 {
-			break
-		}
-		fmt.Printf("%d. %s - %.2f (%s)\n",
-			i+1, expense.Merchant, expense.Amount, expense.Category)
-	}
-
-	// Verify expense category classification
-	fmt.Println("\n=== Expense Category Verification ===")
-
-	// Group expenses by category
-	categoryStats := make(map[string]struct {
-		Count   int
-		Total   float64
-		Samples []models.ClassifiedTransaction
-	})
-
-	expenseCount := 0
-	for _, txn := range classifiedTransactions {
-		// Only count withdrawals (expenses)
-		if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 {
-			expenseCount++
-			category := txn.Category
-			if category == "" {
-				category = "Unclassified"
-			}
-
-			stats, exists := categoryStats[category]
-			if !exists {
-				stats = struct {
-					Count   int
-					Total   float64
-					Samples []models.ClassifiedTransaction
-				}{
-					Count:   0,
-					Total:   0.0,
-					Samples: make([]models.ClassifiedTransaction, 0, 3),
-				}
-			}
-
-			stats.Count++
-			stats.Total += txn.WithdrawalAmt
-
-			// Keep up to 3 sample transactions per category
-			if len(stats.Samples) < 3 {
-				stats.Samples = append(stats.Samples, txn)
-			}
-
-			categoryStats[category] = stats
-		}
-	}
-
-	// Print category statistics
-	fmt.Printf("Total Expense Transactions: %d\n\n", expenseCount)
-
-	// Sort categories by total amount (descending)
-	type catStat struct {
-		Category string
-		Count    int
-		Total    float64
-		Samples  []models.ClassifiedTransaction
-	}
-
-	sortedCategories := make([]catStat, 0, len(categoryStats))
-	for cat, stats := range categoryStats {
-		sortedCategories = append(sortedCategories, catStat{
-			Category: cat,
-			Count:    stats.Count,
-			Total:    stats.Total,
-			Samples:  stats.Samples,
-		})
-	}
-
-	// Simple sort by total amount (descending)
-	for i := 0; i < len(sortedCategories)-1; i++ {
-		for j := i + 1; j < len(sortedCategories); j++ {
-			if sortedCategories[i].Total < sortedCategories[j].Total {
-				sortedCategories[i], sortedCategories[j] = sortedCategories[j], sortedCategories[i]
-			}
-		}
-	}
-
-	// Print each category with samples
-	for _, catStat := range sortedCategories {
-		fmt.Printf("--- %s ---\n", catStat.Category)
-		fmt.Printf("  Count: %d transactions\n", catStat.Count)
-		fmt.Printf("  Total: %.2f\n", catStat.Total)
-		fmt.Printf("  Average: %.2f per transaction\n", catStat.Total/float64(catStat.Count))
-		fmt.Printf("  Sample Transactions:\n")
-
-		for i, sample := range catStat.Samples {
-			// Truncate narration if too long
-			narration := sample.Narration
-			if len(narration) > 80 {
-				narration = narration[:80] + "..."
-			}
-			// Clean up narration
-			narration = strings.TrimSpace(narration)
-			narration = strings.ReplaceAll(narration, "\n", " ")
-			narration = strings.ReplaceAll(narration, "\r", " ")
-
-			fmt.Printf("    %d. Date: %s | Amount: %.2f | Method: %s\n",
-				i+1, sample.Date, sample.WithdrawalAmt, sample.Method)
-			fmt.Printf("       Narration: %s\n", narration)
-			if sample.Merchant != "" {
-				fmt.Printf("       Merchant: %s\n", sample.Merchant)
-			}
-		}
-		fmt.Println()
-	}
-
-	// Write all expense transactions category-wise to a file
-	fmt.Println("\n=== Writing All Expense Transactions by Category to File ===")
-	expensesFile, err := os.Create("expenses_by_category.txt")
-	if err != nil {
-		fmt.Printf("Warning: Could not create expenses_by_category.txt: %v\n", err)
-	} else {
-		defer expensesFile.Close()
-
-		// Write header
-		fmt.Fprintf(expensesFile, "=== All Expense Transactions Grouped by Category ===\n")
-		fmt.Fprintf(expensesFile, "Total Expense Transactions: %d\n\n", expenseCount)
-		fmt.Fprintf(expensesFile, "================================================================================\n\n")
-
-		// Write each category with all transactions
-		for _, catStat := range sortedCategories {
-			fmt.Fprintf(expensesFile, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-			fmt.Fprintf(expensesFile, "CATEGORY: %s\n", catStat.Category)
-			fmt.Fprintf(expensesFile, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-			fmt.Fprintf(expensesFile, "Total Count: %d transactions\n", catStat.Count)
-			fmt.Fprintf(expensesFile, "Total Amount: %.2f\n", catStat.Total)
-			fmt.Fprintf(expensesFile, "Average Amount: %.2f per transaction\n\n", catStat.Total/float64(catStat.Count))
-
-			// Collect all transactions for this category
-			categoryTransactions := make([]models.ClassifiedTransaction, 0)
-			for _, txn := range classifiedTransactions {
-				if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 {
-					category := txn.Category
-					if category == "" {
-						category = "Unclassified"
-					}
-					if category == catStat.Category {
-						categoryTransactions = append(categoryTransactions, txn)
-					}
-				}
-			}
-
-			// Sort transactions by date (ascending)
-			for i := 0; i < len(categoryTransactions)-1; i++ {
-				for j := i + 1; j < len(categoryTransactions); j++ {
-					if categoryTransactions[i].Date > categoryTransactions[j].Date {
-						categoryTransactions[i], categoryTransactions[j] = categoryTransactions[j], categoryTransactions[i]
-					}
-				}
-			}
-
-			// Write all transactions for this category
-			fmt.Fprintf(expensesFile, "All Transactions:\n")
-			fmt.Fprintf(expensesFile, "--------------------------------------------------------------------------------\n")
-			for i, txn := range categoryTransactions {
-				fullNarration := strings.TrimSpace(txn.Narration)
-				fullNarration = strings.ReplaceAll(fullNarration, "\n", " ")
-				fullNarration = strings.ReplaceAll(fullNarration, "\r", " ")
-
-				fmt.Fprintf(expensesFile, "%d. Date: %s | Amount: %.2f | Method: %s\n",
-					i+1, txn.Date, txn.WithdrawalAmt, txn.Method)
-				fmt.Fprintf(expensesFile, "   Narration: %s\n", fullNarration)
-				if txn.Merchant != "" && txn.Merchant != "Unknown" {
-					fmt.Fprintf(expensesFile, "   Merchant: %s\n", txn.Merchant)
-				}
-				if txn.Beneficiary != "" && txn.Beneficiary != "Unknown" {
-					fmt.Fprintf(expensesFile, "   Beneficiary: %s\n", txn.Beneficiary)
-				}
-				if txn.ClassificationMetadata.Confidence > 0 {
-					fmt.Fprintf(expensesFile, "   Confidence: %.2f%%\n", txn.ClassificationMetadata.Confidence*100)
-				}
-				if len(txn.ClassificationMetadata.MatchedKeywords) > 0 {
-					fmt.Fprintf(expensesFile, "   Matched Keywords: %s\n", strings.Join(txn.ClassificationMetadata.MatchedKeywords, ", "))
-				}
-				fmt.Fprintf(expensesFile, "\n")
-			}
-			fmt.Fprintf(expensesFile, "\n")
-		}
-
-		fmt.Fprintf(expensesFile, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-		fmt.Fprintf(expensesFile, "End of Report\n")
-		fmt.Printf("✅ All expense transactions written to: expenses_by_category.txt\n")
-	}
-
-	// Check for potentially misclassified expenses
-	fmt.Println("=== Potential Classification Issues ===")
-
-	// Create a file to write classification issues
-	issuesFile, err := os.Create("classification_issues.txt")
-	if err != nil {
-		fmt.Printf("Warning: Could not create classification_issues.txt: %v\n", err)
-	} else {
-		defer issuesFile.Close()
-	}
-
-	// Helper function to write to both console and file
-	writeIssue := func(format string, args ...interface{}) {
-		msg := fmt.Sprintf(format, args...)
-		fmt.Print(msg)
-		if issuesFile != nil {
-			fmt.Fprint(issuesFile, msg)
-		}
-	}
-
-	issuesFound := false
-
-	// Check for expenses with "Other" category that might be misclassified
-	otherExpenses := 0
-	otherTotal := 0.0
-	otherTransactions := make([]models.ClassifiedTransaction, 0)
-	for _, txn := range classifiedTransactions {
-		if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 && txn.Category == "Other" {
-			otherExpenses++
-			otherTotal += txn.WithdrawalAmt
-			otherTransactions = append(otherTransactions, txn)
-		}
-	}
-
-	if otherExpenses > 0 {
-		issuesFound = true
-		writeIssue("⚠️  Found %d expenses classified as 'Other' (Total: %.2f)\n", otherExpenses, otherTotal)
-		writeIssue("   Sample 'Other' expense transactions:\n")
-
-		// Write all "Other" transactions to file, but only show 5 in console
-		count := 0
-		for _, txn := range otherTransactions {
-			narration := txn.Narration
-			if len(narration) > 100 {
-				narration = narration[:100] + "..."
-			}
-			narration = strings.TrimSpace(narration)
-			narration = strings.ReplaceAll(narration, "\n", " ")
-			narration = strings.ReplaceAll(narration, "\r", " ")
-
-			// Always write to file
-			if issuesFile != nil {
-				fmt.Fprintf(issuesFile, "   - Date: %s | Amount: %.2f | Method: %s\n",
-					txn.Date, txn.WithdrawalAmt, txn.Method)
-				fmt.Fprintf(issuesFile, "     Narration: %s\n", txn.Narration) // Full narration in file
-			}
-
-			// Only show first 5 in console
-			if count < 5 {
-				writeIssue("   - Date: %s | Amount: %.2f | Method: %s\n",
-					txn.Date, txn.WithdrawalAmt, txn.Method)
-				writeIssue("     Narration: %s\n", narration)
-			}
-			count++
-		}
-
-		// Write summary to file
-		if issuesFile != nil {
-			fmt.Fprintf(issuesFile, "\n=== Complete List of 'Other' Transactions ===\n")
-			fmt.Fprintf(issuesFile, "Total Count: %d\n", otherExpenses)
-			fmt.Fprintf(issuesFile, "Total Amount: %.2f\n\n", otherTotal)
-
-			for i, txn := range otherTransactions {
-				fullNarration := strings.TrimSpace(txn.Narration)
-				fullNarration = strings.ReplaceAll(fullNarration, "\n", " ")
-				fullNarration = strings.ReplaceAll(fullNarration, "\r", " ")
-
-				fmt.Fprintf(issuesFile, "%d. Date: %s | Amount: %.2f | Method: %s\n",
-					i+1, txn.Date, txn.WithdrawalAmt, txn.Method)
-				fmt.Fprintf(issuesFile, "   Narration: %s\n", fullNarration)
-				if txn.Merchant != "" {
-					fmt.Fprintf(issuesFile, "   Merchant: %s\n", txn.Merchant)
-				}
-				if txn.Beneficiary != "" {
-					fmt.Fprintf(issuesFile, "   Beneficiary: %s\n", txn.Beneficiary)
-				}
-				fmt.Fprintf(issuesFile, "\n")
-			}
-		}
-
-		writeIssue("\n")
-	}
-
-	// Check for expenses with empty category
-	emptyCategoryCount := 0
-	emptyCategoryTransactions := make([]models.ClassifiedTransaction, 0)
-	for _, txn := range classifiedTransactions {
-		if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 && txn.Category == "" {
-			emptyCategoryCount++
-			emptyCategoryTransactions = append(emptyCategoryTransactions, txn)
-		}
-	}
-
-	if emptyCategoryCount > 0 {
-		issuesFound = true
-		writeIssue("⚠️  Found %d expenses with empty category\n", emptyCategoryCount)
-
-		// Write all empty category transactions to file
-		if issuesFile != nil {
-			fmt.Fprintf(issuesFile, "\n=== Expenses with Empty Category ===\n")
-			fmt.Fprintf(issuesFile, "Total Count: %d\n\n", emptyCategoryCount)
-
-			for i, txn := range emptyCategoryTransactions {
-				fullNarration := strings.TrimSpace(txn.Narration)
-				fullNarration = strings.ReplaceAll(fullNarration, "\n", " ")
-				fullNarration = strings.ReplaceAll(fullNarration, "\r", " ")
-
-				fmt.Fprintf(issuesFile, "%d. Date: %s | Amount: %.2f | Method: %s\n",
-					i+1, txn.Date, txn.WithdrawalAmt, txn.Method)
-				fmt.Fprintf(issuesFile, "   Narration: %s\n", fullNarration)
-				if txn.Merchant != "" {
-					fmt.Fprintf(issuesFile, "   Merchant: %s\n", txn.Merchant)
-				}
-				fmt.Fprintf(issuesFile, "\n")
-			}
-		}
-
-		writeIssue("\n")
-	}
-
-	if !issuesFound {
-		writeIssue("✅ No obvious classification issues found!\n")
-	} else if issuesFile != nil {
-		fmt.Printf("📄 Full classification issues report written to: classification_issues.txt\n")
-	}
-
-	// Encode and send JSON response
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		http.Error(w, "Error encoding JSON", http.StatusInternalServerError)
-		return
-	}
-	// Option 2: If you have a file containing base64 string
-	// Read the base64 string from a file
-	// base64Bytes, err := os.ReadFile("statement_base64.txt")
-	// if err != nil {
-	// 	log.Fatal(err)
-	// }
+// Option 2: If you have a file containing base64 string
+// Read the base64 string from a file
+// base64Bytes, err := os.ReadFile("statement_base64.txt")
+// if err != nil {
+// 	log.Fatal(err)
+// }
 
-	// statement2, err := ReadAccountStatementFromBase64(string(base64Bytes))
-	// if err != nil {
-	// 	log.Fatal(err)
-	// }
+// statement2, err := ReadAccountStatementFromBase64(string(base64Bytes))
+// if err != nil {
+// 	log.Fatal(err)
+// }
 
-	// fmt.Printf("\nSecond statement - Account: %s\n", statement2.AccountInfo.AccountNo)
-}
+// fmt.Printf("\nSecond statement - Account: %s\n", statement2.AccountInfo.AccountNo)
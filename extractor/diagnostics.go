@@ -0,0 +1,79 @@
+package extractor
+
+import "math"
+
+// balanceTolerance absorbs rounding in amounts printed with two decimals
+const balanceTolerance = 0.01
+
+// ParseDiagnostics compares what was parsed with the statement's own summary
+// A clean parse has matching counts and totals and no balance breaks
+type ParseDiagnostics struct {
+	Transactions        int     `json:"transactions"`
+	ParsedDebitCount    int     `json:"parsedDebitCount"`
+	ParsedCreditCount   int     `json:"parsedCreditCount"`
+	SummaryDebitCount   int     `json:"summaryDebitCount"`
+	SummaryCreditCount  int     `json:"summaryCreditCount"`
+	ParsedDebits        float64 `json:"parsedDebits"`
+	ParsedCredits       float64 `json:"parsedCredits"`
+	DebitCountMismatch  bool    `json:"debitCountMismatch"`
+	CreditCountMismatch bool    `json:"creditCountMismatch"`
+	DebitTotalMismatch  bool    `json:"debitTotalMismatch"`
+	CreditTotalMismatch bool    `json:"creditTotalMismatch"`
+	BalanceBreaks       int     `json:"balanceBreaks"` // Rows whose closing balance does not follow from the previous row
+	MissingDates        int     `json:"missingDates"`
+	ZeroAmountRows      int     `json:"zeroAmountRows"`
+}
+
+// Diagnose computes parse diagnostics for an extracted statement
+func Diagnose(statement *TxtAccountStatement) ParseDiagnostics {
+	d := ParseDiagnostics{
+		Transactions:       len(statement.Transactions),
+		SummaryDebitCount:  statement.Summary.DebitCount,
+		SummaryCreditCount: statement.Summary.CreditCount,
+	}
+
+	previous := statement.Summary.OpeningBalance
+	for i, txn := range statement.Transactions {
+		if txn.WithdrawalAmt > 0 {
+			d.ParsedDebitCount++
+			d.ParsedDebits += txn.WithdrawalAmt
+		}
+		if txn.DepositAmt > 0 {
+			d.ParsedCreditCount++
+			d.ParsedCredits += txn.DepositAmt
+		}
+		if txn.WithdrawalAmt == 0 && txn.DepositAmt == 0 {
+			d.ZeroAmountRows++
+		}
+		if txn.Date == "" {
+			d.MissingDates++
+		}
+
+		// Skip the first row when the summary has no opening balance to chain from
+		if i > 0 || statement.Summary.OpeningBalance != 0 {
+			expected := previous - txn.WithdrawalAmt + txn.DepositAmt
+			if math.Abs(expected-txn.ClosingBalance) > balanceTolerance {
+				d.BalanceBreaks++
+			}
+		}
+		previous = txn.ClosingBalance
+	}
+
+	// Only compare against the summary when the statement printed one
+	if statement.Summary.DebitCount > 0 || statement.Summary.CreditCount > 0 {
+		d.DebitCountMismatch = d.ParsedDebitCount != statement.Summary.DebitCount
+		d.CreditCountMismatch = d.ParsedCreditCount != statement.Summary.CreditCount
+	}
+	if statement.Summary.TotalDebits > 0 || statement.Summary.TotalCredits > 0 {
+		d.DebitTotalMismatch = math.Abs(d.ParsedDebits-statement.Summary.TotalDebits) > balanceTolerance
+		d.CreditTotalMismatch = math.Abs(d.ParsedCredits-statement.Summary.TotalCredits) > balanceTolerance
+	}
+	return d
+}
+
+// Clean reports whether the parse is consistent with the statement summary
+func (d ParseDiagnostics) Clean() bool {
+	return !d.DebitCountMismatch && !d.CreditCountMismatch &&
+		!d.DebitTotalMismatch && !d.CreditTotalMismatch &&
+		d.BalanceBreaks == 0
+}
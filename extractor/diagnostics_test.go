@@ -0,0 +1,84 @@
+package extractor
+
+import "testing"
+
+// balancedStatement has two rows that chain from the opening balance and match the summary
+func balancedStatement() *TxtAccountStatement {
+	return &TxtAccountStatement{
+		Transactions: []TxtTransaction{
+			{Date: "01/12/25", WithdrawalAmt: 100, ClosingBalance: 900},
+			{Date: "02/12/25", DepositAmt: 50.5, ClosingBalance: 950.5},
+		},
+		Summary: StatementSummary{
+			OpeningBalance: 1000,
+			TotalDebits:    100,
+			TotalCredits:   50.5,
+			DebitCount:     1,
+			CreditCount:    1,
+		},
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(s *TxtAccountStatement)
+		expected ParseDiagnostics
+		clean    bool
+	}{
+		{
+			name:   "consistent statement",
+			modify: func(s *TxtAccountStatement) {},
+			expected: ParseDiagnostics{Transactions: 2, ParsedDebitCount: 1, ParsedCreditCount: 1, SummaryDebitCount: 1,
+				SummaryCreditCount: 1, ParsedDebits: 100, ParsedCredits: 50.5},
+			clean: true,
+		},
+		{
+			name: "dropped row",
+			modify: func(s *TxtAccountStatement) {
+				s.Transactions = s.Transactions[1:]
+			},
+			expected: ParseDiagnostics{Transactions: 1, ParsedCreditCount: 1, SummaryDebitCount: 1, SummaryCreditCount: 1,
+				ParsedCredits: 50.5, DebitCountMismatch: true, DebitTotalMismatch: true, BalanceBreaks: 1},
+		},
+		{
+			name: "misread amount",
+			modify: func(s *TxtAccountStatement) {
+				s.Transactions[1].DepositAmt = 5.05
+			},
+			expected: ParseDiagnostics{Transactions: 2, ParsedDebitCount: 1, ParsedCreditCount: 1, SummaryDebitCount: 1,
+				SummaryCreditCount: 1, ParsedDebits: 100, ParsedCredits: 5.05, CreditTotalMismatch: true, BalanceBreaks: 1},
+		},
+		{
+			name: "missing date and zero amount",
+			modify: func(s *TxtAccountStatement) {
+				s.Transactions = append(s.Transactions, TxtTransaction{ClosingBalance: 950.5})
+			},
+			expected: ParseDiagnostics{Transactions: 3, ParsedDebitCount: 1, ParsedCreditCount: 1, SummaryDebitCount: 1,
+				SummaryCreditCount: 1, ParsedDebits: 100, ParsedCredits: 50.5, MissingDates: 1, ZeroAmountRows: 1},
+			clean: true,
+		},
+		{
+			name: "no summary",
+			modify: func(s *TxtAccountStatement) {
+				s.Summary = StatementSummary{}
+			},
+			expected: ParseDiagnostics{Transactions: 2, ParsedDebitCount: 1, ParsedCreditCount: 1, ParsedDebits: 100, ParsedCredits: 50.5},
+			clean:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement := balancedStatement()
+			tt.modify(statement)
+			d := Diagnose(statement)
+			if d != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, d)
+			}
+			if d.Clean() != tt.clean {
+				t.Errorf("expected clean %v, got %v", tt.clean, d.Clean())
+			}
+		})
+	}
+}
@@ -5,3 +5,7 @@ go 1.24.0
 toolchain go1.24.11
 
 require github.com/lib/pq v1.10.9 // indirect
+
+require your-module/pagination v0.0.0
+
+replace your-module/pagination => ./pagination
@@ -0,0 +1,90 @@
+// Package logging provides the structured (slog) logger used by the server, with request IDs carried
+// through context.Context and PII-safe redaction of account numbers, names and free text
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Options configures the logger returned by New
+type Options struct {
+	Level  slog.Leveler // Minimum level (default: Info)
+	Format string       // "json" (default) or "text"
+	Redact bool         // Mask sensitive attributes (see SensitiveKeys)
+}
+
+// DefaultOptions returns JSON output at Info level with redaction enabled
+func DefaultOptions() *Options {
+	return &Options{
+		Level:  slog.LevelInfo,
+		Format: "json",
+		Redact: true,
+	}
+}
+
+// New creates a structured logger writing to w
+func New(w io.Writer, opts *Options) *slog.Logger {
+	if opts == nil {
+		opts = DefaultOptions()
+	}
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level}
+	if opts.Redact {
+		handlerOpts.ReplaceAttr = redactAttr
+	}
+
+	var handler slog.Handler
+	if strings.EqualFold(opts.Format, "text") {
+		handler = slog.NewTextHandler(w, handlerOpts)
+	} else {
+		handler = slog.NewJSONHandler(w, handlerOpts)
+	}
+	return slog.New(handler)
+}
+
+// Setup installs a redacting JSON logger on stderr as the slog and log package default
+func Setup() *slog.Logger {
+	logger := New(os.Stderr, DefaultOptions())
+	slog.SetDefault(logger)
+	return logger
+}
+
+type contextKey int
+
+const (
+	loggerKey contextKey = iota
+	requestIDKey
+)
+
+// WithLogger returns a context carrying logger
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, logger)
+}
+
+// FromContext returns the logger stored in ctx, or the default logger
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(loggerKey).(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return slog.Default()
+}
+
+// WithRequestID returns a context carrying id and a logger that tags every record with it
+func WithRequestID(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey, id)
+	return WithLogger(ctx, FromContext(ctx).With(slog.String("request_id", id)))
+}
+
+// RequestID returns the request ID stored in ctx ("" if none)
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
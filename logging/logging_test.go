@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRedaction(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, nil)
+	logger.Info("test",
+		slog.String("account_no", "50100123451725"),
+		slog.String("customer_name", "MR. KALPIT KUMAR SHARMA"),
+		slog.String("query", "how much did I spend on swiggy"),
+		slog.String("category", "Dining"),
+	)
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("invalid JSON log line: %v", err)
+	}
+
+	tests := map[string]string{
+		"account_no":    "XXXXXXXXXX1725",
+		"customer_name": "M. K. K. S.",
+		"query":         "[redacted 30 chars]",
+		"category":      "Dining",
+	}
+	for key, expected := range tests {
+		if record[key] != expected {
+			t.Errorf("%s: expected %q, got %q", key, expected, record[key])
+		}
+	}
+}
+
+func TestMiddlewarePropagatesRequestID(t *testing.T) {
+	var seen string
+	handler := Middleware(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestID(r.Context())
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string // "" means a generated ID
+	}{
+		{"client ID reused", "abc-123", "abc-123"},
+		{"missing ID generated", "", ""},
+		{"invalid ID replaced", "bad id\n", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+
+			if seen == "" || rec.Header().Get(RequestIDHeader) != seen {
+				t.Fatalf("expected response header to echo context ID, got %q and %q", rec.Header().Get(RequestIDHeader), seen)
+			}
+			if tt.expected != "" && seen != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, seen)
+			}
+			if tt.expected == "" && seen == tt.header {
+				t.Errorf("expected a generated ID, got client value %q", seen)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+// Redaction kinds
+const (
+	redactAccount = iota + 1 // Keep the last 4 characters
+	redactName               // Keep initials
+	redactText               // Keep only the length
+)
+
+// SensitiveKeys maps attribute keys to how their values are masked
+// Keys are matched case-insensitively
+var SensitiveKeys = map[string]int{
+	"account":        redactAccount,
+	"account_no":     redactAccount,
+	"account_number": redactAccount,
+	"customer":       redactName,
+	"customer_name":  redactName,
+	"name":           redactName,
+	"beneficiary":    redactName,
+	"email":          redactText,
+	"phone":          redactText,
+	"address":        redactText,
+	"narration":      redactText,
+	"query":          redactText,
+	"message":        redactText,
+	"prompt":         redactText,
+	"context":        redactText,
+	"response":       redactText,
+}
+
+// MaskAccount keeps the last 4 characters of an account or card number
+func MaskAccount(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= 4 {
+		return strings.Repeat("X", len(s))
+	}
+	return strings.Repeat("X", len(s)-4) + s[len(s)-4:]
+}
+
+// MaskName reduces a name to its initials ("KALPIT KUMAR" -> "K. K.")
+func MaskName(s string) string {
+	words := strings.Fields(s)
+	initials := make([]string, 0, len(words))
+	for _, word := range words {
+		for _, r := range word {
+			if unicode.IsLetter(r) {
+				initials = append(initials, string(unicode.ToUpper(r))+".")
+				break
+			}
+		}
+	}
+	return strings.Join(initials, " ")
+}
+
+// MaskText replaces free text with its length
+func MaskText(s string) string {
+	return fmt.Sprintf("[redacted %d chars]", len(s))
+}
+
+// redactAttr is a slog ReplaceAttr hook that masks sensitive string attributes
+func redactAttr(groups []string, a slog.Attr) slog.Attr {
+	kind, ok := SensitiveKeys[strings.ToLower(a.Key)]
+	if !ok || a.Value.Kind() != slog.KindString {
+		return a
+	}
+
+	value := a.Value.String()
+	switch kind {
+	case redactAccount:
+		return slog.String(a.Key, MaskAccount(value))
+	case redactName:
+		return slog.String(a.Key, MaskName(value))
+	default:
+		return slog.String(a.Key, MaskText(value))
+	}
+}
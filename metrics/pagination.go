@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"your-module/pagination"
+)
+
+// PaginationCollector records pagination metrics in a registry
+// Install it with pagination.SetMetricsCollector(metrics.NewPaginationCollector(metrics.Default))
+type PaginationCollector struct {
+	requests      *Counter
+	duration      *Histogram
+	errors        *Counter
+	countDuration *Histogram
+	fetchDuration *Histogram
+	pageSize      *Histogram
+}
+
+var _ pagination.MetricsCollector = (*PaginationCollector)(nil)
+
+// pageSizeBuckets cover typical page sizes up to the pagination package's maximum
+var pageSizeBuckets = []float64{10, 20, 50, 100, 200, 500, 1000}
+
+// NewPaginationCollector registers the pagination metrics in r
+func NewPaginationCollector(r *Registry) *PaginationCollector {
+	return &PaginationCollector{
+		requests: r.NewCounter("pagination_requests_total",
+			"Paginated requests"),
+		duration: r.NewHistogram("pagination_duration_seconds",
+			"Total time spent serving a paginated request", nil),
+		errors: r.NewCounter("pagination_errors_total",
+			"Paginated requests that failed"),
+		countDuration: r.NewHistogram("pagination_count_query_duration_seconds",
+			"Time spent counting total records", nil),
+		fetchDuration: r.NewHistogram("pagination_fetch_query_duration_seconds",
+			"Time spent fetching a page of records", nil),
+		pageSize: r.NewHistogram("pagination_page_size",
+			"Requested page sizes", pageSizeBuckets),
+	}
+}
+
+// RecordPaginationRequest implements pagination.MetricsCollector
+func (c *PaginationCollector) RecordPaginationRequest(ctx context.Context, params pagination.PaginationParams) {
+	c.requests.Inc()
+	c.pageSize.Observe(float64(params.PageSize))
+}
+
+// RecordPaginationDuration implements pagination.MetricsCollector
+func (c *PaginationCollector) RecordPaginationDuration(ctx context.Context, duration time.Duration, params pagination.PaginationParams) {
+	c.duration.Observe(duration.Seconds())
+}
+
+// RecordPaginationError implements pagination.MetricsCollector
+func (c *PaginationCollector) RecordPaginationError(ctx context.Context, err error, params pagination.PaginationParams) {
+	c.errors.Inc()
+}
+
+// RecordTotalRecordsQuery implements pagination.MetricsCollector
+func (c *PaginationCollector) RecordTotalRecordsQuery(ctx context.Context, duration time.Duration) {
+	c.countDuration.Observe(duration.Seconds())
+}
+
+// RecordDataFetchQuery implements pagination.MetricsCollector
+func (c *PaginationCollector) RecordDataFetchQuery(ctx context.Context, duration time.Duration, limit, offset int) {
+	c.fetchDuration.Observe(duration.Seconds())
+}
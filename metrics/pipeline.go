@@ -0,0 +1,147 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"classify/extractor"
+	"classify/statement_analysis_engine_rules/models"
+)
+
+// Default is the registry served at /metrics
+var Default = NewRegistry()
+
+// confidenceBuckets cover the 0-1 classification confidence range
+var confidenceBuckets = []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1}
+
+// HTTP server metrics
+var (
+	HTTPRequests = Default.NewCounter("http_requests_total",
+		"HTTP requests by handler, method and status code", "handler", "method", "code")
+	HTTPRequestDuration = Default.NewHistogram("http_request_duration_seconds",
+		"HTTP request latency by handler", nil, "handler")
+)
+
+// Pipeline stage metrics
+var (
+	StatementsParsed = Default.NewCounter("statements_parsed_total",
+		"Statements parsed, by outcome (clean, inconsistent, error)", "outcome")
+	TransactionsParsed = Default.NewCounter("transactions_parsed_total",
+		"Transactions extracted from statements")
+	ParseIssues = Default.NewCounter("parse_issues_total",
+		"Parse diagnostics that disagree with the statement summary, by kind", "kind")
+	TransactionsClassified = Default.NewCounter("transactions_classified_total",
+		"Classified transactions by category", "category")
+	ClassificationConfidence = Default.NewHistogram("classification_confidence",
+		"Confidence of classified transactions", confidenceBuckets)
+	AnomaliesDetected = Default.NewCounter("anomalies_detected_total",
+		"Detected anomalies by severity", "severity")
+)
+
+// LLM and RAG metrics
+var (
+	LLMCallDuration = Default.NewHistogram("llm_call_duration_seconds",
+		"LLM and embedding call latency by provider, operation and outcome", nil, "provider", "operation", "outcome")
+	RAGFallbacks = Default.NewCounter("rag_fallbacks_total",
+		"Chat requests that fell back from the RAG path, by stage", "stage")
+	LLMCategorizations = Default.NewCounter("llm_categorizations_total",
+		"Unresolved narrations handled by the LLM categorizer, by outcome (cached, accepted, rejected)", "outcome")
+)
+
+var errUnexpectedStatus = errors.New("unexpected status")
+
+// statusRecorder captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// InstrumentHandler records request count and latency for handler under name
+func InstrumentHandler(name string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		handler(rec, r)
+		HTTPRequestDuration.ObserveSince(start, name)
+		HTTPRequests.Inc(name, r.Method, strconv.Itoa(rec.status))
+	}
+}
+
+// ObserveLLMCall records the latency of one LLM or embedding call
+func ObserveLLMCall(provider, operation string, start time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	LLMCallDuration.ObserveSince(start, provider, operation, outcome)
+}
+
+// ObserveLLMResponse records an LLM HTTP call, counting non-200 responses as errors
+func ObserveLLMResponse(provider, operation string, start time.Time, resp *http.Response, err error) {
+	if err == nil && resp.StatusCode != http.StatusOK {
+		err = errUnexpectedStatus
+	}
+	ObserveLLMCall(provider, operation, start, err)
+}
+
+// ObserveParse records the outcome of parsing one statement
+func ObserveParse(statement *extractor.TxtAccountStatement, err error) {
+	if err != nil {
+		StatementsParsed.Inc("error")
+		return
+	}
+
+	d := extractor.Diagnose(statement)
+	TransactionsParsed.Add(float64(d.Transactions))
+	if d.Clean() {
+		StatementsParsed.Inc("clean")
+	} else {
+		StatementsParsed.Inc("inconsistent")
+	}
+
+	if d.DebitCountMismatch {
+		ParseIssues.Inc("debit_count_mismatch")
+	}
+	if d.CreditCountMismatch {
+		ParseIssues.Inc("credit_count_mismatch")
+	}
+	if d.DebitTotalMismatch {
+		ParseIssues.Inc("debit_total_mismatch")
+	}
+	if d.CreditTotalMismatch {
+		ParseIssues.Inc("credit_total_mismatch")
+	}
+	ParseIssues.Add(float64(d.BalanceBreaks), "balance_break")
+	ParseIssues.Add(float64(d.MissingDates), "missing_date")
+	ParseIssues.Add(float64(d.ZeroAmountRows), "zero_amount")
+}
+
+// ObserveClassification records the category and confidence of each classified transaction
+func ObserveClassification(transactions []models.ClassifiedTransaction) {
+	for _, txn := range transactions {
+		category := txn.Category
+		if category == "" {
+			category = "Other"
+		}
+		TransactionsClassified.Inc(category)
+		ClassificationConfidence.Observe(txn.ClassificationMetadata.Confidence)
+	}
+}
+
+// ObserveAnomalies records detected anomalies by severity
+func ObserveAnomalies(detection models.AnomalyDetection) {
+	for _, anomaly := range detection.Anomalies {
+		severity := anomaly.Severity
+		if severity == "" {
+			severity = "unknown"
+		}
+		AnomaliesDetected.Inc(severity)
+	}
+}
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"classify/extractor"
+)
+
+func TestInstrumentHandler(t *testing.T) {
+	handler := InstrumentHandler("test_instrument", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fail") != "" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
+
+	for _, target := range []string{"/", "/", "/?fail=1"} {
+		handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+	}
+
+	if got := HTTPRequests.Value("test_instrument", http.MethodGet, "200"); got != 2 {
+		t.Errorf("expected 2 successful requests, got %v", got)
+	}
+	if got := HTTPRequests.Value("test_instrument", http.MethodGet, "400"); got != 1 {
+		t.Errorf("expected 1 failed request, got %v", got)
+	}
+	if got := HTTPRequestDuration.Count("test_instrument"); got != 3 {
+		t.Errorf("expected 3 latency observations, got %v", got)
+	}
+}
+
+func TestObserveParse(t *testing.T) {
+	clean := &extractor.TxtAccountStatement{
+		Transactions: []extractor.TxtTransaction{{Date: "01/12/25", WithdrawalAmt: 100, ClosingBalance: 900}},
+		Summary:      extractor.StatementSummary{OpeningBalance: 1000, TotalDebits: 100, DebitCount: 1},
+	}
+	// The summary counts a debit the parser missed
+	inconsistent := &extractor.TxtAccountStatement{
+		Transactions: []extractor.TxtTransaction{{Date: "01/12/25", WithdrawalAmt: 100, ClosingBalance: 900}},
+		Summary:      extractor.StatementSummary{OpeningBalance: 1000, TotalDebits: 150, DebitCount: 2},
+	}
+
+	tests := []struct {
+		name      string
+		statement *extractor.TxtAccountStatement
+		err       error
+		outcome   string
+		parsed    float64
+		issues    map[string]float64
+	}{
+		{"error", nil, errors.New("unreadable"), "error", 0, nil},
+		{"clean", clean, nil, "clean", 1, nil},
+		{"inconsistent", inconsistent, nil, "inconsistent", 1, map[string]float64{
+			"debit_count_mismatch": 1,
+			"debit_total_mismatch": 1,
+			"balance_break":        0,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcomes := StatementsParsed.Value(tt.outcome)
+			parsed := TransactionsParsed.Value()
+			issues := make(map[string]float64)
+			for kind := range tt.issues {
+				issues[kind] = ParseIssues.Value(kind)
+			}
+
+			ObserveParse(tt.statement, tt.err)
+
+			if got := StatementsParsed.Value(tt.outcome) - outcomes; got != 1 {
+				t.Errorf("expected one %s statement, got %v", tt.outcome, got)
+			}
+			if got := TransactionsParsed.Value() - parsed; got != tt.parsed {
+				t.Errorf("expected %v parsed transactions, got %v", tt.parsed, got)
+			}
+			for kind, expected := range tt.issues {
+				if got := ParseIssues.Value(kind) - issues[kind]; got != expected {
+					t.Errorf("expected %v %s issues, got %v", expected, kind, got)
+				}
+			}
+		})
+	}
+}
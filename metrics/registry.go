@@ -0,0 +1,309 @@
+// Package metrics is a small in-process metrics registry that renders the Prometheus text exposition format
+// It has no external dependencies: counters and histograms are kept in memory and scraped from /metrics
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// labelSeparator joins label values into a series key (cannot appear in valid UTF-8 label values)
+const labelSeparator = "\xff"
+
+// DefaultBuckets are latency buckets in seconds, from 5ms to 2 minutes (LLM calls can be slow)
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120}
+
+// metric is implemented by every metric type the registry can expose
+type metric interface {
+	name() string
+	write(w *bufio.Writer)
+}
+
+// Registry holds a set of named metrics
+type Registry struct {
+	mu      sync.RWMutex
+	metrics map[string]metric
+	order   []string
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		metrics: make(map[string]metric),
+		order:   make([]string, 0),
+	}
+}
+
+// register adds m to the registry, panicking on duplicate names (a programming error)
+func (r *Registry) register(m metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.metrics[m.name()]; exists {
+		panic("metrics: duplicate metric " + m.name())
+	}
+	r.metrics[m.name()] = m
+	r.order = append(r.order, m.name())
+	sort.Strings(r.order)
+}
+
+// NewCounter registers a counter with the given label names
+func (r *Registry) NewCounter(name, help string, labelNames ...string) *Counter {
+	c := &Counter{
+		desc:   desc{Name: name, Help: help, Labels: labelNames},
+		values: make(map[string]float64),
+	}
+	r.register(c)
+	return c
+}
+
+// NewHistogram registers a histogram with the given upper bounds (nil = DefaultBuckets)
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labelNames ...string) *Histogram {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+	h := &Histogram{
+		desc:    desc{Name: name, Help: help, Labels: labelNames},
+		buckets: sorted,
+		series:  make(map[string]*histogramSeries),
+	}
+	r.register(h)
+	return h
+}
+
+// WriteTo renders every metric in the Prometheus text exposition format (version 0.0.4)
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	r.mu.RLock()
+	for _, name := range r.order {
+		r.metrics[name].write(bw)
+	}
+	r.mu.RUnlock()
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// Handler serves the registry at a scrape endpoint
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		r.WriteTo(w)
+	})
+}
+
+// desc describes a metric family
+type desc struct {
+	Name   string
+	Help   string
+	Labels []string
+}
+
+func (d desc) name() string {
+	return d.Name
+}
+
+// key builds the series key for a set of label values
+func (d desc) key(labelValues []string) string {
+	if len(labelValues) != len(d.Labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", d.Name, len(d.Labels), len(labelValues)))
+	}
+	return strings.Join(labelValues, labelSeparator)
+}
+
+// writeHeader writes the HELP and TYPE lines
+func (d desc) writeHeader(w *bufio.Writer, kind string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", d.Name, escapeHelp(d.Help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", d.Name, kind)
+}
+
+// labelString renders {a="x",b="y"} for a series key plus optional extra label
+func (d desc) labelString(key string, extraName, extraValue string) string {
+	pairs := make([]string, 0, len(d.Labels)+1)
+	if len(d.Labels) > 0 {
+		values := strings.Split(key, labelSeparator)
+		for i, label := range d.Labels {
+			pairs = append(pairs, label+`="`+escapeLabelValue(values[i])+`"`)
+		}
+	}
+	if extraName != "" {
+		pairs = append(pairs, extraName+`="`+escapeLabelValue(extraValue)+`"`)
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// Counter is a monotonically increasing value, optionally split by labels
+type Counter struct {
+	desc
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+// Inc adds one to the series identified by labelValues
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds v (must be >= 0) to the series identified by labelValues
+func (c *Counter) Add(v float64, labelValues ...string) {
+	if v < 0 {
+		return
+	}
+	key := c.key(labelValues)
+	c.mu.Lock()
+	c.values[key] += v
+	c.mu.Unlock()
+}
+
+// Value returns the current value of a series (mainly for tests)
+func (c *Counter) Value(labelValues ...string) float64 {
+	key := c.key(labelValues)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.values[key]
+}
+
+func (c *Counter) write(w *bufio.Writer) {
+	c.writeHeader(w, "counter")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range sortedKeys(c.values) {
+		fmt.Fprintf(w, "%s%s %s\n", c.Name, c.labelString(key, "", ""), formatFloat(c.values[key]))
+	}
+}
+
+// Histogram samples observations into cumulative buckets, optionally split by labels
+type Histogram struct {
+	desc
+	buckets []float64
+	mu      sync.Mutex
+	series  map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	counts []uint64 // Per-bucket (non-cumulative) counts; last slot is +Inf
+	sum    float64
+	count  uint64
+}
+
+// Observe records one observation in the series identified by labelValues
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	key := h.key(labelValues)
+	idx := sort.SearchFloat64s(h.buckets, v)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{counts: make([]uint64, len(h.buckets)+1)}
+		h.series[key] = s
+	}
+	s.counts[idx]++
+	s.sum += v
+	s.count++
+}
+
+// ObserveSince records the seconds elapsed since start
+func (h *Histogram) ObserveSince(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start).Seconds(), labelValues...)
+}
+
+// Count returns the number of observations in a series (mainly for tests)
+func (h *Histogram) Count(labelValues ...string) uint64 {
+	key := h.key(labelValues)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if s, ok := h.series[key]; ok {
+		return s.count
+	}
+	return 0
+}
+
+func (h *Histogram) write(w *bufio.Writer) {
+	h.writeHeader(w, "histogram")
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	keys := make([]string, 0, len(h.series))
+	for key := range h.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s := h.series[key]
+		var cumulative uint64
+		for i, upper := range h.buckets {
+			cumulative += s.counts[i]
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.Name, h.labelString(key, "le", formatFloat(upper)), cumulative)
+		}
+		cumulative += s.counts[len(h.buckets)]
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.Name, h.labelString(key, "le", "+Inf"), cumulative)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.Name, h.labelString(key, "", ""), formatFloat(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.Name, h.labelString(key, "", ""), s.count)
+	}
+}
+
+// Helper functions
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func escapeHelp(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, "\n", `\n`)
+}
+
+func escapeLabelValue(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return strings.ReplaceAll(s, "\n", `\n`)
+}
+
+// countingWriter tracks bytes written for WriteTo
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegistryTextFormat(t *testing.T) {
+	r := NewRegistry()
+	requests := r.NewCounter("requests_total", "Requests served", "code")
+	latency := r.NewHistogram("latency_seconds", "Request latency", []float64{0.1, 1})
+
+	requests.Inc("200")
+	requests.Add(2, "500")
+	latency.Observe(0.05)
+	latency.Observe(0.5)
+	latency.Observe(5)
+
+	var sb strings.Builder
+	if _, err := r.WriteTo(&sb); err != nil {
+		t.Fatalf("WriteTo failed: %v", err)
+	}
+
+	expected := `# HELP latency_seconds Request latency
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 1
+latency_seconds_bucket{le="1"} 2
+latency_seconds_bucket{le="+Inf"} 3
+latency_seconds_sum 5.55
+latency_seconds_count 3
+# HELP requests_total Requests served
+# TYPE requests_total counter
+requests_total{code="200"} 1
+requests_total{code="500"} 2
+`
+	if sb.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, sb.String())
+	}
+}
+
+func TestLabelValueEscaping(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("escaped_total", "Escaping", "value")
+	c.Inc("a \"quoted\"\nline\\")
+
+	var sb strings.Builder
+	r.WriteTo(&sb)
+
+	expected := `escaped_total{value="a \"quoted\"\nline\\"} 1`
+	if !strings.Contains(sb.String(), expected) {
+		t.Errorf("expected output to contain %s, got:\n%s", expected, sb.String())
+	}
+}
@@ -0,0 +1,269 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"classify/auth"
+	"classify/ratelimit"
+
+	"your-module/pagination"
+)
+
+// Document is an OpenAPI 3.0 document
+type Document struct {
+	OpenAPI    string                          `json:"openapi"`
+	Info       Info                            `json:"info"`
+	Paths      map[string]map[string]Operation `json:"paths"`
+	Components DocumentComponents              `json:"components"`
+	Security   []map[string][]string           `json:"security,omitempty"`
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// DocumentComponents holds reusable schemas and security schemes
+type DocumentComponents struct {
+	Schemas         map[string]Schema `json:"schemas"`
+	SecuritySchemes map[string]Schema `json:"securitySchemes,omitempty"`
+}
+
+// Operation is a single method on a path
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"` // Empty list = public endpoint
+}
+
+// Parameter is a query parameter
+type Parameter struct {
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
+	Schema      Schema `json:"schema"`
+}
+
+// RequestBody is a JSON request body
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response is one response status
+type Response struct {
+	Description string               `json:"description"`
+	Headers     map[string]Header    `json:"headers,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// Header documents a response header
+type Header struct {
+	Description string `json:"description"`
+	Schema      Schema `json:"schema"`
+}
+
+// MediaType wraps a schema for one content type
+type MediaType struct {
+	Schema Schema `json:"schema"`
+}
+
+// Endpoint describes a handler in terms of its Go types
+type Endpoint struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Tags        []string
+	Request     interface{}    // Zero value of the request body type (nil = no body)
+	Response    interface{}    // Zero value of the success response type (or item type when Paginated)
+	Status      int            // Success status (default 200)
+	ContentType string         // Success content type (default application/json)
+	Paginated   bool           // Wrap Response in the pagination module's envelope and add its query parameters
+	Query       []Parameter    // Extra query parameters
+	Errors      map[int]string // Error status -> description
+	ErrorBody   interface{}    // Zero value of the error body type (nil = plain text)
+	Public      bool           // No authentication required
+	RateLimited bool           // Responses carry RateLimit-* headers and may be 429
+}
+
+// Builder assembles a Document from Endpoints
+type Builder struct {
+	doc        Document
+	components *Components
+}
+
+// NewBuilder creates a builder; every operation requires the API key or bearer token unless Public
+func NewBuilder(info Info) *Builder {
+	return &Builder{
+		doc: Document{
+			OpenAPI: "3.0.3",
+			Info:    info,
+			Paths:   make(map[string]map[string]Operation),
+			Components: DocumentComponents{
+				SecuritySchemes: map[string]Schema{
+					"apiKey":     {"type": "apiKey", "in": "header", "name": "X-API-Key"},
+					"bearerAuth": {"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+				},
+			},
+			Security: []map[string][]string{{"apiKey": {}}, {"bearerAuth": {}}},
+		},
+		components: NewComponents(),
+	}
+}
+
+// Add registers an endpoint
+func (b *Builder) Add(e Endpoint) *Builder {
+	op := Operation{
+		OperationID: e.OperationID,
+		Summary:     e.Summary,
+		Tags:        e.Tags,
+		Parameters:  append([]Parameter(nil), e.Query...),
+		Responses:   make(map[string]Response),
+	}
+	if e.Public {
+		op.Security = []map[string][]string{}
+	}
+
+	if e.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"application/json": {Schema: b.components.SchemaOf(e.Request)}},
+		}
+	}
+
+	status := e.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	contentType := e.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	success := Response{Description: http.StatusText(status)}
+	if e.Response != nil {
+		schema := b.components.SchemaOf(e.Response)
+		if e.Paginated {
+			schema = b.paginate(&op, schema)
+		}
+		success.Content = map[string]MediaType{contentType: {Schema: schema}}
+	} else if contentType != "application/json" {
+		success.Content = map[string]MediaType{contentType: {Schema: Schema{"type": "string"}}}
+	}
+	if e.RateLimited {
+		success.Headers = rateLimitHeaders()
+	}
+	op.Responses[strconv.Itoa(status)] = success
+
+	for code, description := range e.Errors {
+		op.Responses[strconv.Itoa(code)] = b.errorResponse(description, e.ErrorBody)
+	}
+	if !e.Public {
+		op.Responses["401"] = b.errorResponse("Missing or invalid credentials", auth.ErrorResponse{})
+	}
+	if e.RateLimited {
+		tooMany := b.errorResponse("Rate limit or monthly quota exceeded", ratelimit.ErrorResponse{})
+		tooMany.Headers = rateLimitHeaders()
+		tooMany.Headers["Retry-After"] = Header{Description: "Seconds until the request may be retried", Schema: Schema{"type": "integer"}}
+		op.Responses["429"] = tooMany
+	}
+
+	if b.doc.Paths[e.Path] == nil {
+		b.doc.Paths[e.Path] = make(map[string]Operation)
+	}
+	b.doc.Paths[e.Path][strings.ToLower(e.Method)] = op
+	return b
+}
+
+// paginate wraps itemSchema with pagination.GenerateOpenAPISchema and adds its query parameters
+func (b *Builder) paginate(op *Operation, itemSchema Schema) Schema {
+	spec := pagination.GenerateOpenAPISchema(pagination.DefaultConfig(), itemSchema)
+
+	names := make([]string, 0, len(spec.QueryParameters))
+	for name := range spec.QueryParameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		param := spec.QueryParameters[name]
+		schema := Schema{"type": param.Type}
+		if param.Default != nil {
+			schema["default"] = param.Default
+		}
+		if param.Minimum != nil {
+			schema["minimum"] = *param.Minimum
+		}
+		if param.Maximum != nil {
+			schema["maximum"] = *param.Maximum
+		}
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        name,
+			In:          "query",
+			Description: param.Description,
+			Required:    param.Required,
+			Schema:      schema,
+		})
+	}
+
+	schema := Schema{"type": spec.ResponseSchema.Type, "properties": spec.ResponseSchema.Properties}
+	if len(spec.ResponseSchema.Required) > 0 {
+		schema["required"] = spec.ResponseSchema.Required
+	}
+	return schema
+}
+
+func (b *Builder) errorResponse(description string, body interface{}) Response {
+	if body == nil {
+		return Response{
+			Description: description,
+			Content:     map[string]MediaType{"text/plain": {Schema: Schema{"type": "string"}}},
+		}
+	}
+	return Response{
+		Description: description,
+		Content:     map[string]MediaType{"application/json": {Schema: b.components.SchemaOf(body)}},
+	}
+}
+
+// Document returns the assembled document
+func (b *Builder) Document() Document {
+	doc := b.doc
+	doc.Components.Schemas = b.components.Schemas
+	return doc
+}
+
+// Handler serves doc as JSON
+func Handler(doc Document) http.HandlerFunc {
+	body, err := json.MarshalIndent(doc, "", "  ")
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			http.Error(w, "Failed to encode OpenAPI document", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}
+}
+
+func rateLimitHeaders() map[string]Header {
+	integer := Schema{"type": "integer"}
+	return map[string]Header{
+		"RateLimit-Limit":     {Description: "Requests allowed in a burst", Schema: integer},
+		"RateLimit-Remaining": {Description: "Requests left in the current burst", Schema: integer},
+		"RateLimit-Reset":     {Description: "Seconds until the burst is fully replenished", Schema: integer},
+		"X-Quota-Limit":       {Description: "Monthly request quota", Schema: integer},
+		"X-Quota-Remaining":   {Description: "Requests left this month", Schema: integer},
+		"X-Quota-Reset":       {Description: "When the monthly quota resets", Schema: Schema{"type": "string", "format": "date-time"}},
+	}
+}
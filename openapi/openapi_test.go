@@ -0,0 +1,276 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"classify/auth"
+	"classify/ratelimit"
+	"classify/statement_analysis_engine_rules/analyzer"
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/statement_analysis_engine_rules/review"
+	"classify/webhooks"
+)
+
+var update = flag.Bool("update", false, "rewrite openapi.json from the Go types")
+
+// TestPublishedSpecUpToDate fails when the request/response types change without republishing openapi.json
+func TestPublishedSpecUpToDate(t *testing.T) {
+	generated, err := json.MarshalIndent(Spec(), "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated = append(generated, '\n')
+
+	if *update {
+		if err := os.WriteFile("openapi.json", generated, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	published, err := os.ReadFile("openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(published, generated) {
+		t.Errorf("openapi.json is out of date with the Go types; review the change and run: go test ./openapi -run TestPublishedSpecUpToDate -update")
+	}
+}
+
+// TestHandlersMatchSpec validates real handler output against the response schemas in the spec
+func TestHandlersMatchSpec(t *testing.T) {
+	doc := Spec()
+	principal := auth.Principal{Subject: "test", TenantID: "acme", Method: "api_key"}
+	withPrincipal := func(r *http.Request) *http.Request {
+		return r.WithContext(auth.WithPrincipal(r.Context(), principal))
+	}
+
+	registry := webhooks.NewRegistry()
+	hooks := webhooks.Handler(registry)
+	store, err := overrides.NewStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	caller := func(r *http.Request) (string, string, *slog.Logger) {
+		p, _ := auth.PrincipalFromContext(r.Context())
+		return p.TenantID, p.Subject, slog.Default()
+	}
+	userOverrides := overrides.Handler(store, caller)
+	queue, err := review.NewQueue("", 0, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queue.Collect("acme", "test", classifier.ClassifyTransactions([]models.ClassifiedTransaction{
+		classifier.ConvertFromTxtTransaction("01/12/25", "UPI-ANKIT DAIRY AND SWEE-VYAPAR.1708@HDFCBANK-HDFC0MERUPI-102438018496-UPI", "0000102438018496", "01/12/25", 120, 0, 9880),
+	}, "TEST USER", nil))
+	limiter := ratelimit.NewLimiter(nil, &ratelimit.Config{By: "tenant", Endpoints: map[string]ratelimit.Rule{
+		"/api/chat": {RatePerMinute: 1, Burst: 1},
+	}})
+	limited := limiter.Middleware("/api/chat", func(w http.ResponseWriter, r *http.Request) {})
+	rejectAll := auth.Middleware(auth.AuthenticatorFunc(func(r *http.Request) (auth.Principal, error) {
+		return auth.Principal{}, auth.ErrNoCredentials
+	}), func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		name    string
+		path    string
+		method  string
+		handler http.HandlerFunc
+		request *http.Request
+		status  int
+		repeat  int
+	}{
+		{"webhook create", "/api/webhooks", "post", hooks,
+			withPrincipal(httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(`{"url":"https://93.184.215.14/h","events":["anomaly.detected"]}`))), 201, 1},
+		{"webhook list", "/api/webhooks", "get", hooks,
+			withPrincipal(httptest.NewRequest(http.MethodGet, "/api/webhooks", nil)), 200, 1},
+		{"webhook invalid", "/api/webhooks", "post", hooks,
+			withPrincipal(httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(`{"url":"ftp://x"}`))), 400, 1},
+		{"override create", "/api/overrides", "post", userOverrides,
+			withPrincipal(httptest.NewRequest(http.MethodPost, "/api/overrides", strings.NewReader(`{"type":"merchant","match":"SIMPL","category":"Loan"}`))), 201, 1},
+		{"override list", "/api/overrides", "get", userOverrides,
+			withPrincipal(httptest.NewRequest(http.MethodGet, "/api/overrides", nil)), 200, 1},
+		{"override invalid", "/api/overrides", "post", userOverrides,
+			withPrincipal(httptest.NewRequest(http.MethodPost, "/api/overrides", strings.NewReader(`{"type":"vpa","match":"landlord"}`))), 400, 1},
+		{"override missing", "/api/overrides", "delete", userOverrides,
+			withPrincipal(httptest.NewRequest(http.MethodDelete, "/api/overrides?id=ovr_missing", nil)), 404, 1},
+		{"review list", "/api/review", "get", review.Handler(queue, caller),
+			withPrincipal(httptest.NewRequest(http.MethodGet, "/api/review?status=all", nil)), 200, 1},
+		{"review correct", "/api/review/corrections", "post", review.CorrectionHandler(queue, caller),
+			withPrincipal(httptest.NewRequest(http.MethodPost, "/api/review/corrections", strings.NewReader(`{"narration":"UPI-ANKIT DAIRY AND SWEE-VYAPAR.1708@HDFCBANK","category":"Groceries"}`))), 201, 1},
+		{"review invalid", "/api/review/corrections", "post", review.CorrectionHandler(queue, caller),
+			withPrincipal(httptest.NewRequest(http.MethodPost, "/api/review/corrections", strings.NewReader(`{"category":"Groceries"}`))), 400, 1},
+		{"review corrections", "/api/review/corrections", "get", review.CorrectionHandler(queue, caller),
+			withPrincipal(httptest.NewRequest(http.MethodGet, "/api/review/corrections", nil)), 200, 1},
+		{"review report", "/api/review/report", "get", review.ReportHandler(queue, caller),
+			withPrincipal(httptest.NewRequest(http.MethodGet, "/api/review/report", nil)), 200, 1},
+		{"unauthorized", "/classify", "post", rejectAll,
+			httptest.NewRequest(http.MethodPost, "/classify", nil), 401, 1},
+		{"rate limited", "/api/chat", "post", limited,
+			withPrincipal(httptest.NewRequest(http.MethodPost, "/api/chat", nil)), 429, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rec *httptest.ResponseRecorder
+			for i := 0; i < tt.repeat; i++ {
+				rec = httptest.NewRecorder()
+				tt.handler(rec, tt.request.Clone(tt.request.Context()))
+			}
+			if rec.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, rec.Code, rec.Body)
+			}
+			schema := responseSchema(t, doc, tt.path, tt.method, tt.status)
+			for _, err := range validateJSON(t, doc, schema, rec.Body.Bytes()) {
+				t.Error(err)
+			}
+		})
+	}
+
+	// Analysis output: serialise a real ClassifyResponse
+	t.Run("classify response", func(t *testing.T) {
+		txns := []models.ClassifiedTransaction{
+			classifier.ConvertFromTxtTransaction("01/12/25", "UPI-SWIGGY-SWIGGY@ICICI-ICIC0DC0099-123456789012-UPI", "0000123456789012", "01/12/25", 450, 0, 9550),
+			classifier.ConvertFromTxtTransaction("02/12/25", "NEFT CR-HDFC0000001-ACME CORP-SALARY DEC", "NEFTINH123", "02/12/25", 0, 50000, 59550),
+		}
+		txns = classifier.ClassifyTransactions(txns, "TEST USER", nil)
+		a := analyzer.NewAnalyzer()
+		a.AddTransactions(txns)
+		response := a.Analyze("00112233445566", "TEST USER", "01/12/2025 - 31/12/2025", 10000, 59550)
+		body, err := json.Marshal(response)
+		if err != nil {
+			t.Fatal(err)
+		}
+		schema := responseSchema(t, doc, "/classify", "post", 200)
+		for _, err := range validateJSON(t, doc, schema, body) {
+			t.Error(err)
+		}
+	})
+}
+
+func responseSchema(t *testing.T, doc Document, path, method string, status int) Schema {
+	op, ok := doc.Paths[path][method]
+	if !ok {
+		t.Fatalf("spec has no %s %s", strings.ToUpper(method), path)
+	}
+	resp, ok := op.Responses[fmt.Sprint(status)]
+	if !ok {
+		t.Fatalf("spec has no %d response for %s %s", status, strings.ToUpper(method), path)
+	}
+	media, ok := resp.Content["application/json"]
+	if !ok {
+		t.Fatalf("%d response for %s %s is not JSON in the spec", status, strings.ToUpper(method), path)
+	}
+	return media.Schema
+}
+
+func validateJSON(t *testing.T, doc Document, schema Schema, body []byte) []error {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+	return validate(doc, schema, value, "$")
+}
+
+// validate is a minimal JSON Schema check: types, required properties and undocumented properties
+func validate(doc Document, schema Schema, value interface{}, path string) []error {
+	if ref, ok := schema["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		resolved, ok := doc.Components.Schemas[name]
+		if !ok {
+			return []error{fmt.Errorf("%s: unresolved %s", path, ref)}
+		}
+		return validate(doc, resolved, value, path)
+	}
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		if value == nil && schema["nullable"] == true {
+			return nil
+		}
+		errs := make([]error, 0)
+		for _, s := range allOf {
+			errs = append(errs, validate(doc, s.(Schema), value, path)...)
+		}
+		return errs
+	}
+	if value == nil {
+		if schema["nullable"] == true || len(schema) == 0 {
+			return nil
+		}
+		// encoding/json writes nil slices and maps as null
+		if schema["type"] == "array" || schema["type"] == "object" {
+			return nil
+		}
+		return []error{fmt.Errorf("%s: null is not allowed", path)}
+	}
+
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return []error{fmt.Errorf("%s: expected object, got %T", path, value)}
+		}
+		errs := make([]error, 0)
+		properties, _ := schema["properties"].(map[string]interface{})
+		required, _ := schema["required"].([]string)
+		for _, name := range required {
+			if _, ok := obj[name]; !ok {
+				errs = append(errs, fmt.Errorf("%s: missing required property %q", path, name))
+			}
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if properties != nil {
+				prop, ok := properties[k]
+				if !ok {
+					errs = append(errs, fmt.Errorf("%s: property %q is not in the spec", path, k))
+					continue
+				}
+				errs = append(errs, validate(doc, prop.(Schema), obj[k], path+"."+k)...)
+			} else if additional, ok := schema["additionalProperties"].(Schema); ok {
+				errs = append(errs, validate(doc, additional, obj[k], path+"."+k)...)
+			}
+		}
+		return errs
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return []error{fmt.Errorf("%s: expected array, got %T", path, value)}
+		}
+		errs := make([]error, 0)
+		for i, item := range arr {
+			errs = append(errs, validate(doc, schema["items"].(Schema), item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return errs
+	case "string":
+		if _, ok := value.(string); !ok {
+			return []error{fmt.Errorf("%s: expected string, got %T", path, value)}
+		}
+	case "integer", "number":
+		n, ok := value.(float64)
+		if !ok {
+			return []error{fmt.Errorf("%s: expected %s, got %T", path, schema["type"], value)}
+		}
+		if schema["type"] == "integer" && n != float64(int64(n)) {
+			return []error{fmt.Errorf("%s: expected integer, got %v", path, n)}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []error{fmt.Errorf("%s: expected boolean, got %T", path, value)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Package openapi generates the service's OpenAPI 3 document by reflecting over the request/response types
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Schema is a JSON Schema object as used by OpenAPI 3.0
+type Schema = map[string]interface{}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Components collects named schemas referenced from operations
+type Components struct {
+	Schemas map[string]Schema
+}
+
+// NewComponents creates an empty component set
+func NewComponents() *Components {
+	return &Components{Schemas: make(map[string]Schema)}
+}
+
+// SchemaOf returns the schema for v's type, registering named structs as components
+func (c *Components) SchemaOf(v interface{}) Schema {
+	return c.schemaFor(reflect.TypeOf(v))
+}
+
+// schemaFor maps a Go type to a schema the way encoding/json serialises it
+func (c *Components) schemaFor(t reflect.Type) Schema {
+	if t == nil {
+		return Schema{}
+	}
+	if t.Kind() == reflect.Ptr {
+		schema := c.schemaFor(t.Elem())
+		if _, isRef := schema["$ref"]; isRef {
+			return Schema{"allOf": []interface{}{schema}, "nullable": true}
+		}
+		schema["nullable"] = true
+		return schema
+	}
+	if t == timeType {
+		return Schema{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return Schema{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return Schema{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return Schema{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return Schema{"type": "number", "format": "double"}
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return Schema{"type": "string", "format": "byte"}
+		}
+		return Schema{"type": "array", "items": c.schemaFor(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": c.schemaFor(t.Elem())}
+	case reflect.Interface:
+		return Schema{} // Any JSON value
+	case reflect.Struct:
+		if t.Name() == "" {
+			return c.structSchema(t)
+		}
+		name := componentName(t)
+		if _, seen := c.Schemas[name]; !seen {
+			c.Schemas[name] = Schema{} // Placeholder breaks recursive types
+			c.Schemas[name] = c.structSchema(t)
+		}
+		return Schema{"$ref": "#/components/schemas/" + name}
+	}
+	return Schema{}
+}
+
+// structSchema lists the exported JSON fields; fields without omitempty are required
+func (c *Components) structSchema(t reflect.Type) Schema {
+	properties := make(map[string]interface{})
+	required := make([]string, 0)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty, skip := jsonField(field)
+		if skip {
+			continue
+		}
+
+		// Embedded structs without a JSON name are flattened, as encoding/json does
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				inner := c.structSchema(embedded)
+				for k, v := range inner["properties"].(map[string]interface{}) {
+					if _, shadowed := properties[k]; !shadowed {
+						properties[k] = v
+					}
+				}
+				if req, ok := inner["required"].([]string); ok {
+					required = append(required, req...)
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = c.schemaFor(field.Type)
+		if !omitEmpty && field.Type.Kind() != reflect.Ptr {
+			required = append(required, name)
+		}
+	}
+
+	schema := Schema{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = dedupe(required, properties)
+	}
+	return schema
+}
+
+func jsonField(field reflect.StructField) (name string, omitEmpty, skip bool) {
+	if !field.IsExported() && !field.Anonymous {
+		return "", false, true
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" || opt == "omitzero" {
+			omitEmpty = true
+		}
+	}
+	return parts[0], omitEmpty, false
+}
+
+// componentName qualifies colliding names from different packages (e.g. models.AnomalyDetail)
+func componentName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	if pkg == "models" || pkg == "" {
+		return t.Name()
+	}
+	return strings.ToUpper(pkg[:1]) + pkg[1:] + t.Name()
+}
+
+func dedupe(names []string, properties map[string]interface{}) []string {
+	seen := make(map[string]bool, len(names))
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, ok := properties[name]; ok && !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	return result
+}
@@ -0,0 +1,257 @@
+package openapi
+
+import (
+	"net/http"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/statement_analysis_engine_rules/review"
+	"classify/webhooks"
+)
+
+// Version is the API version published in the document
+const Version = "1.0.0"
+
+// Spec describes the HTTP API served by the classify server
+// Keep it in step with the handlers; openapi.json is the published copy checked by the drift test
+func Spec() Document {
+	b := NewBuilder(Info{
+		Title:       "Statement Classification API",
+		Version:     Version,
+		Description: "Bank statement classification, analytics and chat. Authenticate with X-API-Key or a bearer JWT.",
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodPost,
+		Path:        "/classify",
+		OperationID: "classifyStatement",
+		Summary:     "Parse, classify and analyse the statement",
+		Tags:        []string{"classification"},
+		Response:    models.ClassifyResponse{},
+		Errors: map[int]string{
+			http.StatusUnprocessableEntity: "The statement could not be parsed",
+			http.StatusInternalServerError: "The statement could not be read or encoded",
+		},
+		RateLimited: true,
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/merchants",
+		OperationID: "listMerchants",
+		Summary:     "Spend at every merchant in the statement, ranked by total",
+		Tags:        []string{"classification"},
+		Response:    models.MerchantStats{},
+		Paginated:   true,
+		Query: []Parameter{
+			{Name: "filter_category", In: "query", Description: "Only merchants whose main category matches", Schema: Schema{"type": "string"}},
+			{Name: "search", In: "query", Description: "Part of the merchant name (case-insensitive)", Schema: Schema{"type": "string"}},
+		},
+		Errors: map[int]string{
+			http.StatusUnprocessableEntity: "The statement could not be parsed",
+			http.StatusInternalServerError: "The statement could not be read or encoded",
+		},
+		RateLimited: true,
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/trace",
+		OperationID: "traceTransaction",
+		Summary:     "How every classification layer decided one transaction of the statement",
+		Tags:        []string{"classification"},
+		Response:    models.TraceResponse{},
+		Query: []Parameter{
+			{Name: "row", In: "query", Description: "Transaction number in the statement, from 1", Required: true, Schema: Schema{"type": "integer", "minimum": 1}},
+		},
+		Errors: map[int]string{
+			http.StatusBadRequest:          "Missing row or row out of range",
+			http.StatusUnprocessableEntity: "The statement could not be parsed",
+			http.StatusInternalServerError: "The statement could not be read or encoded",
+		},
+		RateLimited: true,
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodPost,
+		Path:        "/api/chat",
+		OperationID: "chat",
+		Summary:     "Ask a question about the statement (RAG over the statement data)",
+		Tags:        []string{"chat"},
+		Request:     models.ChatRequest{},
+		Response:    models.ChatResponse{},
+		Errors: map[int]string{
+			http.StatusBadRequest:            "Invalid request body or missing message",
+			http.StatusRequestEntityTooLarge: "The request body exceeds the configured limit",
+			http.StatusInternalServerError:   "The LLM provider failed or is not configured",
+		},
+		ErrorBody:   models.ChatResponse{},
+		RateLimited: true,
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/health",
+		OperationID: "health",
+		Summary:     "Liveness check",
+		Tags:        []string{"operations"},
+		Response:    models.HealthResponse{},
+		Public:      true,
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/ready",
+		OperationID: "ready",
+		Summary:     "Readiness check: fails while draining for shutdown or when a dependency is down",
+		Tags:        []string{"operations"},
+		Response:    models.ReadinessResponse{},
+		Errors:      map[int]string{http.StatusServiceUnavailable: "Not ready to receive traffic"},
+		ErrorBody:   models.ReadinessResponse{},
+		Public:      true,
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/overrides",
+		OperationID: "listOverrides",
+		Summary:     "List the caller's category overrides",
+		Tags:        []string{"overrides"},
+		Response:    overrides.ListResponse{},
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodPost,
+		Path:        "/api/overrides",
+		OperationID: "createOverride",
+		Summary:     "Override the classification of a merchant, UPI VPA or narration pattern, or register an own account, for the caller",
+		Tags:        []string{"overrides"},
+		Request:     overrides.Override{},
+		Response:    overrides.OverrideResponse{},
+		Status:      http.StatusCreated,
+		Errors:      map[int]string{http.StatusBadRequest: "Invalid override"},
+		ErrorBody:   overrides.ErrorResponse{},
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodDelete,
+		Path:        "/api/overrides",
+		OperationID: "deleteOverride",
+		Summary:     "Remove a category override",
+		Tags:        []string{"overrides"},
+		Query: []Parameter{{
+			Name: "id", In: "query", Required: true, Description: "Override ID", Schema: Schema{"type": "string"},
+		}},
+		Errors:    map[int]string{http.StatusNotFound: "Override not found"},
+		ErrorBody: overrides.ErrorResponse{},
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/review",
+		OperationID: "listReviewItems",
+		Summary:     "List the caller's low-confidence and \"Other\" classifications awaiting review",
+		Tags:        []string{"review"},
+		Query: []Parameter{{
+			Name: "status", In: "query", Description: "pending (default), corrected, dismissed or all",
+			Schema: Schema{"type": "string", "enum": []string{"pending", "corrected", "dismissed", "all"}},
+		}},
+		Response:  review.ListResponse{},
+		Errors:    map[int]string{http.StatusBadRequest: "Unknown status"},
+		ErrorBody: review.ErrorResponse{},
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodDelete,
+		Path:        "/api/review",
+		OperationID: "dismissReviewItem",
+		Summary:     "Dismiss a review item without correcting it",
+		Tags:        []string{"review"},
+		Query: []Parameter{{
+			Name: "id", In: "query", Required: true, Description: "Review item ID", Schema: Schema{"type": "string"},
+		}},
+		Errors:    map[int]string{http.StatusNotFound: "Review item not found"},
+		ErrorBody: review.ErrorResponse{},
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/review/corrections",
+		OperationID: "listCorrections",
+		Summary:     "List the caller's corrections",
+		Tags:        []string{"review"},
+		Response:    review.CorrectionListResponse{},
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodPost,
+		Path:        "/api/review/corrections",
+		OperationID: "correctClassification",
+		Summary:     "Correct a review item or narration; the correction applies to every transaction with the same narration fingerprint",
+		Tags:        []string{"review"},
+		Request:     review.CorrectionRequest{},
+		Response:    review.CorrectionResponse{},
+		Status:      http.StatusCreated,
+		Errors: map[int]string{
+			http.StatusBadRequest: "Invalid correction",
+			http.StatusNotFound:   "Review item not found",
+		},
+		ErrorBody: review.ErrorResponse{},
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/review/report",
+		OperationID: "correctionReport",
+		Summary:     "Rules the caller's tenant corrects most often",
+		Tags:        []string{"review"},
+		Response:    review.ReportResponse{},
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/api/webhooks",
+		OperationID: "listWebhooks",
+		Summary:     "List the caller's webhook subscriptions (secrets omitted)",
+		Tags:        []string{"webhooks"},
+		Response:    webhooks.SubscriptionListResponse{},
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodPost,
+		Path:        "/api/webhooks",
+		OperationID: "createWebhook",
+		Summary:     "Subscribe a URL to events; the signing secret is only returned here",
+		Tags:        []string{"webhooks"},
+		Request:     webhooks.Subscription{},
+		Response:    webhooks.SubscriptionResponse{},
+		Status:      http.StatusCreated,
+		Errors:      map[int]string{http.StatusBadRequest: "Invalid subscription"},
+		ErrorBody:   webhooks.ErrorResponse{},
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodDelete,
+		Path:        "/api/webhooks",
+		OperationID: "deleteWebhook",
+		Summary:     "Remove a webhook subscription",
+		Tags:        []string{"webhooks"},
+		Query: []Parameter{{
+			Name: "id", In: "query", Required: true, Description: "Subscription ID", Schema: Schema{"type": "string"},
+		}},
+		Errors:    map[int]string{http.StatusNotFound: "Subscription not found"},
+		ErrorBody: webhooks.ErrorResponse{},
+	})
+
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/metrics",
+		OperationID: "metrics",
+		Summary:     "Prometheus metrics",
+		Tags:        []string{"operations"},
+		ContentType: "text/plain",
+		Public:      true,
+	})
+	b.Add(Endpoint{
+		Method:      http.MethodGet,
+		Path:        "/openapi.json",
+		OperationID: "openapi",
+		Summary:     "This document",
+		Tags:        []string{"operations"},
+		Response:    map[string]interface{}{},
+		Public:      true,
+	})
+
+	return b.Document()
+}
@@ -21,6 +21,7 @@ type QueryParameterSchema struct {
 type ResponseSchema struct {
 	Type       string                 `json:"type"`
 	Properties map[string]interface{} `json:"properties"`
+	Required   []string               `json:"required,omitempty"`
 }
 
 // GenerateOpenAPISchema generates OpenAPI 3.0 schema for pagination.
@@ -107,7 +108,7 @@ func GenerateOpenAPISchema(cfg Config, itemSchema map[string]interface{}) OpenAP
 					},
 				},
 			},
-			"required": []string{"data", "pagination"},
+			Required: []string{"data", "pagination"},
 		},
 	}
 }
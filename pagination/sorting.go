@@ -70,7 +70,7 @@ func ParseSortFields(sortStr, orderStr string) []SortField {
 // ToSQLOrderBy converts sort fields to SQL ORDER BY clause.
 // Example: []SortField{{Field: "name", Order: "asc"}, {Field: "created_at", Order: "desc"}}
 // Returns: "ORDER BY name ASC, created_at DESC"
-func (sf []SortField) ToSQLOrderBy() string {
+func ToSQLOrderBy(sf []SortField) string {
 	if len(sf) == 0 {
 		return ""
 	}
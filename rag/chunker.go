@@ -307,12 +307,38 @@ func (c *Chunker) formatCategorySummary(categories map[string]interface{}) strin
 	parts = append(parts, "Category Summary:")
 	
 	for category, amount := range categories {
+		switch category {
+		case "tree", "taxonomyVersion":
+			continue // Formatted below
+		}
 		parts = append(parts, fmt.Sprintf("%s: ₹%.2f", category, toFloat64(amount)))
 	}
 	
+	// The category tree also covers income, investments and transfers
+	if tree, ok := categories["tree"].([]interface{}); ok && len(tree) > 0 {
+		parts = append(parts, "", "All Transactions by Category Group:")
+		parts = appendCategoryTree(parts, tree, "")
+	}
+	
 	return strings.Join(parts, "\n")
 }
 
+// appendCategoryTree formats category tree nodes, indenting children under their parent
+func appendCategoryTree(parts []string, nodes []interface{}, indent string) []string {
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s%s: spent ₹%.2f, received ₹%.2f (%d transactions)",
+			indent, toString(node["category"]), toFloat64(node["spent"]), toFloat64(node["received"]), toInt(node["count"])))
+		if children, ok := node["children"].([]interface{}); ok {
+			parts = appendCategoryTree(parts, children, indent+"  ")
+		}
+	}
+	return parts
+}
+
 func (c *Chunker) formatTopBeneficiaries(beneficiaries []interface{}) string {
 	var parts []string
 	parts = append(parts, "Top Beneficiaries:")
@@ -20,7 +20,7 @@ type Config struct {
 	SimilarityThreshold float32 // Minimum similarity score (default: 0.3)
 
 	// Vector store configuration
-	UsePostgreSQL bool
+	UsePostgreSQL bool // Require pgvector instead of falling back to memory
 	PostgreSQLDSN string
 	TableName     string
 
@@ -62,7 +62,7 @@ func DefaultConfig() *Config {
 		ChunkOverlap:        0.12, // 12% overlap
 		TopK:                5,
 		SimilarityThreshold: 0.3,   // Minimum similarity score
-		UsePostgreSQL:       false, // Will be auto-detected
+		UsePostgreSQL:       false, // pgvector when POSTGRES_DSN works, memory otherwise
 		PostgreSQLDSN:       os.Getenv("POSTGRES_DSN"),
 		TableName:           "statement_chunks",
 		EmbeddingDims:       embeddingDims,
@@ -2,12 +2,16 @@ package rag
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"time"
+
+	"classify/logging"
+	"classify/metrics"
 )
 
 // EmbeddingClient handles embedding generation via Ollama
@@ -39,6 +43,11 @@ type EmbeddingResponse struct {
 
 // GenerateEmbedding generates an embedding for a single text
 func (e *EmbeddingClient) GenerateEmbedding(text string) ([]float32, error) {
+	return e.GenerateEmbeddingContext(context.Background(), text)
+}
+
+// GenerateEmbeddingContext is GenerateEmbedding bound to ctx (the request ID is forwarded to Ollama)
+func (e *EmbeddingClient) GenerateEmbeddingContext(ctx context.Context, text string) ([]float32, error) {
 	req := EmbeddingRequest{
 		Model:  e.config.EmbeddingModel,
 		Prompt: text,
@@ -50,7 +59,16 @@ func (e *EmbeddingClient) GenerateEmbedding(text string) ([]float32, error) {
 	}
 	
 	url := e.config.GetEmbeddingURL()
-	resp, err := e.client.Post(url, "application/json", bytes.NewBuffer(reqBody))
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embedding request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	logging.SetRequestHeader(httpReq)
+
+	start := time.Now()
+	resp, err := e.client.Do(httpReq)
+	metrics.ObserveLLMResponse("ollama", "embedding", start, resp, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call Ollama embeddings API: %w", err)
 	}
@@ -78,6 +96,12 @@ func (e *EmbeddingClient) GenerateEmbedding(text string) ([]float32, error) {
 // GenerateEmbeddingsBatch generates embeddings for multiple texts concurrently
 // Uses goroutines to parallelize embedding generation for better performance
 func (e *EmbeddingClient) GenerateEmbeddingsBatch(texts []string) ([][]float32, error) {
+	return e.GenerateEmbeddingsBatchContext(context.Background(), texts)
+}
+
+// GenerateEmbeddingsBatchContext is GenerateEmbeddingsBatch bound to ctx
+func (e *EmbeddingClient) GenerateEmbeddingsBatchContext(ctx context.Context, texts []string) ([][]float32, error) {
+	logger := logging.FromContext(ctx)
 	embeddings := make([][]float32, len(texts))
 	type result struct {
 		index     int
@@ -98,13 +122,7 @@ func (e *EmbeddingClient) GenerateEmbeddingsBatch(texts []string) ([][]float32,
 			semaphore <- struct{}{}
 			defer func() { <-semaphore }()
 			
-			log.Printf("Generating embedding %d/%d...", idx+1, len(texts))
-			embedding, err := e.GenerateEmbedding(txt)
-			if err != nil {
-				log.Printf("Failed to generate embedding %d: %v", idx, err)
-			} else {
-				log.Printf("Successfully generated embedding %d/%d", idx+1, len(texts))
-			}
+			embedding, err := e.GenerateEmbeddingContext(ctx, txt)
 			results <- result{
 				index:     idx,
 				embedding: embedding,
@@ -118,7 +136,7 @@ func (e *EmbeddingClient) GenerateEmbeddingsBatch(texts []string) ([][]float32,
 	for i := 0; i < len(texts); i++ {
 		res := <-results
 		if res.err != nil {
-			log.Printf("Warning: failed to generate embedding for text %d: %v", res.index, res.err)
+			logger.Warn("failed to generate embedding", slog.Int("index", res.index), slog.Any("error", res.err))
 			// Leave embeddings[res.index] as nil
 		} else {
 			embeddings[res.index] = res.embedding
@@ -130,18 +148,23 @@ func (e *EmbeddingClient) GenerateEmbeddingsBatch(texts []string) ([][]float32,
 		return nil, fmt.Errorf("failed to generate any embeddings")
 	}
 	
-	log.Printf("Successfully generated %d/%d embeddings", successCount, len(texts))
+	logger.Info("generated embeddings", slog.Int("succeeded", successCount), slog.Int("total", len(texts)))
 	return embeddings, nil
 }
 
 // EmbedChunks generates embeddings for all chunks
 func (e *EmbeddingClient) EmbedChunks(chunks []*Chunk) error {
+	return e.EmbedChunksContext(context.Background(), chunks)
+}
+
+// EmbedChunksContext is EmbedChunks bound to ctx
+func (e *EmbeddingClient) EmbedChunksContext(ctx context.Context, chunks []*Chunk) error {
 	texts := make([]string, len(chunks))
 	for i, chunk := range chunks {
 		texts[i] = chunk.Content
 	}
 	
-	embeddings, err := e.GenerateEmbeddingsBatch(texts)
+	embeddings, err := e.GenerateEmbeddingsBatchContext(ctx, texts)
 	if err != nil {
 		return fmt.Errorf("failed to generate embeddings: %w", err)
 	}
@@ -1,10 +1,13 @@
 package rag
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"sync"
 
+	"classify/auth"
+	"classify/logging"
 	"classify/rag/vectorstore"
 )
 
@@ -33,15 +36,19 @@ func NewManager(config *Config) (*Manager, error) {
 	var vs vectorstore.VectorStore
 	if config.PostgreSQLDSN != "" {
 		pgStore, err := vectorstore.NewPGVectorStore(config.PostgreSQLDSN, config.TableName)
-		if err != nil {
-			log.Printf("WARNING: pgvector not available — falling back to in-memory vector store: %v", err)
+		if err != nil && config.UsePostgreSQL {
+			return nil, fmt.Errorf("pgvector store required: %w", err)
+		} else if err != nil {
+			slog.Warn("pgvector not available, falling back to in-memory vector store", slog.Any("error", err))
 			vs = vectorstore.NewMemoryVectorStore()
 		} else {
-			log.Println("Using PostgreSQL + pgvector for vector storage")
+			slog.Info("using PostgreSQL + pgvector for vector storage")
 			vs = pgStore
 		}
+	} else if config.UsePostgreSQL {
+		return nil, fmt.Errorf("pgvector store required but no PostgreSQL DSN provided")
 	} else {
-		log.Println("No PostgreSQL DSN provided — using in-memory vector store")
+		slog.Info("no PostgreSQL DSN provided, using in-memory vector store")
 		vs = vectorstore.NewMemoryVectorStore()
 	}
 
@@ -57,6 +64,11 @@ func NewManager(config *Config) (*Manager, error) {
 
 // HasChunks checks if chunks already exist for a source ID
 func (m *Manager) HasChunks(sourceID string) (bool, error) {
+	return m.HasChunksContext(context.Background(), sourceID)
+}
+
+// HasChunksContext checks if the caller's tenant (see auth.TenantID) already has chunks for a source ID
+func (m *Manager) HasChunksContext(ctx context.Context, sourceID string) (bool, error) {
 	if !m.initialized {
 		return false, fmt.Errorf("RAG manager not initialized")
 	}
@@ -65,7 +77,7 @@ func (m *Manager) HasChunks(sourceID string) (bool, error) {
 	defer m.mu.RUnlock()
 
 	// Try to retrieve chunks - if we get any, they exist
-	chunks, _, err := m.vectorStore.Search(make([]float32, m.config.EmbeddingDims), 1, sourceID)
+	chunks, _, err := m.vectorStore.Search(make([]float32, m.config.EmbeddingDims), 1, auth.TenantID(ctx), sourceID)
 	if err != nil {
 		return false, err
 	}
@@ -84,14 +96,21 @@ func (m *Manager) HasChunks(sourceID string) (bool, error) {
 // This is the main entry point for indexing: Chunk → Embed → Store
 // If chunks already exist for this sourceID, they will be replaced
 func (m *Manager) IndexStatementData(statementData interface{}, sourceID string) error {
+	return m.IndexStatementDataContext(context.Background(), statementData, sourceID)
+}
+
+// IndexStatementDataContext is IndexStatementData with a request context for logging and cancellation
+func (m *Manager) IndexStatementDataContext(ctx context.Context, statementData interface{}, sourceID string) error {
+	logger := logging.FromContext(ctx).With(slog.String("source_id", sourceID))
 	if !m.initialized {
 		return fmt.Errorf("RAG manager not initialized")
 	}
+	tenantID := auth.TenantID(ctx)
 
 	// Check if chunks already exist BEFORE acquiring lock (to avoid deadlock)
-	hasChunks, err := m.HasChunks(sourceID)
+	hasChunks, err := m.HasChunksContext(ctx, sourceID)
 	if err == nil && hasChunks {
-		log.Printf("Chunks already exist for source %s, replacing...", sourceID)
+		logger.Info("chunks already exist, replacing")
 	}
 
 	m.mu.Lock()
@@ -99,29 +118,25 @@ func (m *Manager) IndexStatementData(statementData interface{}, sourceID string)
 
 	// Delete old chunks if they exist (now that we have the lock)
 	if hasChunks {
-		if err := m.vectorStore.DeleteBySourceID(sourceID); err != nil {
-			log.Printf("Warning: failed to delete old chunks: %v", err)
+		if err := m.vectorStore.DeleteBySourceID(tenantID, sourceID); err != nil {
+			logger.Warn("failed to delete old chunks", slog.Any("error", err))
 			// Continue anyway - new chunks will have different IDs
 		}
 	}
 
 	// Step 1: Chunk the statement data
-	log.Printf("Chunking statement data for source: %s", sourceID)
 	chunks, err := m.chunker.ChunkStatementData(statementData, sourceID)
 	if err != nil {
-		log.Printf("Error during chunking: %v", err)
 		return fmt.Errorf("failed to chunk statement data: %w", err)
 	}
-
-	log.Printf("Created %d chunks", len(chunks))
+	logger.Info("chunked statement data", slog.Int("chunks", len(chunks)))
 
 	if len(chunks) == 0 {
 		return fmt.Errorf("no chunks created from statement data")
 	}
 
 	// Step 2: Generate embeddings
-	log.Printf("Generating embeddings for %d chunks", len(chunks))
-	if err := m.embeddingClient.EmbedChunks(chunks); err != nil {
+	if err := m.embeddingClient.EmbedChunksContext(ctx, chunks); err != nil {
 		return fmt.Errorf("failed to generate embeddings: %w", err)
 	}
 
@@ -131,7 +146,7 @@ func (m *Manager) IndexStatementData(statementData interface{}, sourceID string)
 		if len(chunk.Embedding) > 0 {
 			validChunks = append(validChunks, chunk)
 		} else {
-			log.Printf("Warning: chunk %s has no embedding, skipping", chunk.ID)
+			logger.Warn("chunk has no embedding, skipping", slog.String("chunk_id", chunk.ID))
 		}
 	}
 
@@ -139,14 +154,15 @@ func (m *Manager) IndexStatementData(statementData interface{}, sourceID string)
 		return fmt.Errorf("no valid chunks with embeddings to store")
 	}
 
-	log.Printf("Storing %d valid chunks (filtered from %d total)", len(validChunks), len(chunks))
 
 	// Step 4: Store in vector store
-	// Convert rag.Chunk to vectorstore.Chunk
+	// Convert rag.Chunk to vectorstore.Chunk; IDs are prefixed with the tenant so
+	// identical statements uploaded by different tenants never share a row
 	vsChunks := make([]*vectorstore.Chunk, len(validChunks))
 	for i, chunk := range validChunks {
 		vsChunks[i] = &vectorstore.Chunk{
-			ID:        chunk.ID,
+			ID:        tenantID + "/" + chunk.ID,
+			TenantID:  tenantID,
 			SourceID:  chunk.SourceID,
 			Content:   chunk.Content,
 			Embedding: chunk.Embedding,
@@ -157,7 +173,7 @@ func (m *Manager) IndexStatementData(statementData interface{}, sourceID string)
 		return fmt.Errorf("failed to store chunks: %w", err)
 	}
 
-	log.Printf("Successfully indexed statement data: %d chunks stored", len(validChunks))
+	logger.Info("indexed statement data", slog.Int("stored", len(validChunks)), slog.Int("chunks", len(chunks)))
 	return nil
 }
 
@@ -175,6 +191,11 @@ func (m *Manager) RetrieveRelevantChunks(query string, sourceID string) ([]*Chun
 
 // RetrieveRelevantChunksWithScores retrieves relevant chunks with similarity scores
 func (m *Manager) RetrieveRelevantChunksWithScores(query string, sourceID string) ([]RetrievedChunk, error) {
+	return m.RetrieveRelevantChunksWithScoresContext(context.Background(), query, sourceID)
+}
+
+// RetrieveRelevantChunksWithScoresContext is RetrieveRelevantChunksWithScores with a request context
+func (m *Manager) RetrieveRelevantChunksWithScoresContext(ctx context.Context, query string, sourceID string) ([]RetrievedChunk, error) {
 	if !m.initialized {
 		return nil, fmt.Errorf("RAG manager not initialized")
 	}
@@ -182,11 +203,16 @@ func (m *Manager) RetrieveRelevantChunksWithScores(query string, sourceID string
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	return m.retriever.RetrieveWithScores(query, sourceID)
+	return m.retriever.RetrieveWithScoresContext(ctx, query, sourceID)
 }
 
 // DeleteStatementData deletes all chunks for a given source ID
 func (m *Manager) DeleteStatementData(sourceID string) error {
+	return m.DeleteStatementDataContext(context.Background(), sourceID)
+}
+
+// DeleteStatementDataContext deletes the caller's tenant's chunks for a given source ID
+func (m *Manager) DeleteStatementDataContext(ctx context.Context, sourceID string) error {
 	if !m.initialized {
 		return fmt.Errorf("RAG manager not initialized")
 	}
@@ -194,7 +220,23 @@ func (m *Manager) DeleteStatementData(sourceID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	return m.vectorStore.DeleteBySourceID(sourceID)
+	return m.vectorStore.DeleteBySourceID(auth.TenantID(ctx), sourceID)
+}
+
+// Ping checks that the vector store is reachable (always nil for the in-memory store)
+func (m *Manager) Ping(ctx context.Context) error {
+	if pinger, ok := m.vectorStore.(interface{ Ping(context.Context) error }); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// Close releases the vector store's resources
+func (m *Manager) Close() error {
+	if closer, ok := m.vectorStore.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
 }
 
 // GetVectorStore returns the vector store (for testing/debugging)
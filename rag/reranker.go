@@ -1,9 +1,12 @@
 package rag
 
 import (
-	"log"
+	"context"
+	"log/slog"
 	"sort"
 	"strings"
+
+	"classify/logging"
 )
 
 // Reranker re-ranks chunks based on query relevance
@@ -22,6 +25,11 @@ func NewReranker(embeddingClient *EmbeddingClient, config *Config) *Reranker {
 
 // RerankChunks re-ranks chunks using query-chunk relevance scoring
 func (r *Reranker) RerankChunks(query string, chunks []RetrievedChunk) ([]RetrievedChunk, error) {
+	return r.RerankChunksContext(context.Background(), query, chunks)
+}
+
+// RerankChunksContext is RerankChunks with a request context for logging
+func (r *Reranker) RerankChunksContext(ctx context.Context, query string, chunks []RetrievedChunk) ([]RetrievedChunk, error) {
 	if len(chunks) <= 1 {
 		return chunks, nil
 	}
@@ -84,8 +92,11 @@ func (r *Reranker) RerankChunks(query string, chunks []RetrievedChunk) ([]Retrie
 		reranked[i].Score = sc.score // Update score
 	}
 
-	log.Printf("Reranked %d chunks (score range: %.3f - %.3f)", len(reranked), 
-		reranked[len(reranked)-1].Score, reranked[0].Score)
+	logging.FromContext(ctx).Debug("reranked chunks",
+		slog.Int("chunks", len(reranked)),
+		slog.Float64("min_score", float64(reranked[len(reranked)-1].Score)),
+		slog.Float64("max_score", float64(reranked[0].Score)),
+	)
 
 	return reranked, nil
 }
@@ -1,9 +1,12 @@
 package rag
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	
+	"classify/auth"
+	"classify/logging"
 	"classify/rag/vectorstore"
 )
 
@@ -47,22 +50,29 @@ func (r *Retriever) Retrieve(query string, sourceID string) ([]*Chunk, error) {
 
 // RetrieveWithScores finds the most relevant chunks with their similarity scores
 func (r *Retriever) RetrieveWithScores(query string, sourceID string) ([]RetrievedChunk, error) {
+	return r.RetrieveWithScoresContext(context.Background(), query, sourceID)
+}
+
+// RetrieveWithScoresContext is RetrieveWithScores with a request context for logging and cancellation
+func (r *Retriever) RetrieveWithScoresContext(ctx context.Context, query string, sourceID string) ([]RetrievedChunk, error) {
+	logger := logging.FromContext(ctx).With(slog.String("source_id", sourceID))
+
 	// Enhance query before embedding
 	enhancer := NewQueryEnhancer(r.config)
 	enhancedQuery := enhancer.EnhanceQuery(query)
 	
 	if enhancedQuery != query {
-		log.Printf("Query enhanced: '%s' -> '%s'", query, enhancedQuery)
+		logger.Debug("query enhanced", slog.String("query", query), slog.Int("enhanced_length", len(enhancedQuery)))
 	}
 	
 	// Generate embedding for the enhanced query
-	queryEmbedding, err := r.embeddingClient.GenerateEmbedding(enhancedQuery)
+	queryEmbedding, err := r.embeddingClient.GenerateEmbeddingContext(ctx, enhancedQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate query embedding: %w", err)
 	}
 	
 	// Search for similar chunks
-	vsChunks, scores, err := r.vectorStore.Search(queryEmbedding, r.config.TopK, sourceID)
+	vsChunks, scores, err := r.vectorStore.Search(queryEmbedding, r.config.TopK, auth.TenantID(ctx), sourceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search vector store: %w", err)
 	}
@@ -104,24 +114,26 @@ func (r *Retriever) RetrieveWithScores(query string, sourceID string) ([]Retriev
 		if cws.Score >= similarityThreshold {
 			filtered = append(filtered, cws)
 		} else {
-			log.Printf("Filtered out chunk %s with low similarity: %.3f", cws.Chunk.ID, cws.Score)
+			logger.Debug("filtered out chunk with low similarity", slog.String("chunk_id", cws.Chunk.ID), slog.Float64("score", float64(cws.Score)))
 		}
 	}
 	
 	if len(filtered) == 0 && len(chunksWithScores) > 0 {
 		// If all chunks were filtered but we have some, return at least the top one
-		log.Printf("Warning: All chunks filtered by similarity threshold, returning top chunk anyway")
+		logger.Warn("all chunks below similarity threshold, returning top chunk anyway",
+			slog.Float64("threshold", float64(similarityThreshold)))
 		return chunksWithScores[:1], nil
 	}
 	
 	// Re-rank chunks for better relevance
 	reranker := NewReranker(r.embeddingClient, r.config)
-	reranked, err := reranker.RerankChunks(query, filtered)
+	reranked, err := reranker.RerankChunksContext(ctx, query, filtered)
 	if err != nil {
-		log.Printf("Warning: Reranking failed, using original order: %v", err)
+		logger.Warn("reranking failed, using original order", slog.Any("error", err))
 		return filtered, nil
 	}
 	
+	logger.Info("retrieved chunks", slog.Int("candidates", len(chunksWithScores)), slog.Int("returned", len(reranked)))
 	return reranked, nil
 }
 
@@ -3,6 +3,7 @@ package vectorstore
 // Chunk represents a chunk for vector storage (to avoid import cycles)
 type Chunk struct {
 	ID        string
+	TenantID  string // Owner tenant; searches and deletes never cross tenants
 	SourceID  string
 	Content   string
 	Embedding []float32
@@ -17,11 +18,12 @@ type VectorStore interface {
 	// StoreBatch stores multiple chunks
 	StoreBatch(chunks []*Chunk) error
 	
-	// Search performs similarity search and returns top K chunks with scores
-	Search(queryEmbedding []float32, topK int, sourceID string) ([]*Chunk, []float32, error)
+	// Search performs similarity search within a tenant and returns top K chunks with scores
+	// An empty sourceID searches all of the tenant's sources
+	Search(queryEmbedding []float32, topK int, tenantID, sourceID string) ([]*Chunk, []float32, error)
 	
-	// DeleteBySourceID deletes all chunks for a given source ID
-	DeleteBySourceID(sourceID string) error
+	// DeleteBySourceID deletes all of a tenant's chunks for a given source ID
+	DeleteBySourceID(tenantID, sourceID string) error
 	
 	// Count returns the number of stored chunks
 	Count() int
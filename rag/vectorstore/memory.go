@@ -56,7 +56,7 @@ func (m *MemoryVectorStore) StoreBatch(chunks []*Chunk) error {
 }
 
 // Search performs cosine similarity search
-func (m *MemoryVectorStore) Search(queryEmbedding []float32, topK int, sourceID string) ([]*Chunk, []float32, error) {
+func (m *MemoryVectorStore) Search(queryEmbedding []float32, topK int, tenantID, sourceID string) ([]*Chunk, []float32, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 	
@@ -70,6 +70,9 @@ func (m *MemoryVectorStore) Search(queryEmbedding []float32, topK int, sourceID
 	// Calculate cosine similarity for all chunks
 	for _, chunk := range m.chunks {
 		// Filter by sourceID if provided
+		if chunk.TenantID != tenantID {
+			continue
+		}
 		if sourceID != "" && chunk.SourceID != sourceID {
 			continue
 		}
@@ -111,12 +114,12 @@ func (m *MemoryVectorStore) Search(queryEmbedding []float32, topK int, sourceID
 }
 
 // DeleteBySourceID deletes all chunks for a given source ID
-func (m *MemoryVectorStore) DeleteBySourceID(sourceID string) error {
+func (m *MemoryVectorStore) DeleteBySourceID(tenantID, sourceID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	
 	for id, chunk := range m.chunks {
-		if chunk.SourceID == sourceID {
+		if chunk.TenantID == tenantID && chunk.SourceID == sourceID {
 			delete(m.chunks, id)
 		}
 	}
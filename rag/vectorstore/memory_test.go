@@ -0,0 +1,29 @@
+package vectorstore
+
+import "testing"
+
+func TestMemoryVectorStoreTenantIsolation(t *testing.T) {
+	store := NewMemoryVectorStore()
+	store.StoreBatch([]*Chunk{
+		{ID: "a/1", TenantID: "a", SourceID: "same-hash", Content: "tenant a", Embedding: []float32{1, 0}},
+		{ID: "b/1", TenantID: "b", SourceID: "same-hash", Content: "tenant b", Embedding: []float32{1, 0}},
+	})
+
+	for _, tenant := range []string{"a", "b"} {
+		chunks, _, err := store.Search([]float32{1, 0}, 10, tenant, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(chunks) != 1 || chunks[0].TenantID != tenant {
+			t.Errorf("tenant %s: expected only its own chunk, got %d chunks", tenant, len(chunks))
+		}
+	}
+
+	store.DeleteBySourceID("a", "same-hash")
+	if chunks, _, _ := store.Search([]float32{1, 0}, 10, "b", "same-hash"); len(chunks) != 1 {
+		t.Errorf("expected tenant b's chunk to survive tenant a's delete, got %d chunks", len(chunks))
+	}
+	if store.Count() != 1 {
+		t.Errorf("expected 1 chunk after delete, got %d", store.Count())
+	}
+}
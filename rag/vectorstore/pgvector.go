@@ -1,10 +1,11 @@
 package vectorstore
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 	
 	_ "github.com/lib/pq" // PostgreSQL driver
 )
@@ -39,7 +40,7 @@ func NewPGVectorStore(dsn string, tableName string) (*PGVectorStore, error) {
 	
 	if !exists {
 		db.Close()
-		log.Println("WARNING: pgvector extension not found — falling back to in-memory vector store")
+		slog.Warn("pgvector extension not found, falling back to in-memory vector store")
 		return nil, fmt.Errorf("pgvector extension not installed")
 	}
 	
@@ -62,6 +63,7 @@ func (p *PGVectorStore) createTable() error {
 	query := fmt.Sprintf(`
 		CREATE TABLE IF NOT EXISTS %s (
 			id TEXT PRIMARY KEY,
+			tenant_id TEXT NOT NULL DEFAULT '',
 			source_id TEXT NOT NULL,
 			content TEXT NOT NULL,
 			embedding vector(4096),
@@ -74,6 +76,12 @@ func (p *PGVectorStore) createTable() error {
 	if err != nil {
 		return fmt.Errorf("failed to create table: %w", err)
 	}
+
+	// Tables created before tenant isolation lack the column; their rows belong to no tenant
+	_, err = p.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT ''", p.tableName))
+	if err != nil {
+		return fmt.Errorf("failed to add tenant_id column: %w", err)
+	}
 	
 	// Create index for similarity search
 	indexQuery := fmt.Sprintf(`
@@ -86,13 +94,13 @@ func (p *PGVectorStore) createTable() error {
 	_, err = p.db.Exec(indexQuery)
 	if err != nil {
 		// Index creation might fail if not enough data, that's okay
-		log.Printf("Warning: failed to create vector index: %v", err)
+		slog.Warn("failed to create vector index", slog.Any("error", err))
 	}
 	
-	// Create index on source_id for filtering
-	_, err = p.db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s_source_id_idx ON %s(source_id)", p.tableName, p.tableName))
+	// Create index on (tenant_id, source_id) for filtering
+	_, err = p.db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s_tenant_source_idx ON %s(tenant_id, source_id)", p.tableName, p.tableName))
 	if err != nil {
-		log.Printf("Warning: failed to create source_id index: %v", err)
+		slog.Warn("failed to create tenant_id/source_id index", slog.Any("error", err))
 	}
 	
 	return nil
@@ -114,15 +122,16 @@ func (p *PGVectorStore) Store(chunk *Chunk) error {
 	metadataJSON, _ := json.Marshal(chunk.Metadata)
 	
 	query := fmt.Sprintf(`
-		INSERT INTO %s (id, source_id, content, embedding, metadata)
-		VALUES ($1, $2, $3, $4::vector, $5::jsonb)
+		INSERT INTO %s (id, tenant_id, source_id, content, embedding, metadata)
+		VALUES ($1, $2, $3, $4, $5::vector, $6::jsonb)
 		ON CONFLICT (id) DO UPDATE SET
 			content = EXCLUDED.content,
 			embedding = EXCLUDED.embedding,
 			metadata = EXCLUDED.metadata
-	`, p.tableName)
+		WHERE %s.tenant_id = EXCLUDED.tenant_id
+	`, p.tableName, p.tableName)
 	
-	_, err := p.db.Exec(query, chunk.ID, chunk.SourceID, chunk.Content, embeddingStr, string(metadataJSON))
+	_, err := p.db.Exec(query, chunk.ID, chunk.TenantID, chunk.SourceID, chunk.Content, embeddingStr, string(metadataJSON))
 	if err != nil {
 		return fmt.Errorf("failed to store chunk: %w", err)
 	}
@@ -134,7 +143,7 @@ func (p *PGVectorStore) Store(chunk *Chunk) error {
 func (p *PGVectorStore) StoreBatch(chunks []*Chunk) error {
 	for _, chunk := range chunks {
 		if err := p.Store(chunk); err != nil {
-			log.Printf("Warning: failed to store chunk %s: %v", chunk.ID, err)
+			slog.Warn("failed to store chunk", slog.String("chunk_id", chunk.ID), slog.Any("error", err))
 			// Continue with other chunks
 		}
 	}
@@ -142,7 +151,7 @@ func (p *PGVectorStore) StoreBatch(chunks []*Chunk) error {
 }
 
 // Search performs cosine similarity search using pgvector
-func (p *PGVectorStore) Search(queryEmbedding []float32, topK int, sourceID string) ([]*Chunk, []float32, error) {
+func (p *PGVectorStore) Search(queryEmbedding []float32, topK int, tenantID, sourceID string) ([]*Chunk, []float32, error) {
 	embeddingStr := formatVectorForPG(queryEmbedding)
 	
 	var query string
@@ -153,20 +162,21 @@ func (p *PGVectorStore) Search(queryEmbedding []float32, topK int, sourceID stri
 			SELECT id, source_id, content, embedding, metadata,
 				   1 - (embedding <=> $1::vector) as similarity
 			FROM %s
-			WHERE source_id = $2
+			WHERE tenant_id = $2 AND source_id = $3
 			ORDER BY embedding <=> $1::vector
-			LIMIT $3
+			LIMIT $4
 		`, p.tableName)
-		args = []interface{}{embeddingStr, sourceID, topK}
+		args = []interface{}{embeddingStr, tenantID, sourceID, topK}
 	} else {
 		query = fmt.Sprintf(`
 			SELECT id, source_id, content, embedding, metadata,
 				   1 - (embedding <=> $1::vector) as similarity
 			FROM %s
+			WHERE tenant_id = $2
 			ORDER BY embedding <=> $1::vector
-			LIMIT $2
+			LIMIT $3
 		`, p.tableName)
-		args = []interface{}{embeddingStr, topK}
+		args = []interface{}{embeddingStr, tenantID, topK}
 	}
 	
 	rows, err := p.db.Query(query, args...)
@@ -186,7 +196,7 @@ func (p *PGVectorStore) Search(queryEmbedding []float32, topK int, sourceID stri
 		
 		err := rows.Scan(&id, &sourceID, &content, &embeddingStr, &metadataJSON, &similarity)
 		if err != nil {
-			log.Printf("Warning: failed to scan row: %v", err)
+			slog.Warn("failed to scan row", slog.Any("error", err))
 			continue
 		}
 		
@@ -200,6 +210,7 @@ func (p *PGVectorStore) Search(queryEmbedding []float32, topK int, sourceID stri
 		
 		chunk := &Chunk{
 			ID:        id,
+			TenantID:  tenantID,
 			SourceID:  sourceID,
 			Content:   content,
 			Embedding: embedding,
@@ -213,10 +224,10 @@ func (p *PGVectorStore) Search(queryEmbedding []float32, topK int, sourceID stri
 	return chunks, scores, nil
 }
 
-// DeleteBySourceID deletes all chunks for a given source ID
-func (p *PGVectorStore) DeleteBySourceID(sourceID string) error {
-	query := fmt.Sprintf("DELETE FROM %s WHERE source_id = $1", p.tableName)
-	_, err := p.db.Exec(query, sourceID)
+// DeleteBySourceID deletes all of a tenant's chunks for a given source ID
+func (p *PGVectorStore) DeleteBySourceID(tenantID, sourceID string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE tenant_id = $1 AND source_id = $2", p.tableName)
+	_, err := p.db.Exec(query, tenantID, sourceID)
 	if err != nil {
 		return fmt.Errorf("failed to delete chunks: %w", err)
 	}
@@ -234,6 +245,11 @@ func (p *PGVectorStore) Count() int {
 	return count
 }
 
+// Ping checks that the database is reachable
+func (p *PGVectorStore) Ping(ctx context.Context) error {
+	return p.db.PingContext(ctx)
+}
+
 // Close closes the database connection
 func (p *PGVectorStore) Close() error {
 	return p.db.Close()
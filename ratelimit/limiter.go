@@ -0,0 +1,190 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Rule limits one endpoint for one subject
+type Rule struct {
+	RatePerMinute float64 `json:"ratePerMinute"` // Sustained request rate (0 = no rate limit)
+	Burst         int     `json:"burst"`         // Requests allowed back to back (default: one second of traffic, at least 1)
+	MonthlyQuota  int64   `json:"monthlyQuota"`  // Accepted requests per calendar month, UTC (0 = unlimited)
+}
+
+// Config holds the per-endpoint defaults and per-subject overrides
+type Config struct {
+	By        string                     `json:"by"`        // "tenant" (default) or "key"
+	Endpoints map[string]Rule            `json:"endpoints"` // Endpoint -> default rule
+	Overrides map[string]map[string]Rule `json:"overrides"` // Subject (tenant ID or key name) -> endpoint -> rule
+}
+
+// DefaultConfig limits chat (embedding + LLM calls) much harder than classify
+func DefaultConfig() *Config {
+	return &Config{
+		By: "tenant",
+		Endpoints: map[string]Rule{
+			"/classify": {RatePerMinute: 60, Burst: 10, MonthlyQuota: 10000},
+			"/api/chat": {RatePerMinute: 10, Burst: 3, MonthlyQuota: 2000},
+		},
+		Overrides: map[string]map[string]Rule{},
+	}
+}
+
+// LoadConfig reads a JSON config file; endpoints it omits keep their defaults
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate limit config: %w", err)
+	}
+	config := DefaultConfig()
+	loaded := &Config{}
+	if err := json.Unmarshal(data, loaded); err != nil {
+		return nil, fmt.Errorf("failed to parse rate limit config: %w", err)
+	}
+	if loaded.By != "" {
+		config.By = loaded.By
+	}
+	for endpoint, rule := range loaded.Endpoints {
+		config.Endpoints[endpoint] = rule
+	}
+	for subject, rules := range loaded.Overrides {
+		config.Overrides[subject] = rules
+	}
+	return config, config.validate()
+}
+
+// ConfigFromEnv returns the config to use, or nil when limiting is disabled:
+//
+//	RATE_LIMIT_DISABLED=true          turn rate limits and quotas off
+//	RATE_LIMIT_CONFIG=limits.json     JSON file merged over DefaultConfig
+func ConfigFromEnv() (*Config, error) {
+	// A mistyped switch must not silently leave limiting on or off
+	if v := os.Getenv("RATE_LIMIT_DISABLED"); v != "" {
+		disabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RATE_LIMIT_DISABLED %q: expected true or false", v)
+		}
+		if disabled {
+			return nil, nil
+		}
+	}
+	if path := os.Getenv("RATE_LIMIT_CONFIG"); path != "" {
+		return LoadConfig(path)
+	}
+	return DefaultConfig(), nil
+}
+
+func (c *Config) validate() error {
+	if c.By != "tenant" && c.By != "key" {
+		return fmt.Errorf("rate limit config: by must be \"tenant\" or \"key\", got %q", c.By)
+	}
+	return nil
+}
+
+// rule returns the rule for subject on endpoint (override > endpoint default)
+func (c *Config) rule(subject, endpoint string) (Rule, bool) {
+	if rules, ok := c.Overrides[subject]; ok {
+		if rule, ok := rules[endpoint]; ok {
+			return rule, true
+		}
+	}
+	rule, ok := c.Endpoints[endpoint]
+	return rule, ok
+}
+
+// Result describes a rate-limit decision
+type Result struct {
+	Allowed bool
+	Reason  string // "rate_limited" or "quota_exceeded" when not allowed
+
+	Limit      int // Burst size (0 when no rate limit applies)
+	Remaining  int
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+
+	QuotaLimit     int64 // 0 when no quota applies
+	QuotaRemaining int64
+	QuotaReset     time.Time
+}
+
+// Limiter applies a Config using a Store
+type Limiter struct {
+	store  Store
+	config *Config
+	Now    func() time.Time
+}
+
+// NewLimiter creates a limiter (nil store = MemoryStore, nil config = DefaultConfig)
+func NewLimiter(store Store, config *Config) *Limiter {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return &Limiter{store: store, config: config, Now: time.Now}
+}
+
+// Allow checks the rate limit and then the monthly quota for subject on endpoint
+// Only requests that pass the rate limit count against the quota, and requests over the quota
+// give their rate token back
+func (l *Limiter) Allow(ctx context.Context, endpoint, subject string) (Result, error) {
+	rule, ok := l.config.rule(subject, endpoint)
+	if !ok {
+		return Result{Allowed: true}, nil
+	}
+	now := l.Now().UTC()
+	result := Result{Allowed: true}
+
+	rateKey := "rate:" + endpoint + ":" + subject
+	rate := rule.RatePerMinute / 60
+	burst := rule.Burst
+	if burst < 1 {
+		burst = int(rate) + 1
+	}
+	if rule.RatePerMinute > 0 {
+		state, err := l.store.TakeToken(ctx, rateKey, rate, burst, now)
+		if err != nil {
+			return Result{}, fmt.Errorf("rate limit store: %w", err)
+		}
+		result.Limit = burst
+		result.Remaining = state.Remaining
+		result.RetryAfter = state.RetryAfter
+		result.ResetAfter = state.ResetAfter
+		if !state.Allowed {
+			result.Allowed = false
+			result.Reason = "rate_limited"
+			return result, nil
+		}
+	}
+
+	if rule.MonthlyQuota > 0 {
+		period := now.Format("2006-01")
+		used, ok, err := l.store.IncrementUsage(ctx, "quota:"+period+":"+endpoint+":"+subject, rule.MonthlyQuota, now)
+		if err != nil {
+			return Result{}, fmt.Errorf("quota store: %w", err)
+		}
+		result.QuotaLimit = rule.MonthlyQuota
+		result.QuotaRemaining = rule.MonthlyQuota - used
+		result.QuotaReset = time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		if !ok {
+			result.Allowed = false
+			result.Reason = "quota_exceeded"
+			result.RetryAfter = result.QuotaReset.Sub(now)
+			if rule.RatePerMinute > 0 {
+				state, err := l.store.ReturnToken(ctx, rateKey, rate, burst, now)
+				if err != nil {
+					return Result{}, fmt.Errorf("rate limit store: %w", err)
+				}
+				result.Remaining = state.Remaining
+				result.ResetAfter = state.ResetAfter
+			}
+		}
+	}
+	return result, nil
+}
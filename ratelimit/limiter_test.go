@@ -0,0 +1,232 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"classify/auth"
+)
+
+func newTestLimiter(config *Config, now *time.Time) *Limiter {
+	l := NewLimiter(nil, config)
+	l.Now = func() time.Time { return *now }
+	return l
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Date(2025, 12, 17, 10, 0, 0, 0, time.UTC)
+	l := newTestLimiter(&Config{By: "tenant", Endpoints: map[string]Rule{
+		"/api/chat": {RatePerMinute: 60, Burst: 2},
+	}}, &now)
+	ctx := context.Background()
+
+	tests := []struct {
+		advance time.Duration
+		allowed bool
+	}{
+		{0, true},
+		{0, true},
+		{0, false},                      // burst spent
+		{500 * time.Millisecond, false}, // half a token
+		{500 * time.Millisecond, true},  // one token refilled
+		{10 * time.Second, true},        // refills cap at burst
+		{0, true},
+		{0, false},
+	}
+	for i, tt := range tests {
+		now = now.Add(tt.advance)
+		result, err := l.Allow(ctx, "/api/chat", "acme")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Allowed != tt.allowed {
+			t.Errorf("request %d: expected allowed=%v, got %v", i, tt.allowed, result.Allowed)
+		}
+		if !result.Allowed && (result.Reason != "rate_limited" || result.RetryAfter <= 0) {
+			t.Errorf("request %d: expected rate_limited with retry, got %q %v", i, result.Reason, result.RetryAfter)
+		}
+	}
+
+	// Other subjects have their own bucket
+	if result, _ := l.Allow(ctx, "/api/chat", "globex"); !result.Allowed {
+		t.Errorf("expected a separate bucket per subject")
+	}
+}
+
+func TestMonthlyQuota(t *testing.T) {
+	now := time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC)
+	l := newTestLimiter(&Config{By: "tenant",
+		Endpoints: map[string]Rule{"/classify": {MonthlyQuota: 2}},
+		Overrides: map[string]map[string]Rule{"big": {"/classify": {MonthlyQuota: 5}}},
+	}, &now)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if result, _ := l.Allow(ctx, "/classify", "acme"); !result.Allowed {
+			t.Fatalf("request %d: expected allowed within quota", i)
+		}
+	}
+	result, _ := l.Allow(ctx, "/classify", "acme")
+	if result.Allowed || result.Reason != "quota_exceeded" {
+		t.Errorf("expected quota_exceeded, got allowed=%v reason=%q", result.Allowed, result.Reason)
+	}
+	if expected := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); !result.QuotaReset.Equal(expected) {
+		t.Errorf("expected quota reset %v, got %v", expected, result.QuotaReset)
+	}
+
+	// Overrides raise the quota for one subject
+	for i := 0; i < 5; i++ {
+		if result, _ := l.Allow(ctx, "/classify", "big"); !result.Allowed {
+			t.Errorf("override request %d: expected allowed", i)
+		}
+	}
+
+	// A new month starts a fresh counter
+	now = now.Add(2 * time.Hour)
+	if result, _ := l.Allow(ctx, "/classify", "acme"); !result.Allowed {
+		t.Errorf("expected quota to reset in the new month")
+	}
+}
+
+func TestQuotaExceededKeepsRateTokens(t *testing.T) {
+	now := time.Date(2025, 12, 17, 10, 0, 0, 0, time.UTC)
+	l := newTestLimiter(&Config{By: "tenant", Endpoints: map[string]Rule{
+		"/classify": {RatePerMinute: 60, Burst: 3, MonthlyQuota: 1},
+	}}, &now)
+	ctx := context.Background()
+
+	result, _ := l.Allow(ctx, "/classify", "acme")
+	if !result.Allowed || result.Remaining != 2 {
+		t.Fatalf("expected the first request allowed with 2 tokens left, got allowed=%v remaining=%d", result.Allowed, result.Remaining)
+	}
+	for i := 0; i < 2; i++ {
+		result, _ := l.Allow(ctx, "/classify", "acme")
+		if result.Allowed || result.Reason != "quota_exceeded" {
+			t.Fatalf("request %d: expected quota_exceeded, got allowed=%v reason=%q", i, result.Allowed, result.Reason)
+		}
+		if result.Limit != 3 || result.Remaining != 2 {
+			t.Errorf("request %d: expected 2 of 3 tokens left, got %d of %d", i, result.Remaining, result.Limit)
+		}
+	}
+}
+
+func TestConfigFromEnvDisabled(t *testing.T) {
+	tests := []struct {
+		value    string
+		disabled bool
+		valid    bool
+	}{
+		{"", false, true},
+		{"true", true, true},
+		{"1", true, true},
+		{"false", false, true},
+		{"0", false, true},
+		{"yes", false, false},
+		{"on", false, false},
+		{"TRUE1", false, false},
+	}
+	t.Setenv("RATE_LIMIT_CONFIG", "")
+	for _, tt := range tests {
+		t.Setenv("RATE_LIMIT_DISABLED", tt.value)
+		config, err := ConfigFromEnv()
+		if (err == nil) != tt.valid {
+			t.Errorf("%q: expected valid=%v, got %v", tt.value, tt.valid, err)
+			continue
+		}
+		if err == nil && (config == nil) != tt.disabled {
+			t.Errorf("%q: expected disabled=%v, got config %v", tt.value, tt.disabled, config)
+		}
+	}
+}
+
+func TestMemoryStoreEviction(t *testing.T) {
+	now := time.Date(2025, 12, 17, 10, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	// 1 token/second, burst 5: two requests leave the bucket full again after 2s
+	for i := 0; i < 2; i++ {
+		store.TakeToken(ctx, "rate:idle", 1, 5, now)
+	}
+	store.TakeToken(ctx, "rate:blocked", 0, 1, now)
+	store.TakeToken(ctx, "rate:blocked", 0, 1, now)
+	store.IncrementUsage(ctx, "quota:2025-12:idle", 10, now)
+
+	// Within a sweep interval nothing is dropped
+	store.TakeToken(ctx, "rate:busy", 1, 5, now.Add(30*time.Second))
+	if len(store.buckets) != 3 || len(store.usage) != 1 {
+		t.Fatalf("expected 3 buckets and 1 counter, got %d and %d", len(store.buckets), len(store.usage))
+	}
+
+	// The refilled bucket goes; a bucket that never refills and a recent counter stay
+	store.IncrementUsage(ctx, "quota:2025-12:busy", 10, now.Add(time.Minute))
+	if _, ok := store.buckets["rate:idle"]; ok {
+		t.Error("expected the refilled bucket to be evicted")
+	}
+	if _, ok := store.buckets["rate:blocked"]; !ok {
+		t.Error("expected the empty bucket without refill to be kept")
+	}
+	if len(store.usage) != 2 {
+		t.Errorf("expected both counters to be kept, got %d", len(store.usage))
+	}
+
+	// A counter idle for longer than any month goes; the blocked bucket still refuses
+	later := now.Add(usageIdleTTL)
+	store.IncrementUsage(ctx, "quota:2026-01:busy", 10, later.Add(-time.Hour))
+	state, _ := store.TakeToken(ctx, "rate:blocked", 0, 1, later)
+	if state.Allowed {
+		t.Error("expected the bucket without refill to stay empty")
+	}
+	if _, ok := store.usage["quota:2025-12:idle"]; ok {
+		t.Error("expected the idle counter to be evicted")
+	}
+	if _, ok := store.usage["quota:2026-01:busy"]; !ok {
+		t.Error("expected the recent counter to be kept")
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	now := time.Date(2025, 12, 17, 10, 0, 0, 0, time.UTC)
+	l := newTestLimiter(&Config{By: "key", Endpoints: map[string]Rule{
+		"/api/chat": {RatePerMinute: 6, Burst: 1, MonthlyQuota: 100},
+	}}, &now)
+	handler := l.Middleware("/api/chat", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	send := func(subject string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/chat", nil)
+		principal := auth.Principal{Subject: subject, TenantID: "acme", Method: "api_key"}
+		req = req.WithContext(auth.WithPrincipal(req.Context(), principal))
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec
+	}
+
+	rec := send("frontend")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if rec.Header().Get("RateLimit-Limit") != "1" || rec.Header().Get("RateLimit-Remaining") != "0" {
+		t.Errorf("unexpected rate limit headers: %v", rec.Header())
+	}
+	if rec.Header().Get("X-Quota-Remaining") != "99" {
+		t.Errorf("expected X-Quota-Remaining 99, got %q", rec.Header().Get("X-Quota-Remaining"))
+	}
+
+	rec = send("frontend")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") != "10" {
+		t.Errorf("expected Retry-After 10, got %q", rec.Header().Get("Retry-After"))
+	}
+
+	// Limits are per key, so another key of the same tenant is unaffected
+	if rec := send("batch-job"); rec.Code != http.StatusOK {
+		t.Errorf("expected 200 for a different key, got %d", rec.Code)
+	}
+}
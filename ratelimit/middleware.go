@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"classify/auth"
+	"classify/logging"
+	"classify/metrics"
+)
+
+// Rejections counts requests refused by the limiter
+var Rejections = metrics.Default.NewCounter("ratelimit_rejections_total",
+	"Requests rejected by rate limits or quotas, by endpoint and reason", "endpoint", "reason")
+
+// subject identifies who the limits apply to: the tenant, or the API key / token subject
+func (l *Limiter) subject(p auth.Principal) string {
+	if l.config.By == "key" && p.Subject != "" {
+		return p.Subject
+	}
+	return p.TenantID
+}
+
+// Middleware enforces the limits for endpoint; it must run after auth.Middleware
+// Every response carries RateLimit-* headers (and X-Quota-* when a quota applies); rejected
+// requests get 429 with Retry-After and a JSON body naming the limit that was hit
+func (l *Limiter) Middleware(endpoint string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			next(w, r)
+			return
+		}
+
+		principal, _ := auth.PrincipalFromContext(r.Context())
+		result, err := l.Allow(r.Context(), endpoint, l.subject(principal))
+		if err != nil {
+			// Fail open: an unavailable counter store must not take the API down
+			logging.FromContext(r.Context()).Error("rate limiter unavailable", slog.Any("error", err))
+			next(w, r)
+			return
+		}
+
+		setHeaders(w, result)
+		if !result.Allowed {
+			Rejections.Inc(endpoint, result.Reason)
+			logging.FromContext(r.Context()).Warn("request rejected by limiter",
+				slog.String("endpoint", endpoint), slog.String("reason", result.Reason))
+			writeRejection(w, result)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func setHeaders(w http.ResponseWriter, result Result) {
+	h := w.Header()
+	if result.Limit > 0 {
+		h.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+		h.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		h.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+	}
+	if result.QuotaLimit > 0 {
+		remaining := result.QuotaRemaining
+		if remaining < 0 {
+			remaining = 0
+		}
+		h.Set("X-Quota-Limit", strconv.FormatInt(result.QuotaLimit, 10))
+		h.Set("X-Quota-Remaining", strconv.FormatInt(remaining, 10))
+		h.Set("X-Quota-Reset", result.QuotaReset.Format(time.RFC3339))
+	}
+}
+
+// ErrorResponse is the body of a 429 response
+type ErrorResponse struct {
+	Success    bool   `json:"success"`
+	Error      string `json:"error"` // "rate_limited" or "quota_exceeded"
+	Message    string `json:"message"`
+	RetryAfter int    `json:"retryAfter"` // Seconds, same as the Retry-After header
+}
+
+func writeRejection(w http.ResponseWriter, result Result) {
+	message := "Rate limit exceeded, retry after the indicated number of seconds"
+	if result.Reason == "quota_exceeded" {
+		message = "Monthly quota exhausted, it resets at " + result.QuotaReset.Format(time.RFC3339)
+	}
+	retryAfter := ceilSeconds(result.RetryAfter)
+
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Success:    false,
+		Error:      result.Reason,
+		Message:    message,
+		RetryAfter: retryAfter,
+	})
+}
+
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	if d > 366*24*time.Hour {
+		d = 366 * 24 * time.Hour
+	}
+	return int(math.Ceil(d.Seconds()))
+}
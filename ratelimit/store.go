@@ -0,0 +1,169 @@
+// Package ratelimit enforces per-tenant or per-API-key token-bucket rate limits and monthly quotas
+// Counters live in a pluggable Store; MemoryStore is the in-process default
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// BucketState is the outcome of taking a token from a bucket
+type BucketState struct {
+	Allowed    bool
+	Remaining  int           // Whole tokens left after this request
+	RetryAfter time.Duration // Wait before the next token is available (0 if allowed)
+	ResetAfter time.Duration // Time until the bucket is full again
+}
+
+// Store keeps rate-limit and quota counters
+// Implementations must be safe for concurrent use and apply each operation atomically
+type Store interface {
+	// TakeToken removes one token from bucket key, which refills at rate tokens/second up to burst
+	TakeToken(ctx context.Context, key string, rate float64, burst int, now time.Time) (BucketState, error)
+
+	// ReturnToken puts back a token taken from bucket key for a request that was rejected later
+	// It returns the bucket's Remaining and ResetAfter after the return
+	ReturnToken(ctx context.Context, key string, rate float64, burst int, now time.Time) (BucketState, error)
+
+	// IncrementUsage adds one to counter key unless it already reached limit
+	// It returns the usage after the call and whether the increment happened
+	IncrementUsage(ctx context.Context, key string, limit int64, now time.Time) (int64, bool, error)
+}
+
+// MemoryStore eviction
+const (
+	// sweepInterval is how often MemoryStore drops the entries it no longer needs
+	sweepInterval = time.Minute
+	// usageIdleTTL is how long an untouched usage counter is kept; longer than any
+	// month, so the quota period of an evicted counter has ended
+	usageIdleTTL = 32 * 24 * time.Hour
+)
+
+// MemoryStore is an in-process Store (counters are lost on restart and not shared between replicas)
+// Buckets are dropped once they have refilled, which is the state of a new bucket, and usage
+// counters once they have been idle for usageIdleTTL, so memory follows the active subjects
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	usage     map[string]*counter
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	fullAt time.Time // When the bucket is full again (zero if it never refills)
+}
+
+type counter struct {
+	used int64
+	last time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		usage:   make(map[string]*counter),
+	}
+}
+
+// sweep drops refilled buckets and idle usage counters, at most once per sweepInterval
+// The caller holds m.mu
+func (m *MemoryStore) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+	for key, b := range m.buckets {
+		if !b.fullAt.IsZero() && !now.Before(b.fullAt) {
+			delete(m.buckets, key)
+		}
+	}
+	for key, c := range m.usage {
+		if now.Sub(c.last) >= usageIdleTTL {
+			delete(m.usage, key)
+		}
+	}
+}
+
+// TakeToken implements Store
+func (m *MemoryStore) TakeToken(ctx context.Context, key string, rate float64, burst int, now time.Time) (BucketState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sweep(now)
+
+	b := m.refill(key, rate, burst, now)
+	state := BucketState{}
+	if b.tokens >= 1 {
+		b.tokens--
+		state.Allowed = true
+	} else if rate > 0 {
+		state.RetryAfter = secondsToDuration((1 - b.tokens) / rate)
+	} else {
+		state.RetryAfter = time.Duration(math.MaxInt64)
+	}
+	b.report(&state, rate, burst, now)
+	return state, nil
+}
+
+// ReturnToken implements Store
+func (m *MemoryStore) ReturnToken(ctx context.Context, key string, rate float64, burst int, now time.Time) (BucketState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	b := m.refill(key, rate, burst, now)
+	b.tokens = math.Min(float64(burst), b.tokens+1)
+	state := BucketState{Allowed: true}
+	b.report(&state, rate, burst, now)
+	return state, nil
+}
+
+// refill returns bucket key, created full and topped up for the time elapsed since the last request
+// The caller holds m.mu
+func (m *MemoryStore) refill(key string, rate float64, burst int, now time.Time) *bucket {
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(burst), last: now}
+		m.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(burst), b.tokens+elapsed*rate)
+		b.last = now
+	}
+	return b
+}
+
+// report fills the remaining tokens and reset time of state and records when the bucket is full again
+func (b *bucket) report(state *BucketState, rate float64, burst int, now time.Time) {
+	state.Remaining = int(b.tokens)
+	if rate > 0 {
+		state.ResetAfter = secondsToDuration((float64(burst) - b.tokens) / rate)
+		b.fullAt = now.Add(state.ResetAfter)
+	}
+}
+
+// IncrementUsage implements Store
+func (m *MemoryStore) IncrementUsage(ctx context.Context, key string, limit int64, now time.Time) (int64, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sweep(now)
+
+	c, ok := m.usage[key]
+	if !ok {
+		c = &counter{}
+		m.usage[key] = c
+	}
+	c.last = now
+	if c.used >= limit {
+		return c.used, false, nil
+	}
+	c.used++
+	return c.used, true, nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/llmcategory"
+)
+
+// llmCompleter implements llmcategory.Completer with the server's configured LLM provider
+type llmCompleter struct {
+	s         *Server
+	apiKey    string
+	useOllama bool
+}
+
+// newCategorizer creates the LLM categorizer for unresolved transactions and the provider name recorded with its answers
+func (s *Server) newCategorizer() (*llmcategory.Categorizer, error) {
+	cache, err := llmcategory.NewCache(s.config.LLM.CategoryCacheFile)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, useOllama := s.selectProvider("")
+	provider := "gemini:" + s.config.LLM.GeminiModel
+	if useOllama {
+		provider = "ollama:" + s.config.LLM.OllamaChatModel
+	}
+	completer := llmCompleter{s: s, apiKey: apiKey, useOllama: useOllama}
+	return llmcategory.NewCategorizer(completer, provider, cache, s.config.LLM.CategorizeThreshold, s.config.LLM.CategorizeBatchSize), nil
+}
+
+// Complete sends one categorization batch, asking the provider for JSON matching schema
+func (c llmCompleter) Complete(ctx context.Context, system, prompt string, schema map[string]interface{}) (string, error) {
+	if c.useOllama {
+		return c.s.completeOllama(ctx, system, prompt, schema)
+	}
+	return c.s.completeGemini(ctx, c.apiKey, system, prompt, schema)
+}
+
+// completeOllama calls Ollama with structured output (format set to the schema)
+func (s *Server) completeOllama(ctx context.Context, system, prompt string, schema map[string]interface{}) (string, error) {
+	reqBody, err := json.Marshal(OllamaRequest{
+		Model: s.config.LLM.OllamaChatModel,
+		Messages: []OllamaMessage{
+			{Role: "system", Content: system},
+			{Role: "user", Content: prompt},
+		},
+		Stream:  false,
+		Options: map[string]interface{}{"temperature": 0.0},
+		Format:  schema,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to prepare Ollama request: %w", err)
+	}
+
+	resp, err := postLLM(ctx, s.llmClient, "ollama", "categorize", s.config.LLM.OllamaChatURL(), reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to Ollama API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("Ollama API returned status %d: %s", resp.StatusCode, string(body))
+	}
+	var ollamaResp OllamaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ollamaResp); err != nil {
+		return "", fmt.Errorf("failed to parse Ollama response: %w", err)
+	}
+	if ollamaResp.Error != "" {
+		return "", fmt.Errorf("Ollama API error: %s", ollamaResp.Error)
+	}
+	return ollamaResp.Message.Content, nil
+}
+
+// completeGemini calls Gemini in JSON mode with the schema as responseSchema
+func (s *Server) completeGemini(ctx context.Context, apiKey, system, prompt string, schema map[string]interface{}) (string, error) {
+	if apiKey == "" {
+		return "", fmt.Errorf("no Gemini API key configured for categorization")
+	}
+	reqBody, err := json.Marshal(GeminiRequest{
+		Contents:          []GeminiContent{{Role: "user", Parts: []GeminiPart{{Text: prompt}}}},
+		SystemInstruction: &GeminiContent{Parts: []GeminiPart{{Text: system}}},
+		GenerationConfig: &GeminiGenerationConfig{
+			Temperature:      0,
+			ResponseMimeType: "application/json",
+			ResponseSchema:   geminiSchema(schema),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to prepare request: %w", err)
+	}
+
+	resp, err := postLLM(ctx, s.llmClient, "gemini", "categorize", s.config.LLM.GeminiURL(apiKey), reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to Gemini API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var geminiResp GeminiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&geminiResp); err != nil {
+		return "", fmt.Errorf("failed to parse Gemini response: %w", err)
+	}
+	if geminiResp.Error != nil {
+		return "", fmt.Errorf("Gemini API error: %s", geminiResp.Error.Message)
+	}
+	if len(geminiResp.Candidates) == 0 || len(geminiResp.Candidates[0].Content.Parts) == 0 {
+		return "", fmt.Errorf("AI service returned an empty response")
+	}
+	return geminiResp.Candidates[0].Content.Parts[0].Text, nil
+}
+
+// geminiSchema converts a JSON Schema to Gemini's OpenAPI subset, which spells types in upper case
+func geminiSchema(schema map[string]interface{}) map[string]interface{} {
+	converted := make(map[string]interface{}, len(schema))
+	for key, value := range schema {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if key == "properties" {
+				properties := make(map[string]interface{}, len(v))
+				for name, property := range v {
+					if p, ok := property.(map[string]interface{}); ok {
+						properties[name] = geminiSchema(p)
+					}
+				}
+				converted[key] = properties
+			} else {
+				converted[key] = geminiSchema(v)
+			}
+		case string:
+			if key == "type" {
+				v = strings.ToUpper(v)
+			}
+			converted[key] = v
+		default:
+			converted[key] = v
+		}
+	}
+	return converted
+}
@@ -1,47 +1,43 @@
-package main
+package server
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
-	"os"
 	"strings"
-	"sync"
 	"time"
 
+	"classify/logging"
+	"classify/metrics"
 	"classify/rag"
+	"classify/statement_analysis_engine_rules/models"
 )
 
-// ChatRequest represents the incoming chat request
-type ChatRequest struct {
-	Message             string                `json:"message"`
-	StatementData       interface{}           `json:"statementData"`
-	ConversationHistory []ConversationMessage `json:"conversationHistory,omitempty"`
-	APIKey              string                `json:"apiKey,omitempty"`
-}
-
-// ConversationMessage represents a message in the conversation history
-type ConversationMessage struct {
-	Role    string `json:"role"` // "user" or "assistant"
-	Content string `json:"content"`
-}
-
-// ChatResponse represents the response from the chat API
-type ChatResponse struct {
-	Success   bool   `json:"success"`
-	Response  string `json:"response,omitempty"`
-	Error     string `json:"error,omitempty"`
-	Message   string `json:"message,omitempty"`
-	Timestamp string `json:"timestamp"`
-}
+// Chat API types live in models so the OpenAPI spec can be generated from them
+type (
+	ChatRequest         = models.ChatRequest
+	ConversationMessage = models.ConversationMessage
+	ChatResponse        = models.ChatResponse
+)
 
 // GeminiRequest represents the request to Gemini API
 type GeminiRequest struct {
-	Contents []GeminiContent `json:"contents"`
+	Contents          []GeminiContent         `json:"contents"`
+	SystemInstruction *GeminiContent          `json:"systemInstruction,omitempty"`
+	GenerationConfig  *GeminiGenerationConfig `json:"generationConfig,omitempty"`
+}
+
+// GeminiGenerationConfig constrains the response, e.g. to JSON matching a schema
+type GeminiGenerationConfig struct {
+	Temperature      float64                `json:"temperature"`
+	ResponseMimeType string                 `json:"responseMimeType,omitempty"`
+	ResponseSchema   map[string]interface{} `json:"responseSchema,omitempty"`
 }
 
 // GeminiContent represents content in Gemini API request
@@ -79,6 +75,7 @@ type OllamaRequest struct {
 	Messages []OllamaMessage        `json:"messages"`
 	Stream   bool                   `json:"stream"`
 	Options  map[string]interface{} `json:"options,omitempty"`
+	Format   interface{}            `json:"format,omitempty"` // "json" or a JSON Schema for structured output
 }
 
 // OllamaMessage represents a message in Ollama API
@@ -93,58 +90,19 @@ type OllamaResponse struct {
 	Error   string        `json:"error,omitempty"`
 }
 
-// Global RAG manager (initialized once)
-var (
-	ragManager *rag.Manager
-	ragOnce    sync.Once
-	ragMutex   sync.RWMutex
-)
-
-// getRAGManager returns the global RAG manager, initializing it if needed
-func getRAGManager() (*rag.Manager, error) {
-	var initErr error
-	ragOnce.Do(func() {
-		config := rag.DefaultConfig()
-		manager, err := rag.NewManager(config)
-		if err != nil {
-			initErr = err
-			return
-		}
-		ragManager = manager
-	})
-
-	if initErr != nil {
-		return nil, initErr
-	}
-
-	ragMutex.RLock()
-	defer ragMutex.RUnlock()
-	return ragManager, nil
-}
-
 // chatHandler handles POST requests to /api/chat
-func chatHandler(w http.ResponseWriter, r *http.Request) {
-	// Enable CORS
-	enableCORS(w, r)
-
-	// Handle preflight OPTIONS request
-	if r.Method == http.MethodOptions {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
-
-	// Only allow POST method
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
+func (s *Server) chatHandler(w http.ResponseWriter, r *http.Request) {
 	// Set content type to JSON
 	w.Header().Set("Content-Type", "application/json")
 
 	// Parse request body
 	var chatReq ChatRequest
 	if err := json.NewDecoder(r.Body).Decode(&chatReq); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			sendErrorResponse(w, "Request too large", fmt.Sprintf("The request body exceeds %d bytes", tooLarge.Limit), http.StatusRequestEntityTooLarge)
+			return
+		}
 		sendErrorResponse(w, "Invalid request body", "Failed to parse request body", http.StatusBadRequest)
 		return
 	}
@@ -160,25 +118,25 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get API key (use provided key or fallback to environment variable)
-	apiKey := chatReq.APIKey
-	if apiKey == "" {
-		apiKey = os.Getenv("GEMINI_API_KEY")
-	}
+	ctx := r.Context()
+	logger := logging.FromContext(ctx)
 
-	// Determine which AI service to use (Gemini if API key exists, otherwise Ollama)
-	useOllama := apiKey == ""
+	// Determine which AI service to use (see LLMConfig.Provider)
+	apiKey, useOllama := s.selectProvider(chatReq.APIKey)
 
 	// Generate a unique source ID for this statement data
 	// In production, you might want to hash the statement data to reuse indexes
 	sourceID := generateSourceID(chatReq.StatementData)
+	logger = logger.With(slog.String("source_id", sourceID))
+	ctx = logging.WithLogger(ctx, logger)
 
 	// Initialize RAG manager
-	ragMgr, err := getRAGManager()
+	ragMgr, err := s.ragManager()
 	if err != nil {
-		log.Printf("Warning: Failed to initialize RAG manager: %v. Falling back to direct prompt.", err)
+		logger.Warn("failed to initialize RAG manager, falling back to direct prompt", slog.Any("error", err))
+		metrics.RAGFallbacks.Inc("init")
 		// Fallback to old method if RAG fails
-		responseText, err := handleChatWithoutRAG(apiKey, useOllama, chatReq)
+		responseText, err := s.handleChatWithoutRAG(ctx, apiKey, useOllama, chatReq)
 		if err != nil {
 			sendErrorResponse(w, "Internal server error", fmt.Sprintf("Failed to process chat: %v", err), http.StatusInternalServerError)
 			return
@@ -188,18 +146,18 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Check if chunks already exist for this source ID
-	hasChunks, err := ragMgr.HasChunks(sourceID)
+	hasChunks, err := ragMgr.HasChunksContext(ctx, sourceID)
 	if err != nil {
-		log.Printf("Warning: Failed to check for existing chunks: %v", err)
+		logger.Warn("failed to check for existing chunks", slog.Any("error", err))
 	}
 
 	// Index statement data if not already indexed
 	if !hasChunks {
-		log.Printf("Indexing statement data for source: %s", sourceID)
-		if err := ragMgr.IndexStatementData(chatReq.StatementData, sourceID); err != nil {
-			log.Printf("Warning: Failed to index statement data: %v. Falling back to direct prompt.", err)
+		if err := ragMgr.IndexStatementDataContext(ctx, chatReq.StatementData, sourceID); err != nil {
+			logger.Warn("failed to index statement data, falling back to direct prompt", slog.Any("error", err))
+			metrics.RAGFallbacks.Inc("index")
 			// Fallback to old method if indexing fails
-			responseText, err := handleChatWithoutRAG(apiKey, useOllama, chatReq)
+			responseText, err := s.handleChatWithoutRAG(ctx, apiKey, useOllama, chatReq)
 			if err != nil {
 				sendErrorResponse(w, "Internal server error", fmt.Sprintf("Failed to process chat: %v", err), http.StatusInternalServerError)
 				return
@@ -208,29 +166,29 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	} else {
-		log.Printf("Using existing chunks for source: %s", sourceID)
+		logger.Debug("using existing chunks")
 	}
 
 	// Classify query for optimized retrieval
 	classifier := rag.NewQueryClassifier()
 	queryType := classifier.ClassifyQuery(chatReq.Message)
-	log.Printf("Query classified as: %s", queryType)
+	logger.Info("query classified", slog.String("query_type", string(queryType)))
 	
 	// Adjust TopK based on query type (temporarily override config)
 	originalTopK := ragMgr.GetConfig().TopK
 	optimalTopK := classifier.GetOptimalTopK(queryType, originalTopK)
 	if optimalTopK != originalTopK {
-		log.Printf("Adjusted TopK from %d to %d for query type %s", originalTopK, optimalTopK, queryType)
+		logger.Debug("adjusted TopK for query type", slog.Int("from", originalTopK), slog.Int("to", optimalTopK))
 		// Note: We'll retrieve with original TopK but can filter later
 	}
 	
 	// Retrieve relevant chunks with scores using RAG
-	log.Printf("Retrieving relevant chunks for query: %s", chatReq.Message)
-	chunksWithScores, err := ragMgr.RetrieveRelevantChunksWithScores(chatReq.Message, sourceID)
+	chunksWithScores, err := ragMgr.RetrieveRelevantChunksWithScoresContext(ctx, chatReq.Message, sourceID)
 	if err != nil {
-		log.Printf("Warning: Failed to retrieve chunks: %v. Falling back to direct prompt.", err)
+		logger.Warn("failed to retrieve chunks, falling back to direct prompt", slog.Any("error", err))
+		metrics.RAGFallbacks.Inc("retrieve")
 		// Fallback to old method if retrieval fails
-		responseText, err := handleChatWithoutRAG(apiKey, useOllama, chatReq)
+		responseText, err := s.handleChatWithoutRAG(ctx, apiKey, useOllama, chatReq)
 		if err != nil {
 			sendErrorResponse(w, "Internal server error", fmt.Sprintf("Failed to process chat: %v", err), http.StatusInternalServerError)
 			return
@@ -241,8 +199,9 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Check if we have any chunks - if not, fallback to direct prompt
 	if len(chunksWithScores) == 0 {
-		log.Printf("Warning: No relevant chunks retrieved. Falling back to direct prompt.")
-		responseText, err := handleChatWithoutRAG(apiKey, useOllama, chatReq)
+		logger.Warn("no relevant chunks retrieved, falling back to direct prompt")
+		metrics.RAGFallbacks.Inc("no_chunks")
+		responseText, err := s.handleChatWithoutRAG(ctx, apiKey, useOllama, chatReq)
 		if err != nil {
 			sendErrorResponse(w, "Internal server error", fmt.Sprintf("Failed to process chat: %v", err), http.StatusInternalServerError)
 			return
@@ -256,15 +215,16 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 	for i, cws := range chunksWithScores {
 		scores[i] = cws.Score
 	}
-	log.Printf("Retrieved %d relevant chunks with scores: %v", len(chunksWithScores), scores)
+	logger.Debug("retrieved chunk scores", slog.Any("scores", scores))
 
 	// Build optimized context from retrieved chunks
 	contextStr := buildOptimizedContext(chunksWithScores, chatReq.Message, queryType)
 	
 	// Check if context is empty
 	if len(contextStr) < 50 { // Very short context likely means no chunks
-		log.Printf("Warning: Context is empty or too short. Falling back to direct prompt.")
-		responseText, err := handleChatWithoutRAG(apiKey, useOllama, chatReq)
+		logger.Warn("context is empty or too short, falling back to direct prompt", slog.Int("context_length", len(contextStr)))
+		metrics.RAGFallbacks.Inc("short_context")
+		responseText, err := s.handleChatWithoutRAG(ctx, apiKey, useOllama, chatReq)
 		if err != nil {
 			sendErrorResponse(w, "Internal server error", fmt.Sprintf("Failed to process chat: %v", err), http.StatusInternalServerError)
 			return
@@ -281,27 +241,20 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 	// Build user message with context
 	userMessage := fmt.Sprintf("%s\n\n=== USER QUESTION ===\n%s", contextStr, chatReq.Message)
 
-	log.Printf("Context built: %d chunks, total length: %d characters", chunkCount, len(contextStr))
-
-	// Log first 200 chars of context for debugging
-	if len(contextStr) > 200 {
-		log.Printf("Context preview: %s...", contextStr[:200])
-	} else {
-		log.Printf("Context preview: %s", contextStr)
-	}
+	// The context itself contains statement data, so only its size is logged
+	logger.Info("context built", slog.Int("chunks", chunkCount), slog.Int("context_length", len(contextStr)))
 
 	var responseText string
 	var apiErr error
 
 	if useOllama {
 		// Use Ollama API with RAG context
-		log.Printf("Calling Ollama API with RAG context...")
-		responseText, apiErr = callOllamaAPIRAG(systemPrompt, userMessage, chatReq.ConversationHistory)
+		responseText, apiErr = s.callOllamaAPIRAG(ctx, systemPrompt, userMessage, chatReq.ConversationHistory)
 		if apiErr != nil {
-			log.Printf("Error calling Ollama API: %v", apiErr)
 			// Fallback to direct prompt without RAG
-			log.Printf("Falling back to direct prompt (without RAG)...")
-			responseText, apiErr = handleChatWithoutRAG(apiKey, useOllama, chatReq)
+			logger.Warn("Ollama call failed, falling back to direct prompt", slog.Any("error", apiErr))
+			metrics.RAGFallbacks.Inc("llm")
+			responseText, apiErr = s.handleChatWithoutRAG(ctx, apiKey, useOllama, chatReq)
 			if apiErr != nil {
 				sendErrorResponse(w, "Internal server error", fmt.Sprintf("Failed to connect to Ollama service: %v", apiErr), http.StatusInternalServerError)
 				return
@@ -309,17 +262,16 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Use Gemini API with RAG context
-		log.Printf("Calling Gemini API with RAG context...")
-		responseText, apiErr = callGeminiAPIRAG(apiKey, systemPrompt, userMessage, chatReq.ConversationHistory)
+		responseText, apiErr = s.callGeminiAPIRAG(ctx, apiKey, systemPrompt, userMessage, chatReq.ConversationHistory)
 		if apiErr != nil {
-			log.Printf("Error calling Gemini API: %v", apiErr)
 			// If Gemini fails, try Ollama as fallback
-			log.Printf("Falling back to Ollama API...")
-			responseText, apiErr = callOllamaAPIRAG(systemPrompt, userMessage, chatReq.ConversationHistory)
+			logger.Warn("Gemini call failed, falling back to Ollama", slog.Any("error", apiErr))
+			responseText, apiErr = s.callOllamaAPIRAG(ctx, systemPrompt, userMessage, chatReq.ConversationHistory)
 			if apiErr != nil {
 				// Final fallback to direct prompt
-				log.Printf("Falling back to direct prompt (without RAG)...")
-				responseText, apiErr = handleChatWithoutRAG(apiKey, true, chatReq)
+				logger.Warn("Ollama call failed, falling back to direct prompt", slog.Any("error", apiErr))
+				metrics.RAGFallbacks.Inc("llm")
+				responseText, apiErr = s.handleChatWithoutRAG(ctx, apiKey, true, chatReq)
 				if apiErr != nil {
 					sendErrorResponse(w, "Internal server error", fmt.Sprintf("Failed to connect to AI service: %v", apiErr), http.StatusInternalServerError)
 					return
@@ -330,7 +282,7 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Ensure we have a response
 	if responseText == "" {
-		log.Printf("Warning: Empty response from AI service. Sending fallback message.")
+		logger.Warn("empty response from AI service, sending fallback message")
 		responseText = "I apologize, but I couldn't generate a response. Please try again."
 	}
 
@@ -338,13 +290,13 @@ func chatHandler(w http.ResponseWriter, r *http.Request) {
 	validator := rag.NewAnswerValidator()
 	isValid, reason := validator.ValidateAnswer(responseText, contextStr, chatReq.Message)
 	if !isValid {
-		log.Printf("Warning: Answer validation failed: %s. Response may be inaccurate.", reason)
+		logger.Warn("answer validation failed, response may be inaccurate", slog.String("reason", reason))
 		// Continue anyway, but log the warning
 	} else {
-		log.Printf("Answer validated successfully: %s", reason)
+		logger.Debug("answer validated", slog.String("reason", reason))
 	}
 
-	log.Printf("Successfully generated response (length: %d)", len(responseText))
+	logger.Info("generated response", slog.Int("response_length", len(responseText)))
 	sendSuccessResponse(w, responseText)
 }
 
@@ -553,18 +505,16 @@ func sendSuccessResponse(w http.ResponseWriter, responseText string) {
 	w.WriteHeader(http.StatusOK)
 
 	if err := json.NewEncoder(w).Encode(chatResp); err != nil {
-		log.Printf("Error encoding response: %v", err)
+		slog.Error("failed to encode chat response", slog.Any("error", err))
 		// Try to send a simple error response
 		w.Header().Set("Content-Type", "application/json")
 		fmt.Fprintf(w, `{"success":false,"error":"encoding_error","message":"Failed to encode response"}`)
 		return
 	}
-
-	log.Printf("Response sent successfully to client (response length: %d)", len(responseText))
 }
 
 // handleChatWithoutRAG handles chat without RAG (fallback method)
-func handleChatWithoutRAG(apiKey string, useOllama bool, chatReq ChatRequest) (string, error) {
+func (s *Server) handleChatWithoutRAG(ctx context.Context, apiKey string, useOllama bool, chatReq ChatRequest) (string, error) {
 	// Convert statement data to JSON string
 	statementDataJSON, err := json.MarshalIndent(chatReq.StatementData, "", "  ")
 	if err != nil {
@@ -587,19 +537,14 @@ Instructions:
 - Be accurate and cite specific numbers from the data when possible`, string(statementDataJSON))
 
 	if useOllama {
-		return callOllamaAPI(systemPrompt, chatReq.Message, chatReq.ConversationHistory)
+		return s.callOllamaAPI(ctx, systemPrompt, chatReq.Message, chatReq.ConversationHistory)
 	}
-	return callGeminiAPI(apiKey, systemPrompt, chatReq.Message, chatReq.ConversationHistory)
+	return s.callGeminiAPI(ctx, apiKey, systemPrompt, chatReq.Message, chatReq.ConversationHistory)
 }
 
 // callOllamaAPIRAG calls Ollama API with RAG context (no conversation history in context)
-func callOllamaAPIRAG(systemPrompt, userMessage string, conversationHistory []ConversationMessage) (string, error) {
-	// Get model from RAG config
-	ragMgr, err := getRAGManager()
-	chatModel := "llama3" // Default
-	if err == nil && ragMgr != nil {
-		chatModel = ragMgr.GetConfig().ChatModel
-	}
+func (s *Server) callOllamaAPIRAG(ctx context.Context, systemPrompt, userMessage string, conversationHistory []ConversationMessage) (string, error) {
+	chatModel := s.config.LLM.OllamaChatModel
 
 	var messages []OllamaMessage
 
@@ -629,11 +574,11 @@ func callOllamaAPIRAG(systemPrompt, userMessage string, conversationHistory []Co
 		Content: userMessage,
 	})
 
-	return callOllamaWithMessages(messages, chatModel)
+	return s.callOllamaWithMessages(ctx, messages, chatModel)
 }
 
 // callGeminiAPIRAG calls Gemini API with RAG context
-func callGeminiAPIRAG(apiKey, systemPrompt, userMessage string, conversationHistory []ConversationMessage) (string, error) {
+func (s *Server) callGeminiAPIRAG(ctx context.Context, apiKey, systemPrompt, userMessage string, conversationHistory []ConversationMessage) (string, error) {
 	// Build Gemini API request with conversation history
 	var contents []GeminiContent
 
@@ -669,8 +614,7 @@ func callGeminiAPIRAG(apiKey, systemPrompt, userMessage string, conversationHist
 	}
 
 	// Call Gemini API
-	geminiURL := fmt.Sprintf("https://generativelanguage.googleapis.com/v1beta/models/gemini-2.0-flash-exp:generateContent?key=%s", apiKey)
-	resp, err := http.Post(geminiURL, "application/json", bytes.NewBuffer(reqBody))
+	resp, err := postLLM(ctx, s.llmClient, "gemini", "chat", s.config.LLM.GeminiURL(apiKey), reqBody)
 	if err != nil {
 		return "", fmt.Errorf("failed to connect to Gemini API: %w", err)
 	}
@@ -709,12 +653,7 @@ func callGeminiAPIRAG(apiKey, systemPrompt, userMessage string, conversationHist
 }
 
 // callOllamaWithMessages is a helper to call Ollama with a message array
-func callOllamaWithMessages(messages []OllamaMessage, model string) (string, error) {
-	ollamaURL := os.Getenv("OLLAMA_URL")
-	if ollamaURL == "" {
-		ollamaURL = "http://localhost:11434"
-	}
-
+func (s *Server) callOllamaWithMessages(ctx context.Context, messages []OllamaMessage, model string) (string, error) {
 	ollamaReq := OllamaRequest{
 		Model:    model,
 		Messages: messages,
@@ -733,14 +672,7 @@ func callOllamaWithMessages(messages []OllamaMessage, model string) (string, err
 		return "", fmt.Errorf("failed to prepare Ollama request: %w", err)
 	}
 
-	url := ollamaURL + "/api/chat"
-
-	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: 120 * time.Second, // 2 minute timeout for chat requests
-	}
-
-	resp, err := client.Post(url, "application/json", bytes.NewBuffer(reqBody))
+	resp, err := postLLM(ctx, s.llmClient, "ollama", "chat", s.config.LLM.OllamaChatURL(), reqBody)
 	if err != nil {
 		return "", fmt.Errorf("failed to connect to Ollama API: %w", err)
 	}
@@ -767,6 +699,29 @@ func callOllamaWithMessages(messages []OllamaMessage, model string) (string, err
 	return ollamaResp.Message.Content, nil
 }
 
+// postLLM sends a JSON request to an LLM provider, bound to ctx and tagged with its request ID
+// operation labels the call in metrics (chat, categorize); the URL may carry an API key, so it is never logged
+func postLLM(ctx context.Context, client *http.Client, provider, operation, url string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s request: %w", provider, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	logging.SetRequestHeader(req)
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	metrics.ObserveLLMResponse(provider, operation, start, resp, err)
+
+	logger := logging.FromContext(ctx).With(slog.String("provider", provider), slog.Duration("duration", time.Since(start)))
+	if err != nil {
+		logger.Warn("LLM call failed", slog.Any("error", err))
+	} else {
+		logger.Info("LLM call finished", slog.Int("status", resp.StatusCode))
+	}
+	return resp, err
+}
+
 // sendErrorResponse sends an error response
 func sendErrorResponse(w http.ResponseWriter, errorType, message string, statusCode int) {
 	chatResp := ChatResponse{
@@ -778,13 +733,13 @@ func sendErrorResponse(w http.ResponseWriter, errorType, message string, statusC
 
 	w.WriteHeader(statusCode)
 	if err := json.NewEncoder(w).Encode(chatResp); err != nil {
-		log.Printf("Error encoding error response: %v", err)
+		slog.Error("failed to encode error response", slog.Any("error", err))
 		http.Error(w, "Error encoding response", http.StatusInternalServerError)
 	}
 }
 
 // callGeminiAPI calls the Gemini API and returns the response text
-func callGeminiAPI(apiKey, systemPrompt, userMessage string, conversationHistory []ConversationMessage) (string, error) {
+func (s *Server) callGeminiAPI(ctx context.Context, apiKey, systemPrompt, userMessage string, conversationHistory []ConversationMessage) (string, error) {
 	// Build conversation context
 	var conversationContext strings.Builder
 	if len(conversationHistory) > 0 {
@@ -838,8 +793,7 @@ User Question: "%s"`, systemPrompt, conversationContext.String(), userMessage)
 	}
 
 	// Call Gemini API
-	geminiURL := fmt.Sprintf("https://generativelanguage.googleapis.com/v1beta/models/gemini-2.0-flash-exp:generateContent?key=%s", apiKey)
-	resp, err := http.Post(geminiURL, "application/json", bytes.NewBuffer(reqBody))
+	resp, err := postLLM(ctx, s.llmClient, "gemini", "chat", s.config.LLM.GeminiURL(apiKey), reqBody)
 	if err != nil {
 		return "", fmt.Errorf("failed to connect to Gemini API: %w", err)
 	}
@@ -878,18 +832,8 @@ User Question: "%s"`, systemPrompt, conversationContext.String(), userMessage)
 }
 
 // callOllamaAPI calls the Ollama API and returns the response text
-func callOllamaAPI(systemPrompt, userMessage string, conversationHistory []ConversationMessage) (string, error) {
-	// Get model from RAG config or environment
-	chatModel := os.Getenv("OLLAMA_CHAT_MODEL")
-	if chatModel == "" {
-		// Try to get from RAG config
-		ragMgr, err := getRAGManager()
-		if err == nil && ragMgr != nil {
-			chatModel = ragMgr.GetConfig().ChatModel
-		} else {
-			chatModel = "llama3" // Default fallback
-		}
-	}
+func (s *Server) callOllamaAPI(ctx context.Context, systemPrompt, userMessage string, conversationHistory []ConversationMessage) (string, error) {
+	chatModel := s.config.LLM.OllamaChatModel
 
 	// Build messages array for Ollama
 	var messages []OllamaMessage
@@ -940,14 +884,8 @@ func callOllamaAPI(systemPrompt, userMessage string, conversationHistory []Conve
 		return "", fmt.Errorf("failed to prepare Ollama request: %w", err)
 	}
 
-	// Get Ollama URL from environment or use default
-	ollamaURL := os.Getenv("OLLAMA_URL")
-	if ollamaURL == "" {
-		ollamaURL = "http://localhost:11434/api/chat"
-	}
-
 	// Call Ollama API
-	resp, err := http.Post(ollamaURL, "application/json", bytes.NewBuffer(reqBody))
+	resp, err := postLLM(ctx, s.llmClient, "ollama", "chat", s.config.LLM.OllamaChatURL(), reqBody)
 	if err != nil {
 		return "", fmt.Errorf("failed to connect to Ollama API: %w", err)
 	}
@@ -988,36 +926,3 @@ func callOllamaAPI(systemPrompt, userMessage string, conversationHistory []Conve
 func getCurrentTimestamp() string {
 	return time.Now().UTC().Format(time.RFC3339)
 }
-
-// healthHandler handles GET requests to /api/health
-func healthHandler(w http.ResponseWriter, r *http.Request) {
-	// Enable CORS
-	enableCORS(w, r)
-
-	// Handle preflight OPTIONS request
-	if r.Method == http.MethodOptions {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
-
-	// Only allow GET method
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	// Set content type to JSON
-	w.Header().Set("Content-Type", "application/json")
-
-	healthResp := map[string]interface{}{
-		"status":    "healthy",
-		"service":   "chat-api",
-		"timestamp": getCurrentTimestamp(),
-	}
-
-	if err := json.NewEncoder(w).Encode(healthResp); err != nil {
-		log.Printf("Error encoding health response: %v", err)
-		http.Error(w, "Error encoding response", http.StatusInternalServerError)
-		return
-	}
-}
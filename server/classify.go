@@ -0,0 +1,676 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"classify/auth"
+	"classify/extractor"
+	"classify/logging"
+	"classify/metrics"
+	"classify/statement_analysis_engine_rules/analyzer"
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/webhooks"
+)
+
+// classifiedStatement is the configured statement, classified for the caller
+type classifiedStatement struct {
+	statement    *extractor.TxtAccountStatement
+	transactions []models.ClassifiedTransaction
+	overrides    *overrides.Set
+}
+
+// classifyHandler handles POST requests to /classify
+func (s *Server) classifyHandler(w http.ResponseWriter, r *http.Request) {
+	// Set content type to JSON
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+	logger := logging.FromContext(ctx)
+
+	// Steps 1-3: Read, parse and classify the statement
+	classified, ok := s.classifyStatement(w, r)
+	if !ok {
+		return
+	}
+	statement, classifiedTransactions, userOverrides := classified.statement, classified.transactions, classified.overrides
+	principal, _ := auth.PrincipalFromContext(ctx)
+	metrics.ObserveClassification(classifiedTransactions)
+	if queued, err := s.review.Collect(principal.TenantID, principal.Subject, classifiedTransactions); err != nil {
+		logger.Error("failed to queue transactions for review", slog.Any("error", err))
+	} else if queued > 0 {
+		logger.Info("transactions queued for review", slog.Int("items", queued))
+	}
+
+	// Step 3.5: Create analyzer instance
+	analyzerInstance := analyzer.NewAnalyzer()
+	analyzerInstance.SetOverrides(userOverrides)
+	analyzerInstance.AddTransactions(classifiedTransactions)
+
+	// Step 3.6: Set statement totals for accurate income/expense calculation
+	// Use the official totals from the statement (more accurate than summing transactions)
+	analyzerInstance.SetStatementTotals(
+		statement.Summary.TotalCredits, // Total Credits = Total Income
+		statement.Summary.TotalDebits,  // Total Debits = Total Expense
+	)
+
+	// Step 4: Format statement period
+	statementPeriod := fmt.Sprintf("%s - %s",
+		statement.StatementPeriod.FromDate,
+		statement.StatementPeriod.ToDate,
+	)
+
+	// Step 5: Run analysis
+	response := analyzerInstance.AnalyzeContext(
+		ctx,
+		statement.AccountInfo.AccountNo,
+		statement.AccountInfo.AccountHolderName,
+		statementPeriod,
+		statement.Summary.OpeningBalance,
+		statement.Summary.ClosingBalance,
+	)
+	metrics.ObserveAnomalies(response.AnomalyDetection)
+	webhooks.NotifyAnalysis(s.webhooks, auth.TenantID(ctx), response)
+
+	// The console/file report prints full narrations and names, so it only runs when explicitly enabled
+	if s.config.DebugReport {
+		writeClassificationReport(statement, classifiedTransactions, response, userOverrides)
+	}
+
+	// Encode and send JSON response
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Error encoding JSON", http.StatusInternalServerError)
+		return
+	}
+}
+
+// classifyStatement reads, parses and classifies the configured statement with the caller's overrides
+// On failure it writes the error response and returns false
+func (s *Server) classifyStatement(w http.ResponseWriter, r *http.Request) (*classifiedStatement, bool) {
+	statement, ok := s.readStatement(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	// Step 2: Convert extracted transactions to classified transactions
+	classifiedTransactions := make([]models.ClassifiedTransaction, 0, len(statement.Transactions))
+	for _, txn := range statement.Transactions {
+		classifiedTxn := classifier.ConvertFromTxtTransaction(
+			txn.Date,
+			txn.Narration,
+			txn.ChequeRefNo,
+			txn.ValueDate,
+			txn.WithdrawalAmt,
+			txn.DepositAmt,
+			txn.ClosingBalance,
+		)
+		classifiedTransactions = append(classifiedTransactions, classifiedTxn)
+	}
+
+	// Step 3: Classify all transactions first (pass customerName for self-transfer detection)
+	// The caller's overrides are applied on top of the rules
+	userOverrides := s.callerOverrides(r)
+	classifiedTransactions = classifier.ClassifyTransactions(classifiedTransactions, statement.AccountInfo.AccountHolderName, userOverrides)
+
+	// Step 3.1: Ask the LLM about unresolved narrations (optional); cached answers are already applied,
+	// so the statement is classified again only when new answers arrived
+	if s.resolveWithLLM(r, classifiedTransactions) {
+		classifiedTransactions = classifier.ClassifyTransactions(classifiedTransactions, statement.AccountInfo.AccountHolderName, userOverrides)
+	}
+	return &classifiedStatement{statement: statement, transactions: classifiedTransactions, overrides: userOverrides}, true
+}
+
+// readStatement reads and parses the configured statement
+// On failure it writes the error response and returns false
+func (s *Server) readStatement(w http.ResponseWriter, r *http.Request) (*extractor.TxtAccountStatement, bool) {
+	logger := logging.FromContext(r.Context())
+
+	// Read the configured statement file
+	statementFile, err := os.Open(s.config.StatementFile)
+	if err != nil {
+		logger.Error("failed to read statement file", slog.Any("error", err))
+		http.Error(w, "Failed to read statement", http.StatusInternalServerError)
+		return nil, false
+	}
+	defer statementFile.Close()
+
+	statement, err := extractor.ReadAccountStatement(statementFile)
+	metrics.ObserveParse(statement, err)
+	if err != nil {
+		logger.Error("failed to parse statement", slog.Any("error", err))
+		http.Error(w, "Failed to parse statement", http.StatusUnprocessableEntity)
+		return nil, false
+	}
+
+	// Account number and holder name are masked by the logger
+	logger.Info("statement parsed",
+		slog.String("account_no", statement.AccountInfo.AccountNo),
+		slog.String("customer_name", statement.AccountInfo.AccountHolderName),
+		slog.Int("transactions", len(statement.Transactions)),
+		slog.String("period_from", statement.StatementPeriod.FromDate),
+		slog.String("period_to", statement.StatementPeriod.ToDate),
+	)
+	return statement, true
+}
+
+// callerOverrides returns the overrides of the authenticated caller
+func (s *Server) callerOverrides(r *http.Request) *overrides.Set {
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	return s.overrides.For(principal.TenantID, principal.Subject)
+}
+
+// resolveWithLLM asks the LLM categorizer (when configured) about the unresolved transactions
+// It reports whether new answers arrived, in which case the transactions must be classified again
+func (s *Server) resolveWithLLM(r *http.Request, transactions []models.ClassifiedTransaction) bool {
+	if s.categorizer == nil {
+		return false
+	}
+	logger := logging.FromContext(r.Context())
+	stats, err := s.categorizer.Resolve(r.Context(), transactions)
+	if err != nil {
+		logger.Warn("LLM categorization failed, unresolved transactions keep their rule category", slog.Any("error", err))
+	}
+	logger.Info("LLM categorization finished",
+		slog.Int("unresolved", stats.Unresolved),
+		slog.Int("asked", stats.Asked),
+		slog.Int("calls", stats.Calls),
+		slog.Int("accepted", stats.Accepted),
+		slog.Int("rejected", stats.Rejected),
+	)
+	return stats.Accepted > 0
+}
+
+// writeClassificationReport prints the analysis and potential classification issues to stdout and writes
+// expenses_by_category.txt and classification_issues.txt (enabled with debugReport / CLASSIFY_DEBUG_REPORT=1)
+func writeClassificationReport(statement *extractor.TxtAccountStatement, classifiedTransactions []models.ClassifiedTransaction, response models.ClassifyResponse, userOverrides *overrides.Set) {
+	// Step 6: Output results as JSON
+	jsonData, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		slog.Error("failed to marshal response for report", slog.Any("error", err))
+		return
+	}
+
+	fmt.Println("=== Classification Analysis Complete ===")
+	fmt.Println(string(jsonData))
+
+	// Step 7: Access specific data
+	fmt.Println("\n=== Summary ===")
+	fmt.Printf("Total Transactions: %d\n", len(classifiedTransactions))
+	fmt.Printf("Account: %s\n", response.AccountSummary.AccountNumberMasked)
+	fmt.Printf("Customer: %s\n", response.AccountSummary.CustomerName)
+	fmt.Printf("Opening Balance: %.2f\n", response.AccountSummary.OpeningBalance)
+	fmt.Printf("Closing Balance: %.2f\n", response.AccountSummary.ClosingBalance)
+	fmt.Printf("Total Income: %.2f\n", response.AccountSummary.TotalIncome)
+	fmt.Printf("Total Expense: %.2f\n", response.AccountSummary.TotalExpense)
+	fmt.Printf("Net Savings: %.2f\n", response.AccountSummary.NetSavings)
+	fmt.Printf("Savings Rate: %.2f%%\n", response.AccountSummary.SavingsRatePercent)
+
+	fmt.Println("\n=== Transaction Breakdown ===")
+	fmt.Printf("UPI: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.UPI.Amount,
+		response.TransactionBreakdown.UPI.Count)
+	fmt.Printf("IMPS: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.IMPS.Amount,
+		response.TransactionBreakdown.IMPS.Count)
+	fmt.Printf("NEFT: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.NEFT.Amount,
+		response.TransactionBreakdown.NEFT.Count)
+	fmt.Printf("RTGS: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.RTGS.Amount,
+		response.TransactionBreakdown.RTGS.Count)
+	fmt.Printf("EMI: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.EMI.Amount,
+		response.TransactionBreakdown.EMI.Count)
+	fmt.Printf("BillPaid: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.BillPaid.Amount,
+		response.TransactionBreakdown.BillPaid.Count)
+	fmt.Printf("DebitCard: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.DebitCard.Amount,
+		response.TransactionBreakdown.DebitCard.Count)
+	fmt.Printf("ATMWithdrawal: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.ATMWithdrawal.Amount,
+		response.TransactionBreakdown.ATMWithdrawal.Count)
+	fmt.Printf("NetBanking: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.NetBanking.Amount,
+		response.TransactionBreakdown.NetBanking.Count)
+	fmt.Printf("Salary: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.Salary.Amount,
+		response.TransactionBreakdown.Salary.Count)
+	fmt.Printf("RD: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.RD.Amount,
+		response.TransactionBreakdown.RD.Count)
+	fmt.Printf("FD: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.FD.Amount,
+		response.TransactionBreakdown.FD.Count)
+	fmt.Printf("SIP: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.SIP.Amount,
+		response.TransactionBreakdown.SIP.Count)
+	fmt.Printf("Interest: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.Interest.Amount,
+		response.TransactionBreakdown.Interest.Count)
+	fmt.Printf("Cheque: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.Cheque.Amount,
+		response.TransactionBreakdown.Cheque.Count)
+	fmt.Printf("Dividend: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.Dividend.Amount,
+		response.TransactionBreakdown.Dividend.Count)
+	fmt.Printf("Investment: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.Investment.Amount,
+		response.TransactionBreakdown.Investment.Count)
+	fmt.Printf("Other: %.2f (%d transactions)\n",
+		response.TransactionBreakdown.Other.Amount,
+		response.TransactionBreakdown.Other.Count)
+
+	// Verify total
+	totalBreakdown := response.TransactionBreakdown.UPI.Count +
+		response.TransactionBreakdown.IMPS.Count +
+		response.TransactionBreakdown.NEFT.Count +
+		response.TransactionBreakdown.RTGS.Count +
+		response.TransactionBreakdown.EMI.Count +
+		response.TransactionBreakdown.BillPaid.Count +
+		response.TransactionBreakdown.DebitCard.Count +
+		response.TransactionBreakdown.ATMWithdrawal.Count +
+		response.TransactionBreakdown.NetBanking.Count +
+		response.TransactionBreakdown.Salary.Count +
+		response.TransactionBreakdown.RD.Count +
+		response.TransactionBreakdown.FD.Count +
+		response.TransactionBreakdown.SIP.Count +
+		response.TransactionBreakdown.Interest.Count +
+		response.TransactionBreakdown.Cheque.Count +
+		response.TransactionBreakdown.Dividend.Count +
+		response.TransactionBreakdown.Investment.Count +
+		response.TransactionBreakdown.Other.Count
+	fmt.Printf("\nTotal in Breakdown: %d (should match Total Transactions: %d)\n",
+		totalBreakdown, len(classifiedTransactions))
+
+	// Print all "Other" transactions with their narrations
+	// Re-classify to ensure we have the latest classification (pass customerName for self-transfer detection)
+	classifiedTransactions = classifier.ClassifyTransactions(classifiedTransactions, statement.AccountInfo.AccountHolderName, userOverrides)
+
+	fmt.Println("\n=== Other Transactions (with Narrations) ===")
+	otherCount := 0
+	for _, txn := range classifiedTransactions {
+		// Skip transactions with zero amount
+		if txn.DepositAmt == 0 && txn.WithdrawalAmt == 0 {
+			continue
+		}
+
+		// Check if this transaction is classified as "Other"
+		// Only truly "Other" transactions (explicitly classified as "Other" or empty/unknown methods)
+		isOther := false
+		method := txn.Method
+		if method == "" {
+			method = "Other"
+			isOther = true
+		} else if method == "Other" {
+			isOther = true
+		} else {
+			isOther = false
+		}
+
+		if isOther {
+			otherCount++
+			amount := txn.DepositAmt
+			if txn.WithdrawalAmt > 0 {
+				amount = txn.WithdrawalAmt
+			}
+			if txn.DepositAmt > 0 && txn.WithdrawalAmt > 0 {
+				if txn.DepositAmt > txn.WithdrawalAmt {
+					amount = txn.DepositAmt
+				} else {
+					amount = txn.WithdrawalAmt
+				}
+			}
+
+			// Truncate narration if too long
+			narration := txn.Narration
+			if len(narration) > 120 {
+				narration = narration[:120] + "..."
+			}
+
+			// Clean up narration (remove extra whitespace)
+			narration = strings.TrimSpace(narration)
+			narration = strings.ReplaceAll(narration, "\n", " ")
+			narration = strings.ReplaceAll(narration, "\r", " ")
+
+			fmt.Printf("%d. Date: %s | Method: %s | Amount: %.2f\n",
+				otherCount, txn.Date, method, amount)
+			fmt.Printf("   Narration: %s\n", narration)
+			fmt.Println()
+		}
+	}
+	fmt.Printf("Total Other transactions printed: %d\n", otherCount)
+
+	fmt.Println("\n=== Top Expenses ===")
+	for i, expense := range response.TopExpenses {
+		if i >= 5 {
+			break
+		}
+		fmt.Printf("%d. %s - %.2f (%s)\n",
+			i+1, expense.Merchant, expense.Amount, expense.Category)
+	}
+
+	// Verify expense category classification
+	fmt.Println("\n=== Expense Category Verification ===")
+
+	// Group expenses by category
+	categoryStats := make(map[string]struct {
+		Count   int
+		Total   float64
+		Samples []models.ClassifiedTransaction
+	})
+
+	expenseCount := 0
+	for _, txn := range classifiedTransactions {
+		// Only count withdrawals (expenses)
+		if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 {
+			expenseCount++
+			category := txn.Category
+			if category == "" {
+				category = "Unclassified"
+			}
+
+			stats, exists := categoryStats[category]
+			if !exists {
+				stats = struct {
+					Count   int
+					Total   float64
+					Samples []models.ClassifiedTransaction
+				}{
+					Count:   0,
+					Total:   0.0,
+					Samples: make([]models.ClassifiedTransaction, 0, 3),
+				}
+			}
+
+			stats.Count++
+			stats.Total += txn.WithdrawalAmt
+
+			// Keep up to 3 sample transactions per category
+			if len(stats.Samples) < 3 {
+				stats.Samples = append(stats.Samples, txn)
+			}
+
+			categoryStats[category] = stats
+		}
+	}
+
+	// Print category statistics
+	fmt.Printf("Total Expense Transactions: %d\n\n", expenseCount)
+
+	// Sort categories by total amount (descending)
+	type catStat struct {
+		Category string
+		Count    int
+		Total    float64
+		Samples  []models.ClassifiedTransaction
+	}
+
+	sortedCategories := make([]catStat, 0, len(categoryStats))
+	for cat, stats := range categoryStats {
+		sortedCategories = append(sortedCategories, catStat{
+			Category: cat,
+			Count:    stats.Count,
+			Total:    stats.Total,
+			Samples:  stats.Samples,
+		})
+	}
+
+	// Simple sort by total amount (descending)
+	for i := 0; i < len(sortedCategories)-1; i++ {
+		for j := i + 1; j < len(sortedCategories); j++ {
+			if sortedCategories[i].Total < sortedCategories[j].Total {
+				sortedCategories[i], sortedCategories[j] = sortedCategories[j], sortedCategories[i]
+			}
+		}
+	}
+
+	// Print each category with samples
+	for _, catStat := range sortedCategories {
+		fmt.Printf("--- %s ---\n", catStat.Category)
+		fmt.Printf("  Count: %d transactions\n", catStat.Count)
+		fmt.Printf("  Total: %.2f\n", catStat.Total)
+		fmt.Printf("  Average: %.2f per transaction\n", catStat.Total/float64(catStat.Count))
+		fmt.Printf("  Sample Transactions:\n")
+
+		for i, sample := range catStat.Samples {
+			// Truncate narration if too long
+			narration := sample.Narration
+			if len(narration) > 80 {
+				narration = narration[:80] + "..."
+			}
+			// Clean up narration
+			narration = strings.TrimSpace(narration)
+			narration = strings.ReplaceAll(narration, "\n", " ")
+			narration = strings.ReplaceAll(narration, "\r", " ")
+
+			fmt.Printf("    %d. Date: %s | Amount: %.2f | Method: %s\n",
+				i+1, sample.Date, sample.WithdrawalAmt, sample.Method)
+			fmt.Printf("       Narration: %s\n", narration)
+			if sample.Merchant != "" {
+				fmt.Printf("       Merchant: %s\n", sample.Merchant)
+			}
+		}
+		fmt.Println()
+	}
+
+	// Write all expense transactions category-wise to a file
+	fmt.Println("\n=== Writing All Expense Transactions by Category to File ===")
+	expensesFile, err := os.Create("expenses_by_category.txt")
+	if err != nil {
+		fmt.Printf("Warning: Could not create expenses_by_category.txt: %v\n", err)
+	} else {
+		defer expensesFile.Close()
+
+		// Write header
+		fmt.Fprintf(expensesFile, "=== All Expense Transactions Grouped by Category ===\n")
+		fmt.Fprintf(expensesFile, "Total Expense Transactions: %d\n\n", expenseCount)
+		fmt.Fprintf(expensesFile, "================================================================================\n\n")
+
+		// Write each category with all transactions
+		for _, catStat := range sortedCategories {
+			fmt.Fprintf(expensesFile, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+			fmt.Fprintf(expensesFile, "CATEGORY: %s\n", catStat.Category)
+			fmt.Fprintf(expensesFile, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+			fmt.Fprintf(expensesFile, "Total Count: %d transactions\n", catStat.Count)
+			fmt.Fprintf(expensesFile, "Total Amount: %.2f\n", catStat.Total)
+			fmt.Fprintf(expensesFile, "Average Amount: %.2f per transaction\n\n", catStat.Total/float64(catStat.Count))
+
+			// Collect all transactions for this category
+			categoryTransactions := make([]models.ClassifiedTransaction, 0)
+			for _, txn := range classifiedTransactions {
+				if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 {
+					category := txn.Category
+					if category == "" {
+						category = "Unclassified"
+					}
+					if category == catStat.Category {
+						categoryTransactions = append(categoryTransactions, txn)
+					}
+				}
+			}
+
+			// Sort transactions by date (ascending)
+			for i := 0; i < len(categoryTransactions)-1; i++ {
+				for j := i + 1; j < len(categoryTransactions); j++ {
+					if categoryTransactions[i].Date > categoryTransactions[j].Date {
+						categoryTransactions[i], categoryTransactions[j] = categoryTransactions[j], categoryTransactions[i]
+					}
+				}
+			}
+
+			// Write all transactions for this category
+			fmt.Fprintf(expensesFile, "All Transactions:\n")
+			fmt.Fprintf(expensesFile, "--------------------------------------------------------------------------------\n")
+			for i, txn := range categoryTransactions {
+				fullNarration := strings.TrimSpace(txn.Narration)
+				fullNarration = strings.ReplaceAll(fullNarration, "\n", " ")
+				fullNarration = strings.ReplaceAll(fullNarration, "\r", " ")
+
+				fmt.Fprintf(expensesFile, "%d. Date: %s | Amount: %.2f | Method: %s\n",
+					i+1, txn.Date, txn.WithdrawalAmt, txn.Method)
+				fmt.Fprintf(expensesFile, "   Narration: %s\n", fullNarration)
+				if txn.Merchant != "" && txn.Merchant != "Unknown" {
+					fmt.Fprintf(expensesFile, "   Merchant: %s\n", txn.Merchant)
+				}
+				if txn.Beneficiary != "" && txn.Beneficiary != "Unknown" {
+					fmt.Fprintf(expensesFile, "   Beneficiary: %s\n", txn.Beneficiary)
+				}
+				if txn.ClassificationMetadata.Confidence > 0 {
+					fmt.Fprintf(expensesFile, "   Confidence: %.2f%%\n", txn.ClassificationMetadata.Confidence*100)
+				}
+				if len(txn.ClassificationMetadata.MatchedKeywords) > 0 {
+					fmt.Fprintf(expensesFile, "   Matched Keywords: %s\n", strings.Join(txn.ClassificationMetadata.MatchedKeywords, ", "))
+				}
+				fmt.Fprintf(expensesFile, "\n")
+			}
+			fmt.Fprintf(expensesFile, "\n")
+		}
+
+		fmt.Fprintf(expensesFile, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+		fmt.Fprintf(expensesFile, "End of Report\n")
+		fmt.Printf("✅ All expense transactions written to: expenses_by_category.txt\n")
+	}
+
+	// Check for potentially misclassified expenses
+	fmt.Println("=== Potential Classification Issues ===")
+
+	// Create a file to write classification issues
+	issuesFile, err := os.Create("classification_issues.txt")
+	if err != nil {
+		fmt.Printf("Warning: Could not create classification_issues.txt: %v\n", err)
+	} else {
+		defer issuesFile.Close()
+	}
+
+	// Helper function to write to both console and file
+	writeIssue := func(format string, args ...interface{}) {
+		msg := fmt.Sprintf(format, args...)
+		fmt.Print(msg)
+		if issuesFile != nil {
+			fmt.Fprint(issuesFile, msg)
+		}
+	}
+
+	issuesFound := false
+
+	// Check for expenses with "Other" category that might be misclassified
+	otherExpenses := 0
+	otherTotal := 0.0
+	otherTransactions := make([]models.ClassifiedTransaction, 0)
+	for _, txn := range classifiedTransactions {
+		if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 && txn.Category == "Other" {
+			otherExpenses++
+			otherTotal += txn.WithdrawalAmt
+			otherTransactions = append(otherTransactions, txn)
+		}
+	}
+
+	if otherExpenses > 0 {
+		issuesFound = true
+		writeIssue("⚠️  Found %d expenses classified as 'Other' (Total: %.2f)\n", otherExpenses, otherTotal)
+		writeIssue("   Sample 'Other' expense transactions:\n")
+
+		// Write all "Other" transactions to file, but only show 5 in console
+		count := 0
+		for _, txn := range otherTransactions {
+			narration := txn.Narration
+			if len(narration) > 100 {
+				narration = narration[:100] + "..."
+			}
+			narration = strings.TrimSpace(narration)
+			narration = strings.ReplaceAll(narration, "\n", " ")
+			narration = strings.ReplaceAll(narration, "\r", " ")
+
+			// Always write to file
+			if issuesFile != nil {
+				fmt.Fprintf(issuesFile, "   - Date: %s | Amount: %.2f | Method: %s\n",
+					txn.Date, txn.WithdrawalAmt, txn.Method)
+				fmt.Fprintf(issuesFile, "     Narration: %s\n", txn.Narration) // Full narration in file
+			}
+
+			// Only show first 5 in console
+			if count < 5 {
+				writeIssue("   - Date: %s | Amount: %.2f | Method: %s\n",
+					txn.Date, txn.WithdrawalAmt, txn.Method)
+				writeIssue("     Narration: %s\n", narration)
+			}
+			count++
+		}
+
+		// Write summary to file
+		if issuesFile != nil {
+			fmt.Fprintf(issuesFile, "\n=== Complete List of 'Other' Transactions ===\n")
+			fmt.Fprintf(issuesFile, "Total Count: %d\n", otherExpenses)
+			fmt.Fprintf(issuesFile, "Total Amount: %.2f\n\n", otherTotal)
+
+			for i, txn := range otherTransactions {
+				fullNarration := strings.TrimSpace(txn.Narration)
+				fullNarration = strings.ReplaceAll(fullNarration, "\n", " ")
+				fullNarration = strings.ReplaceAll(fullNarration, "\r", " ")
+
+				fmt.Fprintf(issuesFile, "%d. Date: %s | Amount: %.2f | Method: %s\n",
+					i+1, txn.Date, txn.WithdrawalAmt, txn.Method)
+				fmt.Fprintf(issuesFile, "   Narration: %s\n", fullNarration)
+				if txn.Merchant != "" {
+					fmt.Fprintf(issuesFile, "   Merchant: %s\n", txn.Merchant)
+				}
+				if txn.Beneficiary != "" {
+					fmt.Fprintf(issuesFile, "   Beneficiary: %s\n", txn.Beneficiary)
+				}
+				fmt.Fprintf(issuesFile, "\n")
+			}
+		}
+
+		writeIssue("\n")
+	}
+
+	// Check for expenses with empty category
+	emptyCategoryCount := 0
+	emptyCategoryTransactions := make([]models.ClassifiedTransaction, 0)
+	for _, txn := range classifiedTransactions {
+		if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 && txn.Category == "" {
+			emptyCategoryCount++
+			emptyCategoryTransactions = append(emptyCategoryTransactions, txn)
+		}
+	}
+
+	if emptyCategoryCount > 0 {
+		issuesFound = true
+		writeIssue("⚠️  Found %d expenses with empty category\n", emptyCategoryCount)
+
+		// Write all empty category transactions to file
+		if issuesFile != nil {
+			fmt.Fprintf(issuesFile, "\n=== Expenses with Empty Category ===\n")
+			fmt.Fprintf(issuesFile, "Total Count: %d\n\n", emptyCategoryCount)
+
+			for i, txn := range emptyCategoryTransactions {
+				fullNarration := strings.TrimSpace(txn.Narration)
+				fullNarration = strings.ReplaceAll(fullNarration, "\n", " ")
+				fullNarration = strings.ReplaceAll(fullNarration, "\r", " ")
+
+				fmt.Fprintf(issuesFile, "%d. Date: %s | Amount: %.2f | Method: %s\n",
+					i+1, txn.Date, txn.WithdrawalAmt, txn.Method)
+				fmt.Fprintf(issuesFile, "   Narration: %s\n", fullNarration)
+				if txn.Merchant != "" {
+					fmt.Fprintf(issuesFile, "   Merchant: %s\n", txn.Merchant)
+				}
+				fmt.Fprintf(issuesFile, "\n")
+			}
+		}
+
+		writeIssue("\n")
+	}
+
+	if !issuesFound {
+		writeIssue("✅ No obvious classification issues found!\n")
+	} else if issuesFile != nil {
+		fmt.Printf("📄 Full classification issues report written to: classification_issues.txt\n")
+	}
+}
@@ -0,0 +1,280 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"classify/statement_analysis_engine_rules/llmcategory"
+	"classify/statement_analysis_engine_rules/review"
+)
+
+// Config holds the server settings, loaded from a JSON file and overridden by environment variables
+type Config struct {
+	Addr            string    `json:"addr"`            // Listen address (default :8080)
+	TLSCertFile     string    `json:"tlsCertFile"`     // Serve HTTPS when both cert and key are set
+	TLSKeyFile      string    `json:"tlsKeyFile"`      //
+	AllowedOrigins  []string  `json:"allowedOrigins"`  // Browser origins allowed by CORS ("*" must be explicit)
+	MaxBodyBytes    int64     `json:"maxBodyBytes"`    // Request bodies above this get 413 (default 10 MiB)
+	ReadTimeout     Duration  `json:"readTimeout"`     // Default 30s
+	WriteTimeout    Duration  `json:"writeTimeout"`    // Default 3m (LLM calls are slow)
+	IdleTimeout     Duration  `json:"idleTimeout"`     // Default 2m
+	ShutdownTimeout Duration  `json:"shutdownTimeout"` // How long SIGTERM waits for in-flight requests (default 30s)
+	DrainDelay      Duration  `json:"drainDelay"`      // Time /api/ready fails before the listener closes (default 0)
+	StatementFile   string    `json:"statementFile"`   // Statement served by /classify
+	DebugReport     bool      `json:"debugReport"`     // Write the classification report (prints full narrations)
+	RulePack        string    `json:"rulePack"`        // Classification rule pack (JSON; default: built-in)
+	RulePackReload  Duration  `json:"rulePackReload"`  // How often the rule pack file is checked for changes (default 10s)
+	CategoryModel   string    `json:"categoryModel"`   // Statistical fallback model from "stmtctl train" (default: none)
+	Taxonomy        string    `json:"taxonomy"`        // Category hierarchy for summaries, JSON (default: built-in)
+	MerchantKB      string    `json:"merchantKB"`      // Merchant knowledge base for fuzzy merchant resolution, JSON (default: built-in)
+	OverridesFile   string    `json:"overridesFile"`   // Per-user category overrides (default: in memory only)
+	ReviewFile      string    `json:"reviewFile"`      // Review queue and corrections (default: in memory only)
+	ReviewThreshold float64   `json:"reviewThreshold"` // Transactions below this confidence are queued for review (default 0.6)
+	LLM             LLMConfig `json:"llm"`
+	RAG             RAGConfig `json:"rag"`
+}
+
+// LLMConfig selects and configures the chat provider
+type LLMConfig struct {
+	Provider             string   `json:"provider"` // auto (Gemini when a key is available, else Ollama), gemini or ollama
+	GeminiAPIKey         string   `json:"geminiApiKey"`
+	GeminiModel          string   `json:"geminiModel"`
+	OllamaURL            string   `json:"ollamaUrl"`
+	OllamaChatModel      string   `json:"ollamaChatModel"`
+	OllamaEmbeddingModel string   `json:"ollamaEmbeddingModel"`
+	Timeout              Duration `json:"timeout"`             // Per LLM call (default 120s)
+	AllowClientAPIKey    bool     `json:"allowClientApiKey"`   // Accept apiKey in the chat request body
+	Categorize           bool     `json:"categorize"`          // Ask the LLM to categorize transactions the rules leave unresolved
+	CategorizeThreshold  float64  `json:"categorizeThreshold"` // Confidence below which a transaction is unresolved (default 0.5)
+	CategorizeBatchSize  int      `json:"categorizeBatchSize"` // Narrations per categorization call (default 25)
+	CategoryCacheFile    string   `json:"categoryCacheFile"`   // LLM answers by narration fingerprint (default: in memory only)
+}
+
+// RAGConfig selects the vector store used for chat retrieval
+type RAGConfig struct {
+	Store               string  `json:"store"` // auto (pgvector when the DSN works, else memory), memory or postgres
+	PostgresDSN         string  `json:"postgresDsn"`
+	TableName           string  `json:"tableName"`
+	TopK                int     `json:"topK"`
+	SimilarityThreshold float32 `json:"similarityThreshold"`
+}
+
+// Duration is a time.Duration written as a string ("30s", "2m") in the config file
+type Duration time.Duration
+
+// UnmarshalJSON accepts a duration string or a number of seconds
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err == nil {
+		*d = Duration(seconds * float64(time.Second))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"30s\": %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON writes the duration as a string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// DefaultConfig returns the settings used when no config file is given
+func DefaultConfig() *Config {
+	return &Config{
+		Addr:            ":8080",
+		AllowedOrigins:  []string{"http://localhost:5173", "http://127.0.0.1:5173"},
+		MaxBodyBytes:    10 << 20,
+		ReadTimeout:     Duration(30 * time.Second),
+		WriteTimeout:    Duration(3 * time.Minute),
+		IdleTimeout:     Duration(2 * time.Minute),
+		ShutdownTimeout: Duration(30 * time.Second),
+		StatementFile:   "Acct_Statement_XXXXXXXX1725_17122025.txt",
+		RulePackReload:  Duration(10 * time.Second),
+		ReviewThreshold: review.DefaultThreshold,
+		LLM: LLMConfig{
+			Provider:             "auto",
+			GeminiModel:          "gemini-2.0-flash-exp",
+			OllamaURL:            "http://localhost:11434",
+			OllamaChatModel:      "llama3",
+			OllamaEmbeddingModel: "llama3",
+			Timeout:              Duration(120 * time.Second),
+			AllowClientAPIKey:    true,
+			CategorizeThreshold:  llmcategory.DefaultThreshold,
+			CategorizeBatchSize:  llmcategory.DefaultBatchSize,
+		},
+		RAG: RAGConfig{
+			Store:               "auto",
+			TableName:           "statement_chunks",
+			TopK:                5,
+			SimilarityThreshold: 0.3,
+		},
+	}
+}
+
+// LoadConfig reads a JSON config file over DefaultConfig, then applies environment overrides
+// An empty path uses the defaults and the environment only
+func LoadConfig(path string) (*Config, error) {
+	config := DefaultConfig()
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read server config: %w", err)
+		}
+		if err := json.Unmarshal(data, config); err != nil {
+			return nil, fmt.Errorf("failed to parse server config %s: %w", path, err)
+		}
+	}
+	if err := config.applyEnv(); err != nil {
+		return nil, err
+	}
+	return config, config.Validate()
+}
+
+// applyEnv overrides file settings with environment variables:
+//
+//	SERVER_ADDR, TLS_CERT_FILE, TLS_KEY_FILE, CORS_ALLOWED_ORIGINS (comma-separated),
+//	MAX_BODY_BYTES, SHUTDOWN_TIMEOUT, STATEMENT_FILE, CLASSIFY_DEBUG_REPORT, RULE_PACK,
+//	CATEGORY_MODEL, CATEGORY_TAXONOMY, MERCHANT_KB, USER_OVERRIDES_FILE, REVIEW_FILE, LLM_PROVIDER, GEMINI_API_KEY, GEMINI_MODEL,
+//	OLLAMA_URL, OLLAMA_CHAT_MODEL, OLLAMA_EMBEDDING_MODEL, LLM_CATEGORIZE, LLM_CATEGORY_CACHE,
+//	RAG_STORE, POSTGRES_DSN
+func (c *Config) applyEnv() error {
+	setString := func(name string, target *string) {
+		if v := os.Getenv(name); v != "" {
+			*target = v
+		}
+	}
+	setString("SERVER_ADDR", &c.Addr)
+	setString("TLS_CERT_FILE", &c.TLSCertFile)
+	setString("TLS_KEY_FILE", &c.TLSKeyFile)
+	setString("STATEMENT_FILE", &c.StatementFile)
+	setString("RULE_PACK", &c.RulePack)
+	setString("CATEGORY_MODEL", &c.CategoryModel)
+	setString("CATEGORY_TAXONOMY", &c.Taxonomy)
+	setString("MERCHANT_KB", &c.MerchantKB)
+	setString("USER_OVERRIDES_FILE", &c.OverridesFile)
+	setString("REVIEW_FILE", &c.ReviewFile)
+	setString("LLM_PROVIDER", &c.LLM.Provider)
+	setString("GEMINI_API_KEY", &c.LLM.GeminiAPIKey)
+	setString("GEMINI_MODEL", &c.LLM.GeminiModel)
+	setString("OLLAMA_URL", &c.LLM.OllamaURL)
+	setString("OLLAMA_CHAT_MODEL", &c.LLM.OllamaChatModel)
+	setString("OLLAMA_EMBEDDING_MODEL", &c.LLM.OllamaEmbeddingModel)
+	setString("LLM_CATEGORY_CACHE", &c.LLM.CategoryCacheFile)
+	setString("RAG_STORE", &c.RAG.Store)
+	setString("POSTGRES_DSN", &c.RAG.PostgresDSN)
+
+	if v := os.Getenv("CORS_ALLOWED_ORIGINS"); v != "" {
+		c.AllowedOrigins = nil
+		for _, origin := range strings.Split(v, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				c.AllowedOrigins = append(c.AllowedOrigins, origin)
+			}
+		}
+	}
+	if v := os.Getenv("MAX_BODY_BYTES"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid MAX_BODY_BYTES %q: %w", v, err)
+		}
+		c.MaxBodyBytes = n
+	}
+	if v := os.Getenv("SHUTDOWN_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid SHUTDOWN_TIMEOUT %q: %w", v, err)
+		}
+		c.ShutdownTimeout = Duration(d)
+	}
+	setBool := func(name string, target *bool) error {
+		v := os.Getenv(name)
+		if v == "" {
+			return nil
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: expected true or false", name, v)
+		}
+		*target = b
+		return nil
+	}
+	if err := setBool("CLASSIFY_DEBUG_REPORT", &c.DebugReport); err != nil {
+		return err
+	}
+	return setBool("LLM_CATEGORIZE", &c.LLM.Categorize)
+}
+
+// Validate reports settings the server cannot start with
+func (c *Config) Validate() error {
+	if c.Addr == "" {
+		return fmt.Errorf("server config: addr is required")
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return fmt.Errorf("server config: tlsCertFile and tlsKeyFile must be set together")
+	}
+	if c.MaxBodyBytes <= 0 {
+		return fmt.Errorf("server config: maxBodyBytes must be positive, got %d", c.MaxBodyBytes)
+	}
+	if c.ShutdownTimeout < 0 || c.DrainDelay < 0 {
+		return fmt.Errorf("server config: shutdownTimeout and drainDelay must not be negative")
+	}
+	if c.ReviewThreshold < 0 || c.ReviewThreshold > 1 {
+		return fmt.Errorf("server config: reviewThreshold must be between 0 and 1, got %v", c.ReviewThreshold)
+	}
+	switch c.LLM.Provider {
+	case "auto", "ollama":
+	case "gemini":
+		if c.LLM.GeminiAPIKey == "" && !c.LLM.AllowClientAPIKey {
+			return fmt.Errorf("server config: llm provider gemini needs geminiApiKey or allowClientApiKey")
+		}
+	default:
+		return fmt.Errorf("server config: llm provider must be auto, gemini or ollama, got %q", c.LLM.Provider)
+	}
+	if c.LLM.CategorizeThreshold < 0 || c.LLM.CategorizeThreshold > 1 {
+		return fmt.Errorf("server config: llm categorizeThreshold must be between 0 and 1, got %v", c.LLM.CategorizeThreshold)
+	}
+	if c.LLM.CategorizeBatchSize < 0 {
+		return fmt.Errorf("server config: llm categorizeBatchSize must not be negative, got %d", c.LLM.CategorizeBatchSize)
+	}
+	if _, err := url.Parse(c.LLM.OllamaURL); err != nil {
+		return fmt.Errorf("server config: invalid ollamaUrl: %w", err)
+	}
+	switch c.RAG.Store {
+	case "auto", "memory":
+	case "postgres":
+		if c.RAG.PostgresDSN == "" {
+			return fmt.Errorf("server config: rag store postgres needs postgresDsn")
+		}
+	default:
+		return fmt.Errorf("server config: rag store must be auto, memory or postgres, got %q", c.RAG.Store)
+	}
+	return nil
+}
+
+// TLS reports whether the server should serve HTTPS
+func (c *Config) TLS() bool {
+	return c.TLSCertFile != "" && c.TLSKeyFile != ""
+}
+
+// OllamaChatURL returns the Ollama chat endpoint
+func (c LLMConfig) OllamaChatURL() string {
+	return strings.TrimRight(c.OllamaURL, "/") + "/api/chat"
+}
+
+// GeminiURL returns the generateContent endpoint for the configured model
+func (c LLMConfig) GeminiURL(apiKey string) string {
+	return fmt.Sprintf("https://generativelanguage.googleapis.com/v1beta/models/%s:generateContent?key=%s",
+		url.PathEscape(c.GeminiModel), url.QueryEscape(apiKey))
+}
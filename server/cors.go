@@ -0,0 +1,38 @@
+package server
+
+import (
+	"net/http"
+)
+
+// cors sets CORS headers for the configured origins and answers preflight requests before authentication runs
+func cors(allowed []string, next http.Handler) http.Handler {
+	origins := make(map[string]bool, len(allowed))
+	for _, origin := range allowed {
+		origins[origin] = true
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		allow := true
+		switch {
+		case origin != "" && origins[origin]:
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+		case origins["*"]:
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		default:
+			// Unknown origins get no CORS headers, so browsers block the response
+			allow = false
+		}
+		if allow {
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key, X-Request-ID")
+			w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, Retry-After, X-Quota-Limit, X-Quota-Remaining, X-Quota-Reset")
+			w.Header().Set("Access-Control-Max-Age", "3600")
+		}
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
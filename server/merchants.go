@@ -0,0 +1,49 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/analytics"
+	"classify/statement_analysis_engine_rules/models"
+
+	"your-module/pagination"
+)
+
+// merchantsHandler handles GET /api/merchants: merchant analytics for the statement, ranked by spend and paginated
+// filter_category keeps one category and search matches part of the merchant name (case-insensitive)
+func (s *Server) merchantsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	classified, ok := s.classifyStatement(w, r)
+	if !ok {
+		return
+	}
+	params := pagination.ParsePagination(r, pagination.DefaultConfig())
+	merchants := filterMerchants(analytics.CalculateMerchantAnalytics(classified.transactions), params)
+
+	if err := json.NewEncoder(w).Encode(pagination.PaginateSlice(merchants, params)); err != nil {
+		http.Error(w, "Error encoding JSON", http.StatusInternalServerError)
+	}
+}
+
+// filterMerchants applies the filter_category and search parameters; ranks stay those of the full list
+func filterMerchants(merchants []models.MerchantStats, params pagination.PaginationParams) []models.MerchantStats {
+	category, _ := params.Filters["category"].(string)
+	search := strings.ToUpper(params.Search)
+	if category == "" && search == "" {
+		return merchants
+	}
+	filtered := make([]models.MerchantStats, 0, len(merchants))
+	for _, merchant := range merchants {
+		if category != "" && !strings.EqualFold(merchant.Category, category) {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToUpper(merchant.Merchant), search) {
+			continue
+		}
+		filtered = append(filtered, merchant)
+	}
+	return filtered
+}
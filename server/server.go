@@ -0,0 +1,431 @@
+// Package server is the HTTP API: classification, overrides and review, chat, webhooks and operational endpoints
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"classify/auth"
+	"classify/logging"
+	"classify/metrics"
+	"classify/openapi"
+	"classify/rag"
+	"classify/ratelimit"
+	"classify/statement_analysis_engine_rules/llmcategory"
+	"classify/statement_analysis_engine_rules/merchantkb"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/statement_analysis_engine_rules/review"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/taxonomy"
+	"classify/statement_analysis_engine_rules/textmodel"
+	"classify/webhooks"
+
+	"your-module/pagination"
+)
+
+// Server owns the configuration, dependencies and routes of the API
+type Server struct {
+	config        *Config
+	mux           *http.ServeMux
+	authenticator auth.Authenticator
+	limiter       *ratelimit.Limiter   // nil when rate limiting is disabled
+	webhooks      *webhooks.Dispatcher // nil when webhooks are not configured
+	rules         *rulepack.Reloader   // nil when the built-in rule pack is used
+	overrides     *overrides.Store
+	review        *review.Queue
+	categorizer   *llmcategory.Categorizer // nil when LLM categorization is disabled
+	rag           *rag.Manager
+	ragErr        error // Chat falls back to a direct prompt when the RAG store failed to start
+	llmClient     *http.Client
+	draining      atomic.Bool
+}
+
+// paginationMetrics registers the process-wide pagination collector once, however many servers are built
+var paginationMetrics sync.Once
+
+// New builds the server from config (nil uses DefaultConfig)
+// Authentication, rate limits and webhooks are still configured from their own environment variables
+func New(config *Config) (*Server, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	s := &Server{
+		config:    config,
+		mux:       http.NewServeMux(),
+		llmClient: &http.Client{Timeout: time.Duration(config.LLM.Timeout)},
+	}
+
+	// Authentication: API keys and/or JWTs; every request is scoped to the caller's tenant
+	authConfig, err := auth.ConfigFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("invalid authentication configuration: %w", err)
+	}
+	s.authenticator, err = auth.New(authConfig)
+	if err != nil {
+		return nil, fmt.Errorf("authentication not configured: %w", err)
+	}
+	if authConfig.Disabled {
+		slog.Warn("authentication disabled, all requests use the default tenant")
+	}
+
+	// Per-tenant (or per-key) rate limits and monthly quotas
+	limitConfig, err := ratelimit.ConfigFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limit configuration: %w", err)
+	}
+	if limitConfig != nil {
+		s.limiter = ratelimit.NewLimiter(ratelimit.NewMemoryStore(), limitConfig)
+	} else {
+		slog.Warn("rate limiting disabled")
+	}
+
+	// Webhook notifications for completed analyses and anomalies (optional)
+	s.webhooks, err = webhooks.NewFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook configuration: %w", err)
+	}
+
+	// Classification rules: a pack file is validated now and reloaded when it changes
+	if config.RulePack != "" {
+		s.rules = rulepack.NewReloader(config.RulePack, time.Duration(config.RulePackReload))
+		pack, err := s.rules.Load()
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule pack: %w", err)
+		}
+		slog.Info("rule pack loaded", slog.String("path", config.RulePack), slog.String("version", pack.Version))
+	}
+	// Statistical fallback for transactions the rules leave as "Other"
+	if config.CategoryModel != "" {
+		model, err := textmodel.LoadFile(config.CategoryModel)
+		if err != nil {
+			return nil, fmt.Errorf("invalid category model: %w", err)
+		}
+		if err := textmodel.Activate(model); err != nil {
+			return nil, fmt.Errorf("invalid category model: %w", err)
+		}
+	}
+
+	// Category hierarchy used by the category summary
+	if config.Taxonomy != "" {
+		t, err := taxonomy.LoadFile(config.Taxonomy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid taxonomy: %w", err)
+		}
+		taxonomy.Activate(t)
+	}
+
+	// Canonical merchants, aliases and VPAs tried before the rule pack's merchant map
+	if config.MerchantKB != "" {
+		kb, err := merchantkb.LoadFile(config.MerchantKB)
+		if err != nil {
+			return nil, fmt.Errorf("invalid merchant knowledge base: %w", err)
+		}
+		merchantkb.Activate(kb)
+	}
+
+	// LLM answers for transactions the rules and the model leave unresolved, cached by narration fingerprint
+	if config.LLM.Categorize {
+		s.categorizer, err = s.newCategorizer()
+		if err != nil {
+			return nil, fmt.Errorf("invalid LLM category cache: %w", err)
+		}
+		llmcategory.Activate(s.categorizer)
+	}
+
+	// Per-user category overrides, kept in memory unless a file is configured
+	s.overrides, err = overrides.NewStore(config.OverridesFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid overrides file: %w", err)
+	}
+	// Low-confidence and "Other" classifications are queued for review; corrections become overrides
+	s.review, err = review.NewQueue(config.ReviewFile, config.ReviewThreshold, s.overrides)
+	if err != nil {
+		return nil, fmt.Errorf("invalid review file: %w", err)
+	}
+
+	// The vector store is opened at startup so /api/ready can report it
+	s.rag, s.ragErr = rag.NewManager(config.ragConfig())
+	if s.ragErr != nil {
+		slog.Warn("RAG store unavailable, chat will use direct prompts", slog.Any("error", s.ragErr))
+	}
+
+	// Paginated endpoints report into the same registry
+	paginationMetrics.Do(func() {
+		pagination.SetMetricsCollector(metrics.NewPaginationCollector(metrics.Default))
+	})
+
+	s.routes()
+	return s, nil
+}
+
+// ragConfig maps the server settings onto the RAG pipeline's config
+func (c *Config) ragConfig() *rag.Config {
+	config := rag.DefaultConfig()
+	config.OllamaURL = c.LLM.OllamaURL
+	config.EmbeddingModel = c.LLM.OllamaEmbeddingModel
+	config.ChatModel = c.LLM.OllamaChatModel
+	config.TopK = c.RAG.TopK
+	config.SimilarityThreshold = c.RAG.SimilarityThreshold
+	config.TableName = c.RAG.TableName
+	config.PostgreSQLDSN = ""
+	if c.RAG.Store != "memory" {
+		config.PostgreSQLDSN = c.RAG.PostgresDSN
+	}
+	config.UsePostgreSQL = c.RAG.Store == "postgres"
+	return config
+}
+
+// routes registers every endpoint; anything not listed here is a 404 (or 405 for a known path)
+func (s *Server) routes() {
+	s.mux.HandleFunc("POST /classify", s.protect("/classify", s.classifyHandler))
+	s.mux.HandleFunc("POST /api/chat", s.protect("/api/chat", s.chatHandler))
+	s.mux.HandleFunc("GET /api/merchants", s.protect("/api/merchants", s.merchantsHandler))
+	s.mux.HandleFunc("GET /api/trace", s.protect("/api/trace", s.traceHandler))
+	userOverrides := s.protect("/api/overrides", overrides.Handler(s.overrides, caller))
+	s.mux.HandleFunc("GET /api/overrides", userOverrides)
+	s.mux.HandleFunc("POST /api/overrides", userOverrides)
+	s.mux.HandleFunc("DELETE /api/overrides", userOverrides)
+	queue := s.protect("/api/review", review.Handler(s.review, caller))
+	s.mux.HandleFunc("GET /api/review", queue)
+	s.mux.HandleFunc("DELETE /api/review", queue)
+	corrections := s.protect("/api/review/corrections", review.CorrectionHandler(s.review, caller))
+	s.mux.HandleFunc("GET /api/review/corrections", corrections)
+	s.mux.HandleFunc("POST /api/review/corrections", corrections)
+	s.mux.HandleFunc("GET /api/review/report", s.protect("/api/review/report", review.ReportHandler(s.review, caller)))
+	if s.webhooks != nil {
+		hooks := s.protect("/api/webhooks", webhooks.Handler(s.webhooks.Registry()))
+		s.mux.HandleFunc("GET /api/webhooks", hooks)
+		s.mux.HandleFunc("POST /api/webhooks", hooks)
+		s.mux.HandleFunc("DELETE /api/webhooks", hooks)
+	}
+	s.mux.HandleFunc("GET /api/health", metrics.InstrumentHandler("/api/health", healthHandler))
+	s.mux.HandleFunc("GET /api/ready", metrics.InstrumentHandler("/api/ready", s.readyHandler))
+	s.mux.Handle("GET /metrics", metrics.Default.Handler())
+	s.mux.HandleFunc("GET /openapi.json", openapi.Handler(openapi.Spec()))
+}
+
+// protect wraps an API handler with metrics, request logging, authentication and rate limiting
+func (s *Server) protect(name string, handler http.HandlerFunc) http.HandlerFunc {
+	if s.limiter != nil {
+		handler = s.limiter.Middleware(name, handler)
+	}
+	return metrics.InstrumentHandler(name, logging.Middleware(auth.Middleware(s.authenticator, handler)))
+}
+
+// caller identifies the authenticated subject of a protected request for the engine's handlers
+func caller(r *http.Request) (tenantID, userID string, logger *slog.Logger) {
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	return principal.TenantID, principal.Subject, logging.FromContext(r.Context())
+}
+
+// Handler returns the root handler: CORS and the body size limit around the routes
+func (s *Server) Handler() http.Handler {
+	limitBody := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > s.config.MaxBodyBytes {
+			http.Error(w, "Request too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, s.config.MaxBodyBytes)
+		s.mux.ServeHTTP(w, r)
+	})
+	return cors(s.config.AllowedOrigins, limitBody)
+}
+
+// Run listens on the configured address and serves until ctx is cancelled, then drains
+func (s *Server) Run(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.config.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.config.Addr, err)
+	}
+	return s.Serve(ctx, ln)
+}
+
+// Serve serves on ln until ctx is cancelled
+// Shutdown marks the server not ready, waits DrainDelay, stops accepting connections, waits up to ShutdownTimeout
+// for in-flight requests and then flushes queued webhook deliveries
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	srv := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Duration(s.config.ReadTimeout),
+		WriteTimeout:      time.Duration(s.config.WriteTimeout),
+		IdleTimeout:       time.Duration(s.config.IdleTimeout),
+		ErrorLog:          slog.NewLogLogger(slog.Default().Handler(), slog.LevelWarn),
+	}
+
+	if s.rules != nil {
+		go s.rules.Run(ctx)
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		if s.config.TLS() {
+			serveErr <- srv.ServeTLS(ln, s.config.TLSCertFile, s.config.TLSKeyFile)
+		} else {
+			serveErr <- srv.Serve(ln)
+		}
+	}()
+	slog.Info("server started",
+		slog.String("addr", ln.Addr().String()),
+		slog.Bool("tls", s.config.TLS()),
+		slog.Any("endpoints", []string{"POST /classify", "POST /api/chat", "GET /api/health", "GET /api/ready", "GET /metrics", "GET /openapi.json"}),
+	)
+
+	select {
+	case err := <-serveErr:
+		s.close(context.Background())
+		return err
+	case <-ctx.Done():
+	}
+
+	s.draining.Store(true)
+	if delay := time.Duration(s.config.DrainDelay); delay > 0 {
+		// Keep serving while load balancers see /api/ready fail and stop routing here
+		slog.Info("draining, readiness now failing", slog.Duration("delay", delay))
+		time.Sleep(delay)
+	}
+	timeout := time.Duration(s.config.ShutdownTimeout)
+	slog.Info("shutting down, draining in-flight requests", slog.Duration("timeout", timeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := srv.Shutdown(shutdownCtx)
+	if err != nil {
+		// Requests still running after the timeout are cut off
+		srv.Close()
+		err = fmt.Errorf("graceful shutdown incomplete: %w", err)
+	}
+	<-serveErr
+	if closeErr := s.close(shutdownCtx); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	slog.Info("server stopped")
+	return err
+}
+
+// close releases background resources once no more requests are running
+func (s *Server) close(ctx context.Context) error {
+	var err error
+	if s.webhooks != nil {
+		if closeErr := s.webhooks.Close(ctx); closeErr != nil {
+			err = fmt.Errorf("webhook deliveries abandoned: %w", closeErr)
+		}
+	}
+	if s.rag != nil {
+		if closeErr := s.rag.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// ragManager returns the RAG manager, or the error it failed to start with
+func (s *Server) ragManager() (*rag.Manager, error) {
+	if s.ragErr != nil {
+		return nil, s.ragErr
+	}
+	return s.rag, nil
+}
+
+// selectProvider returns the Gemini key to use, or useOllama when chat should go to Ollama
+func (s *Server) selectProvider(clientKey string) (apiKey string, useOllama bool) {
+	if s.config.LLM.Provider == "ollama" {
+		return "", true
+	}
+	apiKey = s.config.LLM.GeminiAPIKey
+	if clientKey != "" && s.config.LLM.AllowClientAPIKey {
+		apiKey = clientKey
+	}
+	if s.config.LLM.Provider == "gemini" {
+		return apiKey, false
+	}
+	return apiKey, apiKey == ""
+}
+
+// healthHandler reports that the process is up (liveness); it does not check dependencies
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	// Set content type to JSON
+	w.Header().Set("Content-Type", "application/json")
+
+	healthResp := models.HealthResponse{
+		Status:    "healthy",
+		Service:   "chat-api",
+		Timestamp: getCurrentTimestamp(),
+	}
+
+	if err := json.NewEncoder(w).Encode(healthResp); err != nil {
+		slog.Error("failed to encode health response", slog.Any("error", err))
+		http.Error(w, "Error encoding response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// readyHandler reports whether the server should receive traffic: 503 while draining or when a dependency is down
+// The RAG store is optional (chat falls back to direct prompts), so its failure only marks the server degraded
+func (s *Server) readyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+	defer cancel()
+
+	resp := models.ReadinessResponse{
+		Status:    "ready",
+		Checks:    make(map[string]string),
+		Timestamp: getCurrentTimestamp(),
+	}
+	check := func(name string, err error) {
+		if err != nil {
+			resp.Checks[name] = err.Error()
+			resp.Status = "not_ready"
+			return
+		}
+		resp.Checks[name] = "ok"
+	}
+	degrade := func(name string, err error) {
+		if err != nil {
+			resp.Checks[name] = "degraded: " + err.Error()
+			resp.Degraded = append(resp.Degraded, name)
+			return
+		}
+		resp.Checks[name] = "ok"
+	}
+
+	if _, err := os.Stat(s.config.StatementFile); err != nil {
+		check("statement_file", errors.New("statement file not readable"))
+	} else {
+		check("statement_file", nil)
+	}
+	mgr, err := s.ragManager()
+	if err == nil {
+		err = mgr.Ping(ctx)
+	}
+	degrade("rag_store", err)
+
+	status := http.StatusOK
+	if resp.Status == "ready" && len(resp.Degraded) > 0 {
+		resp.Status = "degraded"
+	}
+	if s.draining.Load() {
+		resp.Status = "draining"
+	}
+	if resp.Status != "ready" && resp.Status != "degraded" {
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		slog.Error("failed to encode readiness response", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,333 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"classify/statement_analysis_engine_rules/llmcategory"
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func testServer(t *testing.T, config *Config) *Server {
+	t.Helper()
+	t.Setenv("AUTH_DISABLED", "true")
+	t.Setenv("RATE_LIMIT_DISABLED", "true")
+	t.Setenv("WEBHOOK_SUBSCRIPTIONS", "")
+	t.Setenv("WEBHOOKS_ENABLED", "")
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if config.StatementFile == DefaultConfig().StatementFile {
+		config.StatementFile = filepath.Join(t.TempDir(), "statement.txt")
+		if err := os.WriteFile(config.StatementFile, []byte("statement"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config.RAG.Store = "memory"
+	s, err := New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.json")
+	file := `{"addr": ":9090", "allowedOrigins": ["https://app.example.com"], "shutdownTimeout": "5s",
+		"llm": {"provider": "ollama", "ollamaUrl": "http://ollama:11434"}, "rag": {"store": "memory", "topK": 8}}`
+	if err := os.WriteFile(path, []byte(file), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SERVER_ADDR", ":7070")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://a.example.com, https://b.example.com")
+	t.Setenv("MAX_BODY_BYTES", "1024")
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		got      interface{}
+		expected interface{}
+	}{
+		{"env overrides file", config.Addr, ":7070"},
+		{"env origins", strings.Join(config.AllowedOrigins, ","), "https://a.example.com,https://b.example.com"},
+		{"env body limit", config.MaxBodyBytes, int64(1024)},
+		{"file duration", time.Duration(config.ShutdownTimeout), 5 * time.Second},
+		{"file provider", config.LLM.Provider, "ollama"},
+		{"file nested", config.RAG.TopK, 8},
+		{"default kept", config.LLM.OllamaChatModel, "llama3"},
+		{"ollama url", config.LLM.OllamaChatURL(), "http://ollama:11434/api/chat"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, tt.got)
+		}
+	}
+
+	for _, value := range []string{"false", "0"} {
+		t.Setenv("CLASSIFY_DEBUG_REPORT", value)
+		t.Setenv("LLM_CATEGORIZE", value)
+		if config, err := LoadConfig(""); err != nil || config.DebugReport || config.LLM.Categorize {
+			t.Errorf("expected %s to leave the debug report and LLM categorization off, got %v", value, err)
+		}
+	}
+	t.Setenv("CLASSIFY_DEBUG_REPORT", "yes please")
+	if _, err := LoadConfig(""); err == nil || !strings.Contains(err.Error(), "CLASSIFY_DEBUG_REPORT") {
+		t.Errorf("expected an error for an invalid CLASSIFY_DEBUG_REPORT, got %v", err)
+	}
+	t.Setenv("CLASSIFY_DEBUG_REPORT", "true")
+	if config, err := LoadConfig(""); err != nil || !config.DebugReport {
+		t.Errorf("expected true to enable the debug report, got %v", err)
+	}
+	t.Setenv("CLASSIFY_DEBUG_REPORT", "")
+	t.Setenv("LLM_CATEGORIZE", "")
+
+	invalid := []string{
+		`{"tlsCertFile": "cert.pem"}`,
+		`{"llm": {"provider": "openai"}}`,
+		`{"rag": {"store": "postgres"}}`,
+		`{"shutdownTimeout": "soon"}`,
+		`{"llm": {"categorizeThreshold": 2}}`,
+	}
+	for _, body := range invalid {
+		if err := os.WriteFile(path, []byte(body), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadConfig(path); err == nil {
+			t.Errorf("expected an error for %s", body)
+		}
+	}
+}
+
+func TestSelectProvider(t *testing.T) {
+	tests := []struct {
+		provider   string
+		serverKey  string
+		clientKey  string
+		allow      bool
+		wantKey    string
+		wantOllama bool
+	}{
+		{"auto", "", "", true, "", true},
+		{"auto", "server", "", true, "server", false},
+		{"auto", "server", "client", true, "client", false},
+		{"auto", "", "client", false, "", true},
+		{"ollama", "server", "client", true, "", true},
+		{"gemini", "server", "", true, "server", false},
+	}
+	for _, tt := range tests {
+		config := DefaultConfig()
+		config.LLM.Provider = tt.provider
+		config.LLM.GeminiAPIKey = tt.serverKey
+		config.LLM.AllowClientAPIKey = tt.allow
+		s := &Server{config: config}
+		key, useOllama := s.selectProvider(tt.clientKey)
+		if key != tt.wantKey || useOllama != tt.wantOllama {
+			t.Errorf("%+v: expected (%q, %v), got (%q, %v)", tt, tt.wantKey, tt.wantOllama, key, useOllama)
+		}
+	}
+}
+
+func TestCategorizeCompleter(t *testing.T) {
+	var format map[string]interface{}
+	ollama := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req OllamaRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		format, _ = req.Format.(map[string]interface{})
+		json.NewEncoder(w).Encode(OllamaResponse{Message: OllamaMessage{Role: "assistant", Content: `{"results": []}`}})
+	}))
+	defer ollama.Close()
+
+	config := DefaultConfig()
+	config.LLM.Provider = "ollama"
+	config.LLM.OllamaURL = ollama.URL
+	s := &Server{config: config, llmClient: ollama.Client()}
+	completer := llmCompleter{s: s, useOllama: true}
+	schema := llmcategory.ResponseSchema()
+	raw, err := completer.Complete(context.Background(), "system", `{"transactions": []}`, schema)
+	if err != nil || raw != `{"results": []}` {
+		t.Fatalf("expected the Ollama answer, got %q (%v)", raw, err)
+	}
+	if format["type"] != "object" {
+		t.Errorf("expected the response schema as the Ollama format, got %v", format)
+	}
+
+	converted := geminiSchema(schema)
+	items := converted["properties"].(map[string]interface{})["results"].(map[string]interface{})["items"].(map[string]interface{})
+	id := items["properties"].(map[string]interface{})["id"].(map[string]interface{})
+	if converted["type"] != "OBJECT" || id["type"] != "INTEGER" {
+		t.Errorf("expected upper-case Gemini schema types, got %v", converted)
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	config := DefaultConfig()
+	config.MaxBodyBytes = 64
+	handler := testServer(t, config).Handler()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		origin string
+		status int
+	}{
+		{"health", http.MethodGet, "/api/health", "", "", http.StatusOK},
+		{"ready", http.MethodGet, "/api/ready", "", "", http.StatusOK},
+		{"openapi", http.MethodGet, "/openapi.json", "", "", http.StatusOK},
+		{"wrong method", http.MethodGet, "/classify", "", "", http.StatusMethodNotAllowed},
+		{"list overrides", http.MethodGet, "/api/overrides", "", "", http.StatusOK},
+		{"invalid override", http.MethodPost, "/api/overrides", `{"type":"vpa"}`, "", http.StatusBadRequest},
+		{"review queue", http.MethodGet, "/api/review", "", "", http.StatusOK},
+		{"review report", http.MethodGet, "/api/review/report", "", "", http.StatusOK},
+		{"merchants", http.MethodGet, "/api/merchants?page=2&page_size=5", "", "", http.StatusOK},
+		{"trace without row", http.MethodGet, "/api/trace", "", "", http.StatusBadRequest},
+		{"trace row out of range", http.MethodGet, "/api/trace?row=1", "", "", http.StatusBadRequest},
+		{"unknown path", http.MethodGet, "/api/unknown", "", "", http.StatusNotFound},
+		{"preflight", http.MethodOptions, "/api/chat", "", "http://localhost:5173", http.StatusOK},
+		{"body too large", http.MethodPost, "/api/chat", `{"message":"` + strings.Repeat("x", 100) + `"}`, "", http.StatusRequestEntityTooLarge},
+		{"invalid chat", http.MethodPost, "/api/chat", `{}`, "", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.status {
+				t.Errorf("expected %d, got %d: %s", tt.status, rec.Code, rec.Body)
+			}
+			if tt.origin != "" && rec.Header().Get("Access-Control-Allow-Origin") != tt.origin {
+				t.Errorf("expected CORS origin %s, got %q", tt.origin, rec.Header().Get("Access-Control-Allow-Origin"))
+			}
+		})
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	config := DefaultConfig()
+	config.StatementFile = filepath.Join(t.TempDir(), "missing.txt")
+	s := testServer(t, config)
+
+	ready := func() (int, models.ReadinessResponse) {
+		rec := httptest.NewRecorder()
+		s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/ready", nil))
+		var resp models.ReadinessResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		return rec.Code, resp
+	}
+
+	if code, resp := ready(); code != http.StatusServiceUnavailable || resp.Checks["statement_file"] == "ok" {
+		t.Errorf("expected 503 for a missing statement file, got %d %+v", code, resp)
+	}
+
+	if err := os.WriteFile(config.StatementFile, []byte("statement"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if code, resp := ready(); code != http.StatusOK || resp.Status != "ready" {
+		t.Errorf("expected 200 ready, got %d %+v", code, resp)
+	}
+
+	// Chat works without RAG, so a failed store keeps the server in rotation
+	s.ragErr = errors.New("connection refused")
+	if code, resp := ready(); code != http.StatusOK || resp.Status != "degraded" || len(resp.Degraded) != 1 || resp.Degraded[0] != "rag_store" {
+		t.Errorf("expected 200 degraded by rag_store, got %d %+v", code, resp)
+	}
+
+	s.draining.Store(true)
+	if code, resp := ready(); code != http.StatusServiceUnavailable || resp.Status != "draining" {
+		t.Errorf("expected 503 draining, got %d %+v", code, resp)
+	}
+
+	// Liveness is unaffected by draining
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/health", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected health 200 while draining, got %d", rec.Code)
+	}
+}
+
+func TestGracefulShutdown(t *testing.T) {
+	config := DefaultConfig()
+	config.ShutdownTimeout = Duration(5 * time.Second)
+	s := testServer(t, config)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	s.mux.HandleFunc("GET /slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		io.WriteString(w, "done")
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- s.Serve(ctx, ln) }()
+
+	type result struct {
+		body string
+		err  error
+	}
+	inFlight := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + ln.Addr().String() + "/slow")
+		if err != nil {
+			inFlight <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		inFlight <- result{string(body), err}
+	}()
+	<-started
+
+	// SIGTERM equivalent: shutdown must wait for the in-flight request
+	cancel()
+	select {
+	case err := <-served:
+		t.Fatalf("server stopped with a request in flight: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if !s.draining.Load() {
+		t.Error("expected the server to be draining")
+	}
+	if _, err := http.Get("http://" + ln.Addr().String() + "/api/health"); err == nil {
+		t.Error("expected new connections to be refused while draining")
+	}
+
+	close(release)
+	if r := <-inFlight; r.err != nil || r.body != "done" {
+		t.Errorf("expected in-flight request to complete, got %q, %v", r.body, r.err)
+	}
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("expected clean shutdown, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not stop after the request finished")
+	}
+}
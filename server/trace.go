@@ -0,0 +1,53 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+)
+
+// traceHandler handles GET /api/trace?row=N: how every classification layer decided one transaction of the statement
+// Only the requested row is classified; cached LLM answers and the caller's overrides show up as layers, and the
+// LLM is asked only when the row is unresolved
+func (s *Server) traceHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	row, err := strconv.Atoi(r.URL.Query().Get("row"))
+	if err != nil || row < 1 {
+		http.Error(w, "row must be a transaction number (rows are numbered from 1)", http.StatusBadRequest)
+		return
+	}
+	statement, ok := s.readStatement(w, r)
+	if !ok {
+		return
+	}
+	if row > len(statement.Transactions) {
+		http.Error(w, fmt.Sprintf("row %d out of range (statement has %d transactions)", row, len(statement.Transactions)), http.StatusBadRequest)
+		return
+	}
+
+	txn := statement.Transactions[row-1]
+	converted := classifier.ConvertFromTxtTransaction(txn.Date, txn.Narration, txn.ChequeRefNo, txn.ValueDate, txn.WithdrawalAmt, txn.DepositAmt, txn.ClosingBalance)
+	userOverrides := s.callerOverrides(r)
+	traced := classifier.TraceTransaction(converted, statement.AccountInfo.AccountHolderName, userOverrides)
+	if s.resolveWithLLM(r, []models.ClassifiedTransaction{traced}) {
+		traced = classifier.TraceTransaction(converted, statement.AccountInfo.AccountHolderName, userOverrides)
+	}
+	response := models.TraceResponse{
+		Row:                    row,
+		Date:                   traced.Date,
+		Narration:              traced.Narration,
+		Method:                 traced.Method,
+		Category:               traced.Category,
+		Merchant:               traced.Merchant,
+		Beneficiary:            traced.Beneficiary,
+		ClassificationMetadata: traced.ClassificationMetadata,
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Error encoding JSON", http.StatusInternalServerError)
+	}
+}
@@ -24,6 +24,8 @@ func CalculateAccountSummary(
 }
 
 // CalculateAccountSummaryWithTotals calculates account summary with optional statement totals
+// Transfers paired with the other side in another of the user's statements (PairInternalTransfers)
+// are money moving between own accounts, so they count as neither income nor expense
 func CalculateAccountSummaryWithTotals(
 	accountNo string,
 	customerName string,
@@ -37,6 +39,14 @@ func CalculateAccountSummaryWithTotals(
 	totalIncome := 0.0
 	totalExpense := 0.0
 	totalInvestments := 0.0
+	internalTransfers := 0.0
+	pairedCredits := 0.0
+	for _, txn := range transactions {
+		if txn.InternalTransfer != nil && txn.InternalTransfer.Paired {
+			internalTransfers += txn.WithdrawalAmt + txn.DepositAmt
+			pairedCredits += txn.DepositAmt
+		}
+	}
 
 	// Investment categories/methods to exclude from expenses
 	// These represent wealth accumulation, savings, or money movement (not consumption)
@@ -58,12 +68,15 @@ func CalculateAccountSummaryWithTotals(
 	// We use a threshold check: if credits > 0 OR debits > 0, assume they were provided
 	// This handles cases where one might legitimately be 0
 	if statementTotalCredits > 0 || statementTotalDebits > 0 {
-		// Use official statement totals for income
-		totalIncome = statementTotalCredits
+		// Use official statement totals for income, less the credits from own accounts
+		totalIncome = statementTotalCredits - pairedCredits
 		
 		// For expense vs investment breakdown, we need to calculate from transactions
 		// because bank statement doesn't separate investments from expenses
 		for _, txn := range transactions {
+			if txn.InternalTransfer != nil && txn.InternalTransfer.Paired {
+				continue
+			}
 			if txn.WithdrawalAmt > 0 && txn.DepositAmt == 0 {
 				// Check if it's an investment
 				isInvestment := investmentCategories[txn.Category] || investmentMethods[txn.Method]
@@ -78,6 +91,10 @@ func CalculateAccountSummaryWithTotals(
 	} else {
 		// Calculate from transactions
 		for _, txn := range transactions {
+			if txn.InternalTransfer != nil && txn.InternalTransfer.Paired {
+				continue
+			}
+
 			// Count deposits as income
 			// Only count if DepositAmt > 0 and WithdrawalAmt == 0 (to avoid double counting)
 			if txn.DepositAmt > 0 && txn.WithdrawalAmt == 0 {
@@ -154,6 +171,7 @@ func CalculateAccountSummaryWithTotals(
 		TotalInvestments:    totalInvestments,
 		NetSavings:          netSavings,
 		SavingsRatePercent:  savingsRate,
+		InternalTransfers:   internalTransfers,
 	}
 }
 
@@ -5,11 +5,18 @@ import (
 	"classify/statement_analysis_engine_rules/anomaly_engine/alerts"
 	"classify/statement_analysis_engine_rules/anomaly_engine/profiles"
 	"classify/statement_analysis_engine_rules/models"
+	"time"
 )
 
 // CalculateAnomalyDetectionWithEngine uses the new anomaly_engine package
 // This integrates the bank-grade anomaly detection engine
 func CalculateAnomalyDetectionWithEngine(transactions []models.ClassifiedTransaction, userID string) models.AnomalyDetection {
+	return CalculateAnomalyDetectionWithEngineAsOf(transactions, userID, time.Time{})
+}
+
+// CalculateAnomalyDetectionWithEngineAsOf runs the anomaly engine anchored to asOf
+// A zero asOf anchors to the statement end date
+func CalculateAnomalyDetectionWithEngineAsOf(transactions []models.ClassifiedTransaction, userID string, asOf time.Time) models.AnomalyDetection {
 	if len(transactions) < 10 {
 		return models.AnomalyDetection{
 			Anomalies:    make([]models.AnomalyDetail, 0),
@@ -23,6 +30,7 @@ func CalculateAnomalyDetectionWithEngine(transactions []models.ClassifiedTransac
 
 	// Create engine with default config
 	engineConfig := anomaly_engine.DefaultEngineConfig()
+	engineConfig.AsOf = asOf
 	engine := anomaly_engine.NewEngine(engineConfig, transactions)
 
 	// Evaluate all transactions
@@ -6,8 +6,18 @@ import (
 )
 
 // CalculateTopBeneficiaries calculates top beneficiaries
+// Beneficiaries are grouped by resolved counterparty (see ResolveCounterparties), else by the
+// account and IFSC (or bank) the narration names, so truncated and differently spelled names
+// of one payee add up
 func CalculateTopBeneficiaries(transactions []models.ClassifiedTransaction, limit int) []models.TopBeneficiary {
-	beneficiaryMap := make(map[string]map[string]float64) // beneficiary -> method -> amount
+	type beneficiaryGroup struct {
+		id      string
+		account string
+		ifsc    string
+		names   map[string]int
+		methods map[string]float64 // method -> amount
+	}
+	groups := make(map[string]*beneficiaryGroup)
 
 	for _, txn := range transactions {
 		// Only count withdrawals (expenses) with beneficiaries
@@ -16,42 +26,76 @@ func CalculateTopBeneficiaries(transactions []models.ClassifiedTransaction, limi
 			continue
 		}
 
-		if beneficiaryMap[txn.Beneficiary] == nil {
-			beneficiaryMap[txn.Beneficiary] = make(map[string]float64)
+		key := txn.Beneficiary
+		var account, ifsc string
+		if fields := txn.NarrationFields; fields != nil && fields.Account != "" {
+			account, ifsc = fields.Account, fields.IFSC
+			key = account + "|" + ifsc
+			if ifsc == "" {
+				key = account + "|" + fields.Bank
+			}
+		}
+		if txn.CounterpartyID != "" {
+			key = txn.CounterpartyID
+		}
+		group := groups[key]
+		if group == nil {
+			group = &beneficiaryGroup{id: txn.CounterpartyID, names: make(map[string]int), methods: make(map[string]float64)}
+			groups[key] = group
 		}
-		beneficiaryMap[txn.Beneficiary][txn.Method] += txn.WithdrawalAmt
+		if group.account == "" {
+			group.account, group.ifsc = account, ifsc
+		}
+		group.names[txn.Beneficiary]++
+		group.methods[txn.Method] += txn.WithdrawalAmt
 	}
 
 	// Convert to slice and sort
 	type beneficiaryData struct {
-		name   string
-		method string
-		amount float64
+		id      string
+		name    string
+		account string
+		ifsc    string
+		method  string
+		amount  float64
 	}
 
 	beneficiaries := make([]beneficiaryData, 0)
-	for name, methods := range beneficiaryMap {
+	for _, group := range groups {
 		totalAmount := 0.0
 		primaryMethod := ""
 		maxMethodAmount := 0.0
 		// Find the method with the highest amount (primary method)
-		for method, amount := range methods {
+		for method, amount := range group.methods {
 			totalAmount += amount
 			if amount > maxMethodAmount {
 				maxMethodAmount = amount
 				primaryMethod = method
 			}
 		}
+		// Show the name used most often, the longest on a tie
+		name := ""
+		for candidate, count := range group.names {
+			if count > group.names[name] || count == group.names[name] && (len(candidate) > len(name) || len(candidate) == len(name) && candidate < name) {
+				name = candidate
+			}
+		}
 		beneficiaries = append(beneficiaries, beneficiaryData{
-			name:   name,
-			method: primaryMethod,
-			amount: totalAmount,
+			id:      group.id,
+			name:    name,
+			account: group.account,
+			ifsc:    group.ifsc,
+			method:  primaryMethod,
+			amount:  totalAmount,
 		})
 	}
 
 	// Sort by amount descending
 	sort.Slice(beneficiaries, func(i, j int) bool {
-		return beneficiaries[i].amount > beneficiaries[j].amount
+		if beneficiaries[i].amount != beneficiaries[j].amount {
+			return beneficiaries[i].amount > beneficiaries[j].amount
+		}
+		return beneficiaries[i].name < beneficiaries[j].name
 	})
 
 	// Take top N
@@ -65,9 +109,12 @@ func CalculateTopBeneficiaries(transactions []models.ClassifiedTransaction, limi
 	result := make([]models.TopBeneficiary, limit)
 	for i := 0; i < limit; i++ {
 		result[i] = models.TopBeneficiary{
-			Name:   beneficiaries[i].name,
-			Amount: beneficiaries[i].amount,
-			Type:   beneficiaries[i].method,
+			CounterpartyID: beneficiaries[i].id,
+			Name:           beneficiaries[i].name,
+			Account:        beneficiaries[i].account,
+			IFSC:           beneficiaries[i].ifsc,
+			Amount:         beneficiaries[i].amount,
+			Type:           beneficiaries[i].method,
 		}
 	}
 
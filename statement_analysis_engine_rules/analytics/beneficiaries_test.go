@@ -0,0 +1,29 @@
+package analytics
+
+import (
+	"reflect"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func TestCalculateTopBeneficiariesGroupsByAccount(t *testing.T) {
+	account := &models.NarrationFields{Rail: "IMPS", Direction: "DR", Account: "XXXXXXX2950", Bank: "IDFC First Bank"}
+	transactions := []models.ClassifiedTransaction{
+		{Beneficiary: "KALPIT KUMAR SHARMA", Method: "IMPS", WithdrawalAmt: 10000, NarrationFields: account},
+		{Beneficiary: "KALPIT KUMAR SHA", Method: "IMPS", WithdrawalAmt: 5000, NarrationFields: account},
+		{Beneficiary: "KALPIT KUMAR SHARMA", Method: "IMPS", WithdrawalAmt: 2000, NarrationFields: account},
+		{Beneficiary: "RAMESH TRADERS", Method: "NEFT", WithdrawalAmt: 12000,
+			NarrationFields: &models.NarrationFields{Rail: "NEFT", Direction: "DR", IFSC: "SBIN0001234"}},
+		{Beneficiary: "RAMESH TRADERS", Method: "UPI", WithdrawalAmt: 1000},
+		{Beneficiary: "KALPIT KUMAR SHARMA", Method: "IMPS", DepositAmt: 3000, NarrationFields: account},
+	}
+
+	expected := []models.TopBeneficiary{
+		{Name: "KALPIT KUMAR SHARMA", Account: "XXXXXXX2950", Amount: 17000, Type: "IMPS"},
+		{Name: "RAMESH TRADERS", Amount: 13000, Type: "NEFT"},
+	}
+	if got := CalculateTopBeneficiaries(transactions, 5); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
@@ -1,11 +1,21 @@
 package analytics
 
-import "classify/statement_analysis_engine_rules/models"
+import (
+	"sort"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/taxonomy"
+)
 
 // CalculateCategorySummary calculates category-wise summary
-// NOTE: This is for OPERATIONAL EXPENSES only - investments and income are tracked separately
+// NOTE: The flat fields are OPERATIONAL EXPENSES only - investments and income are tracked separately
+// The tree covers every transaction, grouped by the active taxonomy
 func CalculateCategorySummary(transactions []models.ClassifiedTransaction) models.CategorySummary {
-	summary := models.CategorySummary{}
+	active := taxonomy.Active()
+	summary := models.CategorySummary{
+		Tree:            categoryTree(transactions, active),
+		TaxonomyVersion: active.Version,
+	}
 
 	// Categories to EXCLUDE from expense summary
 	// These are NOT operational expenses - they're tracked separately
@@ -76,3 +86,73 @@ func CalculateCategorySummary(transactions []models.ClassifiedTransaction) model
 
 	return summary
 }
+
+// treeNode accumulates totals for one category while the tree is built
+type treeNode struct {
+	node     models.CategoryNode
+	children map[string]*treeNode
+}
+
+// child returns the child node for category, creating it when needed
+func (n *treeNode) child(category string) *treeNode {
+	if n.children == nil {
+		n.children = make(map[string]*treeNode)
+	}
+	child, ok := n.children[category]
+	if !ok {
+		child = &treeNode{node: models.CategoryNode{Category: category}}
+		n.children[category] = child
+	}
+	return child
+}
+
+// nodes returns the children that have transactions: taxonomy order first, then the rest by name
+func (n *treeNode) nodes(order []taxonomy.Category) []models.CategoryNode {
+	nodes := make([]models.CategoryNode, 0, len(n.children))
+	placed := make(map[string]bool, len(order))
+	for _, category := range order {
+		if child, ok := n.children[category.Name]; ok {
+			placed[category.Name] = true
+			nodes = append(nodes, child.build(category.Children))
+		}
+	}
+	var rest []string
+	for name := range n.children {
+		if !placed[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	for _, name := range rest {
+		nodes = append(nodes, n.children[name].build(nil))
+	}
+	return nodes
+}
+
+// build converts the node and its children into the response model
+func (n *treeNode) build(order []taxonomy.Category) models.CategoryNode {
+	node := n.node
+	if len(n.children) > 0 {
+		node.Children = n.nodes(order)
+	}
+	return node
+}
+
+// categoryTree adds every transaction to each category on its taxonomy path
+func categoryTree(transactions []models.ClassifiedTransaction, t *taxonomy.Taxonomy) []models.CategoryNode {
+	root := &treeNode{}
+	for _, txn := range transactions {
+		category := txn.Category
+		if category == "" {
+			category = "Other"
+		}
+		node := root
+		for _, name := range t.Path(category) {
+			node = node.child(name)
+			node.node.Spent += txn.WithdrawalAmt
+			node.node.Received += txn.DepositAmt
+			node.node.Count++
+		}
+	}
+	return root.nodes(t.Categories)
+}
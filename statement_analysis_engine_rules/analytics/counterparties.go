@@ -0,0 +1,420 @@
+package analytics
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Counterparty flow directions
+const (
+	CounterpartyOutflow = "OUTFLOW" // Only paid
+	CounterpartyInflow  = "INFLOW"  // Only received from
+	CounterpartyBoth    = "BOTH"
+)
+
+// ResolveCounterparties clusters the counterparties of the transactions into entities and
+// sets each transaction's CounterpartyID
+//
+// Transactions paid to the same VPA or the same account (with its IFSC or bank) are one
+// entity. A name then joins the entity of a longer name it matches (utils.MatchNames, or the
+// statement cut it short) when that match is unambiguous: "RAHUL S" joins "RAHUL SHARMA" only
+// when no other entity is a "RAHUL S..." as well. A VPA used under unrelated names (a shared
+// collection VPA) does not link them
+func ResolveCounterparties(transactions []models.ClassifiedTransaction) {
+	type identity struct {
+		name string
+		keys []string
+	}
+	identities := make([]identity, len(transactions))
+
+	// VPAs seen under names that do not match each other are not an identity
+	vpaNames := make(map[string][]string)
+	for i, txn := range transactions {
+		identities[i].name = counterpartyName(txn)
+		if txn.UPI != nil && txn.UPI.VPA != "" {
+			vpaNames[txn.UPI.VPA] = append(vpaNames[txn.UPI.VPA], identities[i].name)
+		}
+	}
+	sharedVPA := make(map[string]bool)
+	for vpa, names := range vpaNames {
+		for _, name := range names[1:] {
+			if name != names[0] && !similarCounterpartyNames(name, names[0]) {
+				sharedVPA[vpa] = true
+				break
+			}
+		}
+	}
+
+	uf := newUnionFind()
+	for i, txn := range transactions {
+		keys := counterpartyKeys(txn, sharedVPA)
+		identities[i].keys = keys
+		nodes := keys
+		if name := identities[i].name; name != "" {
+			nodes = append([]string{"NAME:" + name}, keys...)
+		}
+		for _, node := range nodes {
+			uf.union(nodes[0], node)
+		}
+	}
+
+	var unique []string
+	for _, id := range identities {
+		if id.name != "" {
+			unique = append(unique, id.name)
+		}
+	}
+	unique = uniqueStrings(unique)
+	names := make([]nameEntry, 0, len(unique))
+	for _, name := range unique {
+		if entry := newNameEntry(name); len(entry.words) > 0 {
+			names = append(names, entry)
+		}
+	}
+	// Most specific names first, so "RAHUL SHARMA" has joined "RAHUL SHARMA HDFC" by the time
+	// "RAHUL S" looks for its entity; "ASHOK KUMAR" stays apart from "ASHOK KUMAR GAUR" and
+	// "ASHOK KUMAR KHERA"
+	sort.SliceStable(names, func(i, j int) bool { return names[i].moreSpecific(names[j]) })
+
+	// Similar names have first words that are prefixes of each other, so only those are compared
+	byFirst := make(map[string][]int)
+	var firsts []string
+	for i, entry := range names {
+		first := entry.words[0]
+		if _, ok := byFirst[first]; !ok {
+			firsts = append(firsts, first)
+		}
+		byFirst[first] = append(byFirst[first], i)
+	}
+	sort.Strings(firsts)
+
+	for _, entry := range names {
+		root := uf.find(entry.node)
+		var match string
+		ambiguous := false
+		for _, i := range similarFirstWords(entry.words[0], firsts, byFirst) {
+			other := names[i]
+			if !other.moreSpecific(entry) || !similarNameEntries(entry, other) {
+				continue
+			}
+			otherRoot := uf.find(other.node)
+			if otherRoot == root || otherRoot == match {
+				continue
+			}
+			if match != "" {
+				ambiguous = true
+				break
+			}
+			match = otherRoot
+		}
+		if match != "" && !ambiguous {
+			uf.union(root, match)
+		}
+	}
+
+	// Name each entity after its strongest key: an account, else a VPA, else a name
+	anchors := make(map[string]string)
+	for _, id := range identities {
+		nodes := id.keys
+		if id.name != "" {
+			nodes = append(nodes, "NAME:"+id.name)
+		}
+		for _, node := range nodes {
+			root := uf.find(node)
+			if anchor, ok := anchors[root]; !ok || anchorRank(node) < anchorRank(anchor) ||
+				anchorRank(node) == anchorRank(anchor) && node < anchor {
+				anchors[root] = node
+			}
+		}
+	}
+	for i, id := range identities {
+		switch {
+		case id.name != "":
+			transactions[i].CounterpartyID = counterpartyID(anchors[uf.find("NAME:"+id.name)])
+		case len(id.keys) > 0:
+			transactions[i].CounterpartyID = counterpartyID(anchors[uf.find(id.keys[0])])
+		}
+	}
+}
+
+// CalculateCounterparties totals the transactions of every resolved counterparty
+// (see ResolveCounterparties), largest total flow first
+func CalculateCounterparties(transactions []models.ClassifiedTransaction) []models.Counterparty {
+	entities := make(map[string]*models.Counterparty)
+	nameCounts := make(map[string]map[string]int)
+	var order []string
+
+	for _, txn := range transactions {
+		if txn.CounterpartyID == "" {
+			continue
+		}
+		entity := entities[txn.CounterpartyID]
+		if entity == nil {
+			entity = &models.Counterparty{ID: txn.CounterpartyID}
+			entities[txn.CounterpartyID] = entity
+			nameCounts[txn.CounterpartyID] = make(map[string]int)
+			order = append(order, txn.CounterpartyID)
+		}
+		if name := counterpartyName(txn); name != "" {
+			nameCounts[txn.CounterpartyID][name]++
+		}
+		if txn.UPI != nil && txn.UPI.VPA != "" {
+			entity.VPAs = append(entity.VPAs, txn.UPI.VPA)
+		}
+		if fields := txn.NarrationFields; fields != nil && fields.Account != "" {
+			entity.Accounts = append(entity.Accounts, fields.Account)
+		} else if txn.UPI != nil && txn.UPI.VPA == "" && txn.UPI.Account != "" {
+			entity.Accounts = append(entity.Accounts, txn.UPI.Account)
+		}
+		if fields := txn.NarrationFields; fields != nil && fields.IFSC != "" {
+			entity.IFSCs = append(entity.IFSCs, fields.IFSC)
+		} else if txn.UPI != nil && txn.UPI.VPA == "" && txn.UPI.IFSC != "" {
+			entity.IFSCs = append(entity.IFSCs, txn.UPI.IFSC)
+		}
+
+		if txn.WithdrawalAmt > 0 {
+			entity.TotalPaid += txn.WithdrawalAmt
+			entity.PaidCount++
+		}
+		if txn.DepositAmt > 0 {
+			entity.TotalReceived += txn.DepositAmt
+			entity.ReceivedCount++
+		}
+		if date, err := utils.ParseDate(txn.Date); err == nil {
+			if first, err := utils.ParseDate(entity.FirstSeen); entity.FirstSeen == "" || err == nil && date.Before(first) {
+				entity.FirstSeen = txn.Date
+			}
+			if last, err := utils.ParseDate(entity.LastSeen); entity.LastSeen == "" || err == nil && date.After(last) {
+				entity.LastSeen = txn.Date
+			}
+		}
+	}
+
+	result := make([]models.Counterparty, 0, len(order))
+	for _, id := range order {
+		entity := entities[id]
+		for name := range nameCounts[id] {
+			entity.Names = append(entity.Names, name)
+		}
+		sort.Strings(entity.Names)
+		entity.Name = mostFrequentName(nameCounts[id])
+		entity.VPAs = uniqueStrings(entity.VPAs)
+		entity.Accounts = uniqueStrings(entity.Accounts)
+		entity.IFSCs = uniqueStrings(entity.IFSCs)
+		switch {
+		case entity.PaidCount > 0 && entity.ReceivedCount > 0:
+			entity.Direction = CounterpartyBoth
+		case entity.ReceivedCount > 0:
+			entity.Direction = CounterpartyInflow
+		default:
+			entity.Direction = CounterpartyOutflow
+		}
+		result = append(result, *entity)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].TotalPaid+result[i].TotalReceived > result[j].TotalPaid+result[j].TotalReceived
+	})
+	return result
+}
+
+// counterpartyName is the transaction's counterparty name, upper-cased with single spaces
+func counterpartyName(txn models.ClassifiedTransaction) string {
+	name := txn.Beneficiary
+	if name == "" && txn.UPI != nil {
+		name = txn.UPI.Name
+	}
+	if name == "" && txn.NarrationFields != nil {
+		name = txn.NarrationFields.Name
+	}
+	return strings.Join(strings.Fields(strings.ToUpper(name)), " ")
+}
+
+// counterpartyKeys lists the identifiers that pin down the transaction's counterparty
+// A masked account is only an identifier together with its IFSC or bank
+func counterpartyKeys(txn models.ClassifiedTransaction, sharedVPA map[string]bool) []string {
+	var keys []string
+	if upi := txn.UPI; upi != nil {
+		if upi.VPA != "" && !sharedVPA[upi.VPA] {
+			keys = append(keys, "VPA:"+upi.VPA)
+		}
+		// With a VPA the account is the reversed payer's own (REV-UPI)
+		if upi.VPA == "" && upi.Account != "" {
+			if key := accountKey(upi.Account, upi.IFSC, upi.PSPBank); key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	if fields := txn.NarrationFields; fields != nil && fields.Account != "" {
+		if key := accountKey(fields.Account, fields.IFSC, fields.Bank); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func accountKey(account, ifsc, bank string) string {
+	switch {
+	case ifsc != "":
+		return "ACCOUNT:" + account + "|" + ifsc
+	case bank != "":
+		return "ACCOUNT:" + account + "|" + bank
+	case !strings.ContainsAny(account, "X*"):
+		return "ACCOUNT:" + account
+	}
+	return ""
+}
+
+// Titles skipped when comparing first names
+var counterpartyTitles = map[string]bool{"MR": true, "MRS": true, "MS": true, "DR": true, "SMT": true, "SHRI": true}
+
+// nameEntry is a counterparty name with its union-find node and words, split once
+type nameEntry struct {
+	name       string
+	node       string
+	words      []string // nameWords
+	matchWords []string // utils.NameMatchWords
+}
+
+func newNameEntry(name string) nameEntry {
+	return nameEntry{name: name, node: "NAME:" + name, words: nameWords(name), matchWords: utils.NameMatchWords(name)}
+}
+
+// moreSpecific reports whether the name says more than other: more words, or longer
+func (e nameEntry) moreSpecific(other nameEntry) bool {
+	if len(e.words) != len(other.words) {
+		return len(e.words) > len(other.words)
+	}
+	return len(e.name) > len(other.name)
+}
+
+// similarFirstWords returns the indexes of the names whose first word is a prefix of first, or
+// has first as its prefix (firsts is sorted)
+func similarFirstWords(first string, firsts []string, byFirst map[string][]int) []int {
+	var indexes []int
+	for end := 1; end <= len(first); end++ {
+		indexes = append(indexes, byFirst[first[:end]]...)
+	}
+	for i := sort.SearchStrings(firsts, first); i < len(firsts) && strings.HasPrefix(firsts[i], first); i++ {
+		if firsts[i] != first {
+			indexes = append(indexes, byFirst[firsts[i]]...)
+		}
+	}
+	return indexes
+}
+
+// similarCounterpartyNames reports whether two names can be the same counterparty
+// Besides utils.MatchNames, statements cut long names mid-word ("KALPIT KUMAR SHA"). The
+// first names must agree, since MatchNames ignores initials ("M J STORE" is not "HITKARI STORE")
+func similarCounterpartyNames(a, b string) bool {
+	return similarNameEntries(newNameEntry(a), newNameEntry(b))
+}
+
+// similarNameEntries is similarCounterpartyNames with the names' words already split
+func similarNameEntries(ea, eb nameEntry) bool {
+	wa, wb := ea.words, eb.words
+	if len(wa) == 0 || len(wb) == 0 || !strings.HasPrefix(wa[0], wb[0]) && !strings.HasPrefix(wb[0], wa[0]) {
+		return false
+	}
+	if utils.MatchNameWords(ea.matchWords, eb.matchWords) {
+		return true
+	}
+	a, b := ea.name, eb.name
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return len(a) >= 10 && strings.Contains(a, " ") && strings.HasPrefix(b, a)
+}
+
+func nameWords(name string) []string {
+	words := strings.Fields(strings.ReplaceAll(name, ".", " "))
+	for len(words) > 1 && counterpartyTitles[words[0]] {
+		words = words[1:]
+	}
+	return words
+}
+
+// anchorRank orders the keys an entity ID is derived from
+func anchorRank(node string) int {
+	switch {
+	case strings.HasPrefix(node, "ACCOUNT:"):
+		return 0
+	case strings.HasPrefix(node, "VPA:"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// counterpartyID derives a stable entity ID from the entity's anchor key, so the same
+// account, VPA or name gets the same ID in every statement
+func counterpartyID(anchor string) string {
+	sum := sha256.Sum256([]byte(anchor))
+	return "cp_" + hex.EncodeToString(sum[:6])
+}
+
+// mostFrequentName picks the name used most often, the longest on a tie
+func mostFrequentName(counts map[string]int) string {
+	name := ""
+	for candidate, count := range counts {
+		if count > counts[name] || count == counts[name] &&
+			(len(candidate) > len(name) || len(candidate) == len(name) && candidate < name) {
+			name = candidate
+		}
+	}
+	return name
+}
+
+func uniqueStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// unionFind is a disjoint-set forest over string nodes
+type unionFind struct {
+	parent map[string]string
+}
+
+func newUnionFind() *unionFind {
+	return &unionFind{parent: make(map[string]string)}
+}
+
+func (u *unionFind) find(node string) string {
+	if _, ok := u.parent[node]; !ok {
+		u.parent[node] = node
+		return node
+	}
+	for u.parent[node] != node {
+		u.parent[node] = u.parent[u.parent[node]]
+		node = u.parent[node]
+	}
+	return node
+}
+
+func (u *unionFind) union(a, b string) {
+	ra, rb := u.find(a), u.find(b)
+	if ra == rb {
+		return
+	}
+	// Keep the smaller root, so the forest does not depend on the order of unions
+	if rb < ra {
+		ra, rb = rb, ra
+	}
+	u.parent[rb] = ra
+}
@@ -0,0 +1,83 @@
+package analytics
+
+import (
+	"reflect"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func TestResolveCounterparties(t *testing.T) {
+	idfc := &models.NarrationFields{Rail: "IMPS", Direction: "DR", Account: "XXXXXXX2950", Bank: "IDFC First Bank"}
+	transactions := []models.ClassifiedTransaction{
+		{Date: "01/04/2025", Beneficiary: "RAHUL SHARMA HDFC", WithdrawalAmt: 1000},
+		{Date: "05/04/2025", Beneficiary: "RAHUL SHARMA", WithdrawalAmt: 2000},
+		{Date: "09/04/2025", Beneficiary: "RAHUL S", DepositAmt: 500},
+		{Date: "02/04/2025", Beneficiary: "ASHOK KUMAR GAUR", WithdrawalAmt: 100},
+		{Date: "03/04/2025", Beneficiary: "ASHOK KUMAR KHERA", WithdrawalAmt: 100},
+		{Date: "04/04/2025", Beneficiary: "ASHOK KUMAR", WithdrawalAmt: 100},
+		{Date: "06/04/2025", Beneficiary: "M J STORE", WithdrawalAmt: 50},
+		{Date: "07/04/2025", Beneficiary: "HITKARI STORE", WithdrawalAmt: 50},
+		// One account under truncated names, and a VPA under a different spelling
+		{Date: "10/04/2025", Beneficiary: "MR KALPIT KUMAR SHA", WithdrawalAmt: 5000, NarrationFields: idfc},
+		{Date: "11/04/2025", Beneficiary: "KALPIT SHARMA", WithdrawalAmt: 7000, NarrationFields: idfc},
+		{Date: "12/04/2025", Beneficiary: "K SHARMA", WithdrawalAmt: 300,
+			UPI: &models.UPIDetails{Name: "K SHARMA", VPA: "KALPIT@OKICICI"}},
+		{Date: "13/04/2025", Beneficiary: "KALPIT SHARMA", WithdrawalAmt: 200,
+			UPI: &models.UPIDetails{Name: "KALPIT SHARMA", VPA: "KALPIT@OKICICI"}},
+		// A collection VPA shared by unrelated names links nothing
+		{Date: "14/04/2025", Beneficiary: "ANIL", WithdrawalAmt: 10, UPI: &models.UPIDetails{Name: "ANIL", VPA: "COUNTER@OKSBI"}},
+		{Date: "15/04/2025", Beneficiary: "BINA", WithdrawalAmt: 10, UPI: &models.UPIDetails{Name: "BINA", VPA: "COUNTER@OKSBI"}},
+		{Date: "16/04/2025", Merchant: "AMAZON", WithdrawalAmt: 999},
+	}
+	ResolveCounterparties(transactions)
+
+	groups := [][]int{{0, 1, 2}, {3}, {4}, {5}, {6}, {7}, {8, 9, 10, 11}, {12}, {13}}
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		id := transactions[group[0]].CounterpartyID
+		if id == "" || seen[id] {
+			t.Errorf("expected a new entity for %s, got %q", transactions[group[0]].Beneficiary, id)
+		}
+		seen[id] = true
+		for _, i := range group[1:] {
+			if transactions[i].CounterpartyID != id {
+				t.Errorf("expected %s in the entity of %s", transactions[i].Beneficiary, transactions[group[0]].Beneficiary)
+			}
+		}
+	}
+	if id := transactions[14].CounterpartyID; id != "" {
+		t.Errorf("expected no counterparty for a card merchant, got %q", id)
+	}
+
+	// IDs are derived from the entity's account, VPA or name, so they do not depend on the statement
+	again := []models.ClassifiedTransaction{transactions[9]}
+	again[0].CounterpartyID = ""
+	ResolveCounterparties(again)
+	if again[0].CounterpartyID != transactions[9].CounterpartyID {
+		t.Errorf("expected a stable ID %s, got %s", transactions[9].CounterpartyID, again[0].CounterpartyID)
+	}
+}
+
+func TestCalculateCounterparties(t *testing.T) {
+	transactions := []models.ClassifiedTransaction{
+		{Date: "09/04/2025", Beneficiary: "RAHUL S", DepositAmt: 500},
+		{Date: "01/04/2025", Beneficiary: "RAHUL SHARMA", WithdrawalAmt: 1000},
+		{Date: "05/04/2025", Beneficiary: "RAHUL SHARMA", WithdrawalAmt: 2000,
+			UPI: &models.UPIDetails{Name: "RAHUL SHARMA", VPA: "RAHUL@OKAXIS"}},
+		{Date: "02/04/2025", Beneficiary: "INFOSYS LIMITED", DepositAmt: 90000,
+			NarrationFields: &models.NarrationFields{Rail: "ACH", Direction: "CR", Name: "INFOSYS LIMITED"}},
+	}
+	ResolveCounterparties(transactions)
+
+	expected := []models.Counterparty{
+		{ID: transactions[3].CounterpartyID, Name: "INFOSYS LIMITED", Names: []string{"INFOSYS LIMITED"},
+			TotalReceived: 90000, ReceivedCount: 1, Direction: CounterpartyInflow, FirstSeen: "02/04/2025", LastSeen: "02/04/2025"},
+		{ID: transactions[0].CounterpartyID, Name: "RAHUL SHARMA", Names: []string{"RAHUL S", "RAHUL SHARMA"}, VPAs: []string{"RAHUL@OKAXIS"},
+			TotalPaid: 3000, PaidCount: 2, TotalReceived: 500, ReceivedCount: 1, Direction: CounterpartyBoth,
+			FirstSeen: "01/04/2025", LastSeen: "09/04/2025"},
+	}
+	if got := CalculateCounterparties(transactions); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
@@ -0,0 +1,162 @@
+package analytics
+
+import (
+	"math"
+	"sort"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// DefaultTransferWindowDays is how many days apart the two sides of an internal transfer may be booked
+const DefaultTransferWindowDays = 3
+
+// Internal transfer pairing bases, strongest first
+const (
+	TransferBasisReference  = "reference"          // Both sides carry the same UTR/RRN
+	TransferBasisAccount    = "account"            // One side names the other statement's account
+	TransferBasisRegistered = "registered account" // Both sides go to or come from a registered own account
+)
+
+// AccountTransactions is one loaded statement: its account number and classified transactions
+type AccountTransactions struct {
+	AccountNo    string
+	Transactions []models.ClassifiedTransaction
+}
+
+// PairInternalTransfers pairs debits in one statement with credits in another that move
+// money between the user's own accounts, and marks both sides' InternalTransfer as paired
+// (CalculateAccountSummaryWithTotals leaves paired transfers out of income and expense)
+//
+// The sides must have the same amount and be booked at most windowDays apart (<= 0 uses
+// DefaultTransferWindowDays). Amount and date alone are a coincidence, so a pair also needs a
+// shared reference, one side naming the other statement's account, or both sides matching
+// registered accounts. Each debit takes the strongest, then nearest, unpaired credit.
+// Transactions are updated in place; the number of pairs is returned
+func PairInternalTransfers(statements []AccountTransactions, windowDays int) int {
+	if windowDays <= 0 {
+		windowDays = DefaultTransferWindowDays
+	}
+
+	type side struct {
+		statement int
+		index     int
+	}
+	var debits, credits []side
+	for s, statement := range statements {
+		for i, txn := range statement.Transactions {
+			if txn.InternalTransfer != nil && txn.InternalTransfer.Paired {
+				continue
+			}
+			switch {
+			case txn.WithdrawalAmt > 0 && txn.DepositAmt == 0:
+				debits = append(debits, side{s, i})
+			case txn.DepositAmt > 0 && txn.WithdrawalAmt == 0:
+				credits = append(credits, side{s, i})
+			}
+		}
+	}
+	txnAt := func(sd side) *models.ClassifiedTransaction {
+		return &statements[sd.statement].Transactions[sd.index]
+	}
+	sort.SliceStable(debits, func(i, j int) bool {
+		a, _ := utils.ParseDate(txnAt(debits[i]).Date)
+		b, _ := utils.ParseDate(txnAt(debits[j]).Date)
+		return a.Before(b)
+	})
+
+	used := make(map[side]bool)
+	pairs := 0
+	for _, d := range debits {
+		debit := txnAt(d)
+		debitDate, err := utils.ParseDate(debit.Date)
+		if err != nil {
+			continue
+		}
+
+		best, bestRank, bestGap, basis := side{}, 0, 0.0, ""
+		for _, c := range credits {
+			credit := txnAt(c)
+			if used[c] || c.statement == d.statement || math.Abs(credit.DepositAmt-debit.WithdrawalAmt) >= 0.005 {
+				continue
+			}
+			creditDate, err := utils.ParseDate(credit.Date)
+			if err != nil {
+				continue
+			}
+			gap := math.Abs(creditDate.Sub(debitDate).Hours() / 24)
+			if gap > float64(windowDays) {
+				continue
+			}
+			rank, candidateBasis := transferPairRank(*debit, *credit, statements[d.statement].AccountNo, statements[c.statement].AccountNo)
+			if rank > bestRank || rank == bestRank && rank > 0 && gap < bestGap {
+				best, bestRank, bestGap, basis = c, rank, gap, candidateBasis
+			}
+		}
+		if bestRank == 0 {
+			continue
+		}
+
+		used[best] = true
+		credit := txnAt(best)
+		reference := ""
+		if basis == TransferBasisReference {
+			reference = transferReference(*debit)
+		}
+		markInternalTransfer(debit, utils.MaskAccountNumber(statements[best.statement].AccountNo), credit.Date, reference, basis)
+		markInternalTransfer(credit, utils.MaskAccountNumber(statements[d.statement].AccountNo), debit.Date, reference, basis)
+		pairs++
+	}
+	return pairs
+}
+
+// transferPairRank scores how surely a debit and a credit are one internal transfer (0: not at all)
+func transferPairRank(debit, credit models.ClassifiedTransaction, debitAccount, creditAccount string) (int, string) {
+	if reference := transferReference(debit); reference != "" && reference == transferReference(credit) {
+		return 3, TransferBasisReference
+	}
+	if utils.SameAccountNumber(transferAccount(debit), creditAccount) || utils.SameAccountNumber(transferAccount(credit), debitAccount) {
+		return 2, TransferBasisAccount
+	}
+	if debit.InternalTransfer != nil && credit.InternalTransfer != nil {
+		return 1, TransferBasisRegistered
+	}
+	return 0, ""
+}
+
+// transferReference is the UTR/RRN of a parsed transfer narration
+func transferReference(txn models.ClassifiedTransaction) string {
+	if txn.NarrationFields != nil && txn.NarrationFields.Reference != "" {
+		return txn.NarrationFields.Reference
+	}
+	if txn.UPI != nil {
+		return txn.UPI.Reference
+	}
+	return ""
+}
+
+// transferAccount is the counterparty account of a parsed transfer narration
+func transferAccount(txn models.ClassifiedTransaction) string {
+	if txn.NarrationFields != nil && txn.NarrationFields.Account != "" {
+		return txn.NarrationFields.Account
+	}
+	if txn.UPI != nil && txn.UPI.VPA == "" {
+		return txn.UPI.Account
+	}
+	return ""
+}
+
+func markInternalTransfer(txn *models.ClassifiedTransaction, account, pairDate, reference, basis string) {
+	transfer := models.InternalTransfer{}
+	if txn.InternalTransfer != nil {
+		transfer = *txn.InternalTransfer
+	}
+	if transfer.Account == "" {
+		transfer.Account = account
+	}
+	transfer.Paired = true
+	transfer.PairDate = pairDate
+	transfer.Reference = reference
+	transfer.Basis = basis
+	txn.InternalTransfer = &transfer
+}
@@ -0,0 +1,87 @@
+package analytics
+
+import (
+	"testing"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func TestPairInternalTransfers(t *testing.T) {
+	own := func() *models.InternalTransfer { return &models.InternalTransfer{Account: "ICICI savings"} }
+	hdfc := AccountTransactions{AccountNo: "50100123456789", Transactions: []models.ClassifiedTransaction{
+		{Date: "01/04/2025", DepositAmt: 90000, Category: "Salary"},
+		{Date: "10/04/2025", WithdrawalAmt: 5000,
+			NarrationFields: &models.NarrationFields{Rail: "IMPS", Direction: "DR", Reference: "512345678901"}},
+		{Date: "12/04/2025", WithdrawalAmt: 2000,
+			NarrationFields: &models.NarrationFields{Rail: "NEFT", Direction: "DR", Account: "XXXXXXXX4411"}},
+		{Date: "15/04/2025", WithdrawalAmt: 3000, Category: "Investment", InternalTransfer: own()},
+		{Date: "20/04/2025", WithdrawalAmt: 999, Category: "Shopping"},
+		{Date: "25/04/2025", WithdrawalAmt: 4000, Category: "Investment", InternalTransfer: own()},
+	}}
+	icici := AccountTransactions{AccountNo: "001234564411", Transactions: []models.ClassifiedTransaction{
+		{Date: "11/04/2025", DepositAmt: 5000,
+			NarrationFields: &models.NarrationFields{Rail: "IMPS", Direction: "CR", Reference: "512345678901"}},
+		{Date: "12/04/2025", DepositAmt: 2000},
+		{Date: "16/04/2025", DepositAmt: 3000, InternalTransfer: own()},
+		{Date: "20/04/2025", DepositAmt: 999},                           // Same amount and day, nothing else in common
+		{Date: "01/05/2025", DepositAmt: 4000, InternalTransfer: own()}, // Outside the window
+	}}
+	statements := []AccountTransactions{hdfc, icici}
+
+	if pairs := PairInternalTransfers(statements, 0); pairs != 3 {
+		t.Errorf("expected 3 pairs, got %d", pairs)
+	}
+	tests := []struct {
+		statement, index int
+		basis            string // Empty when unpaired
+		pairDate         string
+	}{
+		{0, 1, TransferBasisReference, "11/04/2025"},
+		{1, 0, TransferBasisReference, "10/04/2025"},
+		{0, 2, TransferBasisAccount, "12/04/2025"},
+		{1, 1, TransferBasisAccount, "12/04/2025"},
+		{0, 3, TransferBasisRegistered, "16/04/2025"},
+		{1, 2, TransferBasisRegistered, "15/04/2025"},
+		{0, 4, "", ""},
+		{1, 3, "", ""},
+		{0, 5, "", ""},
+		{1, 4, "", ""},
+	}
+	for _, tt := range tests {
+		txn := statements[tt.statement].Transactions[tt.index]
+		paired := txn.InternalTransfer != nil && txn.InternalTransfer.Paired
+		if paired != (tt.basis != "") {
+			t.Errorf("%s %.0f: expected paired %v, got %+v", txn.Date, txn.WithdrawalAmt+txn.DepositAmt, tt.basis != "", txn.InternalTransfer)
+			continue
+		}
+		if paired && (txn.InternalTransfer.Basis != tt.basis || txn.InternalTransfer.PairDate != tt.pairDate) {
+			t.Errorf("%s %.0f: expected %s on %s, got %+v", txn.Date, txn.WithdrawalAmt+txn.DepositAmt, tt.basis, tt.pairDate, txn.InternalTransfer)
+		}
+	}
+	if transfer := statements[1].Transactions[1].InternalTransfer; transfer.Account != "XXXXXX6789" {
+		t.Errorf("expected the other statement's masked account, got %s", transfer.Account)
+	}
+	if transfer := statements[0].Transactions[3].InternalTransfer; transfer.Account != "ICICI savings" {
+		t.Errorf("expected the registered account name to be kept, got %s", transfer.Account)
+	}
+
+	// Paired transfers are neither income nor expense, with or without statement totals
+	// (a paired credit is added so the statement total of 95000 includes one)
+	transactions := append([]models.ClassifiedTransaction{
+		{Date: "02/04/2025", DepositAmt: 5000, InternalTransfer: &models.InternalTransfer{Paired: true}},
+	}, statements[0].Transactions...)
+	summaries := []struct {
+		name            string
+		credits, debits float64
+	}{
+		{"from transactions", 0, 0},
+		{"statement totals", 95000, 14999},
+	}
+	for _, tt := range summaries {
+		summary := CalculateAccountSummaryWithTotals(hdfc.AccountNo, "TEST", "01/04/2025 - 30/04/2025", 0, 0, transactions, tt.credits, tt.debits)
+		if summary.TotalIncome != 90000 || summary.TotalExpense+summary.TotalInvestments != 4999 || summary.InternalTransfers != 15000 {
+			t.Errorf("%s: expected income 90000, outflow 4999 and transfers 15000, got %v, %v and %v",
+				tt.name, summary.TotalIncome, summary.TotalExpense+summary.TotalInvestments, summary.InternalTransfers)
+		}
+	}
+}
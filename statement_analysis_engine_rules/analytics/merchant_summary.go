@@ -1,8 +1,17 @@
 package analytics
 
-import "classify/statement_analysis_engine_rules/models"
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/utils"
+)
 
 // CalculateMerchantSummary calculates merchant-wise summary
+// Deprecated: only covers five merchants; use CalculateMerchantAnalytics
 func CalculateMerchantSummary(transactions []models.ClassifiedTransaction) models.MerchantSummary {
 	summary := models.MerchantSummary{}
 
@@ -31,3 +40,181 @@ func CalculateMerchantSummary(transactions []models.ClassifiedTransaction) model
 
 	return summary
 }
+
+// flatTrendPercent is the month-over-month change below which a merchant's trend is flat
+const flatTrendPercent = 5.0
+
+// merchantExcluded are categories that move money between the customer's own accounts, not spend at a merchant
+var merchantExcluded = map[string]bool{
+	"Investment":    true,
+	"Investments":   true,
+	"Self_Transfer": true,
+}
+
+// merchantAccumulator collects one merchant's spend while the analytics are built
+type merchantAccumulator struct {
+	stats      models.MerchantStats
+	first      time.Time
+	last       time.Time
+	byCategory map[string]float64
+	byMonth    map[string]*models.MerchantMonth
+}
+
+// CalculateMerchantAnalytics returns the spend at every canonical merchant, ranked by total
+// Only debits count; self-transfers, investments and payments to people (paidToPerson) are
+// left out, as are transactions without a merchant
+// The trend compares the statement's last month with the month before it
+func CalculateMerchantAnalytics(transactions []models.ClassifiedTransaction) []models.MerchantStats {
+	merchants := make(map[string]*merchantAccumulator)
+	categorySpend := make(map[string]float64)
+	var lastMonth time.Time
+
+	for _, txn := range transactions {
+		if txn.WithdrawalAmt == 0 || txn.DepositAmt > 0 || merchantExcluded[txn.Category] || txn.Method == "Self_Transfer" {
+			continue
+		}
+		date, _ := utils.ParseDate(txn.Date)
+		if month := monthStart(date); month.After(lastMonth) {
+			lastMonth = month
+		}
+		amount := txn.WithdrawalAmt
+		categorySpend[txn.Category] += amount
+
+		// Payments to people are not merchants, and transfers to masked account numbers have none
+		if paidToPerson(txn) {
+			continue
+		}
+		name, _ := utils.CanonicalizeMerchant(txn.Merchant)
+		if name == "" || name == "Unknown" || strings.Contains(name, "XXXX") {
+			continue
+		}
+		m := merchants[name]
+		if m == nil {
+			m = &merchantAccumulator{
+				stats:      models.MerchantStats{Merchant: name},
+				byCategory: make(map[string]float64),
+				byMonth:    make(map[string]*models.MerchantMonth),
+			}
+			merchants[name] = m
+		}
+		m.stats.Total += amount
+		m.stats.Count++
+		m.byCategory[txn.Category] += amount
+		if date.IsZero() {
+			continue
+		}
+		if m.first.IsZero() || date.Before(m.first) {
+			m.first = date
+		}
+		if date.After(m.last) {
+			m.last = date
+		}
+		key := date.Format("2006-01")
+		if m.byMonth[key] == nil {
+			m.byMonth[key] = &models.MerchantMonth{Month: key}
+		}
+		m.byMonth[key].Amount += amount
+		m.byMonth[key].Count++
+	}
+
+	result := make([]models.MerchantStats, 0, len(merchants))
+	for _, m := range merchants {
+		result = append(result, m.build(categorySpend, lastMonth))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Merchant < result[j].Merchant
+	})
+	for i := range result {
+		result[i].Rank = i + 1
+	}
+	return result
+}
+
+// build finishes the merchant's stats: dominant category, dates, months and trend
+func (m *merchantAccumulator) build(categorySpend map[string]float64, lastMonth time.Time) models.MerchantStats {
+	stats := m.stats
+	stats.Total = roundAmount(stats.Total)
+	stats.AverageTicket = roundAmount(m.stats.Total / float64(stats.Count))
+
+	best := -1.0
+	for category, amount := range m.byCategory {
+		if amount > best || (amount == best && category < stats.Category) {
+			best, stats.Category = amount, category
+		}
+	}
+	if total := categorySpend[stats.Category]; total > 0 {
+		stats.CategorySharePercent = roundAmount(m.byCategory[stats.Category] / total * 100)
+	}
+
+	stats.FirstSeen = utils.FormatDate(m.first, "YYYY-MM-DD")
+	stats.LastSeen = utils.FormatDate(m.last, "YYYY-MM-DD")
+	stats.Monthly = make([]models.MerchantMonth, 0, len(m.byMonth))
+	for _, month := range m.byMonth {
+		month.Amount = roundAmount(month.Amount)
+		stats.Monthly = append(stats.Monthly, *month)
+	}
+	sort.Slice(stats.Monthly, func(i, j int) bool { return stats.Monthly[i].Month < stats.Monthly[j].Month })
+
+	var current, previous float64
+	if !lastMonth.IsZero() {
+		if month := m.byMonth[lastMonth.Format("2006-01")]; month != nil {
+			current = month.Amount
+		}
+		if month := m.byMonth[lastMonth.AddDate(0, -1, 0).Format("2006-01")]; month != nil {
+			previous = month.Amount
+		}
+	}
+	switch {
+	case previous == 0 && current == 0:
+		stats.Trend = "inactive"
+	case previous == 0 && !m.first.Before(lastMonth):
+		stats.Trend = "new"
+	case previous == 0:
+		stats.Trend = "returning"
+	default:
+		stats.TrendPercent = roundAmount((current - previous) / previous * 100)
+		switch {
+		case math.Abs(stats.TrendPercent) < flatTrendPercent:
+			stats.Trend = "flat"
+		case stats.TrendPercent > 0:
+			stats.Trend = "up"
+		default:
+			stats.Trend = "down"
+		}
+	}
+	return stats
+}
+
+// monthStart returns the first day of t's month (zero for a zero time)
+func monthStart(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// roundAmount rounds to two decimals
+func roundAmount(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// paidToPerson reports payments to people rather than merchants: UPI payments to a personal
+// VPA and bank transfers (IMPS, NEFT, RTGS) to a named beneficiary
+func paidToPerson(txn models.ClassifiedTransaction) bool {
+	if txn.UPI != nil {
+		return txn.UPI.Kind == utils.UPIPerson
+	}
+	if fields := txn.NarrationFields; fields != nil && txn.Beneficiary != "" {
+		switch fields.Rail {
+		case "IMPS", "NEFT", "RTGS":
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package analytics
+
+import (
+	"reflect"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/models"
+	_ "classify/statement_analysis_engine_rules/rulepack" // Activates the built-in canonical merchants
+)
+
+func TestCalculateMerchantAnalytics(t *testing.T) {
+	transactions := []models.ClassifiedTransaction{
+		{Date: "05/10/25", Merchant: "Swiggy", Category: "Food_Delivery", WithdrawalAmt: 400},
+		{Date: "20/10/25", Merchant: "SWIGGYINSTAMART", Category: "Groceries", WithdrawalAmt: 200},
+		{Date: "03/11/25", Merchant: "Swiggy", Category: "Food_Delivery", WithdrawalAmt: 900},
+		{Date: "10/11/25", Merchant: "Zomato", Category: "Food_Delivery", WithdrawalAmt: 300},
+		{Date: "12/10/25", Merchant: "Corner Bakery", Category: "Dining", WithdrawalAmt: 150},
+		{Date: "15/11/25", Merchant: "", Category: "Food_Delivery", WithdrawalAmt: 100},
+		{Date: "16/11/25", Merchant: "Swiggy", Category: "Refund", DepositAmt: 400},
+		{Date: "17/11/25", Merchant: "Own Account", Category: "Investment", WithdrawalAmt: 50000},
+		{Date: "18/11/25", Merchant: "XXXXXX2035", Category: "Other", WithdrawalAmt: 20000},
+		{Date: "02/09/25", Merchant: "Hill Pharmacy", Category: "Healthcare", WithdrawalAmt: 90},
+		{Date: "21/11/25", Merchant: "Hill Pharmacy", Category: "Healthcare", WithdrawalAmt: 60},
+		// Payments to people: a personal VPA and an IMPS transfer to a beneficiary
+		{Date: "22/11/25", Merchant: "RAHUL VERMA", Category: "Other", WithdrawalAmt: 90000,
+			UPI: &models.UPIDetails{Name: "RAHUL VERMA", VPA: "RAHULV@OKAXIS", Kind: "Person"}},
+		{Date: "23/11/25", Merchant: "KALPIT SHARMA", Beneficiary: "KALPIT SHARMA", Category: "Other", WithdrawalAmt: 80000,
+			NarrationFields: &models.NarrationFields{Rail: "IMPS", Direction: "DR", Name: "KALPIT SHARMA"}},
+	}
+
+	got := CalculateMerchantAnalytics(transactions)
+	if len(got) != 4 {
+		t.Fatalf("expected 4 merchants, got %+v", got)
+	}
+
+	swiggy := got[0]
+	expected := models.MerchantStats{
+		Rank:          1,
+		Merchant:      "Swiggy",
+		Category:      "Food_Delivery",
+		Total:         1500,
+		Count:         3,
+		AverageTicket: 500,
+		FirstSeen:     "2025-10-05",
+		LastSeen:      "2025-11-03",
+		Monthly: []models.MerchantMonth{
+			{Month: "2025-10", Amount: 600, Count: 2},
+			{Month: "2025-11", Amount: 900, Count: 1},
+		},
+		Trend:                "up",
+		TrendPercent:         50,
+		CategorySharePercent: 76.47, // 1300 of the 1700 spent on Food_Delivery
+	}
+	if !reflect.DeepEqual(swiggy, expected) {
+		t.Errorf("expected %+v, got %+v", expected, swiggy)
+	}
+
+	tests := []struct {
+		rank     int
+		merchant string
+		trend    string
+	}{
+		{2, "Zomato", "new"},
+		{3, "Hill Pharmacy", "returning"},
+		{4, "Corner Bakery", "down"},
+	}
+	for i, tt := range tests {
+		m := got[i+1]
+		if m.Rank != tt.rank || m.Merchant != tt.merchant || m.Trend != tt.trend {
+			t.Errorf("expected #%d %s (%s), got #%d %s (%s)", tt.rank, tt.merchant, tt.trend, m.Rank, m.Merchant, m.Trend)
+		}
+	}
+}
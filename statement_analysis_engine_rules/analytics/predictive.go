@@ -7,16 +7,29 @@ import (
 )
 
 // CalculatePredictiveInsights calculates predictive insights
+// Relative dates are anchored to the statement end date (latest transaction date)
 func CalculatePredictiveInsights(
 	transactions []models.ClassifiedTransaction,
 	closingBalance float64,
 ) models.PredictiveInsights {
+	return CalculatePredictiveInsightsAsOf(transactions, closingBalance, time.Time{})
+}
+
+// CalculatePredictiveInsightsAsOf calculates predictive insights relative to asOf
+// A zero asOf falls back to the statement end date, then to the system clock
+func CalculatePredictiveInsightsAsOf(
+	transactions []models.ClassifiedTransaction,
+	closingBalance float64,
+	asOf time.Time,
+) models.PredictiveInsights {
+	asOf = utils.ResolveAsOf(asOf, utils.SystemClock, transactionDates(transactions)...)
+
 	// Calculate average daily expense
 	avgDailyExpense := calculateAverageDailyExpense(transactions)
 	projected30DaySpend := avgDailyExpense * 30
 
 	// Predict low balance date
-	predictedLowBalanceDate := predictLowBalanceDate(closingBalance, avgDailyExpense)
+	predictedLowBalanceDate := predictLowBalanceDate(asOf, closingBalance, avgDailyExpense)
 
 	// Calculate upcoming EMI impact
 	upcomingEMI := calculateUpcomingEMI(transactions)
@@ -90,7 +103,7 @@ func calculateAverageDailyExpense(transactions []models.ClassifiedTransaction) f
 	return 0
 }
 
-func predictLowBalanceDate(currentBalance float64, avgDailyExpense float64) string {
+func predictLowBalanceDate(asOf time.Time, currentBalance float64, avgDailyExpense float64) string {
 	if avgDailyExpense == 0 {
 		return "N/A"
 	}
@@ -100,10 +113,25 @@ func predictLowBalanceDate(currentBalance float64, avgDailyExpense float64) stri
 		daysUntilLow = 0
 	}
 
-	futureDate := time.Now().AddDate(0, 0, daysUntilLow)
+	futureDate := asOf.AddDate(0, 0, daysUntilLow)
 	return utils.FormatDate(futureDate, "DD/MM/YYYY")
 }
 
+// StatementEndDate returns the latest transaction date, or the zero time if no date parses
+func StatementEndDate(transactions []models.ClassifiedTransaction) time.Time {
+	latest, _ := utils.LatestDate(transactionDates(transactions)...)
+	return latest
+}
+
+// transactionDates collects the raw date strings of all transactions
+func transactionDates(transactions []models.ClassifiedTransaction) []string {
+	dates := make([]string, 0, len(transactions))
+	for _, txn := range transactions {
+		dates = append(dates, txn.Date)
+	}
+	return dates
+}
+
 func calculateUpcomingEMI(transactions []models.ClassifiedTransaction) float64 {
 	// Find recurring EMI payments
 	emiAmount := 0.0
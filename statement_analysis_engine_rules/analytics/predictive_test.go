@@ -0,0 +1,45 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func TestPredictiveInsightsAnchoredToStatementEnd(t *testing.T) {
+	transactions := []models.ClassifiedTransaction{
+		{Date: "01/03/2024", WithdrawalAmt: 1000, Category: "Shopping"},
+		{Date: "11/03/2024", WithdrawalAmt: 1000, Category: "Dining"},
+	}
+
+	// 2000 spent over 10 days = 200/day; 2000 balance lasts 10 days past 11/03/2024
+	got := CalculatePredictiveInsights(transactions, 2000)
+	if got.PredictedLowBalanceDate != "21/03/2024" {
+		t.Errorf("expected low balance date 21/03/2024, got %s", got.PredictedLowBalanceDate)
+	}
+
+	asOf := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	got = CalculatePredictiveInsightsAsOf(transactions, 2000, asOf)
+	if got.PredictedLowBalanceDate != "11/04/2024" {
+		t.Errorf("expected low balance date 11/04/2024, got %s", got.PredictedLowBalanceDate)
+	}
+}
+
+func TestStatementEndDate(t *testing.T) {
+	transactions := []models.ClassifiedTransaction{
+		{Date: "15/03/2024"},
+		{Date: "not a date"},
+		{Date: "02/03/2024"},
+	}
+
+	got := StatementEndDate(transactions)
+	want := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if !StatementEndDate(nil).IsZero() {
+		t.Error("expected zero time for empty statement")
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"sync"
 )
 
 // RecurringPaymentDetector implements comprehensive recurring payment detection
 type RecurringPaymentDetector struct {
 	transactions []models.ClassifiedTransaction
+	fingerprints sync.Map // Narration or payment name -> utils.FingerprintNarration, safe for concurrent matching
 }
 
 // NewRecurringPaymentDetector creates a new detector
@@ -42,6 +44,10 @@ func (d *RecurringPaymentDetector) DetectRecurringPayments() []models.RecurringP
 			// Extract human-readable name from transactions
 			// Don't use the signature hash directly - extract merchant/beneficiary/narration
 			displayName := d.extractDisplayName(txns, signature)
+			counterpartyID := ""
+			if strings.HasPrefix(signature, "COUNTERPARTY:") {
+				counterpartyID = txns[0].CounterpartyID
+			}
 
 			result = append(result, models.RecurringPayment{
 				Name:       displayName,
@@ -53,6 +59,8 @@ func (d *RecurringPaymentDetector) DetectRecurringPayments() []models.RecurringP
 				FirstSeen:  firstSeen,
 				LastSeen:   lastSeen,
 				Count:      len(txns),
+
+				CounterpartyID: counterpartyID,
 			})
 		}
 	}
@@ -89,6 +97,17 @@ func (d *RecurringPaymentDetector) groupByCounterparty() map[string][]models.Cla
 	return groups
 }
 
+// fingerprint memoizes utils.FingerprintNarration; every transaction is fingerprinted
+// while grouping and again while matching, and every payment name once per transaction
+func (d *RecurringPaymentDetector) fingerprint(text string) string {
+	if cached, ok := d.fingerprints.Load(text); ok {
+		return cached.(string)
+	}
+	fingerprint := utils.FingerprintNarration(text)
+	d.fingerprints.Store(text, fingerprint)
+	return fingerprint
+}
+
 // getCounterpartySignature returns a stable identifier for the counterparty
 func (d *RecurringPaymentDetector) getCounterpartySignature(txn models.ClassifiedTransaction) string {
 	// Priority 1: Normalized merchant name (if available and meaningful)
@@ -100,13 +119,18 @@ func (d *RecurringPaymentDetector) getCounterpartySignature(txn models.Classifie
 		}
 	}
 
-	// Priority 2: Narration fingerprint (most stable for recurring payments)
-	fingerprint := utils.FingerprintNarration(txn.Narration)
+	// Priority 2: Resolved counterparty of a transfer, whose narration and name vary per payment
+	if txn.CounterpartyID != "" && (txn.UPI != nil || txn.NarrationFields != nil) {
+		return "COUNTERPARTY:" + txn.CounterpartyID
+	}
+
+	// Priority 3: Narration fingerprint (most stable for recurring payments)
+	fingerprint := d.fingerprint(txn.Narration)
 	if fingerprint != "" {
 		return "FINGERPRINT:" + fingerprint
 	}
 
-	// Priority 3: Beneficiary identifier
+	// Priority 4: Beneficiary identifier
 	if txn.Beneficiary != "" {
 		beneficiaryUpper := strings.ToUpper(strings.TrimSpace(txn.Beneficiary))
 		return "BENEFICIARY:" + beneficiaryUpper
@@ -486,8 +510,13 @@ func (d *RecurringPaymentDetector) shouldExclude(
 		if txn.Method == "UPI" || txn.Method == "IMPS" {
 			if !strings.Contains(narrationUpper, "SALARY") &&
 				!strings.Contains(narrationUpper, "PAYROLL") {
-				// Check if it looks like P2P (person name, not merchant)
-				if utils.IsPersonToPersonTransfer(txn.Narration, txn.Merchant, txn.WithdrawalAmt) {
+				// Check if it looks like P2P (person name, not merchant); the classifier's
+				// UPI kind also weighs how often the VPA recurs
+				if txn.UPI != nil && txn.UPI.Kind != utils.UPIUnknown {
+					if txn.UPI.Kind == utils.UPIPerson {
+						return true
+					}
+				} else if utils.IsPersonToPersonTransfer(txn.Narration, txn.Merchant, txn.WithdrawalAmt) {
 					return true
 				}
 			}
@@ -723,15 +752,16 @@ func MatchTransactionToRecurring(
 	// This handles cases where signature format differs
 	txnMerchantUpper := strings.ToUpper(txn.Merchant)
 	txnBeneficiaryUpper := strings.ToUpper(txn.Beneficiary)
-	txnFingerprint := utils.FingerprintNarration(txn.Narration)
+	txnFingerprint := detector.fingerprint(txn.Narration)
 
 	for _, rp := range recurringMap {
 		if rp.Confidence >= 50 {
 			rpNameUpper := strings.ToUpper(rp.Name)
-			rpFingerprint := utils.FingerprintNarration(rp.Name)
+			rpFingerprint := detector.fingerprint(rp.Name)
 
-			// Check if merchant/beneficiary matches
-			if (txnMerchantUpper != "" && strings.Contains(rpNameUpper, txnMerchantUpper)) ||
+			// Check if counterparty/merchant/beneficiary matches
+			if (txn.CounterpartyID != "" && rp.CounterpartyID == txn.CounterpartyID) ||
+				(txnMerchantUpper != "" && strings.Contains(rpNameUpper, txnMerchantUpper)) ||
 				(txnBeneficiaryUpper != "" && strings.Contains(rpNameUpper, txnBeneficiaryUpper)) ||
 				(txnFingerprint != "" && rpFingerprint != "" && txnFingerprint == rpFingerprint) {
 				return models.RecurringMetadata{
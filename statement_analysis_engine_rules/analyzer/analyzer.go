@@ -1,10 +1,16 @@
 package analyzer
 
 import (
+	"classify/logging"
 	"classify/statement_analysis_engine_rules/analytics"
 	"classify/statement_analysis_engine_rules/classifier"
 	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/statement_analysis_engine_rules/utils"
+	"context"
+	"log/slog"
 	"strings"
+	"time"
 )
 
 // Analyzer is the main analyzer struct
@@ -12,15 +18,49 @@ type Analyzer struct {
 	transactions        []models.ClassifiedTransaction
 	statementTotalCredits float64 // Optional: official statement total credits
 	statementTotalDebits  float64 // Optional: official statement total debits
+	asOf                  time.Time   // Optional: reference date for relative computations
+	clock                 utils.Clock // Fallback when neither asOf nor a statement date is available
+	overrides             *overrides.Set // Optional: the user's category overrides
 }
 
 // NewAnalyzer creates a new analyzer instance
 func NewAnalyzer() *Analyzer {
 	return &Analyzer{
 		transactions: make([]models.ClassifiedTransaction, 0),
+		clock:        utils.SystemClock,
 	}
 }
 
+// SetAsOf pins the reference date for relative computations ("next 30 days", low balance date)
+// By default the analyzer anchors to the statement end date
+func (a *Analyzer) SetAsOf(asOf time.Time) {
+	a.asOf = asOf
+}
+
+// SetClock replaces the wall clock used as a last-resort reference date
+func (a *Analyzer) SetClock(clock utils.Clock) {
+	if clock == nil {
+		clock = utils.SystemClock
+	}
+	a.clock = clock
+}
+
+// AsOf returns the effective reference date: explicit as-of date, else statement end date, else clock
+func (a *Analyzer) AsOf() time.Time {
+	if !a.asOf.IsZero() {
+		return a.asOf
+	}
+	if end := analytics.StatementEndDate(a.transactions); !end.IsZero() {
+		return end
+	}
+	return a.clock.Now()
+}
+
+// SetOverrides applies a user's category overrides whenever the analyzer classifies
+func (a *Analyzer) SetOverrides(userOverrides *overrides.Set) {
+	a.overrides = userOverrides
+}
+
 // SetStatementTotals sets the official statement totals (use these for accurate calculations)
 func (a *Analyzer) SetStatementTotals(totalCredits, totalDebits float64) {
 	a.statementTotalCredits = totalCredits
@@ -40,7 +80,7 @@ func (a *Analyzer) AddTransactions(transactions []models.ClassifiedTransaction)
 // ClassifyAll classifies all transactions
 // customerName is optional - if provided, used for self-transfer detection
 func (a *Analyzer) ClassifyAll(customerName string) {
-	a.transactions = classifier.ClassifyTransactions(a.transactions, customerName)
+	a.transactions = classifier.ClassifyTransactions(a.transactions, customerName, a.overrides)
 }
 
 // Analyze generates complete analysis
@@ -51,8 +91,31 @@ func (a *Analyzer) Analyze(
 	openingBalance float64,
 	closingBalance float64,
 ) models.ClassifyResponse {
+	return a.AnalyzeContext(context.Background(), accountNo, customerName, statementPeriod, openingBalance, closingBalance)
+}
+
+// AnalyzeContext is Analyze with a request context; stage timings are logged with the context's logger
+func (a *Analyzer) AnalyzeContext(
+	ctx context.Context,
+	accountNo string,
+	customerName string,
+	statementPeriod string,
+	openingBalance float64,
+	closingBalance float64,
+) models.ClassifyResponse {
+	// Callers outside the server may log through a handler that does not redact, so the account is masked here
+	logger := logging.FromContext(ctx).With(slog.String("account_no", logging.MaskAccount(accountNo)))
+	start := time.Now()
+
 	// Classify all transactions first (pass customerName for self-transfer detection)
 	a.ClassifyAll(customerName)
+	logger.Debug("classified transactions",
+		slog.Int("transactions", len(a.transactions)),
+		slog.Duration("duration", time.Since(start)),
+	)
+
+	// Resolve the reference date once so every relative computation agrees
+	asOf := a.AsOf()
 
 	// Calculate all analytics
 	// Use statement totals if available, otherwise calculate from transactions
@@ -69,24 +132,31 @@ func (a *Analyzer) Analyze(
 
 	transactionBreakdown := analytics.CalculateTransactionBreakdown(a.transactions)
 	topBeneficiaries := analytics.CalculateTopBeneficiaries(a.transactions, 5)
+	counterparties := analytics.CalculateCounterparties(a.transactions)
 	topExpenses := analytics.CalculateTopExpenses(a.transactions, 5)
 	monthlySummary := analytics.CalculateMonthlySummary(a.transactions)
 	categorySummary := analytics.CalculateCategorySummary(a.transactions)
 	merchantSummary := analytics.CalculateMerchantSummary(a.transactions)
+	merchantAnalytics := analytics.CalculateMerchantAnalytics(a.transactions)
 	transactionTrends := analytics.CalculateTransactionTrends(monthlySummary, categorySummary)
 	recurringPayments := analytics.CalculateRecurringPayments(a.transactions)
 	fraudRisk := analytics.CalculateFraudRisk(a.transactions)
 	bigTicketMovements := analytics.CalculateBigTicketMovements(a.transactions, 20000)
 	taxInsights := analytics.CalculateTaxInsights(a.transactions)
 	// Use new anomaly_engine package (bank-grade detection)
-	anomalyDetection := analytics.CalculateAnomalyDetectionWithEngine(a.transactions, customerName)
+	anomalyStart := time.Now()
+	anomalyDetection := analytics.CalculateAnomalyDetectionWithEngineAsOf(a.transactions, customerName, asOf)
+	logger.Debug("anomaly detection finished",
+		slog.Int("anomalies", anomalyDetection.AnomalyCount),
+		slog.Duration("duration", time.Since(anomalyStart)),
+	)
 	cashFlowScore := analytics.CalculateCashFlowScore(
 		openingBalance,
 		closingBalance,
 		accountSummary.TotalIncome,
 		accountSummary.TotalExpense,
 	)
-	predictiveInsights := analytics.CalculatePredictiveInsights(a.transactions, closingBalance)
+	predictiveInsights := analytics.CalculatePredictiveInsightsAsOf(a.transactions, closingBalance, asOf)
 
 	// Calculate salary utilization (simplified - would need salary detection)
 	salaryUtilization := analytics.CalculateSalaryUtilization(a.transactions, 0, "")
@@ -99,14 +169,22 @@ func (a *Analyzer) Analyze(
 	// Prepare all transactions for heatmap and pattern analysis
 	transactionDetails := analytics.PrepareTransactionsForResponse(a.transactions)
 
+	logger.Debug("analysis complete",
+		slog.Int("transactions", len(a.transactions)),
+		slog.String("as_of", asOf.Format("2006-01-02")),
+		slog.Duration("duration", time.Since(start)),
+	)
+
 	return models.ClassifyResponse{
 		AccountSummary:       accountSummary,
 		TransactionBreakdown: transactionBreakdown,
 		TopBeneficiaries:     topBeneficiaries,
+		Counterparties:       counterparties,
 		TopExpenses:          topExpenses,
 		MonthlySummary:       monthlySummary,
 		CategorySummary:      categorySummary,
 		MerchantSummary:      merchantSummary,
+		MerchantAnalytics:    merchantAnalytics,
 		TransactionTrends:    transactionTrends,
 		RecommendedProducts:  recommendedProducts,
 		PredictiveInsights:   predictiveInsights,
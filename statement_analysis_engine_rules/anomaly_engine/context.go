@@ -5,23 +5,31 @@ import (
 
 	"classify/statement_analysis_engine_rules/anomaly_engine/types"
 	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/utils"
 )
 
 // TransactionContext is an alias for types.TransactionContext
 type TransactionContext = types.TransactionContext
 
 // NewTransactionContext creates a new transaction context
+// Unparseable dates fall back to the system clock - prefer NewTransactionContextAt for reproducible results
 func NewTransactionContext(txn models.ClassifiedTransaction, userID string) TransactionContext {
+	return NewTransactionContextAt(txn, userID, utils.SystemClock.Now())
+}
+
+// NewTransactionContextAt creates a new transaction context anchored to asOf
+// asOf is used as the timestamp when the transaction date cannot be parsed
+func NewTransactionContextAt(txn models.ClassifiedTransaction, userID string, asOf time.Time) TransactionContext {
 	return types.TransactionContext{
 		Txn:       txn,
 		UserID:    userID,
-		Timestamp: parseTransactionTimestamp(txn.Date),
+		Timestamp: parseTransactionTimestamp(txn.Date, asOf),
 		Location:  "",
 		DeviceID:  "",
 	}
 }
 
-func parseTransactionTimestamp(dateStr string) time.Time {
+func parseTransactionTimestamp(dateStr string, fallback time.Time) time.Time {
 	layouts := []string{
 		"02/01/2006",
 		"2006-01-02",
@@ -35,6 +43,5 @@ func parseTransactionTimestamp(dateStr string) time.Time {
 		}
 	}
 
-	return time.Now()
+	return fallback
 }
-
@@ -230,7 +230,8 @@ func parseTransactionDate(dateStr string) (time.Time, error) {
 		}
 	}
 
-	return time.Now(), nil
+	// Undated rows are never matched as duplicates
+	return time.Time{}, fmt.Errorf("unrecognized date format: %q", dateStr)
 }
 
 // User-friendly explanations (Zerodha/HDFC style)
@@ -98,6 +98,7 @@ func (p *PatternDetector) detectMultipleTransfersToSameAccount(txn models.Classi
 	if targetAccount == "" || amount < p.config.SameAccountThreshold {
 		return signals
 	}
+	target := patternTarget(txn, targetAccount)
 	
 	// Look through recent history
 	txnDate, err := parseTransactionDateForPattern(txn.Date)
@@ -121,7 +122,7 @@ func (p *PatternDetector) detectMultipleTransfersToSameAccount(txn models.Classi
 		}
 		
 		// Check if same account
-		if otherTarget == "" || otherTarget != targetAccount {
+		if otherTarget == "" || patternTarget(other, otherTarget) != target {
 			continue
 		}
 		
@@ -236,6 +237,7 @@ func (p *PatternDetector) detectHighValueRecurring(txn models.ClassifiedTransact
 	if beneficiary != "" {
 		target = beneficiary
 	}
+	target = patternTarget(txn, target)
 	
 	// Check if this appears to be recurring (same merchant/beneficiary, similar amount)
 	similarCount := 0
@@ -253,7 +255,7 @@ func (p *PatternDetector) detectHighValueRecurring(txn models.ClassifiedTransact
 			otherTarget = otherBeneficiary
 		}
 		
-		if otherTarget != target {
+		if patternTarget(other, otherTarget) != target {
 			continue
 		}
 		
@@ -338,7 +340,17 @@ func parseTransactionDateForPattern(dateStr string) (time.Time, error) {
 		}
 	}
 
-	return time.Now(), nil
+	// Undated rows are left out of the pattern comparison
+	return time.Time{}, fmt.Errorf("unrecognized date format: %q", dateStr)
+}
+
+// patternTarget identifies the payee when comparing transactions: the resolved counterparty
+// when there is one, so name variants of one payee count together, else the given name
+func patternTarget(txn models.ClassifiedTransaction, name string) string {
+	if txn.CounterpartyID != "" {
+		return "COUNTERPARTY:" + txn.CounterpartyID
+	}
+	return name
 }
 
 func maskAccount(account string) string {
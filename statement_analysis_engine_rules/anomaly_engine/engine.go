@@ -1,11 +1,14 @@
 package anomaly_engine
 
 import (
+	"time"
+
 	"classify/statement_analysis_engine_rules/anomaly_engine/detectors"
 	"classify/statement_analysis_engine_rules/anomaly_engine/profiles"
 	"classify/statement_analysis_engine_rules/anomaly_engine/suppression"
 	"classify/statement_analysis_engine_rules/anomaly_engine/types"
 	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/utils"
 )
 
 // DetectorFunc is a function type for detectors
@@ -20,6 +23,7 @@ type Engine struct {
 	profile       *profiles.UserProfile
 	history       []models.ClassifiedTransaction // For duplicate detection
 	suppressor    *suppression.Suppressor       // Bank-grade suppression rules
+	asOf          time.Time                      // Reference date for relative computations
 }
 
 // EngineConfig holds engine configuration
@@ -31,6 +35,11 @@ type EngineConfig struct {
 	EnablePatternDetector     bool // Multi-transaction pattern detection
 	EnableIncomeDetector      bool // Income disruption detection
 	HistorySize               int // Number of recent transactions to keep for duplicate detection
+
+	// AsOf anchors relative computations (zero = latest transaction date in history)
+	AsOf time.Time
+	// Clock is consulted only when neither AsOf nor a history date is available (nil = system clock)
+	Clock utils.Clock
 }
 
 // DefaultEngineConfig returns default engine configuration
@@ -60,6 +69,13 @@ func NewEngine(config *EngineConfig, transactionHistory []models.ClassifiedTrans
 		suppressor:    suppression.NewSuppressor(),
 	}
 
+	// Anchor to the statement end date by default so re-runs are reproducible
+	dates := make([]string, 0, len(transactionHistory))
+	for _, txn := range transactionHistory {
+		dates = append(dates, txn.Date)
+	}
+	engine.asOf = utils.ResolveAsOf(config.AsOf, config.Clock, dates...)
+
 	// Build user profile from history
 	engine.profile = profiles.BuildUserProfile(transactionHistory)
 
@@ -264,7 +280,7 @@ func (e *Engine) EvaluateBatch(transactions []models.ClassifiedTransaction, user
 	results := make([]AnomalyResult, 0, len(transactions))
 
 	for _, txn := range transactions {
-		ctx := NewTransactionContext(txn, userID)
+		ctx := NewTransactionContextAt(txn, userID, e.asOf)
 		result := e.Evaluate(ctx)
 		results = append(results, result)
 	}
@@ -272,6 +288,11 @@ func (e *Engine) EvaluateBatch(transactions []models.ClassifiedTransaction, user
 	return results
 }
 
+// AsOf returns the reference date the engine anchors relative computations to
+func (e *Engine) AsOf() time.Time {
+	return e.asOf
+}
+
 // UpdateProfile rebuilds user profile (call after adding new transactions)
 func (e *Engine) UpdateProfile(transactionHistory []models.ClassifiedTransaction) {
 	e.profile = profiles.BuildUserProfile(transactionHistory)
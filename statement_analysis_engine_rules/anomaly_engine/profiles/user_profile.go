@@ -2,6 +2,7 @@ package profiles
 
 import (
 	"classify/statement_analysis_engine_rules/models"
+	"fmt"
 	"math"
 	"sort"
 	"strings"
@@ -255,7 +256,8 @@ func parseTransactionTimestamp(dateStr string) (time.Time, error) {
 		}
 	}
 
-	return time.Now(), nil
+	// Undated rows are left out of active hours and the transaction span
+	return time.Time{}, fmt.Errorf("unrecognized date format: %q", dateStr)
 }
 
 func calculateTransactionDays(transactions []models.ClassifiedTransaction) int {
@@ -2,39 +2,96 @@ package classifier
 
 import (
 	"classify/statement_analysis_engine_rules/analytics"
+	"classify/statement_analysis_engine_rules/llmcategory"
+	"classify/statement_analysis_engine_rules/merchantkb"
 	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
 	"classify/statement_analysis_engine_rules/rules"
+	"classify/statement_analysis_engine_rules/textmodel"
 	"classify/statement_analysis_engine_rules/utils"
+	"fmt"
+	"runtime"
 	"strings"
+	"sync"
 )
 
 // ClassifyTransaction classifies a single transaction
 // Implements: Narration → Signals → Facts → Category
 // customerName is optional - if provided, used for self-transfer detection
-func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string) models.ClassifiedTransaction {
+// userOverrides is optional - a matching user override wins over every rule
+func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string, userOverrides *overrides.Set) models.ClassifiedTransaction {
+	return classifyTransaction(txn, customerName, userOverrides, nil, nil)
+}
+
+// classifyTransaction is ClassifyTransaction, recording every layer into tr when it is not nil
+// vpaStats, when not nil, refines the UPI counterparty kind with the VPAs and names of the whole statement
+func classifyTransaction(txn models.ClassifiedTransaction, customerName string, userOverrides *overrides.Set, vpaStats *utils.VPAStats, tr *tracer) models.ClassifiedTransaction {
 	// Step 1: Clean narration first (critical - improves accuracy by 20-30%)
 	normalizedNarration := utils.NormalizeNarration(txn.Narration)
+	tr.step(TraceNormalization, txn.Narration, normalizedNarration)
+
+	// UPI counterparty, parsed from the raw narration since normalizing drops the @ of VPAs;
+	// its person vs merchant kind feeds the category layers
+	credit := txn.DepositAmt > 0 && txn.WithdrawalAmt == 0
+	txn.UPI = nil
+	if upi, ok := utils.ParseUPI(txn.Narration, credit); ok {
+		if vpaStats != nil {
+			upi = vpaStats.Refine(upi)
+		}
+		txn.UPI = &upi
+	}
+	upiKind := ""
+	if txn.UPI != nil {
+		upiKind = txn.UPI.Kind
+	}
 
 	// Step 2: Extract signals (Channel, Gateway, Merchant, Intent)
 	// Separate concepts: Channel, Gateway, Merchant, Intent
 	// Channel detection (payment method)
 	txn.Method = rules.ClassifyMethod(normalizedNarration)
+	tr.step(TraceMethod, normalizedNarration, txn.Method)
 
 	// Gateway detection (separate from channel)
 	gateway := utils.ExtractGateway(normalizedNarration)
+	tr.step(TraceGateway, normalizedNarration, gateway)
 
 	// Merchant extraction and canonicalization (separate from category)
 	rawMerchant := rules.ExtractMerchantName(normalizedNarration)
 	if rawMerchant == "Unknown" {
 		rawMerchant = ""
 	}
-	// Canonicalize merchant (normalize aliases - critical for long-term maintenance)
-	canonicalMerchant, _ := utils.CanonicalizeMerchant(rawMerchant)
-	if canonicalMerchant != "" {
-		txn.Merchant = canonicalMerchant
+	// Merchant knowledge base first (curated VPAs and aliases, then fuzzy matches of truncated names),
+	// then the rule pack's canonical map for what it misses
+	// Fuzzy and prefix matches are suggestions: they are recorded but do not rename the merchant
+	var merchantMatch *models.MerchantMatch
+	var merchantCandidates []models.TraceCandidate
+	kb := merchantkb.Active()
+	match, resolved := kb.Resolve(txn.Narration, rawMerchant)
+	if resolved {
+		merchantMatch = &models.MerchantMatch{
+			Version:  kb.Version,
+			Name:     match.Merchant.Name,
+			Brand:    match.Merchant.Brand,
+			Category: match.Merchant.Category,
+			Method:   match.Method,
+			Score:    match.Score,
+			Matched:  match.Matched,
+		}
+		merchantCandidates = append(merchantCandidates,
+			models.TraceCandidate{Value: match.Merchant.Name, Score: match.Score, Source: match.Method + ": " + match.Matched})
+	}
+	if resolved && match.Exact() {
+		txn.Merchant = match.Merchant.Name
 	} else {
-		txn.Merchant = rawMerchant
+		// Canonicalize merchant (normalize aliases - critical for long-term maintenance)
+		canonicalMerchant, _ := utils.CanonicalizeMerchant(rawMerchant)
+		if canonicalMerchant != "" {
+			txn.Merchant = canonicalMerchant
+		} else {
+			txn.Merchant = rawMerchant
+		}
 	}
+	tr.step(TraceMerchant, rawMerchant, txn.Merchant, merchantCandidates...)
 
 	// Step 3: Classify category (Intent) with amount for charge detection
 	// Determine the transaction amount - prioritize withdrawal for expense detection
@@ -60,7 +117,24 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 	}
 
 	// Get category with metadata (matched keywords, confidence, etc.)
-	categoryResult := rules.ClassifyCategoryWithMetadata(normalizedNarration, txn.Merchant, amount)
+	tr.candidates(normalizedNarration, txn.Merchant, amount)
+	categoryResult := rules.ClassifyCategoryWithUPIKind(normalizedNarration, txn.Merchant, amount, upiKind)
+	tr.rulesResult(categoryResult)
+
+	// Step 3.1: Merchant knowledge base category
+	// Curated VPA and alias matches replace the built-in patterns; fuzzy guesses only fill in "Other".
+	// The rule pack's known merchants and category rules are curated too, so they keep their say
+	if merchantMatch != nil && merchantMatch.Category != "" {
+		exact := merchantMatch.Method == merchantkb.MatchVPA || merchantMatch.Method == merchantkb.MatchAlias
+		patternsOnly := categoryResult.Layer == rules.LayerPatterns || categoryResult.Layer == rules.LayerDefault
+		if categoryResult.Category == "Other" || exact && patternsOnly {
+			categoryResult.Category = merchantMatch.Category
+			categoryResult.Confidence = merchantkb.Confidence * merchantMatch.Score
+			categoryResult.Reason = fmt.Sprintf("Merchant knowledge base: %s (%s match on %s)", merchantMatch.Name, merchantMatch.Method, merchantMatch.Matched)
+			categoryResult.MatchedKeywords = append(categoryResult.MatchedKeywords, "MERCHANT_KB")
+		}
+	}
+	tr.decide(TraceMerchantKB, categoryResult.Category, categoryResult.Reason)
 
 	// Step 3.5: CRITICAL FIX - Credit transactions CANNOT be expenses
 	// Expenses are only for debit transactions (money spent)
@@ -140,6 +214,7 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 			}
 		}
 	}
+	tr.decide(TraceCreditOverride, categoryResult.Category, categoryResult.Reason)
 
 	// Step 3.6: Handle Refunds and Reimbursements
 	// Also handle POS reversals (CRV POS) and IMPS reversals (REV-IMPS) - these are refunds
@@ -164,6 +239,7 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 		categoryResult.Reason = "UPI reversal/refund detected (REV-UPI)"
 		categoryResult.MatchedKeywords = append(categoryResult.MatchedKeywords, "REFUND", "REV_UPI")
 	}
+	tr.decide(TraceReversal, categoryResult.Category, categoryResult.Reason)
 
 	// Step 3.7: Handle Loan EMI Reimbursements
 	// Credit entries for loan EMI reimbursements should be categorized as "Reimbursement", not "Income"
@@ -189,10 +265,19 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 			}
 		}
 	}
+	tr.decide(TraceReimbursement, categoryResult.Category, categoryResult.Reason)
 	txn.Category = categoryResult.Category
 
-	// Step 4: Extract beneficiary
-	txn.Beneficiary = rules.ExtractBeneficiary(normalizedNarration, txn.Method)
+	// Step 4: Extract beneficiary; UPI and rail template narrations are parsed raw, since
+	// normalizing drops the @ of VPAs and the / of slash templates
+	if fields, ok := utils.ParseNarrationFields(txn.Narration, credit); ok {
+		txn.NarrationFields = &fields
+	}
+	if txn.UPI != nil && txn.Method == "UPI" || txn.NarrationFields != nil {
+		txn.Beneficiary = rules.ExtractBeneficiary(txn.Narration, txn.Method)
+	} else {
+		txn.Beneficiary = rules.ExtractBeneficiary(normalizedNarration, txn.Method)
+	}
 
 	// Step 4.5: Handle FD premature closure and interest
 	// Pattern: IB FD PREMAT PRINCIPAL-50300618314680 (withdrawal - principal returned)
@@ -213,6 +298,8 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 			categoryResult.MatchedKeywords = append(categoryResult.MatchedKeywords, "FD", "PRINCIPAL", "INVESTMENT")
 		}
 	}
+	// From here on txn.Category is the decision; some steps only rewrite categoryResult
+	tr.decide(TraceFixedDeposit, txn.Category, categoryResult.Reason)
 
 	// Step 5: Determine if income or expense
 	// Dividends and Salary are always income (even if they come as deposits)
@@ -241,6 +328,7 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 	} else {
 		txn.IsIncome = txn.DepositAmt > 0
 	}
+	tr.decide(TraceIncomeMethod, txn.Category, categoryResult.Reason)
 
 	// Step 6: Priority overrides (high confidence rules)
 	// Detect self-transfers (IMPS/NEFT/RTGS to same account holder)
@@ -261,7 +349,30 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 		categoryResult.Reason = "Self-transfer detected - 'OWN' indicator in narration"
 	}
 
-	if (txn.Method == "IMPS" || txn.Method == "NEFT" || txn.Method == "RTGS") && txn.Beneficiary != "" {
+	// Pattern 0b: The counterparty is one of the accounts the user registered (account overrides)
+	account, registeredAccount := userOverrides.OwnAccount(txn)
+	if registeredAccount {
+		category := "Investment"
+		if account.Category != "" {
+			category = account.Category
+		}
+		txn.Category = category
+		categoryResult.Category = category
+		categoryResult.MatchedKeywords = append(categoryResult.MatchedKeywords, "SELF_TRANSFER", "REGISTERED_ACCOUNT")
+		categoryResult.Confidence = 0.99
+		categoryResult.Reason = "Self-transfer detected - registered account " + account.AccountName()
+
+		// Copied, since the input transaction shares the pointer
+		transfer := models.InternalTransfer{}
+		if txn.InternalTransfer != nil {
+			transfer = *txn.InternalTransfer
+		}
+		transfer.Account = account.AccountName()
+		txn.InternalTransfer = &transfer
+	}
+	tr.decide(TraceSelfTransfer, txn.Category, categoryResult.Reason)
+
+	if !registeredAccount && (txn.Method == "IMPS" || txn.Method == "NEFT" || txn.Method == "RTGS") && txn.Beneficiary != "" {
 		beneficiaryUpper := strings.ToUpper(txn.Beneficiary)
 
 		// Self-transfer indicators (generic patterns):
@@ -377,6 +488,7 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 		categoryResult.Confidence = 0.98 // Very high confidence for internal transfers
 		categoryResult.Reason = "Internal fund transfer detected (INF/INFT) - classified as Investment (savings movement)"
 	}
+	tr.decide(TraceSelfTransfer, txn.Category, categoryResult.Reason)
 
 	// If Method is OnlineShopping (ICICI ONL code), ensure Category is Shopping
 	// Only apply to debit transactions - expenses cannot be credits
@@ -459,6 +571,7 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 		}
 		// If not investment-type, keep as Bills_Utilities (default classification)
 	}
+	tr.decide(TraceMethodOverride, txn.Category, categoryResult.Reason)
 
 	// Check if bill payment
 	// Only apply to debit transactions - expenses cannot be credits
@@ -471,6 +584,8 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 		}
 	}
 
+	tr.decide(TraceBillPayment, txn.Category, categoryResult.Reason)
+
 	// Step 6.4: Improve ACH D categorization
 	// ACH D - HDFC BANK LTD patterns are typically credit card payments or loan payments
 	// Pattern: "ACH D- HDFC BANK LTD-408491108"
@@ -519,6 +634,8 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 		}
 	}
 
+	tr.decide(TraceACHDebit, txn.Category, categoryResult.Reason)
+
 	// Step 6.5: FINAL SAFEGUARD - Ensure credit transactions are NEVER classified as expenses
 	// This is a critical check to catch any edge cases that might have slipped through
 	// Check if this is a credit transaction (pure credit or net credit)
@@ -550,6 +667,76 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 		categoryResult.Reason = "FINAL SAFEGUARD: Credit transaction cannot be expense - classified as Income"
 		categoryResult.MatchedKeywords = append(categoryResult.MatchedKeywords, "CREDIT_SAFEGUARD")
 	}
+	tr.decide(TraceCreditSafeguard, txn.Category, categoryResult.Reason)
+
+	// Step 6.6: User overrides (top priority - the user knows their payees better than the rules)
+	if override, ok := userOverrides.Match(txn.Narration, txn.Merchant, rawMerchant); ok {
+		if override.Category != "" {
+			txn.Category = override.Category
+			categoryResult.Category = override.Category
+		}
+		if override.Merchant != "" {
+			txn.Merchant = override.Merchant
+		}
+		if override.Beneficiary != "" {
+			txn.Beneficiary = override.Beneficiary
+		}
+		categoryResult.Confidence = 1.0
+		categoryResult.Reason = override.Describe()
+		categoryResult.MatchedKeywords = append(categoryResult.MatchedKeywords, "USER_OVERRIDE")
+	}
+	tr.decide(TraceUserOverride, txn.Category, categoryResult.Reason)
+
+	// Step 6.7: Statistical fallback - only for "Other" transactions the rules were unsure about
+	// (user overrides score 1.0, so they are never second-guessed; cash withdrawals say nothing about the spend)
+	var modelPrediction *models.ModelPrediction
+	model := textmodel.Active()
+	if model != nil && txn.Category == "Other" && categoryResult.Confidence < model.Threshold && txn.Method != "ATMWithdrawal" {
+		signedAmount := amount
+		if isCreditTxn {
+			signedAmount = -amount
+		}
+		if prediction, ok := model.Predict(textmodel.Features(txn.Narration, txn.Method, signedAmount)); ok {
+			modelPrediction = &models.ModelPrediction{
+				Version:     model.Version,
+				Category:    prediction.Category,
+				Probability: prediction.Probability,
+				Features:    prediction.Features,
+			}
+			// A predicted "Other" changes nothing, and as in Step 6.5 a credit is never an expense
+			applies := prediction.Category != "Other" && !(isCreditTxn && expenseCats[prediction.Category])
+			if applies && prediction.Probability >= model.MinProbability {
+				modelPrediction.Applied = true
+				txn.Category = prediction.Category
+				categoryResult.Category = prediction.Category
+				categoryResult.Confidence = prediction.Probability
+				categoryResult.Reason = fmt.Sprintf("Statistical model %s: %s (p=%.2f) from %s",
+					model.Version, prediction.Category, prediction.Probability, strings.Join(prediction.Features, ", "))
+				categoryResult.MatchedKeywords = append(categoryResult.MatchedKeywords, "TEXT_MODEL")
+			}
+			tr.decide(TraceTextModel, txn.Category, categoryResult.Reason,
+				models.TraceCandidate{Value: prediction.Category, Score: prediction.Probability, Source: model.Version})
+		}
+	}
+
+	// Step 6.8: LLM answers, cached by narration fingerprint (llmcategory.Categorizer.Resolve asks for unresolved ones)
+	var llmAnswer *models.LLMCategory
+	categorizer := llmcategory.Active()
+	if categorizer != nil && categorizer.Unresolved(txn.Category, categoryResult.Confidence, txn.Method, categoryResult.MatchedKeywords) {
+		if entry, ok := categorizer.Cached(txn.Narration); ok {
+			llmAnswer = &models.LLMCategory{Provider: entry.Provider, Category: entry.Category, AnsweredAt: entry.CreatedAt}
+			if entry.Category != "Other" && !(isCreditTxn && expenseCats[entry.Category]) {
+				llmAnswer.Applied = true
+				txn.Category = entry.Category
+				categoryResult.Category = entry.Category
+				categoryResult.Confidence = llmcategory.Confidence
+				categoryResult.Reason = fmt.Sprintf("LLM (%s): %s", entry.Provider, entry.Category)
+				categoryResult.MatchedKeywords = append(categoryResult.MatchedKeywords, "LLM")
+			}
+			tr.decide(TraceLLM, txn.Category, categoryResult.Reason,
+				models.TraceCandidate{Value: entry.Category, Score: llmcategory.Confidence, Source: entry.Provider})
+		}
+	}
 
 	// Step 7: Build classification metadata (for explainability)
 	// Detect amount pattern (secondary signal)
@@ -561,7 +748,7 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 	// Set gateway and channel (separate concepts)
 	categoryResult.Gateway = gateway
 	categoryResult.Channel = txn.Method
-	categoryResult.RuleVersion = utils.RuleVersion
+	categoryResult.RuleVersion = utils.RuleVersion()
 
 	// If no reason set, generate one
 	if categoryResult.Reason == "" {
@@ -576,7 +763,11 @@ func ClassifyTransaction(txn models.ClassifiedTransaction, customerName string)
 		Channel:         categoryResult.Channel,
 		RuleVersion:     categoryResult.RuleVersion,
 		Reason:          categoryResult.Reason,
+		Model:           modelPrediction,
+		LLM:             llmAnswer,
+		MerchantMatch:   merchantMatch,
 	}
+	tr.finish(&txn, amount)
 
 	return txn
 }
@@ -632,13 +823,31 @@ func generateReason(category string, keywords []string, gateway string, channel
 
 // ClassifyTransactions classifies a list of transactions
 // customerName is optional - if provided, used for self-transfer detection
-func ClassifyTransactions(transactions []models.ClassifiedTransaction, customerName string) []models.ClassifiedTransaction {
+// userOverrides is optional (nil applies none)
+func ClassifyTransactions(transactions []models.ClassifiedTransaction, customerName string, userOverrides *overrides.Set) []models.ClassifiedTransaction {
+	return classifyTransactions(transactions, customerName, userOverrides, false)
+}
+
+// classifyTransactions is ClassifyTransactions, with a decision trace on every transaction when trace is set
+func classifyTransactions(transactions []models.ClassifiedTransaction, customerName string, userOverrides *overrides.Set, trace bool) []models.ClassifiedTransaction {
 	classified := make([]models.ClassifiedTransaction, len(transactions))
 
-	// First pass: classify all transactions
-	for i, txn := range transactions {
-		classified[i] = ClassifyTransaction(txn, customerName)
-	}
+	// Person vs merchant UPI counterparties are refined with how often their VPAs and names
+	// recur across the statement, before any transaction is categorized
+	stats := newVPAStats(transactions)
+
+	// First pass: classify all transactions (independent of each other, so spread across the CPUs)
+	parallelFor(len(transactions), func(i int) {
+		var tr *tracer
+		if trace {
+			tr = &tracer{}
+		}
+		classified[i] = classifyTransaction(transactions[i], customerName, userOverrides, stats, tr)
+	})
+
+	// Cluster beneficiaries, VPAs and accounts into counterparty entities before recurring
+	// detection, which groups transfers by them
+	analytics.ResolveCounterparties(classified)
 
 	// Second pass: detect recurring payments using comprehensive detection
 	// PERFORMANCE FIX: Detect all recurring payments ONCE, then build lookup map
@@ -654,15 +863,65 @@ func ClassifyTransactions(transactions []models.ClassifiedTransaction, customerN
 	}
 
 	// Third pass: match each transaction to recurring payments using lookup map
-	for i := range classified {
+	parallelFor(len(classified), func(i int) {
 		recurringMetadata := analytics.MatchTransactionToRecurring(classified[i], detector, recurringMap)
 		classified[i].IsRecurring = recurringMetadata.IsRecurring
 		classified[i].RecurringMetadata = recurringMetadata
-	}
+	})
 
 	return classified
 }
 
+// newVPAStats records the UPI counterparties of every transaction
+func newVPAStats(transactions []models.ClassifiedTransaction) *utils.VPAStats {
+	upis := make([]*models.UPIDetails, len(transactions))
+	parallelFor(len(transactions), func(i int) {
+		txn := transactions[i]
+		if upi, ok := utils.ParseUPI(txn.Narration, txn.DepositAmt > 0 && txn.WithdrawalAmt == 0); ok {
+			upis[i] = &upi
+		}
+	})
+	stats := utils.NewVPAStats()
+	for _, upi := range upis {
+		if upi != nil {
+			stats.Add(*upi)
+		}
+	}
+	return stats
+}
+
+// parallelFor calls fn for every index below n on a pool of one worker per CPU
+// Each index is handled exactly once, so fn may write to its own slot of a slice
+func parallelFor(n int, fn func(i int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	indexes := make(chan int, workers)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
 // ConvertFromTxtTransaction converts from extracted statement transaction to classified transaction
 func ConvertFromTxtTransaction(date, narration, chequeRefNo, valueDate string, withdrawalAmt, depositAmt, closingBalance float64) models.ClassifiedTransaction {
 	return models.ClassifiedTransaction{
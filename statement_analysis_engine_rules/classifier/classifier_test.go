@@ -0,0 +1,224 @@
+package classifier
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/merchantkb"
+	"classify/statement_analysis_engine_rules/models"
+)
+
+// benchmarkNarrations covers the rails and merchant shapes of a typical HDFC statement
+var benchmarkNarrations = []string{
+	"UPI-SHYAM MEDICOS-PAYTMQR6AQSV7@PTYS-YESB0PTMUPI-%012d-UPI",
+	"UPI-ANKIT DAIRY AND SWEE-VYAPAR.170819826526@HDFCBANK-HDFC0MERUPI-%012d-UPI",
+	"UPI-SWIGGY-SWIGGY.STORES@ICICI-ICIC0DC0099-%012d-PAYMENT FROM PHONE",
+	"UPI-ZOMATO LIMITED-ZOMATO-ORDER@PTYBL-YESB0PTMUPI-%012d-ZOMATO ORDER",
+	"UPI-RAHUL VERMA-RAHULV@OKAXIS-UTIB0000123-%012d-SENT USING PAYTM",
+	"POS 416021XXXXXX1234 CAFE COFFEE DAY %d",
+	"NEFT CR-YESB0000001-ZERODHA BROKING LIMITED NSE CLIENT-%d",
+	"IMPS-%012d-RAHUL VERMA-HDFC-XXXXXXXX2950-IMPSTXN",
+	"ACH D- TP ACH MAXLIFEINSURA-%d",
+	"EMI 4452581 CHQ S44525810472 %d",
+	"50400334918713- RD INSTALLMENT-APR 2025 %d",
+	"P:K16675 ACME TECHNOLOGIES SALARY FOR APR 2025 %d",
+	"UPI-INDIAN OIL PETROL PUMP-IOCL.%d@SBI-SBIN0000001-PETROL",
+	"UPI-AIRTEL PAYMENTS-AIRTELPREPAID@AIRTEL-AIRP0000001-%012d-RECHARGE",
+	"UPI-AMAZON PAY-AMAZON@APL-UTIB0000100-%012d-ORDER",
+	"EAW-416021XXXXXX1234-S1ANMU12-PUNE-%d",
+	"UPI-AUTOPE PAYMENT SOLUT-PINELABS.10109729@HDFCBANK-HDFC0MERUPI-%012d-PAYMENT FOR 555415",
+	"INTEREST PAID TILL 31-MAR-2025 %d",
+	"BILLDESK ELECTRICITY MSEDCL %d",
+	"UPI-MAHA GANESH TRADERS-PAYTMQR641Q4E@PTYS-YESB0PTMUPI-%012d-UPI",
+}
+
+// benchmarkStatement returns n transactions cycling through benchmarkNarrations
+func benchmarkStatement(n int) []models.ClassifiedTransaction {
+	transactions := make([]models.ClassifiedTransaction, n)
+	for i := range transactions {
+		narration := fmt.Sprintf(benchmarkNarrations[i%len(benchmarkNarrations)], 100000000000+i)
+		date := fmt.Sprintf("%02d/%02d/25", i%28+1, i/28%12+1)
+		withdrawal, deposit := float64(100+i%5000), 0.0
+		if i%7 == 0 {
+			withdrawal, deposit = 0, float64(1000+i%50000)
+		}
+		transactions[i] = ConvertFromTxtTransaction(date, narration, fmt.Sprintf("%016d", i), date, withdrawal, deposit, 100000)
+	}
+	return transactions
+}
+
+func TestClassifyTransactionsMatchesSequential(t *testing.T) {
+	transactions := benchmarkStatement(2000)
+	got := ClassifyTransactions(transactions, "RAHUL VERMA", nil)
+	stats := newVPAStats(transactions)
+	for i, txn := range transactions {
+		expected := classifyTransaction(txn, "RAHUL VERMA", nil, stats, nil)
+		if got[i].Method != expected.Method || got[i].Category != expected.Category || got[i].Merchant != expected.Merchant ||
+			!reflect.DeepEqual(got[i].ClassificationMetadata, expected.ClassificationMetadata) {
+			t.Fatalf("transaction %d (%s): expected %s/%s/%s, got %s/%s/%s", i, txn.Narration,
+				expected.Method, expected.Category, expected.Merchant, got[i].Method, got[i].Category, got[i].Merchant)
+		}
+	}
+}
+
+func TestRefinedUPIKindDecidesP2P(t *testing.T) {
+	// One VPA paid under three names is an aggregator's, not a person's
+	var transactions []models.ClassifiedTransaction
+	for i, name := range []string{"RAVI KUMAR", "ANIL DESAI", "SUNIL JOSHI"} {
+		narration := fmt.Sprintf("UPI-%s-Q7781234@YBL-YESB0000001-%012d-UPI", name, 100000000000+i)
+		transactions = append(transactions, ConvertFromTxtTransaction("01/04/25", narration, "", "01/04/25", 6000, 0, 100000))
+	}
+
+	single := ClassifyTransaction(transactions[0], "", nil)
+	if !hasKeyword(single, "P2P") {
+		t.Errorf("expected P2P for a lone personal VPA, got %v", single.ClassificationMetadata.MatchedKeywords)
+	}
+	for _, txn := range ClassifyTransactions(transactions, "", nil) {
+		if txn.UPI == nil || txn.UPI.Kind != "Aggregator" {
+			t.Fatalf("expected an Aggregator VPA, got %+v", txn.UPI)
+		}
+		if hasKeyword(txn, "P2P") {
+			t.Errorf("expected no P2P for %s, got %v", txn.Narration, txn.ClassificationMetadata.MatchedKeywords)
+		}
+	}
+}
+
+func hasKeyword(txn models.ClassifiedTransaction, keyword string) bool {
+	for _, k := range txn.ClassificationMetadata.MatchedKeywords {
+		if k == keyword {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTraceTransaction(t *testing.T) {
+	// A credit from Amazon: the known merchant says Shopping, the credit override turns it into a refund
+	txn := ConvertFromTxtTransaction("01/04/25", "UPI-AMAZON PAY-AMAZON@APL-UTIB0000100-123456789012-ORDER", "", "01/04/25", 0, 500, 1000)
+	traced := TraceTransaction(txn, "RAHUL VERMA", nil)
+	trace := traced.ClassificationMetadata.Trace
+	if trace == nil {
+		t.Fatal("expected a trace")
+	}
+	if trace.Category != "Refund" || trace.Layer != TraceCreditOverride {
+		t.Errorf("expected Refund from %s, got %s from %s", TraceCreditOverride, trace.Category, trace.Layer)
+	}
+
+	steps := make(map[string]models.TraceStep)
+	var layers []string
+	for _, step := range trace.Steps {
+		steps[step.Layer] = step
+		layers = append(layers, step.Layer)
+	}
+	expected := []string{TraceNormalization, TraceMethod, TraceGateway, TraceMerchant, TraceKnownMerchant,
+		TraceIntentKeywords, TraceAmountPattern, TraceCategoryRules, TraceCategory, TraceCreditOverride}
+	if !reflect.DeepEqual(layers, expected) {
+		t.Errorf("expected layers %v, got %v", expected, layers)
+	}
+	if known := steps[TraceKnownMerchant]; known.Output != "Shopping" || len(known.Candidates) != 1 || known.Candidates[0].Source != "Amazon: AMAZON" {
+		t.Errorf("unexpected known merchant step %+v", known)
+	}
+	if category := steps[TraceCategory]; category.Output != "Shopping" || category.Candidates[0].Source != "known_merchant" {
+		t.Errorf("unexpected category step %+v", category)
+	}
+	if override := steps[TraceCreditOverride]; override.Overrode != "Shopping" || override.Output != "Refund" || override.Reason != traced.ClassificationMetadata.Reason {
+		t.Errorf("unexpected credit override step %+v", override)
+	}
+
+	// Tracing only adds the trace
+	untraced := ClassifyTransaction(txn, "RAHUL VERMA", nil)
+	if untraced.ClassificationMetadata.Trace != nil {
+		t.Errorf("expected no trace without asking for one")
+	}
+	traced.ClassificationMetadata.Trace = nil
+	if !reflect.DeepEqual(traced, untraced) {
+		t.Errorf("expected %+v, got %+v", untraced, traced)
+	}
+}
+
+func TestTraceTransactions(t *testing.T) {
+	transactions := benchmarkStatement(len(benchmarkNarrations) * 2)
+	traced := TraceTransactions(transactions, "RAHUL VERMA", nil)
+	for i, txn := range ClassifyTransactions(transactions, "RAHUL VERMA", nil) {
+		trace := traced[i].ClassificationMetadata.Trace
+		if trace == nil || trace.Category != txn.Category || traced[i].Category != txn.Category {
+			t.Fatalf("transaction %d (%s): expected a trace ending in %s, got %+v", i, txn.Narration, txn.Category, trace)
+		}
+	}
+}
+
+func TestMerchantKnowledgeBase(t *testing.T) {
+	t.Cleanup(func() { merchantkb.Activate(nil) })
+	kb, err := merchantkb.Parse([]byte(`{"version": "kb-test", "merchants": [
+		{"name": "Acme Widgets", "brand": "Acme", "category": "Shopping", "vpas": ["ACMEWIDGETS@YBL"]},
+		{"name": "Acme Kitchen Supplies", "brand": "Acme", "category": "Shopping", "aliases": ["ACME KITCHEN SUPPLIES"]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	merchantkb.Activate(kb)
+
+	tests := []struct {
+		narration string
+		merchant  string
+		method    string
+		category  string
+	}{
+		{"UPI-AW ONLINE-ACMEWIDGETS@YBL-YESB0YBLUPI-123456789012-UPI", "Acme Widgets", merchantkb.MatchVPA, "Shopping"},
+		// A fuzzy match is a suggestion: its category fills in Other, but the merchant keeps its extracted name
+		{"UPI-ACME KITCHEN SUPPLIE-ACMEKS@YBL-YESB0YBLUPI-123456789012-UPI", "ACME KITCHEN SUPPLIE", merchantkb.MatchFuzzy, "Shopping"},
+		{"UPI-SWIGGY-SWIGGY@ICICI-ICIC0DC0099-123456789012-UPI", "Swiggy", "", "Food_Delivery"},
+	}
+	for _, tt := range tests {
+		txn := ClassifyTransaction(ConvertFromTxtTransaction("01/04/25", tt.narration, "", "01/04/25", 250, 0, 1000), "", nil)
+		match := txn.ClassificationMetadata.MerchantMatch
+		method := ""
+		if match != nil {
+			method = match.Method
+			if match.Version != "kb-test" || match.Brand != "Acme" {
+				t.Errorf("%s: unexpected match %+v", tt.narration, match)
+			}
+		}
+		if txn.Merchant != tt.merchant || method != tt.method || txn.Category != tt.category {
+			t.Errorf("%s: expected %s by %q as %s, got %s by %q as %s", tt.narration, tt.merchant, tt.method, tt.category, txn.Merchant, method, txn.Category)
+		}
+	}
+}
+
+func BenchmarkClassifyTransactions100k(b *testing.B) {
+	transactions := benchmarkStatement(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ClassifyTransactions(transactions, "RAHUL VERMA", nil)
+	}
+	b.ReportMetric(float64(len(transactions)*b.N)/b.Elapsed().Seconds(), "txns/s")
+}
+
+// BenchmarkClassifyTransactionsDistinctPayees covers counterparty resolution, which compares payee names
+func BenchmarkClassifyTransactionsDistinctPayees(b *testing.B) {
+	firstNames := []string{"RAHUL", "PRIYA", "AMIT", "SNEHA", "VIKRAM", "ANITA", "SURESH", "KAVYA", "ROHIT", "NEHA"}
+	transactions := make([]models.ClassifiedTransaction, 8000)
+	for i := range transactions {
+		// Distinct surnames from the digits of i spelled as letters
+		surname := []byte(fmt.Sprintf("%05d", i))
+		for j := range surname {
+			surname[j] += 'K' - '0'
+		}
+		narration := fmt.Sprintf("UPI-%s %s-PAYEE%d@OKAXIS-UTIB0000123-%012d-UPI", firstNames[i%len(firstNames)], surname, i, 100000000000+i)
+		date := fmt.Sprintf("%02d/%02d/25", i%28+1, i/28%12+1)
+		transactions[i] = ConvertFromTxtTransaction(date, narration, fmt.Sprintf("%016d", i), date, float64(100+i%5000), 0, 100000)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ClassifyTransactions(transactions, "RAHUL VERMA", nil)
+	}
+	b.ReportMetric(float64(len(transactions)*b.N)/b.Elapsed().Seconds(), "txns/s")
+}
+
+func BenchmarkClassifyTransaction(b *testing.B) {
+	transactions := benchmarkStatement(len(benchmarkNarrations))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ClassifyTransaction(transactions[i%len(transactions)], "RAHUL VERMA", nil)
+	}
+}
@@ -0,0 +1,152 @@
+package classifier
+
+import (
+	"strconv"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/statement_analysis_engine_rules/rules"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Layers recorded in models.TraceStep.Layer, in the order ClassifyTransaction runs them
+const (
+	TraceNormalization   = "normalization"
+	TraceMethod          = "method"
+	TraceGateway         = "gateway"
+	TraceMerchant        = "merchant"
+	TraceKnownMerchant   = "known_merchant"
+	TraceIntentKeywords  = "intent_keywords"
+	TraceAmountPattern   = "amount_pattern"
+	TraceCategoryRules   = "category_rules"
+	TraceCategory        = "category" // ClassifyCategoryWithMetadata; the candidate source names the rules layer that decided
+	TraceMerchantKB      = "merchant_kb"
+	TraceCreditOverride  = "credit_override"
+	TraceReversal        = "reversal"
+	TraceReimbursement   = "reimbursement"
+	TraceFixedDeposit    = "fixed_deposit"
+	TraceIncomeMethod    = "income_method"
+	TraceSelfTransfer    = "self_transfer"
+	TraceMethodOverride  = "method_override"
+	TraceBillPayment     = "bill_payment"
+	TraceACHDebit        = "ach_debit"
+	TraceCreditSafeguard = "credit_safeguard"
+	TraceUserOverride    = "user_override"
+	TraceTextModel       = "text_model"
+	TraceLLM             = "llm"
+)
+
+// TraceTransaction classifies a transaction like ClassifyTransaction and records
+// the decision of every layer in ClassificationMetadata.Trace
+func TraceTransaction(txn models.ClassifiedTransaction, customerName string, userOverrides *overrides.Set) models.ClassifiedTransaction {
+	return classifyTransaction(txn, customerName, userOverrides, nil, &tracer{})
+}
+
+// TraceTransactions classifies a list of transactions like ClassifyTransactions, with a trace on each
+func TraceTransactions(transactions []models.ClassifiedTransaction, customerName string, userOverrides *overrides.Set) []models.ClassifiedTransaction {
+	return classifyTransactions(transactions, customerName, userOverrides, true)
+}
+
+// tracer collects the steps of one classification
+// A nil tracer records nothing, so untraced classification only pays for the nil checks
+type tracer struct {
+	steps    []models.TraceStep
+	category string // Category after the last deciding step
+	reason   string
+	layer    string // Layer of the last deciding step
+}
+
+// step records a layer that does not decide the category
+func (t *tracer) step(layer, input, output string, candidates ...models.TraceCandidate) {
+	if t == nil {
+		return
+	}
+	t.steps = append(t.steps, models.TraceStep{Layer: layer, Input: input, Output: output, Candidates: candidates})
+}
+
+// decide records a layer that may have set the category
+// Nothing is recorded when the layer left category and reason alone and proposed no candidates
+func (t *tracer) decide(layer, category, reason string, candidates ...models.TraceCandidate) {
+	if t == nil {
+		return
+	}
+	changed := category != t.category || reason != t.reason
+	if !changed && len(candidates) == 0 {
+		return
+	}
+	step := models.TraceStep{Layer: layer, Output: category, Reason: reason, Candidates: candidates}
+	if t.category != "" && t.category != category {
+		step.Overrode = t.category
+	}
+	t.steps = append(t.steps, step)
+	if changed {
+		t.category, t.reason, t.layer = category, reason, layer
+	}
+}
+
+// candidates records the known merchants, intent keywords, amount pattern and pack rules
+// that ClassifyCategoryWithMetadata weighs without reporting
+func (t *tracer) candidates(narration, merchant string, amount float64) {
+	if t == nil {
+		return
+	}
+	active := utils.ActiveRules()
+
+	var known []models.TraceCandidate
+	for _, hit := range utils.KnownMerchantHits(narration, merchant) {
+		m := active.KnownMerchants[hit.Index]
+		known = append(known, models.TraceCandidate{Value: m.Category, Score: m.Confidence, Source: m.Name + ": " + strings.Join(hit.Matched, ", ")})
+	}
+	t.step(TraceKnownMerchant, narration, firstValue(known), known...)
+
+	var intents []models.TraceCandidate
+	for _, hit := range utils.IntentKeywordHits(narration) {
+		k := active.IntentKeywords[hit.Index]
+		intents = append(intents, models.TraceCandidate{Value: k.Category, Score: k.Confidence, Source: k.Keyword})
+	}
+	t.step(TraceIntentKeywords, narration, "", intents...)
+
+	pattern, _ := utils.DetectAmountPattern(amount)
+	t.step(TraceAmountPattern, formatAmount(amount), pattern)
+
+	combined := strings.ToUpper(narration) + " " + strings.ToUpper(merchant)
+	var packRules []models.TraceCandidate
+	for _, hit := range utils.CategoryRuleHits(combined) {
+		r := active.CategoryRules[hit.Index]
+		packRules = append(packRules, models.TraceCandidate{Value: r.Category, Score: r.Confidence, Source: r.ID + ": " + strings.Join(hit.Matched, ", ")})
+	}
+	t.step(TraceCategoryRules, combined, firstValue(packRules), packRules...)
+}
+
+// rulesResult records the result of ClassifyCategoryWithMetadata with the rules layer that decided it as source
+func (t *tracer) rulesResult(result rules.CategoryResult) {
+	t.decide(TraceCategory, result.Category, result.Reason, models.TraceCandidate{Value: result.Category, Score: result.Confidence, Source: result.Layer})
+}
+
+// finish stores the trace on the classified transaction
+func (t *tracer) finish(txn *models.ClassifiedTransaction, amount float64) {
+	if t == nil {
+		return
+	}
+	txn.ClassificationMetadata.Trace = &models.DecisionTrace{
+		Narration: txn.Narration,
+		Amount:    amount,
+		Steps:     t.steps,
+		Category:  txn.Category,
+		Layer:     t.layer,
+	}
+}
+
+// firstValue is the value of the first candidate, the one its layer picks
+func firstValue(candidates []models.TraceCandidate) string {
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[0].Value
+}
+
+// formatAmount renders an amount as a trace step input
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,392 @@
+// Package coverage measures how the active rule pack behaves over a corpus of narrations
+//
+// A Collector classifies every transaction it is given and records, for each
+// category rule, known merchant, intent keyword and keyword group of the pack,
+// how often it matched, how often it was the one its layer picked and how often
+// it decided the final category. The Report lists the rules and keywords that never fire,
+// the rules always shadowed by a higher-priority one, the narrations on which
+// the layers propose different categories and which layer decided each
+// transaction. It is meant to be reviewed (and diffed) before a rule release.
+package coverage
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/rules"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Rule kinds
+const (
+	KindCategoryRule  = "category_rule"
+	KindMerchant      = "merchant"
+	KindIntentKeyword = "intent_keyword"
+	KindKeywordGroup  = "keyword_group"
+)
+
+// Deciding layers besides the rules.Layer* ones of ClassifyCategoryWithMetadata
+const (
+	LayerTransaction = "transaction_rules" // Adjustments of ClassifyTransaction (credits, refunds, self-transfers, method rules)
+	LayerModel       = "model"             // Statistical fallback model
+	LayerLLM         = "llm"               // Cached LLM answer
+)
+
+// Proposal keys of a Conflict besides rules.LayerKnownMerchant and rules.LayerPackRule
+const (
+	ProposalIntent = "intent" // Category of the highest-scoring intent keyword
+	ProposalRules  = "rules"  // rules.ClassifyCategoryWithMetadata
+	ProposalFinal  = "final"  // classifier.ClassifyTransaction
+)
+
+// MaxExamples is the number of narrations kept per conflict
+const MaxExamples = 3
+
+// Report is the coverage of the active rule pack over a corpus
+type Report struct {
+	RuleVersion    string         `json:"ruleVersion"`
+	Transactions   int            `json:"transactions"`
+	CategoryRules  []RuleCoverage `json:"categoryRules"`
+	Merchants      []RuleCoverage `json:"merchants"`
+	IntentKeywords []RuleCoverage `json:"intentKeywords"`
+	KeywordGroups  []RuleCoverage `json:"keywordGroups"` // Keyword groups of the built-in patterns, by ID
+	DeadRules      []string       `json:"deadRules"`     // "kind:id" of rules that never matched
+	DeadKeywords   []string       `json:"deadKeywords"`  // "kind:id:keyword" of keywords that never matched, in rules that did
+	ShadowedRules  []string       `json:"shadowedRules"` // "kind:id" of rules that matched but were never picked
+	Winners        []LayerCount   `json:"winners"`       // Deciding layer of the final category, most frequent first
+	Conflicts      []Conflict     `json:"conflicts"`     // Narrations on which the layers disagree, most frequent first
+}
+
+// RuleCoverage counts the narrations a rule matched
+type RuleCoverage struct {
+	Kind       string         `json:"kind"`
+	ID         string         `json:"id"` // Rule ID, merchant name or intent keyword
+	Category   string         `json:"category"`
+	Matched    int            `json:"matched"`              // Narrations where any keyword or pattern matched
+	Picked     int            `json:"picked"`               // Narrations where it was the first match of its layer (every match for intent keywords, the deciding keyword for keyword groups)
+	Decided    int            `json:"decided"`              // Narrations whose final category it decided
+	ShadowedBy map[string]int `json:"shadowedBy,omitempty"` // Rule picked instead (a layer for keyword groups overruled by another layer) -> narrations
+	Keywords   []KeywordCount `json:"keywords"`
+}
+
+// KeywordCount counts the narrations a keyword, alias or pattern matched
+type KeywordCount struct {
+	Keyword string `json:"keyword"`
+	Hits    int    `json:"hits"`
+}
+
+// LayerCount counts the transactions a layer decided
+type LayerCount struct {
+	Layer        string  `json:"layer"`
+	Transactions int     `json:"transactions"`
+	Percent      float64 `json:"percent"`
+}
+
+// Conflict is a combination of layer proposals seen on one or more narrations
+type Conflict struct {
+	Proposals map[string]string `json:"proposals"` // Layer -> proposed category
+	Final     string            `json:"final"`
+	Winner    string            `json:"winner"`
+	Count     int               `json:"count"`
+	Examples  []string          `json:"examples"`
+}
+
+// Collector accumulates coverage one transaction at a time; it is not safe for concurrent use
+type Collector struct {
+	ruleSet        *utils.RuleSet
+	transactions   int
+	categoryRules  []*RuleCoverage
+	merchants      []*RuleCoverage
+	intentKeywords []*RuleCoverage
+	keywordGroups  []*RuleCoverage
+	winners        map[string]int
+	conflicts      map[string]*Conflict
+}
+
+// NewCollector starts collecting coverage for the active rule pack
+func NewCollector() *Collector {
+	ruleSet := utils.ActiveRules()
+	c := &Collector{
+		ruleSet:   ruleSet,
+		winners:   make(map[string]int),
+		conflicts: make(map[string]*Conflict),
+	}
+	for _, rule := range ruleSet.CategoryRules {
+		keywords := append([]string(nil), rule.Keywords...)
+		for _, pattern := range rule.Patterns {
+			keywords = append(keywords, pattern.String())
+		}
+		c.categoryRules = append(c.categoryRules, newRuleCoverage(KindCategoryRule, rule.ID, rule.Category, keywords))
+	}
+	for _, merchant := range ruleSet.KnownMerchants {
+		aliases := make([]string, len(merchant.Patterns))
+		for i, alias := range merchant.Patterns {
+			aliases[i] = strings.ToUpper(alias)
+		}
+		c.merchants = append(c.merchants, newRuleCoverage(KindMerchant, merchant.Name, merchant.Category, aliases))
+	}
+	for _, intent := range ruleSet.IntentKeywords {
+		c.intentKeywords = append(c.intentKeywords, newRuleCoverage(KindIntentKeyword, intent.Keyword, intent.Category, []string{intent.Keyword}))
+	}
+	for _, group := range rulepack.Default().KeywordGroups {
+		if keywords, ok := ruleSet.KeywordGroups[group.ID]; ok {
+			c.keywordGroups = append(c.keywordGroups, newRuleCoverage(KindKeywordGroup, group.ID, group.Category, keywords))
+		}
+	}
+	return c
+}
+
+func newRuleCoverage(kind, id, category string, keywords []string) *RuleCoverage {
+	r := &RuleCoverage{Kind: kind, ID: id, Category: category, ShadowedBy: make(map[string]int)}
+	seen := make(map[string]bool)
+	for _, keyword := range keywords {
+		if !seen[keyword] {
+			seen[keyword] = true
+			r.Keywords = append(r.Keywords, KeywordCount{Keyword: keyword})
+		}
+	}
+	return r
+}
+
+// Add classifies the transactions (unclassified, as from classifier.ConvertFromTxtTransaction) and records their coverage
+func (c *Collector) Add(transactions []models.ClassifiedTransaction, customerName string) {
+	for _, txn := range transactions {
+		c.add(txn, customerName)
+	}
+}
+
+// AddNarrations records the coverage of bare narrations, classified as debits of amount 0
+func (c *Collector) AddNarrations(narrations []string) {
+	for _, narration := range narrations {
+		c.add(classifier.ConvertFromTxtTransaction("", narration, "", "", 0, 0, 0), "")
+	}
+}
+
+func (c *Collector) add(txn models.ClassifiedTransaction, customerName string) {
+	c.transactions++
+	classified := classifier.ClassifyTransaction(txn, customerName, nil)
+
+	// Re-run the category layers on the classifier's inputs to learn which one decided
+	normalized := utils.NormalizeNarration(txn.Narration)
+	amount := txn.WithdrawalAmt
+	if txn.DepositAmt > amount {
+		amount = txn.DepositAmt
+	}
+	upiKind := ""
+	if classified.UPI != nil {
+		upiKind = classified.UPI.Kind
+	}
+	categoryResult := rules.ClassifyCategoryWithUPIKind(normalized, classified.Merchant, amount, upiKind)
+	winner := decidingLayer(classified, categoryResult)
+	c.winners[winner]++
+
+	proposals := make(map[string]string)
+	combined := strings.ToUpper(normalized + " " + classified.Merchant)
+	if hits := utils.CategoryRuleHits(combined); len(hits) > 0 {
+		c.record(c.categoryRules, hits, winner == rules.LayerPackRule)
+		proposals[rules.LayerPackRule] = c.categoryRules[hits[0].Index].Category
+	}
+	if hits := utils.KnownMerchantHits(normalized, strings.ToUpper(classified.Merchant)); len(hits) > 0 {
+		c.record(c.merchants, hits, winner == rules.LayerKnownMerchant)
+		proposals[rules.LayerKnownMerchant] = c.merchants[hits[0].Index].Category
+	}
+	if hits := utils.IntentKeywordHits(normalized); len(hits) > 0 {
+		for _, hit := range hits {
+			r := c.intentKeywords[hit.Index]
+			r.Matched++
+			r.Picked++
+			r.Keywords[0].Hits++
+		}
+		proposals[ProposalIntent] = topIntent(utils.DetectIntentKeywords(normalized))
+	}
+	c.recordGroups(combined, categoryResult, winner)
+	proposals[ProposalRules] = categoryResult.Category
+	proposals[ProposalFinal] = classified.Category
+
+	distinct := make(map[string]bool)
+	for _, category := range proposals {
+		distinct[category] = true
+	}
+	if len(distinct) > 1 {
+		c.conflict(proposals, classified.Category, winner, txn.Narration)
+	}
+}
+
+// record counts the hits of one layer; the first hit is the rule the layer picked
+func (c *Collector) record(list []*RuleCoverage, hits []utils.RuleHit, decided bool) {
+	picked := list[hits[0].Index]
+	picked.Picked++
+	if decided {
+		picked.Decided++
+	}
+	for i, hit := range hits {
+		r := list[hit.Index]
+		r.Matched++
+		if i > 0 {
+			r.ShadowedBy[picked.ID]++
+		}
+		for _, keyword := range hit.Matched {
+			for k := range r.Keywords {
+				if r.Keywords[k].Keyword == keyword {
+					r.Keywords[k].Hits++
+				}
+			}
+		}
+	}
+}
+
+// recordGroups counts the keyword groups found in the narration and merchant
+// Only the group that decided the pattern layer is picked; groups without a category are signals and never are
+func (c *Collector) recordGroups(combined string, categoryResult rules.CategoryResult, winner string) {
+	found := c.ruleSet.Match(combined)
+	for _, r := range c.keywordGroups {
+		matched := found.In(c.ruleSet.Group(r.ID))
+		if len(matched) == 0 {
+			continue
+		}
+		r.Matched++
+		for _, keyword := range matched {
+			for k := range r.Keywords {
+				if r.Keywords[k].Keyword == keyword {
+					r.Keywords[k].Hits++
+				}
+			}
+		}
+		switch {
+		case r.ID == categoryResult.Group:
+			r.Picked++
+			if winner == rules.LayerPatterns {
+				r.Decided++
+			}
+		case r.Category == "":
+		case categoryResult.Group != "":
+			r.ShadowedBy[categoryResult.Group]++
+		case categoryResult.Layer != rules.LayerPatterns:
+			r.ShadowedBy[categoryResult.Layer]++
+		}
+	}
+}
+
+func (c *Collector) conflict(proposals map[string]string, final, winner, narration string) {
+	layers := make([]string, 0, len(proposals))
+	for layer := range proposals {
+		layers = append(layers, layer)
+	}
+	sort.Strings(layers)
+	parts := make([]string, 0, len(layers)+1)
+	for _, layer := range layers {
+		parts = append(parts, layer+"="+proposals[layer])
+	}
+	key := strings.Join(append(parts, "winner="+winner), "|")
+
+	conflict, ok := c.conflicts[key]
+	if !ok {
+		conflict = &Conflict{Proposals: proposals, Final: final, Winner: winner}
+		c.conflicts[key] = conflict
+	}
+	conflict.Count++
+	if len(conflict.Examples) < MaxExamples {
+		conflict.Examples = append(conflict.Examples, narration)
+	}
+}
+
+// decidingLayer names the layer the final category came from
+func decidingLayer(classified models.ClassifiedTransaction, categoryResult rules.CategoryResult) string {
+	meta := classified.ClassificationMetadata
+	switch {
+	case meta.LLM != nil && meta.LLM.Applied:
+		return LayerLLM
+	case meta.Model != nil && meta.Model.Applied:
+		return LayerModel
+	case classified.Category != categoryResult.Category:
+		return LayerTransaction
+	}
+	return categoryResult.Layer
+}
+
+// topIntent returns the category with the highest intent score (the first by name on a tie)
+func topIntent(scores map[string]float64) string {
+	top, best := "", 0.0
+	for category, score := range scores {
+		if score > best || (score == best && category < top) {
+			top, best = category, score
+		}
+	}
+	return top
+}
+
+// Report summarizes what has been collected so far
+func (c *Collector) Report() Report {
+	report := Report{
+		RuleVersion:    c.ruleSet.Version,
+		Transactions:   c.transactions,
+		CategoryRules:  values(c.categoryRules),
+		Merchants:      values(c.merchants),
+		IntentKeywords: values(c.intentKeywords),
+		KeywordGroups:  values(c.keywordGroups),
+		DeadRules:      make([]string, 0),
+		DeadKeywords:   make([]string, 0),
+		ShadowedRules:  make([]string, 0),
+		Winners:        make([]LayerCount, 0, len(c.winners)),
+		Conflicts:      make([]Conflict, 0, len(c.conflicts)),
+	}
+
+	for _, list := range [][]RuleCoverage{report.CategoryRules, report.Merchants, report.IntentKeywords, report.KeywordGroups} {
+		for _, r := range list {
+			id := r.Kind + ":" + r.ID
+			switch {
+			case r.Matched == 0:
+				report.DeadRules = append(report.DeadRules, id)
+				continue
+			case r.Picked == 0 && !(r.Kind == KindKeywordGroup && r.Category == ""):
+				report.ShadowedRules = append(report.ShadowedRules, id)
+			}
+			for _, keyword := range r.Keywords {
+				if keyword.Hits == 0 {
+					report.DeadKeywords = append(report.DeadKeywords, id+":"+keyword.Keyword)
+				}
+			}
+		}
+	}
+
+	for layer, count := range c.winners {
+		report.Winners = append(report.Winners, LayerCount{
+			Layer:        layer,
+			Transactions: count,
+			Percent:      math.Round(float64(count)*10000/float64(c.transactions)) / 100,
+		})
+	}
+	sort.Slice(report.Winners, func(i, j int) bool {
+		if report.Winners[i].Transactions != report.Winners[j].Transactions {
+			return report.Winners[i].Transactions > report.Winners[j].Transactions
+		}
+		return report.Winners[i].Layer < report.Winners[j].Layer
+	})
+
+	keys := make([]string, 0, len(c.conflicts))
+	for key := range c.conflicts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if c.conflicts[keys[i]].Count != c.conflicts[keys[j]].Count {
+			return c.conflicts[keys[i]].Count > c.conflicts[keys[j]].Count
+		}
+		return keys[i] < keys[j]
+	})
+	for _, key := range keys {
+		report.Conflicts = append(report.Conflicts, *c.conflicts[key])
+	}
+	return report
+}
+
+func values(list []*RuleCoverage) []RuleCoverage {
+	out := make([]RuleCoverage, len(list))
+	for i, r := range list {
+		out[i] = *r
+	}
+	return out
+}
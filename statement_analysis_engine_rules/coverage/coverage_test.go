@@ -0,0 +1,141 @@
+package coverage_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/coverage"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/rules"
+)
+
+const coveragePack = `{
+  "version": "v9.0.0-coverage",
+  "name": "coverage",
+  "merchants": [
+    {"name": "Acme Coffee", "category": "Dining", "aliases": ["ACMECOFFEE", "ACME COFFEE"], "confidence": 0.95},
+    {"name": "Never Seen", "category": "Shopping", "aliases": ["NEVERSEEN"], "confidence": 0.9}
+  ],
+  "intentKeywords": [
+    {"keyword": "COFFEE", "category": "Dining", "confidence": 0.5},
+    {"keyword": "PET", "category": "Shopping", "confidence": 0.4}
+  ],
+  "categoryRules": [
+    {"id": "pet-care", "category": "Pet_Care", "keywords": ["PETSHOP", "PETCLINIC"], "priority": 5, "confidence": 0.85},
+    {"id": "pet-shop", "category": "Shopping", "keywords": ["PETSHOP"], "priority": 1, "confidence": 0.8},
+    {"id": "unused", "category": "Education", "keywords": ["NOSUCHSCHOOL"], "regexes": ["SCHOOL\\s+\\d{9}"], "confidence": 0.8}
+  ]
+}`
+
+func TestCollectorReport(t *testing.T) {
+	t.Cleanup(func() {
+		if err := rulepack.Activate(rulepack.Default()); err != nil {
+			t.Fatal(err)
+		}
+	})
+	pack, err := rulepack.Parse([]byte(coveragePack), "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rulepack.Activate(pack); err != nil {
+		t.Fatal(err)
+	}
+
+	collector := coverage.NewCollector()
+	collector.AddNarrations([]string{
+		"UPI-ACME COFFEE-ACMECOFFEE@YBL-YESB0YBLUPI-123456789012-COFFEE",
+		"UPI-HAPPY PETSHOP-PETSHOP@OKSBI-SBIN0001234-123456789012-UPI",
+		"UPI-HAPPY PETSHOP-PETSHOP@OKSBI-SBIN0001234-223456789012-UPI",
+		"POS 4591XXXXXXXX1234 BLUE TOKAI CAFE",
+	})
+	report := collector.Report()
+
+	if report.RuleVersion != "v9.0.0-coverage" || report.Transactions != 4 {
+		t.Fatalf("expected 4 transactions of v9.0.0-coverage, got %d of %s", report.Transactions, report.RuleVersion)
+	}
+
+	petCare := report.CategoryRules[0]
+	if petCare.Matched != 2 || petCare.Picked != 2 || petCare.Decided != 2 {
+		t.Errorf("expected pet-care matched/picked/decided 2/2/2, got %d/%d/%d", petCare.Matched, petCare.Picked, petCare.Decided)
+	}
+	if expected := []coverage.KeywordCount{{Keyword: "PETSHOP", Hits: 2}, {Keyword: "PETCLINIC", Hits: 0}}; !reflect.DeepEqual(petCare.Keywords, expected) {
+		t.Errorf("expected %v, got %v", expected, petCare.Keywords)
+	}
+	petShop := report.CategoryRules[1]
+	if expected := map[string]int{"pet-care": 2}; petShop.Matched != 2 || petShop.Picked != 0 || !reflect.DeepEqual(petShop.ShadowedBy, expected) {
+		t.Errorf("expected pet-shop shadowed twice by pet-care, got matched %d, picked %d, shadowed by %v", petShop.Matched, petShop.Picked, petShop.ShadowedBy)
+	}
+
+	tests := []struct {
+		name     string
+		got      []string
+		expected []string
+	}{
+		{"dead rules", withoutGroups(report.DeadRules), []string{"category_rule:unused", "merchant:Never Seen"}},
+		{"dead keywords", withoutGroups(report.DeadKeywords), []string{"category_rule:pet-care:PETCLINIC"}},
+		{"shadowed rules", withoutGroups(report.ShadowedRules), []string{"category_rule:pet-shop"}},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, tt.got)
+		}
+	}
+
+	winners := map[string]int{}
+	for _, w := range report.Winners {
+		winners[w.Layer] = w.Transactions
+	}
+	if expected := map[string]int{rules.LayerKnownMerchant: 1, rules.LayerPackRule: 2, rules.LayerPatterns: 1}; !reflect.DeepEqual(winners, expected) {
+		t.Errorf("expected winners %v, got %v", expected, winners)
+	}
+
+	// The PET intent keyword proposes Shopping on the pet shop narrations, which the pack rule decided as Pet_Care
+	if len(report.Conflicts) != 1 {
+		t.Fatalf("expected 1 conflict, got %+v", report.Conflicts)
+	}
+	conflict := report.Conflicts[0]
+	if conflict.Count != 2 || conflict.Proposals[coverage.ProposalIntent] != "Shopping" || conflict.Final != "Pet_Care" || conflict.Winner != rules.LayerPackRule {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+
+	// Keyword groups the pack leaves out keep the built-in keywords and are covered too
+	groups := map[string]coverage.RuleCoverage{}
+	for _, r := range report.KeywordGroups {
+		groups[r.ID] = r
+	}
+	dining := groups["dining"]
+	if dining.Kind != coverage.KindKeywordGroup || dining.Category != "Dining" {
+		t.Fatalf("expected the dining keyword group, got %+v", dining)
+	}
+	// COFFEE matched the Acme Coffee narration, which the known merchant decided; CAFE decided the POS narration
+	if dining.Matched != 2 || dining.Picked != 1 || dining.Decided != 1 {
+		t.Errorf("expected dining matched/picked/decided 2/1/1, got %d/%d/%d", dining.Matched, dining.Picked, dining.Decided)
+	}
+	if expected := map[string]int{rules.LayerKnownMerchant: 1}; !reflect.DeepEqual(dining.ShadowedBy, expected) {
+		t.Errorf("expected dining shadowed by %v, got %v", expected, dining.ShadowedBy)
+	}
+	if !contains(report.DeadRules, "keyword_group:fuel") || contains(report.DeadRules, "keyword_group:dining") {
+		t.Errorf("expected fuel and not dining among the dead rules, got %v", report.DeadRules)
+	}
+}
+
+// withoutGroups drops the keyword group entries of a report list
+func withoutGroups(list []string) []string {
+	out := make([]string, 0, len(list))
+	for _, id := range list {
+		if !strings.HasPrefix(id, coverage.KindKeywordGroup+":") {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "store.json")
+	for _, data := range []string{`{"v": 1}`, `{"v": 2}`} {
+		if err := WriteAtomic(path, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != data {
+			t.Errorf("expected %s, got %s", data, got)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected no temp files left, got %d entries", len(entries))
+	}
+
+	if err := WriteAtomic(filepath.Join(dir, "missing", "store.json"), []byte("{}")); err == nil {
+		t.Error("expected an error for a missing directory")
+	}
+}
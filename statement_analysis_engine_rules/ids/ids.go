@@ -0,0 +1,18 @@
+// Package ids generates the random IDs of stored records (overrides, review items, webhook subscriptions)
+package ids
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// New returns a random 128-bit ID in hex, prefixed with prefix (e.g. "ovr_")
+func New(prefix string) string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%s%x", prefix, time.Now().UnixNano())
+	}
+	return prefix + hex.EncodeToString(b)
+}
@@ -0,0 +1,105 @@
+package llmcategory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"classify/statement_analysis_engine_rules/fileutil"
+)
+
+// Entry is the LLM's answer for one narration fingerprint
+// "Other" is cached too, so a narration the LLM cannot place is not asked about again
+type Entry struct {
+	Fingerprint string    `json:"fingerprint"`
+	Category    string    `json:"category"`
+	Provider    string    `json:"provider"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// Cache holds LLM answers by narration fingerprint, optionally persisted to a JSON file
+// Narrations themselves are not stored
+type Cache struct {
+	mu      sync.RWMutex
+	path    string
+	entries map[string]Entry
+}
+
+// NewCache opens the cache; an empty path keeps it in memory and a missing file starts empty
+func NewCache(path string) (*Cache, error) {
+	c := &Cache{path: path, entries: make(map[string]Entry)}
+	if path == "" {
+		return c, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read LLM category cache: %w", err)
+	}
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM category cache: %w", err)
+	}
+	for _, entry := range entries {
+		// Entries from an older taxonomy are dropped and asked again
+		if category, ok := Valid(entry.Category); ok && entry.Fingerprint != "" {
+			entry.Category = category
+			c.entries[entry.Fingerprint] = entry
+		}
+	}
+	return c, nil
+}
+
+// Get returns the cached answer for a fingerprint
+func (c *Cache) Get(fingerprint string) (Entry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[fingerprint]
+	return entry, ok
+}
+
+// Len returns the number of cached answers
+func (c *Cache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.entries)
+}
+
+// Put stores answers and saves the cache
+func (c *Cache) Put(entries ...Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, entry := range entries {
+		c.entries[entry.Fingerprint] = entry
+	}
+	return c.save()
+}
+
+// save writes the cache file (temp file plus rename); callers hold mu
+func (c *Cache) save() error {
+	if c.path == "" {
+		return nil
+	}
+	entries := make([]Entry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Fingerprint < entries[j].Fingerprint })
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteAtomic(c.path, data); err != nil {
+		return fmt.Errorf("failed to save LLM category cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// Package llmcategory asks an LLM to categorize transactions the rules and the
+// statistical model leave unresolved
+//
+// Resolve batches the unresolved narrations that are not cached yet and asks the
+// LLM for one category each from the active taxonomy (Categories), constrained by
+// ResponseSchema. Answers are validated against the taxonomy and cached by
+// narration fingerprint, so each unique merchant costs one call. The classifier
+// applies cached answers through the active Categorizer; callers re-classify
+// after Resolve reports new answers.
+package llmcategory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"classify/metrics"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Defaults for NewCategorizer
+const (
+	// DefaultThreshold is the confidence below which a classification is unresolved
+	DefaultThreshold = 0.5
+	// DefaultBatchSize is the number of narrations per LLM call
+	DefaultBatchSize = 25
+	// Confidence is recorded for applied LLM answers
+	Confidence = 0.7
+)
+
+// systemPrompt instructs the LLM; the taxonomy is appended
+const systemPrompt = `You categorize Indian bank statement transactions.
+For every transaction, answer with exactly one category from this list: %s.
+Prefer the most specific category: a child such as Dining over its parent such as Food.
+Use the merchant or payee name, the payment method, the direction and the amount.
+Answer "Other" for transfers to people and whenever you are not sure. Do not invent categories.
+Respond only with JSON: {"results": [{"id": <id>, "category": "<category>"}]}.`
+
+// Completer sends a prompt to an LLM and returns its raw answer, constrained to schema (a JSON Schema object)
+type Completer interface {
+	Complete(ctx context.Context, system, prompt string, schema map[string]interface{}) (string, error)
+}
+
+// Categorizer resolves transactions with an LLM and caches its answers
+type Categorizer struct {
+	completer Completer
+	provider  string
+	cache     *Cache
+	threshold float64
+	batchSize int
+}
+
+// Stats reports what Resolve did
+type Stats struct {
+	Unresolved int `json:"unresolved"` // Unresolved transactions
+	Cached     int `json:"cached"`     // Distinct narrations already answered
+	Asked      int `json:"asked"`      // Distinct narrations sent to the LLM
+	Calls      int `json:"calls"`      // LLM calls made
+	Accepted   int `json:"accepted"`   // Answers from the taxonomy (including "Other")
+	Rejected   int `json:"rejected"`   // Missing answers and categories outside the taxonomy
+}
+
+// NewCategorizer creates a categorizer; provider names the LLM in the cache and metadata
+// threshold <= 0 uses DefaultThreshold and batchSize <= 0 uses DefaultBatchSize
+func NewCategorizer(completer Completer, provider string, cache *Cache, threshold float64, batchSize int) *Categorizer {
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	return &Categorizer{completer: completer, provider: provider, cache: cache, threshold: threshold, batchSize: batchSize}
+}
+
+// Unresolved reports whether a classification is left to the LLM: "Other" or below the
+// threshold, but not a user override, a deliberate P2P transfer or a cash withdrawal
+func (c *Categorizer) Unresolved(category string, confidence float64, method string, keywords []string) bool {
+	for _, keyword := range keywords {
+		if keyword == "USER_OVERRIDE" || keyword == "P2P" {
+			return false
+		}
+	}
+	if method == "ATMWithdrawal" {
+		return false
+	}
+	return category == "Other" || confidence < c.threshold
+}
+
+// Cached returns the cached answer for a narration
+func (c *Categorizer) Cached(narration string) (Entry, bool) {
+	fingerprint := utils.FingerprintNarration(narration)
+	if fingerprint == "" {
+		return Entry{}, false
+	}
+	return c.cache.Get(fingerprint)
+}
+
+// question is one narration in a batch
+type question struct {
+	ID          int     `json:"id"`
+	Narration   string  `json:"narration"`
+	Method      string  `json:"method,omitempty"`
+	Direction   string  `json:"direction"`
+	Amount      float64 `json:"amount"`
+	fingerprint string
+}
+
+// answer is the LLM's response for a batch
+type answer struct {
+	Results []struct {
+		ID       int    `json:"id"`
+		Category string `json:"category"`
+	} `json:"results"`
+}
+
+// Resolve asks the LLM about unresolved transactions whose narrations are not cached
+// Answers are cached as they arrive; the first failed call stops the run and is returned with the stats so far
+func (c *Categorizer) Resolve(ctx context.Context, transactions []models.ClassifiedTransaction) (stats Stats, err error) {
+	defer func() { observe(stats) }()
+	seen := make(map[string]bool)
+	var pending []question
+	for _, txn := range transactions {
+		metadata := txn.ClassificationMetadata
+		if !c.Unresolved(txn.Category, metadata.Confidence, txn.Method, metadata.MatchedKeywords) {
+			continue
+		}
+		stats.Unresolved++
+		fingerprint := utils.FingerprintNarration(txn.Narration)
+		if fingerprint == "" || seen[fingerprint] {
+			continue
+		}
+		seen[fingerprint] = true
+		if _, ok := c.cache.Get(fingerprint); ok {
+			stats.Cached++
+			continue
+		}
+		q := question{Narration: strings.Join(strings.Fields(txn.Narration), " "), Method: txn.Method, Direction: "debit", Amount: txn.WithdrawalAmt, fingerprint: fingerprint}
+		if txn.DepositAmt > txn.WithdrawalAmt {
+			q.Direction, q.Amount = "credit", txn.DepositAmt
+		}
+		pending = append(pending, q)
+	}
+
+	for start := 0; start < len(pending); start += c.batchSize {
+		end := start + c.batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		batch := pending[start:end]
+		for i := range batch {
+			batch[i].ID = i + 1
+		}
+		stats.Asked += len(batch)
+		stats.Calls++
+		var entries []Entry
+		entries, err = c.ask(ctx, batch)
+		stats.Accepted += len(entries)
+		stats.Rejected += len(batch) - len(entries)
+		if saveErr := c.cache.Put(entries...); saveErr != nil && err == nil {
+			err = saveErr
+		}
+		if err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+// observe records the outcome of one Resolve run
+func observe(stats Stats) {
+	metrics.LLMCategorizations.Add(float64(stats.Cached), "cached")
+	metrics.LLMCategorizations.Add(float64(stats.Accepted), "accepted")
+	metrics.LLMCategorizations.Add(float64(stats.Rejected), "rejected")
+}
+
+// ask sends one batch and returns the answers that name a taxonomy category
+func (c *Categorizer) ask(ctx context.Context, batch []question) ([]Entry, error) {
+	prompt, err := json.Marshal(map[string]interface{}{"transactions": batch})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.completer.Complete(ctx, fmt.Sprintf(systemPrompt, strings.Join(Categories(), ", ")), string(prompt), ResponseSchema())
+	if err != nil {
+		return nil, fmt.Errorf("LLM categorization failed: %w", err)
+	}
+	var parsed answer
+	if err := json.Unmarshal([]byte(stripFences(raw)), &parsed); err != nil {
+		return nil, fmt.Errorf("LLM categorization returned invalid JSON: %w", err)
+	}
+
+	now := time.Now().UTC()
+	entries := make([]Entry, 0, len(parsed.Results))
+	answered := make(map[int]bool, len(parsed.Results))
+	for _, result := range parsed.Results {
+		if result.ID < 1 || result.ID > len(batch) || answered[result.ID] {
+			continue
+		}
+		category, ok := Valid(result.Category)
+		if !ok {
+			continue
+		}
+		answered[result.ID] = true
+		entries = append(entries, Entry{Fingerprint: batch[result.ID-1].fingerprint, Category: category, Provider: c.provider, CreatedAt: now})
+	}
+	return entries, nil
+}
+
+// stripFences removes a ```json fence some models wrap around their answer despite the schema
+func stripFences(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if !strings.HasPrefix(raw, "```") {
+		return raw
+	}
+	raw = strings.TrimPrefix(raw, "```")
+	raw = strings.TrimPrefix(raw, "json")
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(raw), "```"))
+}
+
+var active atomic.Pointer[Categorizer]
+
+// Active returns the categorizer whose cached answers the classifier applies (nil when disabled)
+func Active() *Categorizer {
+	return active.Load()
+}
+
+// Activate makes the categorizer's cached answers apply to all subsequent classifications; nil disables them
+func Activate(c *Categorizer) {
+	active.Store(c)
+}
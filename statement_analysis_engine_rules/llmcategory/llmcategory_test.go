@@ -0,0 +1,236 @@
+package llmcategory_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/llmcategory"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/taxonomy"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// fakeCompleter answers each narration with the category of the first matching substring
+type fakeCompleter struct {
+	answers map[string]string
+	fence   bool
+	err     error
+	calls   int
+}
+
+func (f *fakeCompleter) Complete(ctx context.Context, system, prompt string, schema map[string]interface{}) (string, error) {
+	f.calls++
+	if f.err != nil {
+		return "", f.err
+	}
+	var request struct {
+		Transactions []struct {
+			ID        int    `json:"id"`
+			Narration string `json:"narration"`
+		} `json:"transactions"`
+	}
+	if err := json.Unmarshal([]byte(prompt), &request); err != nil {
+		return "", err
+	}
+	var results []string
+	for _, txn := range request.Transactions {
+		for substring, category := range f.answers {
+			if strings.Contains(txn.Narration, substring) {
+				results = append(results, fmt.Sprintf(`{"id": %d, "category": %q}`, txn.ID, category))
+			}
+		}
+	}
+	raw := `{"results": [` + strings.Join(results, ", ") + `]}`
+	if f.fence {
+		raw = "```json\n" + raw + "\n```"
+	}
+	return raw, nil
+}
+
+// unresolved returns transactions the rules leave as "Other"
+func unresolved(t *testing.T, narrations ...string) []models.ClassifiedTransaction {
+	t.Helper()
+	transactions := make([]models.ClassifiedTransaction, 0, len(narrations))
+	for i, narration := range narrations {
+		txn := classifier.ConvertFromTxtTransaction("01/12/25", narration, fmt.Sprintf("00001022222222%02d", i), "01/12/25", 260, 0, 9000)
+		txn = classifier.ClassifyTransaction(txn, "TEST USER", nil)
+		if txn.Category != "Other" {
+			t.Fatalf("expected the rules to leave %q as Other, got %s", narration, txn.Category)
+		}
+		transactions = append(transactions, txn)
+	}
+	return transactions
+}
+
+func TestResolve(t *testing.T) {
+	completer := &fakeCompleter{answers: map[string]string{"BHANDAR": "groceries", "GALAXY": "Crypto"}}
+	cache, err := llmcategory.NewCache("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	categorizer := llmcategory.NewCategorizer(completer, "fake:test", cache, 0, 1)
+	transactions := unresolved(t,
+		"UPI-VERMA BHANDAR-PAYTMQR8CD9EF@PTYS-YESB0PTMUPI-102222222221-UPI",
+		"UPI-VERMA BHANDAR-PAYTMQR8CD9EF@PTYS-YESB0PTMUPI-102222222299-UPI",
+		"UPI-GALAXY HUB-PAYTMQR1GH2IJ@PTYS-YESB0PTMUPI-102222222222-UPI",
+	)
+
+	stats, err := categorizer.Resolve(context.Background(), transactions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := llmcategory.Stats{Unresolved: 3, Asked: 2, Calls: 2, Accepted: 1, Rejected: 1}
+	if stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+	entry, ok := categorizer.Cached(transactions[1].Narration)
+	if !ok || entry.Category != "Groceries" || entry.Provider != "fake:test" {
+		t.Errorf("expected the taxonomy spelling cached for the narration, got %+v (%v)", entry, ok)
+	}
+	if _, ok := categorizer.Cached(transactions[2].Narration); ok {
+		t.Error("expected a category outside the taxonomy not to be cached")
+	}
+
+	// Cached narrations are not asked again; rejected ones are
+	stats, err = categorizer.Resolve(context.Background(), transactions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = llmcategory.Stats{Unresolved: 3, Cached: 1, Asked: 1, Calls: 1, Rejected: 1}
+	if stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+	if completer.calls != 3 {
+		t.Errorf("expected 3 LLM calls in total, got %d", completer.calls)
+	}
+}
+
+func TestResolveFencesAndErrors(t *testing.T) {
+	transactions := unresolved(t, "UPI-VERMA BHANDAR-PAYTMQR8CD9EF@PTYS-YESB0PTMUPI-102222222221-UPI")
+
+	cache, _ := llmcategory.NewCache("")
+	fenced := &fakeCompleter{answers: map[string]string{"BHANDAR": "Groceries"}, fence: true}
+	if stats, err := llmcategory.NewCategorizer(fenced, "fake", cache, 0, 0).Resolve(context.Background(), transactions); err != nil || stats.Accepted != 1 {
+		t.Errorf("expected a fenced answer to be accepted, got %+v (%v)", stats, err)
+	}
+
+	cache, _ = llmcategory.NewCache("")
+	failing := &fakeCompleter{err: errors.New("connection refused")}
+	stats, err := llmcategory.NewCategorizer(failing, "fake", cache, 0, 0).Resolve(context.Background(), transactions)
+	if err == nil || stats.Asked != 1 || stats.Rejected != 1 || cache.Len() != 0 {
+		t.Errorf("expected the failed call to be reported, got %+v (%v)", stats, err)
+	}
+}
+
+func TestCachePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "categories.json")
+	cache, err := llmcategory.NewCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC()
+	if err := cache.Put(
+		llmcategory.Entry{Fingerprint: "a", Category: "Dining", Provider: "fake", CreatedAt: now},
+		llmcategory.Entry{Fingerprint: "b", Category: "Retired_Category", Provider: "fake", CreatedAt: now},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := llmcategory.NewCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry, ok := reopened.Get("a"); !ok || entry.Category != "Dining" {
+		t.Errorf("expected the saved entry back, got %+v (%v)", entry, ok)
+	}
+	if reopened.Len() != 1 {
+		t.Errorf("expected entries outside the taxonomy to be dropped, got %d entries", reopened.Len())
+	}
+}
+
+func TestClassifierApplies(t *testing.T) {
+	narration := "UPI-VERMA BHANDAR-PAYTMQR8CD9EF@PTYS-YESB0PTMUPI-102222222221-UPI"
+	credit := "NEFT CR-XXXXXX2035-GALAXY HUB"
+	cache, _ := llmcategory.NewCache("")
+	if err := cache.Put(
+		llmcategory.Entry{Fingerprint: utils.FingerprintNarration(narration), Category: "Groceries", Provider: "fake:test"},
+		llmcategory.Entry{Fingerprint: utils.FingerprintNarration(credit), Category: "Shopping", Provider: "fake:test"},
+	); err != nil {
+		t.Fatal(err)
+	}
+	llmcategory.Activate(llmcategory.NewCategorizer(&fakeCompleter{}, "fake:test", cache, 0, 0))
+	defer llmcategory.Activate(nil)
+
+	txn := classifier.ConvertFromTxtTransaction("01/12/25", narration, "0000102222222221", "01/12/25", 260, 0, 9000)
+	txn = classifier.ClassifyTransaction(txn, "TEST USER", nil)
+	metadata := txn.ClassificationMetadata
+	if txn.Category != "Groceries" || metadata.LLM == nil || !metadata.LLM.Applied || metadata.Confidence != llmcategory.Confidence {
+		t.Fatalf("expected the cached answer to be applied, got %s (%+v)", txn.Category, metadata.LLM)
+	}
+	if !strings.HasPrefix(metadata.Reason, "LLM (fake:test)") {
+		t.Errorf("expected the provider in the reason, got %q", metadata.Reason)
+	}
+
+	// A credit never gets an expense category from the LLM
+	deposit := classifier.ConvertFromTxtTransaction("01/12/25", credit, "0000102222222222", "01/12/25", 0, 5000, 14000)
+	deposit = classifier.ClassifyTransaction(deposit, "TEST USER", nil)
+	if deposit.Category == "Shopping" || (deposit.ClassificationMetadata.LLM != nil && deposit.ClassificationMetadata.LLM.Applied) {
+		t.Errorf("expected the expense answer to be ignored for a credit, got %s (%+v)", deposit.Category, deposit.ClassificationMetadata.LLM)
+	}
+}
+
+func TestCategoriesFollowActiveTaxonomy(t *testing.T) {
+	if !contains(llmcategory.Categories(), "Self_Transfer") {
+		t.Errorf("expected the built-in taxonomy's Self_Transfer, got %v", llmcategory.Categories())
+	}
+
+	custom, err := taxonomy.Parse([]byte(`{"version": "custom-1", "categories": [
+		{"name": "Lifestyle", "children": [{"name": "Dining"}, {"name": "Pet_Care", "aliases": ["Pets"]}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taxonomy.Activate(custom)
+	defer taxonomy.Activate(nil)
+
+	expected := []string{"Lifestyle", "Dining", "Pet_Care", "Other"}
+	if got := llmcategory.Categories(); strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	items := llmcategory.ResponseSchema()["properties"].(map[string]interface{})["results"].(map[string]interface{})["items"].(map[string]interface{})
+	enum := items["properties"].(map[string]interface{})["category"].(map[string]interface{})["enum"].([]string)
+	if strings.Join(enum, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected enum %v, got %v", expected, enum)
+	}
+
+	tests := []struct {
+		answer   string
+		expected string
+		ok       bool
+	}{
+		{"pet care", "Pet_Care", true},
+		{"PETS", "Pet_Care", true},
+		{"other", "Other", true},
+		{"Shopping", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := llmcategory.Valid(tt.answer); got != tt.expected || ok != tt.ok {
+			t.Errorf("%s: expected %q/%v, got %q/%v", tt.answer, tt.expected, tt.ok, got, ok)
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
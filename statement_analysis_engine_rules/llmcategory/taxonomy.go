@@ -0,0 +1,49 @@
+package llmcategory
+
+import (
+	"strings"
+
+	"classify/statement_analysis_engine_rules/taxonomy"
+)
+
+// Categories returns the categories the LLM may answer with: every category of the active
+// taxonomy (taxonomy.Active), and "Other" for narrations it cannot place
+func Categories() []string {
+	names := taxonomy.Active().Names()
+	for _, name := range names {
+		if strings.EqualFold(name, "Other") {
+			return names
+		}
+	}
+	return append(names, "Other")
+}
+
+// Valid returns the taxonomy spelling of category, accepting aliases, any case and spaces for underscores
+func Valid(category string) (string, bool) {
+	normalized := strings.ReplaceAll(strings.TrimSpace(category), " ", "_")
+	if strings.EqualFold(normalized, "Other") {
+		return taxonomy.Active().Canonical("Other"), true
+	}
+	return taxonomy.Active().Lookup(normalized)
+}
+
+// ResponseSchema is the JSON Schema the LLM's answer must follow: one of Categories per narration id
+func ResponseSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"results": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id":       map[string]interface{}{"type": "integer"},
+						"category": map[string]interface{}{"type": "string", "enum": Categories()},
+					},
+					"required": []string{"id", "category"},
+				},
+			},
+		},
+		"required": []string{"results"},
+	}
+}
@@ -0,0 +1,388 @@
+// Package merchantkb resolves the merchant strings of narrations to canonical merchants
+//
+// The knowledge base is a curated JSON file of merchants with their aliases, UPI
+// VPAs, category and brand group. Resolution tries the VPAs in the narration, then
+// exact aliases, then fuzzy Jaro-Winkler matching and alias prefixes, so truncated or
+// garbled names ("SWIGGYINSTAMA", "AMZN MKTP IN") still resolve. Fuzzy and prefix
+// matches are guesses: they score below 1 and only suggest a merchant. The built-in knowledge base
+// (default.json) is active at startup; Activate swaps in one loaded from a file.
+// Learner turns recurring unresolved strings into alias suggestions for curators.
+package merchantkb
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"classify/statement_analysis_engine_rules/fileutil"
+)
+
+// Methods of a Match, in the order Resolve tries them
+const (
+	MatchVPA    = "vpa"    // A VPA of the merchant appears in the narration
+	MatchAlias  = "alias"  // The merchant string is the name or an alias (ignoring case, spaces and punctuation)
+	MatchFuzzy  = "fuzzy"  // Jaro-Winkler similarity to the name or an alias reaches Matching.Threshold
+	MatchPrefix = "prefix" // The merchant string starts with a word-bounded alias of at least Matching.MinLength characters
+)
+
+// maxPrefixDigits is the longest run of digits that may follow a prefix alias without a word boundary ("BIGBASKET123")
+const maxPrefixDigits = 4
+
+// Confidence is the classification confidence of a knowledge base category; fuzzy matches scale it by their score
+const Confidence = 0.9
+
+// KnowledgeBase is a versioned set of merchants
+type KnowledgeBase struct {
+	Version   string     `json:"version"`
+	Matching  Matching   `json:"matching"`
+	Merchants []Merchant `json:"merchants"`
+
+	aliases map[string]int // compact name or alias -> merchant
+	keys    []string       // aliases keys, sorted so fuzzy ties resolve the same way every run
+	vpas    map[string]int // upper-cased VPA -> merchant
+}
+
+// Merchant is a canonical merchant and the spellings that identify it
+type Merchant struct {
+	Name     string   `json:"name"`
+	Brand    string   `json:"brand,omitempty"`    // Brand group, e.g. Swiggy for Swiggy Instamart
+	Category string   `json:"category,omitempty"` // Category of the merchant's transactions (none: the rules decide)
+	Aliases  []string `json:"aliases,omitempty"`
+	VPAs     []string `json:"vpas,omitempty"` // UPI VPAs that only this merchant collects on
+}
+
+// Matching tunes fuzzy resolution
+type Matching struct {
+	Threshold   float64 `json:"threshold"`   // Minimum Jaro-Winkler similarity of a fuzzy match (default 0.92)
+	MinLength   int     `json:"minLength"`   // Shorter merchant strings only resolve exactly (default 5)
+	PrefixScale float64 `json:"prefixScale"` // Jaro-Winkler boost per common leading character, at most 0.25 (default 0.1)
+}
+
+// Match is a merchant string resolved by the knowledge base
+type Match struct {
+	Merchant Merchant
+	Method   string  // MatchVPA, MatchAlias, MatchFuzzy or MatchPrefix
+	Score    float64 // 1 for exact matches; the similarity for fuzzy ones and Matching.Threshold for prefix ones
+	Matched  string  // VPA or alias that matched
+}
+
+// Exact reports whether the match is a curated VPA or alias rather than a guess
+func (m Match) Exact() bool {
+	return m.Method == MatchVPA || m.Method == MatchAlias
+}
+
+//go:embed default.json
+var defaultKB []byte
+
+// Default returns the built-in knowledge base
+func Default() *KnowledgeBase {
+	kb, err := Parse(defaultKB)
+	if err != nil {
+		panic(fmt.Sprintf("merchantkb: built-in knowledge base is invalid: %v", err))
+	}
+	return kb
+}
+
+// LoadFile reads a JSON knowledge base
+func LoadFile(path string) (*KnowledgeBase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read merchant knowledge base: %w", err)
+	}
+	kb, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("merchant knowledge base %s: %w", path, err)
+	}
+	return kb, nil
+}
+
+// Parse decodes and validates a JSON knowledge base, filling in the default matching thresholds
+// Names, aliases and VPAs must each belong to one merchant
+func Parse(data []byte) (*KnowledgeBase, error) {
+	var kb KnowledgeBase
+	if err := json.Unmarshal(data, &kb); err != nil {
+		return nil, fmt.Errorf("invalid merchant knowledge base: %w", err)
+	}
+	if kb.Matching.Threshold == 0 {
+		kb.Matching.Threshold = 0.92
+	}
+	if kb.Matching.MinLength == 0 {
+		kb.Matching.MinLength = 5
+	}
+	if kb.Matching.PrefixScale == 0 {
+		kb.Matching.PrefixScale = 0.1
+	}
+	if err := kb.index(); err != nil {
+		return nil, err
+	}
+	return &kb, nil
+}
+
+// index validates the merchants and builds the lookup tables
+func (kb *KnowledgeBase) index() error {
+	if kb.Matching.Threshold < 0 || kb.Matching.Threshold > 1 {
+		return fmt.Errorf("invalid merchant knowledge base: threshold must be between 0 and 1")
+	}
+	if kb.Matching.PrefixScale < 0 || kb.Matching.PrefixScale > 0.25 {
+		return fmt.Errorf("invalid merchant knowledge base: prefixScale must be between 0 and 0.25")
+	}
+	kb.aliases = make(map[string]int)
+	kb.vpas = make(map[string]int)
+	for i, merchant := range kb.Merchants {
+		if strings.TrimSpace(merchant.Name) == "" {
+			return fmt.Errorf("invalid merchant knowledge base: merchants[%d] has no name", i)
+		}
+		for _, alias := range append([]string{merchant.Name}, merchant.Aliases...) {
+			key := compact(alias)
+			if key == "" {
+				return fmt.Errorf("invalid merchant knowledge base: %s has an empty alias", merchant.Name)
+			}
+			if existing, ok := kb.aliases[key]; ok && existing != i {
+				return fmt.Errorf("invalid merchant knowledge base: %q is used by both %s and %s", alias, kb.Merchants[existing].Name, merchant.Name)
+			}
+			kb.aliases[key] = i
+		}
+		for _, vpa := range merchant.VPAs {
+			key := strings.ToUpper(strings.TrimSpace(vpa))
+			if !strings.Contains(key, "@") {
+				return fmt.Errorf("invalid merchant knowledge base: %s has an invalid VPA %q", merchant.Name, vpa)
+			}
+			if existing, ok := kb.vpas[key]; ok && existing != i {
+				return fmt.Errorf("invalid merchant knowledge base: VPA %q is used by both %s and %s", vpa, kb.Merchants[existing].Name, merchant.Name)
+			}
+			kb.vpas[key] = i
+		}
+	}
+	kb.keys = make([]string, 0, len(kb.aliases))
+	for key := range kb.aliases {
+		kb.keys = append(kb.keys, key)
+	}
+	sort.Strings(kb.keys)
+	return nil
+}
+
+// Resolve finds the merchant of a narration and the merchant string extracted from it
+func (kb *KnowledgeBase) Resolve(narration, merchant string) (Match, bool) {
+	if kb == nil {
+		return Match{}, false
+	}
+	if vpa, i, ok := kb.findVPA(strings.ToUpper(narration)); ok {
+		return Match{Merchant: kb.Merchants[i], Method: MatchVPA, Score: 1, Matched: vpa}, true
+	}
+
+	key := compact(merchant)
+	if key == "" {
+		return Match{}, false
+	}
+	if i, ok := kb.aliases[key]; ok {
+		return Match{Merchant: kb.Merchants[i], Method: MatchAlias, Score: 1, Matched: key}, true
+	}
+	if trimmed := compact(trimCompanySuffix(merchant)); trimmed != key {
+		if i, ok := kb.aliases[trimmed]; ok {
+			return Match{Merchant: kb.Merchants[i], Method: MatchAlias, Score: 1, Matched: trimmed}, true
+		}
+	}
+	if len(key) < kb.Matching.MinLength {
+		return Match{}, false
+	}
+	// An alias the string extends is a prefix, not a misspelling: Jaro-Winkler rates "SWIGGYRAM" close to "SWIGGY"
+	extends := func(alias string) bool { return len(key) > len(alias) && strings.HasPrefix(key, alias) }
+	if alias, score := kb.nearest(key, extends); score >= kb.Matching.Threshold {
+		return Match{Merchant: kb.Merchants[kb.aliases[alias]], Method: MatchFuzzy, Score: score, Matched: alias}, true
+	}
+
+	// Longest alias the merchant string starts with as a word, e.g. "AMZNMKTP" of "AMZN MKTP IN"
+	best := ""
+	for _, alias := range kb.keys {
+		if len(alias) >= kb.Matching.MinLength && len(alias) > len(best) && strings.HasPrefix(key, alias) && endsWord(merchant, key, alias) {
+			best = alias
+		}
+	}
+	if best != "" {
+		return Match{Merchant: kb.Merchants[kb.aliases[best]], Method: MatchPrefix, Score: kb.Matching.Threshold, Matched: best}, true
+	}
+	return Match{}, false
+}
+
+// endsWord reports whether the prefix alias of the compact merchant string key ends a word of merchant,
+// or is only followed by a few digits ("AMAZONIA TRADERS" does not start with the word AMAZON)
+func endsWord(merchant, key, alias string) bool {
+	rest := key[len(alias):]
+	if rest == "" || len(rest) <= maxPrefixDigits && strings.Trim(rest, "0123456789") == "" {
+		return true
+	}
+	seen := 0
+	for _, r := range strings.ToUpper(merchant) {
+		alnum := r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+		if seen == len(alias) {
+			return !alnum
+		}
+		if alnum {
+			seen++
+		}
+	}
+	return false
+}
+
+// companySuffixes end company names; statements often truncate them ("BHARTI AIRTEL LIMITE")
+var companySuffixes = []string{"LIMITED", "LTD", "PRIVATE", "PVT", "LLP", "INDIA", "IN"}
+
+// trimCompanySuffix drops the trailing company suffixes of a merchant string, keeping at least one word
+func trimCompanySuffix(merchant string) string {
+	words := strings.FieldsFunc(strings.ToUpper(merchant), func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	for len(words) > 1 && isCompanySuffix(words[len(words)-1]) {
+		words = words[:len(words)-1]
+	}
+	return strings.Join(words, " ")
+}
+
+// isCompanySuffix reports whether word is a company suffix or a truncation of one ("PR", "LIMITE")
+func isCompanySuffix(word string) bool {
+	for _, suffix := range companySuffixes {
+		if len(word) >= 2 && strings.HasPrefix(suffix, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// nearest returns the alias most similar to a compact merchant string and its similarity, ignoring aliases skip rejects
+func (kb *KnowledgeBase) nearest(key string, skip func(alias string) bool) (string, float64) {
+	best, bestScore := "", 0.0
+	for _, alias := range kb.keys {
+		if skip != nil && skip(alias) {
+			continue
+		}
+		if upperBound(len(key), len(alias), kb.Matching.PrefixScale) <= bestScore {
+			continue
+		}
+		if score := jaroWinkler(key, alias, kb.Matching.PrefixScale); score > bestScore {
+			best, bestScore = alias, score
+		}
+	}
+	return best, bestScore
+}
+
+// findVPA returns the first VPA of the knowledge base found in an upper-cased narration
+// A VPA must not have letters, digits, dots or underscores on either side
+func (kb *KnowledgeBase) findVPA(upper string) (string, int, bool) {
+	for at := strings.IndexByte(upper, '@'); at >= 0; {
+		start := at
+		for start > 0 && isVPAChar(upper[start-1], true) {
+			start--
+		}
+		end := at + 1
+		for end < len(upper) && isVPAChar(upper[end], false) {
+			end++
+		}
+		// The local part may contain hyphens, so try every hyphen-separated suffix of it
+		for s := start; s < at; s++ {
+			if s > start && upper[s-1] != '-' {
+				continue
+			}
+			if i, ok := kb.vpas[upper[s:end]]; ok {
+				return upper[s:end], i, true
+			}
+		}
+		next := strings.IndexByte(upper[at+1:], '@')
+		if next < 0 {
+			break
+		}
+		at += next + 1
+	}
+	return "", 0, false
+}
+
+// isVPAChar reports whether c can be part of a VPA; hyphens only occur before the @
+func isVPAChar(c byte, local bool) bool {
+	return c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '.' || c == '_' || local && c == '-'
+}
+
+// compact upper-cases s and drops everything but letters and digits
+func compact(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(s) {
+		if r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Merchant returns the merchant with the given name (ignoring case)
+func (kb *KnowledgeBase) Merchant(name string) (Merchant, bool) {
+	for _, merchant := range kb.Merchants {
+		if strings.EqualFold(merchant.Name, name) {
+			return merchant, true
+		}
+	}
+	return Merchant{}, false
+}
+
+// AddMerchant adds a merchant, or merges the aliases and VPAs of one with the same name
+// Brand and category are set when given; the knowledge base is unchanged if the result is invalid
+func (kb *KnowledgeBase) AddMerchant(merchant Merchant) error {
+	previous := append([]Merchant(nil), kb.Merchants...)
+	found := false
+	for i, existing := range kb.Merchants {
+		if !strings.EqualFold(existing.Name, merchant.Name) {
+			continue
+		}
+		existing.Aliases = append(append([]string(nil), existing.Aliases...), merchant.Aliases...)
+		existing.VPAs = append(append([]string(nil), existing.VPAs...), merchant.VPAs...)
+		if merchant.Brand != "" {
+			existing.Brand = merchant.Brand
+		}
+		if merchant.Category != "" {
+			existing.Category = merchant.Category
+		}
+		kb.Merchants[i] = existing
+		found = true
+	}
+	if !found {
+		kb.Merchants = append(kb.Merchants, merchant)
+	}
+	if err := kb.index(); err != nil {
+		kb.Merchants = previous
+		kb.index()
+		return err
+	}
+	return nil
+}
+
+// Save writes the knowledge base as JSON (temp file plus rename, so readers never see a partial file)
+func (kb *KnowledgeBase) Save(path string) error {
+	data, err := json.MarshalIndent(kb, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteAtomic(path, data); err != nil {
+		return fmt.Errorf("failed to save merchant knowledge base: %w", err)
+	}
+	return nil
+}
+
+var active atomic.Pointer[KnowledgeBase]
+
+func init() {
+	active.Store(Default())
+}
+
+// Active returns the knowledge base used for classification
+func Active() *KnowledgeBase {
+	return active.Load()
+}
+
+// Activate makes kb the knowledge base for all subsequent classifications; nil restores the built-in one
+func Activate(kb *KnowledgeBase) {
+	if kb == nil {
+		kb = Default()
+	}
+	active.Store(kb)
+}
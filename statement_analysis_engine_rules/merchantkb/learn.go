@@ -0,0 +1,140 @@
+package merchantkb
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/rules"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Maximum examples and VPAs kept per suggestion
+const (
+	maxExamples = 3
+	maxVPAs     = 5
+)
+
+// vpaPattern matches a VPA that is a whole hyphen-separated field; wrapped statement lines
+// put spaces inside VPAs ("PAYTMQR6AQSV7@P TYS"), and the broken halves are not worth approving
+var vpaPattern = regexp.MustCompile(`(?:^|-)([A-Z0-9._]+@[A-Z][A-Z0-9]*)(?:-|$)`)
+
+// transferMethods pay people or accounts rather than merchants, so their names are not alias candidates
+var transferMethods = map[string]bool{
+	"IMPS": true, "NEFT": true, "RTGS": true, "Self_Transfer": true, "Salary": true,
+	"ATMWithdrawal": true, "Interest": true, "Dividend": true,
+}
+
+// Suggestion is a recurring merchant string that neither the knowledge base (by VPA or exact alias) nor the rule pack resolves
+// A curator approves it as an alias (or VPA) of Nearest or of a new merchant
+type Suggestion struct {
+	Alias    string   `json:"alias"` // Most frequent spelling
+	Count    int      `json:"count"`
+	VPAs     []string `json:"vpas,omitempty"`    // VPAs seen with the string
+	Nearest  string   `json:"nearest,omitempty"` // Closest merchant; fuzzy and prefix matches are only suggested, never applied
+	Score    float64  `json:"score,omitempty"`   // Jaro-Winkler similarity to Nearest
+	Examples []string `json:"examples"`
+
+	spellings map[string]int
+}
+
+// Learner collects unresolved merchant strings across statements
+type Learner struct {
+	kb          *KnowledgeBase
+	suggestions map[string]*Suggestion // compact merchant string -> suggestion
+}
+
+// NewLearner returns a learner for strings kb does not resolve (nil: the active knowledge base)
+func NewLearner(kb *KnowledgeBase) *Learner {
+	if kb == nil {
+		kb = Active()
+	}
+	return &Learner{kb: kb, suggestions: make(map[string]*Suggestion)}
+}
+
+// Add records the unresolved merchant strings of classified transactions
+func (l *Learner) Add(transactions []models.ClassifiedTransaction) {
+	for _, txn := range transactions {
+		if transferMethods[txn.Method] {
+			continue
+		}
+		raw := rules.ExtractMerchantName(utils.NormalizeNarration(txn.Narration))
+		key := compact(raw)
+		if raw == "Unknown" || len(key) < l.kb.Matching.MinLength {
+			continue
+		}
+		if match, ok := l.kb.Resolve(txn.Narration, raw); ok && match.Exact() {
+			continue
+		}
+		if name, category := utils.CanonicalizeMerchant(raw); category != "" || !strings.EqualFold(name, raw) {
+			continue
+		}
+
+		s, ok := l.suggestions[key]
+		if !ok {
+			s = &Suggestion{spellings: make(map[string]int)}
+			s.Nearest, s.Score = l.nearestMerchant(key)
+			l.suggestions[key] = s
+		}
+		s.Count++
+		s.spellings[strings.ToUpper(strings.TrimSpace(raw))]++
+		for _, m := range vpaPattern.FindAllStringSubmatch(strings.ToUpper(txn.Narration), -1) {
+			if vpa := m[1]; len(s.VPAs) < maxVPAs && !contains(s.VPAs, vpa) {
+				s.VPAs = append(s.VPAs, vpa)
+			}
+		}
+		if len(s.Examples) < maxExamples {
+			s.Examples = append(s.Examples, txn.Narration)
+		}
+	}
+}
+
+// Suggestions returns the strings seen at least minCount times, most frequent first
+func (l *Learner) Suggestions(minCount int) []Suggestion {
+	suggestions := make([]Suggestion, 0, len(l.suggestions))
+	for _, s := range l.suggestions {
+		if s.Count < minCount {
+			continue
+		}
+		suggestion := *s
+		suggestion.Alias = mostFrequent(s.spellings)
+		suggestions = append(suggestions, suggestion)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Count != suggestions[j].Count {
+			return suggestions[i].Count > suggestions[j].Count
+		}
+		return suggestions[i].Alias < suggestions[j].Alias
+	})
+	return suggestions
+}
+
+// nearestMerchant is the merchant of the alias closest to a compact string
+func (l *Learner) nearestMerchant(key string) (string, float64) {
+	alias, score := l.kb.nearest(key, nil)
+	if alias == "" {
+		return "", 0
+	}
+	return l.kb.Merchants[l.kb.aliases[alias]].Name, score
+}
+
+// mostFrequent returns the spelling seen most often (alphabetically first on ties)
+func mostFrequent(spellings map[string]int) string {
+	best, count := "", 0
+	for spelling, n := range spellings {
+		if n > count || n == count && spelling < best {
+			best, count = spelling, n
+		}
+	}
+	return best
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package merchantkb_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/merchantkb"
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func TestResolve(t *testing.T) {
+	kb := merchantkb.Default()
+	tests := []struct {
+		narration string
+		merchant  string
+		expected  string
+		method    string
+	}{
+		{"UPI-SWIGGY-SWIGGY.STORES@ICICI-ICIC0DC0099-123456789012-PAYMENT", "", "Swiggy", merchantkb.MatchVPA},
+		{"UPI-ZOMATO LIMITED-ZOMATO-ORDER@PTYBL-YESB0PTMUPI-123456789012-ZOMATO ORDER", "", "Zomato", merchantkb.MatchVPA},
+		{"POS 416021XXXXXX1234 AMZN MKTP", "AMZN MKTP", "Amazon", merchantkb.MatchAlias},
+		{"UPI-SWIGGYINSTAMA-PAYTM-123", "SWIGGYINSTAMA", "Swiggy Instamart", merchantkb.MatchFuzzy},
+		{"POS 416021XXXXXX1234 AMZN MKTP IN", "AMZN MKTP IN", "Amazon", merchantkb.MatchAlias},
+		{"POS 416021XXXXXX1234 AMZN MKTP SHOP", "AMZN MKTP SHOP", "Amazon", merchantkb.MatchPrefix},
+		{"UPI-ZEPTO MARKETPLACE PR-123", "ZEPTO MARKETPLACE PR", "Zepto", merchantkb.MatchAlias},
+		{"UPI-BIGBASKET ORDER 12345678-123", "BIGBASKET ORDER 12345678", "BigBasket", merchantkb.MatchPrefix},
+		{"UPI-BIGBASKET123-123", "BIGBASKET123", "BigBasket", merchantkb.MatchPrefix},
+		{"UPI-BHARTI AIRTEL LIMITE-123", "BHARTI AIRTEL LIMITE", "Airtel", merchantkb.MatchAlias},
+		{"UPI-AMAZONIA TRADERS-123", "AMAZONIA TRADERS", "", ""},
+		{"UPI-SWIGGYRAM-123", "SWIGGYRAM", "", ""},
+		{"UPI-UBERTY-123", "UBERTY", "", ""},
+		{"UPI-OLA-123", "Ola", "Ola", merchantkb.MatchAlias},
+		{"UPI-OLAX-123", "OLAX", "", ""},
+		{"UPI-MAHA GANESH TRADERS-PAYTMQR641Q4E@PTYS", "MAHA GANESH TRADERS", "", ""},
+	}
+	for _, tt := range tests {
+		match, ok := kb.Resolve(tt.narration, tt.merchant)
+		if match.Merchant.Name != tt.expected || match.Method != tt.method || ok != (tt.expected != "") {
+			t.Errorf("%s: expected %q by %q, got %q by %q (%.3f)", tt.merchant, tt.expected, tt.method, match.Merchant.Name, match.Method, match.Score)
+		}
+		if ok && match.Exact() != (match.Score == 1) {
+			t.Errorf("%s: expected a score of 1 only for exact matches, got %.3f by %q", tt.merchant, match.Score, match.Method)
+		}
+	}
+}
+
+func TestGuessesKeepMerchantName(t *testing.T) {
+	tests := []struct {
+		narration string
+		merchant  string
+		method    string
+	}{
+		{"UPI-SWIGGY-SWIGGY.STORES@ICICI-ICIC0DC0099-123456789012-PAYMENT", "Swiggy", merchantkb.MatchVPA},
+		{"UPI-BIGBASKET ORDER 12345678-BBORDER@YBL-YESB0YBLUPI-123456789012-UPI", "BIGBASKET ORDER 12345678", merchantkb.MatchPrefix},
+	}
+	for _, tt := range tests {
+		txn := classifier.ConvertFromTxtTransaction("01/04/25", tt.narration, "", "01/04/25", 100, 0, 1000)
+		classified := classifier.ClassifyTransaction(txn, "RAHUL VERMA", nil)
+		match := classified.ClassificationMetadata.MerchantMatch
+		if match == nil || match.Method != tt.method {
+			t.Fatalf("%s: expected a %s match, got %+v", tt.narration, tt.method, match)
+		}
+		if !strings.EqualFold(classified.Merchant, tt.merchant) {
+			t.Errorf("%s: expected merchant %q, got %q", tt.narration, tt.merchant, classified.Merchant)
+		}
+	}
+}
+
+func TestThreshold(t *testing.T) {
+	kb, err := merchantkb.Parse([]byte(`{"matching": {"threshold": 0.99}, "merchants": [{"name": "Swiggy Instamart", "aliases": ["SWIGGYINSTAMART"]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match, ok := kb.Resolve("", "SWIGGYINSTAMA"); ok {
+		t.Errorf("expected no match above 0.99, got %+v", match)
+	}
+	if kb.Matching.MinLength != 5 || kb.Matching.PrefixScale != 0.1 {
+		t.Errorf("expected default minLength and prefixScale, got %+v", kb.Matching)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{"alias of two merchants", `{"merchants": [{"name": "Amazon", "aliases": ["AMZN"]}, {"name": "Amazon Pay", "aliases": ["amzn"]}]}`, `"amzn" is used by both Amazon and Amazon Pay`},
+		{"invalid VPA", `{"merchants": [{"name": "Amazon", "vpas": ["amazon"]}]}`, `invalid VPA "amazon"`},
+		{"no name", `{"merchants": [{"aliases": ["AMZN"]}]}`, "merchants[0] has no name"},
+		{"threshold", `{"matching": {"threshold": 1.5}, "merchants": []}`, "threshold must be between 0 and 1"},
+	}
+	for _, tt := range tests {
+		if _, err := merchantkb.Parse([]byte(tt.data)); err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.expected, err)
+		}
+	}
+}
+
+func TestAddMerchantAndSave(t *testing.T) {
+	kb := merchantkb.Default()
+	if err := kb.AddMerchant(merchantkb.Merchant{Name: "swiggy instamart", Aliases: []string{"SWGY INSTMRT"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := kb.AddMerchant(merchantkb.Merchant{Name: "Maha Ganesh Traders", Category: "Groceries", VPAs: []string{"paytmqr641q4e@ptys"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := kb.AddMerchant(merchantkb.Merchant{Name: "Zepto", Aliases: []string{"AMAZON"}}); err == nil {
+		t.Errorf("expected an alias conflict")
+	}
+
+	path := filepath.Join(t.TempDir(), "merchants.json")
+	if err := kb.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := merchantkb.LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match, _ := saved.Resolve("", "SWGY INSTMRT"); match.Merchant.Name != "Swiggy Instamart" || match.Merchant.Category != "Groceries" {
+		t.Errorf("expected the approved alias of Swiggy Instamart, got %+v", match)
+	}
+	if match, _ := saved.Resolve("UPI-MAHA GANESH TRADERS-PAYTMQR641Q4E@PTYS-YESB0PTMUPI-1", ""); match.Merchant.Name != "Maha Ganesh Traders" {
+		t.Errorf("expected the new merchant by VPA, got %+v", match)
+	}
+	if zepto, _ := saved.Merchant("Zepto"); len(zepto.Aliases) != 3 {
+		t.Errorf("expected the rejected alias to leave Zepto alone, got %v", zepto.Aliases)
+	}
+}
+
+func TestLearnerSuggestions(t *testing.T) {
+	narrations := []string{
+		"UPI-SHYAM MEDICOS-PAYTMQR6AQSV7@PTYS-YESB0PTMUPI-100000000001-UPI",
+		"UPI-SHYAM MEDICOS-PAYTMQR6AQSV7@PTYS-YESB0PTMUPI-100000000002-UPI",
+		"UPI-SHYAM MEDICOS-PAYTMQR6AQSV7@P TYS-YESB0PTMUPI-100000000003-UPI",
+		"UPI-AIRTEL-AIRTELPREPAID@AIRTEL-AIRP0000001-100000000004-RECHARGE",
+		"UPI-AIRTEL-AIRTELPREPAID@AIRTEL-AIRP0000001-100000000005-RECHARGE",
+		"UPI-ONCE ONLY STORE-ONCEONLY@YBL-YESB0YBLUPI-100000000006-UPI",
+	}
+	transactions := make([]models.ClassifiedTransaction, len(narrations))
+	for i, narration := range narrations {
+		transactions[i] = classifier.ConvertFromTxtTransaction("01/04/25", narration, "", "01/04/25", 100, 0, 1000)
+	}
+
+	learner := merchantkb.NewLearner(nil)
+	learner.Add(classifier.ClassifyTransactions(transactions, "RAHUL VERMA", nil))
+	suggestions := learner.Suggestions(2)
+	if len(suggestions) != 1 {
+		t.Fatalf("expected 1 suggestion, got %+v", suggestions)
+	}
+	s := suggestions[0]
+	if s.Alias != "SHYAM MEDICOS" || s.Count != 3 || !reflect.DeepEqual(s.VPAs, []string{"PAYTMQR6AQSV7@PTYS"}) || len(s.Examples) != 3 {
+		t.Errorf("unexpected suggestion %+v", s)
+	}
+}
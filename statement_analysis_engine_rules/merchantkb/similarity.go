@@ -0,0 +1,73 @@
+package merchantkb
+
+// jaroWinkler returns the Jaro-Winkler similarity of a and b (0 to 1)
+// Each of the first four common leading characters moves the Jaro similarity prefixScale closer to 1
+func jaroWinkler(a, b string, prefixScale float64) float64 {
+	sim := jaro(a, b)
+	prefix := 0
+	for prefix < 4 && prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return sim + float64(prefix)*prefixScale*(1-sim)
+}
+
+// jaro returns the Jaro similarity of a and b; both are compact ASCII strings
+func jaro(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+	window := max(len(a), len(b))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	aMatched := make([]bool, len(a))
+	bMatched := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		lo, hi := max(0, i-window), min(len(b), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !bMatched[j] && a[i] == b[j] {
+				aMatched[i], bMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Half the matched characters that appear in a different order
+	transpositions, j := 0, 0
+	for i := range a {
+		if !aMatched[i] {
+			continue
+		}
+		for !bMatched[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// upperBound is the highest Jaro-Winkler similarity strings of these lengths can reach,
+// so nearest can skip aliases far longer or shorter than the merchant string
+func upperBound(la, lb int, prefixScale float64) float64 {
+	if la > lb {
+		la, lb = lb, la
+	}
+	if lb == 0 {
+		return 1
+	}
+	sim := (1 + float64(la)/float64(lb) + 1) / 3
+	return sim + 4*prefixScale*(1-sim)
+}
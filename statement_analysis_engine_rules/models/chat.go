@@ -0,0 +1,39 @@
+package models
+
+// ChatRequest represents the incoming chat request
+type ChatRequest struct {
+	Message             string                `json:"message"`
+	StatementData       interface{}           `json:"statementData"`
+	ConversationHistory []ConversationMessage `json:"conversationHistory,omitempty"`
+	APIKey              string                `json:"apiKey,omitempty"`
+}
+
+// ConversationMessage represents a message in the conversation history
+type ConversationMessage struct {
+	Role    string `json:"role"` // "user" or "assistant"
+	Content string `json:"content"`
+}
+
+// ChatResponse represents the response from the chat API
+type ChatResponse struct {
+	Success   bool   `json:"success"`
+	Response  string `json:"response,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Timestamp string `json:"timestamp"`
+}
+
+// HealthResponse represents the response from the health endpoint
+type HealthResponse struct {
+	Status    string `json:"status"`
+	Service   string `json:"service"`
+	Timestamp string `json:"timestamp"`
+}
+
+// ReadinessResponse represents the response from the readiness endpoint
+type ReadinessResponse struct {
+	Status    string            `json:"status"`             // ready, degraded (200), not_ready or draining (503)
+	Checks    map[string]string `json:"checks"`             // Dependency -> "ok" or the error
+	Degraded  []string          `json:"degraded,omitempty"` // Optional dependencies that are down (rag_store)
+	Timestamp string            `json:"timestamp"`
+}
@@ -13,6 +13,7 @@ type AccountSummary struct {
 	TotalInvestments    float64 `json:"totalInvestments"`
 	NetSavings          float64 `json:"netSavings"`
 	SavingsRatePercent  float64 `json:"savingsRatePercent"`
+	InternalTransfers   float64 `json:"internalTransfers,omitempty"` // Paired transfers between the user's own accounts, left out of income and expense
 }
 
 // TransactionType represents transaction breakdown by type
@@ -45,9 +46,29 @@ type TransactionBreakdown struct {
 
 // TopBeneficiary represents a top beneficiary
 type TopBeneficiary struct {
-	Name   string  `json:"name"`
-	Amount float64 `json:"amount"`
-	Type   string  `json:"type"`
+	CounterpartyID string  `json:"counterpartyId,omitempty"` // Resolved counterparty, see Counterparty
+	Name           string  `json:"name"`
+	Account        string  `json:"account,omitempty"` // Counterparty account, when the narration carries one
+	IFSC           string  `json:"ifsc,omitempty"`
+	Amount         float64 `json:"amount"`
+	Type           string  `json:"type"`
+}
+
+// Counterparty is a resolved counterparty entity with its totals across the statement
+type Counterparty struct {
+	ID            string   `json:"id"`   // Stable across statements for the same account, VPA or name
+	Name          string   `json:"name"` // Name used most often
+	Names         []string `json:"names"`
+	VPAs          []string `json:"vpas,omitempty"`
+	Accounts      []string `json:"accounts,omitempty"`
+	IFSCs         []string `json:"ifscs,omitempty"`
+	TotalPaid     float64  `json:"totalPaid"`
+	PaidCount     int      `json:"paidCount"`
+	TotalReceived float64  `json:"totalReceived"`
+	ReceivedCount int      `json:"receivedCount"`
+	Direction     string   `json:"direction"` // OUTFLOW, INFLOW or BOTH
+	FirstSeen     string   `json:"firstSeen"` // Date of first interaction
+	LastSeen      string   `json:"lastSeen"`  // Date of last interaction
 }
 
 // TopExpense represents a top expense
@@ -83,9 +104,24 @@ type CategorySummary struct {
 	Entertainment  float64 `json:"Entertainment"`
 	Loan           float64 `json:"Loan"`
 	// Note: Investments removed - tracked separately in accountSummary.totalInvestments
+
+	// Tree covers every transaction (debits and credits, all categories) grouped by the category taxonomy
+	// The flat fields above are kept for existing clients
+	Tree            []CategoryNode `json:"tree"`
+	TaxonomyVersion string         `json:"taxonomyVersion"`
+}
+
+// CategoryNode is one category in the summary tree; a parent's totals include its children
+type CategoryNode struct {
+	Category string         `json:"category"`
+	Spent    float64        `json:"spent"`    // Sum of withdrawals
+	Received float64        `json:"received"` // Sum of deposits
+	Count    int            `json:"count"`
+	Children []CategoryNode `json:"children,omitempty"`
 }
 
 // MerchantSummary represents merchant-wise summary
+// Deprecated: kept for existing clients; MerchantAnalytics covers every merchant
 type MerchantSummary struct {
 	Amazon   float64 `json:"Amazon"`
 	Flipkart float64 `json:"Flipkart"`
@@ -94,6 +130,29 @@ type MerchantSummary struct {
 	Uber     float64 `json:"Uber"`
 }
 
+// MerchantStats is the spend at one canonical merchant (utils.CanonicalizeMerchant)
+type MerchantStats struct {
+	Rank                 int             `json:"rank"` // 1 is the merchant with the highest spend
+	Merchant             string          `json:"merchant"`
+	Category             string          `json:"category"` // Category with the most spend at the merchant
+	Total                float64         `json:"total"`
+	Count                int             `json:"count"`
+	AverageTicket        float64         `json:"averageTicket"`
+	FirstSeen            string          `json:"firstSeen"` // YYYY-MM-DD
+	LastSeen             string          `json:"lastSeen"`  // YYYY-MM-DD
+	Monthly              []MerchantMonth `json:"monthly"`   // Oldest month first
+	Trend                string          `json:"trend"`     // up, down or flat against the previous month; new, returning or inactive
+	TrendPercent         float64         `json:"trendPercent"`
+	CategorySharePercent float64         `json:"categorySharePercent"` // Share of the statement's spend in Category
+}
+
+// MerchantMonth is the spend at a merchant in one month
+type MerchantMonth struct {
+	Month  string  `json:"month"` // YYYY-MM
+	Amount float64 `json:"amount"`
+	Count  int     `json:"count"`
+}
+
 // TransactionTrends represents transaction trends
 type TransactionTrends struct {
 	HighestSpendMonth string `json:"highestSpendMonth"`
@@ -152,6 +211,8 @@ type RecurringPayment struct {
 	FirstSeen  string  `json:"firstSeen"` // Date of first occurrence
 	LastSeen   string  `json:"lastSeen"`  // Date of last occurrence
 	Count      int     `json:"count"`     // Number of occurrences
+
+	CounterpartyID string `json:"counterpartyId,omitempty"` // Set when the payments were grouped by resolved counterparty
 }
 
 // SavingsOpportunity represents a savings opportunity
@@ -246,10 +307,12 @@ type ClassifyResponse struct {
 	AccountSummary       AccountSummary        `json:"accountSummary"`
 	TransactionBreakdown TransactionBreakdown  `json:"transactionBreakdown"`
 	TopBeneficiaries     []TopBeneficiary      `json:"topBeneficiaries"`
+	Counterparties       []Counterparty        `json:"counterparties"` // Every resolved counterparty, largest flow first
 	TopExpenses          []TopExpense          `json:"topExpenses"`
 	MonthlySummary       []MonthlySummary      `json:"monthlySummary"`
 	CategorySummary      CategorySummary       `json:"categorySummary"`
 	MerchantSummary      MerchantSummary       `json:"merchantSummary"`
+	MerchantAnalytics    []MerchantStats       `json:"merchantAnalytics"` // Every merchant, ranked by spend
 	TransactionTrends    TransactionTrends     `json:"transactionTrends"`
 	RecommendedProducts  []RecommendedProduct  `json:"recommendedProducts"`
 	PredictiveInsights   PredictiveInsights    `json:"predictiveInsights"`
@@ -264,3 +327,15 @@ type ClassifyResponse struct {
 	AnomalyDetection     AnomalyDetection      `json:"anomalyDetection"` // Anomaly detection results
 	Transactions         []TransactionDetail   `json:"transactions"` // All transactions for heatmap and pattern analysis
 }
+
+// TraceResponse is the decision trace of one statement transaction (GET /api/trace)
+type TraceResponse struct {
+	Row                    int                    `json:"row"` // 1-based position in the statement
+	Date                   string                 `json:"date"`
+	Narration              string                 `json:"narration"`
+	Method                 string                 `json:"method"`
+	Category               string                 `json:"category"`
+	Merchant               string                 `json:"merchant"`
+	Beneficiary            string                 `json:"beneficiary"`
+	ClassificationMetadata ClassificationMetadata `json:"classificationMetadata"` // Trace holds every layer's decision
+}
@@ -1,14 +1,74 @@
 package models
 
+import "time"
+
 // ClassificationMetadata stores "why" a classification happened (for explainability)
 // This implements the principle: "Store 'why' a classification happened"
 type ClassificationMetadata struct {
-	Confidence      float64  `json:"confidence"`       // 0.0 to 1.0
-	MatchedKeywords []string `json:"matchedKeywords"` // Keywords that matched
-	Gateway         string   `json:"gateway"`          // Payment gateway (BillDesk, PayU, etc.) - separate concept
-	Channel         string   `json:"channel"`          // Payment channel (UPI, POS, ECS, etc.) - separate concept
-	RuleVersion     string   `json:"ruleVersion"`      // Version of rules used
-	Reason          string   `json:"reason"`          // Human-readable explanation
+	Confidence      float64          `json:"confidence"`      // 0.0 to 1.0
+	MatchedKeywords []string         `json:"matchedKeywords"` // Keywords that matched
+	Gateway         string           `json:"gateway"`         // Payment gateway (BillDesk, PayU, etc.) - separate concept
+	Channel         string           `json:"channel"`         // Payment channel (UPI, POS, ECS, etc.) - separate concept
+	RuleVersion     string           `json:"ruleVersion"`     // Version of rules used
+	Reason          string           `json:"reason"`          // Human-readable explanation
+	Model           *ModelPrediction `json:"model,omitempty"` // Statistical fallback, set when the model was consulted
+	LLM             *LLMCategory     `json:"llm,omitempty"`   // Cached LLM answer, set when one was found
+	Trace           *DecisionTrace   `json:"trace,omitempty"` // Layer-by-layer decision, set only when tracing was asked for
+	MerchantMatch   *MerchantMatch   `json:"merchantMatch,omitempty"` // Merchant knowledge base entry, set when it matched the merchant
+}
+
+// MerchantMatch records how the merchant knowledge base resolved a transaction's merchant
+type MerchantMatch struct {
+	Version  string  `json:"version"` // Knowledge base version
+	Name     string  `json:"name"`
+	Brand    string  `json:"brand,omitempty"`
+	Category string  `json:"category,omitempty"`
+	Method   string  `json:"method"`  // vpa, alias, fuzzy or prefix; only vpa and alias matches rename the merchant
+	Score    float64 `json:"score"`   // 1 for vpa and alias matches; below 1 for fuzzy and prefix ones
+	Matched  string  `json:"matched"` // VPA or alias that matched
+}
+
+// ModelPrediction records what the statistical fallback model predicted for a transaction
+type ModelPrediction struct {
+	Version     string   `json:"version"`     // Model version
+	Category    string   `json:"category"`    // Predicted category
+	Probability float64  `json:"probability"` // 0.0 to 1.0
+	Features    []string `json:"features"`    // Features that favoured the prediction most
+	Applied     bool     `json:"applied"`     // false when the probability was below the model's minimum
+}
+
+// LLMCategory records the cached LLM answer for an unresolved transaction
+type LLMCategory struct {
+	Provider   string    `json:"provider"`   // LLM that answered
+	Category   string    `json:"category"`   // Category from the fixed taxonomy
+	AnsweredAt time.Time `json:"answeredAt"` // When the answer was cached
+	Applied    bool      `json:"applied"`    // false for "Other" and for an expense category on a credit
+}
+
+// DecisionTrace records every layer that ran while classifying a transaction, in order
+type DecisionTrace struct {
+	Narration string      `json:"narration"` // Narration as it came in
+	Amount    float64     `json:"amount"`    // Amount the layers classified (withdrawal or deposit)
+	Steps     []TraceStep `json:"steps"`
+	Category  string      `json:"category"` // Final category
+	Layer     string      `json:"layer"`    // Layer of the step that decided Category
+}
+
+// TraceStep is one layer of a DecisionTrace: what it saw, what it considered and what it decided
+type TraceStep struct {
+	Layer      string           `json:"layer"`
+	Input      string           `json:"input,omitempty"`
+	Candidates []TraceCandidate `json:"candidates,omitempty"` // Every proposal of the layer, in the order the layer weighs them
+	Output     string           `json:"output,omitempty"`
+	Overrode   string           `json:"overrode,omitempty"` // Category the step replaced, empty when it was the first to decide
+	Reason     string           `json:"reason,omitempty"`
+}
+
+// TraceCandidate is a value a layer proposed, with its score and the rule or keyword behind it
+type TraceCandidate struct {
+	Value  string  `json:"value"`
+	Score  float64 `json:"score,omitempty"`
+	Source string  `json:"source,omitempty"`
 }
 
 // ClassifiedTransaction represents a transaction with classification information
@@ -35,6 +95,55 @@ type ClassifiedTransaction struct {
 	
 	// Classification metadata (for explainability and debugging)
 	ClassificationMetadata ClassificationMetadata `json:"classificationMetadata,omitempty"`
+
+	// Parsed UPI narration, set for UPI transactions
+	UPI *UPIDetails `json:"upi,omitempty"`
+
+	// Parsed IMPS/NEFT/RTGS/NACH/ACH narration, set when the narration follows its rail's template
+	NarrationFields *NarrationFields `json:"narrationFields,omitempty"`
+
+	// Resolved counterparty entity: the same ID for every name, VPA and account of one payee
+	CounterpartyID string `json:"counterpartyId,omitempty"`
+
+	// Set when the counterparty is one of the user's own accounts
+	InternalTransfer *InternalTransfer `json:"internalTransfer,omitempty"`
+}
+
+// InternalTransfer marks a transfer between two of the user's own accounts
+type InternalTransfer struct {
+	Account   string `json:"account"`             // The other account: registered account or masked number of the paired statement
+	Paired    bool   `json:"paired"`              // The other side was found in another loaded statement
+	PairDate  string `json:"pairDate,omitempty"`  // Date of the other side
+	Reference string `json:"reference,omitempty"` // UTR/RRN both sides carry
+	Basis     string `json:"basis,omitempty"`     // What paired the sides: reference, account or registered account
+}
+
+// NarrationFields holds the fields of an IMPS, NEFT, RTGS, NACH or ACH narration
+type NarrationFields struct {
+	Rail      string `json:"rail"`                // IMPS, NEFT, RTGS, NACH or ACH
+	Direction string `json:"direction"`           // CR or DR
+	Reference string `json:"reference,omitempty"` // UTR (NEFT/RTGS), RRN (IMPS) or ACH/NACH transaction reference
+	Name      string `json:"name,omitempty"`      // Counterparty: remitter on credits, beneficiary on debits
+	Account   string `json:"account,omitempty"`   // Counterparty account as printed (usually masked)
+	IFSC      string `json:"ifsc,omitempty"`      // Counterparty branch
+	Bank      string `json:"bank,omitempty"`      // Bank of the IFSC or of the printed bank code
+	MandateID string `json:"mandateId,omitempty"` // NACH/ACH mandate reference (UMRN)
+	Remark    string `json:"remark,omitempty"`    // Remaining text fields, in order
+}
+
+// UPIDetails holds the fields of a UPI narration and who the counterparty is
+type UPIDetails struct {
+	Name      string `json:"name,omitempty"`      // Counterparty name as printed (banks truncate it)
+	VPA       string `json:"vpa,omitempty"`       // e.g. SWIGGY.STORES@ICICI
+	Handle    string `json:"handle,omitempty"`    // Part of the VPA after @
+	Account   string `json:"account,omitempty"`   // Masked account, for payments to account and IFSC
+	IFSC      string `json:"ifsc,omitempty"`      // IFSC printed with the transaction
+	PSPBank   string `json:"pspBank,omitempty"`   // Bank behind the VPA handle (or the IFSC)
+	Reference string `json:"reference,omitempty"` // UPI reference number (RRN)
+	Remark    string `json:"remark,omitempty"`    // Payer's note; empty for the default "UPI"
+	Direction string `json:"direction"`           // Counterparty role: payee (we paid) or payer (we were paid)
+	Kind      string `json:"kind"`                // Person, Aggregator, Business or Unknown
+	KindBasis string `json:"kindBasis,omitempty"` // Handle pattern or frequency signal behind Kind
 }
 
 // RecurringMetadata stores recurring payment detection details
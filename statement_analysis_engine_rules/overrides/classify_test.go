@@ -0,0 +1,92 @@
+package overrides_test
+
+import (
+	"strings"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/overrides"
+)
+
+// TestClassifierAppliesOverrides checks that a matching override wins over the rules and says so in the metadata
+func TestClassifierAppliesOverrides(t *testing.T) {
+	set := overrides.NewSet([]overrides.Override{
+		{ID: "ovr_landlord", Type: overrides.TypeVPA, Match: "ramesh.k@okaxis", Beneficiary: "Landlord", Category: "Rent"},
+		{ID: "ovr_simpl", Type: overrides.TypeMerchant, Match: "SIMPL", Category: "BNPL"},
+		{ID: "ovr_swiggy", Type: overrides.TypeMerchant, Match: "Swiggy", Merchant: "Swiggy Instamart"},
+	})
+
+	tests := []struct {
+		narration   string
+		category    string
+		merchant    string
+		beneficiary string
+		override    string // Expected override ID, empty when the rules should decide
+	}{
+		{"UPI-RAMESH KUMAR-RAMESH.K@OKAXIS-UTIB0000123-512345678901-NOV", "Rent", "", "Landlord", "ovr_landlord"},
+		{"UPI-SIMPL-SIMPL.PAY@AXISBANK-UTIB0000100-512345678902-PAYMENT", "BNPL", "", "", "ovr_simpl"},
+		{"UPI-SWIGGY-SWIGGY@ICICI-ICIC0DC0099-123456789012-UPI", "Food_Delivery", "Swiggy Instamart", "", "ovr_swiggy"},
+		{"UPI-ZOMATO-ZOMATO@HDFC-HDFC0000001-123456789012-ORDER", "Food_Delivery", "", "", ""},
+	}
+	for _, tt := range tests {
+		txn := classifier.ConvertFromTxtTransaction("01/12/25", tt.narration, "0000123456789012", "01/12/25", 450, 0, 9550)
+		withRules := classifier.ClassifyTransaction(txn, "TEST USER", nil)
+		classified := classifier.ClassifyTransaction(txn, "TEST USER", set)
+
+		if classified.Category != tt.category {
+			t.Errorf("%s: expected category %s, got %s", tt.narration, tt.category, classified.Category)
+		}
+		if tt.merchant != "" && classified.Merchant != tt.merchant {
+			t.Errorf("%s: expected merchant %s, got %s", tt.narration, tt.merchant, classified.Merchant)
+		}
+		if tt.beneficiary != "" && classified.Beneficiary != tt.beneficiary {
+			t.Errorf("%s: expected beneficiary %s, got %s", tt.narration, tt.beneficiary, classified.Beneficiary)
+		}
+
+		reason := classified.ClassificationMetadata.Reason
+		if tt.override == "" {
+			if reason != withRules.ClassificationMetadata.Reason {
+				t.Errorf("%s: expected the rules to decide, got %q", tt.narration, reason)
+			}
+			continue
+		}
+		if !strings.HasPrefix(reason, "User override "+tt.override) {
+			t.Errorf("%s: expected reason to name %s, got %q", tt.narration, tt.override, reason)
+		}
+		if classified.ClassificationMetadata.Confidence != 1.0 {
+			t.Errorf("%s: expected confidence 1.0, got %v", tt.narration, classified.ClassificationMetadata.Confidence)
+		}
+	}
+}
+
+// TestClassifierDetectsRegisteredAccounts checks that transfers to the user's own accounts become self-transfers
+func TestClassifierDetectsRegisteredAccounts(t *testing.T) {
+	set := overrides.NewSet([]overrides.Override{
+		{ID: "ovr_icici", Type: overrides.TypeAccount, Match: "XX2950", Label: "ICICI savings"},
+	})
+
+	tests := []struct {
+		narration string
+		own       bool
+	}{
+		{"IMPS-512345678901-KALPIT SHARMA-ICIC-XXXXXXX2950-MONTHLY", true},
+		{"IMPS-512345678901-KALPIT SHARMA-ICIC-XXXXXXX4411-MONTHLY", false},
+	}
+	for _, tt := range tests {
+		txn := classifier.ConvertFromTxtTransaction("01/12/25", tt.narration, "0000123456789012", "01/12/25", 5000, 0, 9550)
+		classified := classifier.ClassifyTransaction(txn, "TEST USER", set)
+
+		if own := classified.InternalTransfer != nil; own != tt.own {
+			t.Fatalf("%s: expected own account %v, got %+v", tt.narration, tt.own, classified.InternalTransfer)
+		}
+		if !tt.own {
+			continue
+		}
+		if classified.Category != "Investment" || classified.InternalTransfer.Account != "ICICI savings" {
+			t.Errorf("%s: expected an Investment self-transfer to ICICI savings, got %s %+v", tt.narration, classified.Category, classified.InternalTransfer)
+		}
+		if !strings.Contains(classified.ClassificationMetadata.Reason, "registered account ICICI savings") {
+			t.Errorf("%s: expected reason to name the account, got %q", tt.narration, classified.ClassificationMetadata.Reason)
+		}
+	}
+}
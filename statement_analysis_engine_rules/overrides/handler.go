@@ -0,0 +1,92 @@
+package overrides
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Caller returns the tenant and user a request acts for and the logger for its errors
+// The server derives them from its authentication and request logging middleware
+type Caller func(r *http.Request) (tenantID, userID string, logger *slog.Logger)
+
+// ListResponse is returned by GET /api/overrides
+type ListResponse struct {
+	Success   bool       `json:"success"`
+	Overrides []Override `json:"overrides"`
+}
+
+// OverrideResponse is returned by POST /api/overrides
+type OverrideResponse struct {
+	Success  bool     `json:"success"`
+	Override Override `json:"override"`
+}
+
+// ErrorResponse is returned when an override request fails
+type ErrorResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+}
+
+// Handler manages the caller's overrides
+// Overrides belong to the user caller returns (the authenticated subject) within its tenant
+//
+//	GET    list overrides
+//	POST   create {type, match, category?, merchant?, beneficiary?}
+//	DELETE ?id=... remove an override
+func Handler(store *Store, caller Caller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tenantID, userID, logger := caller(r)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(ListResponse{Success: true, Overrides: store.List(tenantID, userID)})
+
+		case http.MethodPost:
+			var o Override
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(&o); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid JSON body")
+				return
+			}
+			o.ID = ""
+			o.TenantID = tenantID
+			o.UserID = userID
+			o.CreatedAt = time.Time{}
+			if err := o.Validate(); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			created, err := store.Add(o)
+			if err != nil {
+				logger.Error("failed to store override", slog.Any("error", err))
+				writeError(w, http.StatusInternalServerError, "failed to store override")
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(OverrideResponse{Success: true, Override: created})
+
+		case http.MethodDelete:
+			removed, err := store.Remove(tenantID, userID, r.URL.Query().Get("id"))
+			if err != nil {
+				logger.Error("failed to remove override", slog.Any("error", err))
+				writeError(w, http.StatusInternalServerError, "failed to remove override")
+				return
+			}
+			if !removed {
+				writeError(w, http.StatusNotFound, "override not found")
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Success: false, Error: message})
+}
@@ -0,0 +1,317 @@
+// Package overrides holds per-user classification overrides ("this UPI payee is my landlord, call it Rent"),
+// corrections recorded from the review queue (fingerprint overrides) and the user's own accounts
+// (account overrides, for self-transfer detection)
+// The classifier applies a user's overrides after every rule, so an override always wins
+package overrides
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/taxonomy"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Override types, checked in this order
+const (
+	TypeFingerprint = "fingerprint" // utils.FingerprintNarration of a corrected narration -> category
+	TypeVPA         = "vpa"         // UPI VPA in the narration -> beneficiary and/or category
+	TypeMerchant    = "merchant"    // Merchant name -> category and/or merchant name
+	TypePattern     = "pattern"     // Regex on the narration -> category
+	TypeAccount     = "account"     // The user's own account number, last 4 digits or VPA -> self-transfer
+)
+
+var typeOrder = map[string]int{
+	TypeFingerprint: 0,
+	TypeVPA:         1,
+	TypeMerchant:    2,
+	TypePattern:     3,
+	TypeAccount:     4,
+}
+
+// Override replaces the classifier's result for matching transactions of one user
+type Override struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenantId"`
+	UserID      string    `json:"userId"`
+	Type        string    `json:"type"`                  // fingerprint, vpa, merchant, pattern or account
+	Match       string    `json:"match"`                 // Fingerprint, VPA, merchant name or regex (case-insensitive)
+	Category    string    `json:"category,omitempty"`    // Category to assign, from the category taxonomy
+	Merchant    string    `json:"merchant,omitempty"`    // Merchant name to assign (merchant overrides only)
+	Beneficiary string    `json:"beneficiary,omitempty"` // Beneficiary to assign (vpa overrides only)
+	Label       string    `json:"label,omitempty"`       // Name of the account, e.g. "ICICI savings" (account overrides only)
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// Validate checks a new override: its type, match and targets, normalising the match, and that
+// its category is one of the active taxonomy (taxonomy.Active), in the taxonomy's spelling
+func (o *Override) Validate() error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+	if o.Category != "" {
+		category, ok := taxonomy.Active().Lookup(o.Category)
+		if !ok {
+			return fmt.Errorf("unknown category %q: not in the category taxonomy", o.Category)
+		}
+		o.Category = category
+	}
+	return nil
+}
+
+// validate is Validate without the taxonomy check, for stored overrides: a category the
+// taxonomy no longer has still applies
+func (o *Override) validate() error {
+	o.Type = strings.ToLower(strings.TrimSpace(o.Type))
+	o.Match = strings.TrimSpace(o.Match)
+	o.Category = strings.TrimSpace(o.Category)
+	o.Merchant = strings.TrimSpace(o.Merchant)
+	o.Beneficiary = strings.TrimSpace(o.Beneficiary)
+	o.Label = strings.TrimSpace(o.Label)
+	if o.Match == "" {
+		return fmt.Errorf("match is required")
+	}
+
+	switch o.Type {
+	case TypeFingerprint:
+		o.Match = strings.ToLower(o.Match)
+		if len(o.Match) != 64 || strings.Trim(o.Match, "0123456789abcdef") != "" {
+			return fmt.Errorf("invalid fingerprint %q: expected a SHA-256 hex digest", o.Match)
+		}
+		if o.Merchant != "" || o.Beneficiary != "" {
+			return fmt.Errorf("fingerprint overrides set a category only")
+		}
+		if o.Category == "" {
+			return fmt.Errorf("fingerprint overrides need a category")
+		}
+	case TypeVPA:
+		if !strings.Contains(o.Match, "@") {
+			return fmt.Errorf("invalid VPA %q: expected name@handle", o.Match)
+		}
+		if o.Merchant != "" {
+			return fmt.Errorf("vpa overrides set a beneficiary or category, not a merchant")
+		}
+		if o.Category == "" && o.Beneficiary == "" {
+			return fmt.Errorf("vpa overrides need a beneficiary or category")
+		}
+	case TypeMerchant:
+		if o.Beneficiary != "" {
+			return fmt.Errorf("merchant overrides set a category or merchant, not a beneficiary")
+		}
+		if o.Category == "" && o.Merchant == "" {
+			return fmt.Errorf("merchant overrides need a category or merchant")
+		}
+	case TypePattern:
+		if _, err := regexp.Compile("(?i)" + o.Match); err != nil {
+			return fmt.Errorf("invalid pattern %q: %v", o.Match, err)
+		}
+		if o.Merchant != "" || o.Beneficiary != "" {
+			return fmt.Errorf("pattern overrides set a category only")
+		}
+		if o.Category == "" {
+			return fmt.Errorf("pattern overrides need a category")
+		}
+	case TypeAccount:
+		if !strings.Contains(o.Match, "@") {
+			o.Match = strings.ToUpper(strings.ReplaceAll(o.Match, " ", ""))
+			if strings.Trim(o.Match, "0123456789X*") != "" || len(strings.Trim(o.Match, "X*")) < 4 {
+				return fmt.Errorf("invalid account %q: expected an account number, its last 4 digits or a VPA", o.Match)
+			}
+		}
+		if o.Merchant != "" || o.Beneficiary != "" {
+			return fmt.Errorf("account overrides set a category only")
+		}
+	default:
+		return fmt.Errorf("unknown override type %q (expected fingerprint, vpa, merchant, pattern or account)", o.Type)
+	}
+	if o.Label != "" && o.Type != TypeAccount {
+		return fmt.Errorf("only account overrides have a label")
+	}
+	return nil
+}
+
+// Describe explains the override for ClassificationMetadata.Reason
+func (o Override) Describe() string {
+	targets := make([]string, 0, 3)
+	if o.Category != "" {
+		targets = append(targets, "category "+o.Category)
+	}
+	if o.Merchant != "" {
+		targets = append(targets, "merchant "+o.Merchant)
+	}
+	if o.Beneficiary != "" {
+		targets = append(targets, "beneficiary "+o.Beneficiary)
+	}
+	if o.Type == TypeAccount {
+		targets = append(targets, "own account")
+	}
+	match := o.Match
+	if o.Type == TypeFingerprint {
+		match = match[:12]
+	}
+	return fmt.Sprintf("User override %s: %s %q -> %s", o.ID, o.Type, match, strings.Join(targets, ", "))
+}
+
+type compiled struct {
+	override Override
+	upper    string         // Upper-cased match for vpa and merchant overrides
+	pattern  *regexp.Regexp // Pattern overrides
+}
+
+// Set is one user's overrides, ready for matching
+// A nil Set matches nothing
+type Set struct {
+	rules []compiled
+}
+
+// NewSet orders the overrides by type (fingerprint, vpa, merchant, pattern, account) and then newest first
+// Overrides that fail validation are dropped
+func NewSet(list []Override) *Set {
+	s := &Set{rules: make([]compiled, 0, len(list))}
+	for _, o := range list {
+		if err := o.validate(); err != nil {
+			continue
+		}
+		rule := compiled{override: o, upper: strings.ToUpper(o.Match)}
+		if o.Type == TypePattern {
+			rule.pattern = regexp.MustCompile("(?i)" + o.Match)
+		}
+		s.rules = append(s.rules, rule)
+	}
+	sort.SliceStable(s.rules, func(i, j int) bool {
+		a, b := s.rules[i].override, s.rules[j].override
+		if typeOrder[a.Type] != typeOrder[b.Type] {
+			return typeOrder[a.Type] < typeOrder[b.Type]
+		}
+		return a.CreatedAt.After(b.CreatedAt)
+	})
+	return s
+}
+
+// Len returns the number of overrides in the set
+func (s *Set) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.rules)
+}
+
+// Match returns the first override matching the narration or merchant
+// merchants are the names the classifier resolved (canonical and raw); empty names are ignored
+// Account overrides never match here (see OwnAccount)
+func (s *Set) Match(narration string, merchants ...string) (Override, bool) {
+	if s == nil {
+		return Override{}, false
+	}
+	upper := strings.ToUpper(narration)
+	fingerprint := ""
+	for _, rule := range s.rules {
+		switch rule.override.Type {
+		case TypeFingerprint:
+			if fingerprint == "" {
+				fingerprint = utils.FingerprintNarration(narration)
+			}
+			if fingerprint == rule.override.Match {
+				return rule.override, true
+			}
+		case TypeVPA:
+			if containsToken(upper, rule.upper) {
+				return rule.override, true
+			}
+		case TypeMerchant:
+			for _, merchant := range merchants {
+				if merchant != "" && strings.EqualFold(strings.TrimSpace(merchant), rule.override.Match) {
+					return rule.override, true
+				}
+			}
+			if containsToken(upper, rule.upper) {
+				return rule.override, true
+			}
+		case TypePattern:
+			if rule.pattern.MatchString(narration) {
+				return rule.override, true
+			}
+		}
+	}
+	return Override{}, false
+}
+
+// OwnAccount returns the user's account that is the counterparty of txn: a registered VPA
+// the UPI payment was made to or from, a registered number matching the counterparty account
+// of the parsed narration (masked accounts match on the digits shown), or a full account
+// number written in the narration
+func (s *Set) OwnAccount(txn models.ClassifiedTransaction) (Override, bool) {
+	if s == nil {
+		return Override{}, false
+	}
+	var accounts []string
+	if upi := txn.UPI; upi != nil && upi.VPA == "" && upi.Account != "" {
+		accounts = append(accounts, upi.Account) // With a VPA the account is the payer's own (REV-UPI)
+	}
+	if fields := txn.NarrationFields; fields != nil && fields.Account != "" {
+		accounts = append(accounts, fields.Account)
+	}
+	compact := ""
+	for _, rule := range s.rules {
+		if rule.override.Type != TypeAccount {
+			continue
+		}
+		if strings.Contains(rule.upper, "@") {
+			if txn.UPI != nil && strings.EqualFold(txn.UPI.VPA, rule.upper) {
+				return rule.override, true
+			}
+			continue
+		}
+		for _, account := range accounts {
+			if utils.SameAccountNumber(account, rule.upper) {
+				return rule.override, true
+			}
+		}
+		if len(rule.upper) >= 9 && !strings.ContainsAny(rule.upper, "X*") {
+			if compact == "" {
+				compact = strings.ToUpper(strings.ReplaceAll(txn.Narration, " ", ""))
+			}
+			if containsToken(compact, rule.upper) {
+				return rule.override, true
+			}
+		}
+	}
+	return Override{}, false
+}
+
+// AccountName names an account override: its label, else the masked number or the VPA
+func (o Override) AccountName() string {
+	switch {
+	case o.Label != "":
+		return o.Label
+	case strings.Contains(o.Match, "@"):
+		return o.Match
+	default:
+		return utils.MaskAccountNumber(strings.Trim(o.Match, "X*"))
+	}
+}
+
+// containsToken reports whether token appears in text without letters or digits on either side
+// so that a merchant override for "OLA" does not match "COLA"
+func containsToken(text, token string) bool {
+	for start := 0; ; {
+		i := strings.Index(text[start:], token)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(token)
+		if (i == 0 || !isWordChar(text[i-1])) && (end == len(text) || !isWordChar(text[end])) {
+			return true
+		}
+		start = i + 1
+	}
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,243 @@
+package overrides
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		override Override
+		expected string // Empty when valid
+	}{
+		{"vpa to beneficiary", Override{Type: "VPA", Match: " landlord@okaxis ", Beneficiary: "Landlord", Category: "Rent"}, ""},
+		{"merchant remap", Override{Type: "merchant", Match: "SIMPL", Category: "Loan"}, ""},
+		{"pattern", Override{Type: "pattern", Match: `NACH.*MAINTENANCE`, Category: "Bills_Utilities"}, ""},
+		{"category outside the taxonomy", Override{Type: "merchant", Match: "SIMPL", Category: "BNPL"}, "not in the category taxonomy"},
+		{"fingerprint", Override{Type: "fingerprint", Match: strings.Repeat("AB", 32), Category: "Groceries"}, ""},
+		{"short fingerprint", Override{Type: "fingerprint", Match: "abc123", Category: "Groceries"}, "invalid fingerprint"},
+		{"missing match", Override{Type: "merchant", Category: "Loan"}, "match is required"},
+		{"unknown type", Override{Type: "amount", Match: "500", Category: "Rent"}, "unknown override type"},
+		{"vpa without handle", Override{Type: "vpa", Match: "landlord", Category: "Rent"}, "invalid VPA"},
+		{"vpa without target", Override{Type: "vpa", Match: "landlord@okaxis"}, "need a beneficiary or category"},
+		{"merchant with beneficiary", Override{Type: "merchant", Match: "SIMPL", Beneficiary: "X"}, "not a beneficiary"},
+		{"bad pattern", Override{Type: "pattern", Match: "(", Category: "Rent"}, "invalid pattern"},
+		{"pattern without category", Override{Type: "pattern", Match: "RENT"}, "need a category"},
+		{"account number", Override{Type: "account", Match: "5010 0123 4567 89", Label: "HDFC savings"}, ""},
+		{"account last 4", Override{Type: "account", Match: "xx1725"}, ""},
+		{"account vpa", Override{Type: "account", Match: "me@okicici", Category: "Investment"}, ""},
+		{"short account", Override{Type: "account", Match: "725"}, "invalid account"},
+		{"account with letters", Override{Type: "account", Match: "SAVINGS"}, "invalid account"},
+		{"account with beneficiary", Override{Type: "account", Match: "1725", Beneficiary: "Me"}, "category only"},
+		{"label on merchant", Override{Type: "merchant", Match: "SIMPL", Category: "Loan", Label: "X"}, "only account overrides"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.override.Validate()
+			if tt.expected == "" && err != nil {
+				t.Errorf("expected valid, got %v", err)
+			}
+			if tt.expected != "" && (err == nil || !strings.Contains(err.Error(), tt.expected)) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestSetMatch(t *testing.T) {
+	now := time.Now()
+	set := NewSet([]Override{
+		{ID: "pattern", Type: TypePattern, Match: `RENT`, Category: "Rent", CreatedAt: now},
+		{ID: "ola-old", Type: TypeMerchant, Match: "OLA", Category: "Travel", CreatedAt: now.Add(-time.Hour)},
+		{ID: "ola-new", Type: TypeMerchant, Match: "OLA", Category: "Commute", CreatedAt: now},
+		{ID: "vpa", Type: TypeVPA, Match: "landlord@okaxis", Beneficiary: "Landlord", CreatedAt: now.Add(-time.Hour)},
+		{ID: "invalid", Type: TypePattern, Match: "(", Category: "Rent"},
+	})
+	if set.Len() != 4 {
+		t.Fatalf("expected the invalid override to be dropped, got %d overrides", set.Len())
+	}
+
+	tests := []struct {
+		narration string
+		merchants []string
+		expected  string // Override ID, empty for no match
+	}{
+		{"UPI-RAMESH-LANDLORD@OKAXIS-UTIB0000123-512345678901-RENT NOV", nil, "vpa"},
+		{"UPI-RAMESH-XLANDLORD@OKAXIS-UTIB0000123-512345678901-NOV", nil, ""},
+		{"UPI-OLA CABS-OLA.MONEY@AXISBANK-UTIB0000100-512345678902-RIDE", nil, "ola-new"},
+		{"POS 4591XXXXXXXX1234 COCA COLA STORE", nil, ""},
+		{"POS 4591XXXXXXXX1234 ANI TECHNOLOGIES", []string{"", "ola"}, "ola-new"},
+		{"NEFT-FLAT RENT DECEMBER", nil, "pattern"},
+		{"NEFT-SALARY DECEMBER", nil, ""},
+	}
+	for _, tt := range tests {
+		override, ok := set.Match(tt.narration, tt.merchants...)
+		if override.ID != tt.expected || ok != (tt.expected != "") {
+			t.Errorf("%s: expected %q, got %q (%v)", tt.narration, tt.expected, override.ID, ok)
+		}
+	}
+
+	var empty *Set
+	if _, ok := empty.Match("UPI-RENT"); ok {
+		t.Error("expected a nil set to match nothing")
+	}
+}
+
+func TestSetOwnAccount(t *testing.T) {
+	set := NewSet([]Override{
+		{ID: "hdfc", Type: TypeAccount, Match: "50100123456789", Label: "HDFC savings"},
+		{ID: "icici", Type: TypeAccount, Match: "XX1725"},
+		{ID: "vpa", Type: TypeAccount, Match: "me@okicici"},
+		{ID: "merchant", Type: TypeMerchant, Match: "OLA", Category: "Travel"},
+	})
+
+	tests := []struct {
+		name     string
+		txn      models.ClassifiedTransaction
+		expected string // Override ID, empty for no match
+	}{
+		{"upi to own vpa", models.ClassifiedTransaction{Narration: "UPI-ME-ME@OKICICI-ICIC0000001-512345678901-SELF",
+			UPI: &models.UPIDetails{VPA: "ME@OKICICI"}}, "vpa"},
+		{"neft to masked last 4", models.ClassifiedTransaction{Narration: "NEFT DR-ICIC0000001-ME-NETBANK",
+			NarrationFields: &models.NarrationFields{Rail: "NEFT", Account: "XXXXXXXX1725"}}, "icici"},
+		{"imps to full number", models.ClassifiedTransaction{Narration: "IMPS-512345678901-ME-HDFC-XXXXXXXXXX6789-SELF",
+			NarrationFields: &models.NarrationFields{Rail: "IMPS", Account: "XXXXXXXXXX6789"}}, "hdfc"},
+		{"number in narration", models.ClassifiedTransaction{Narration: "FT - DR - 50100123456789 - SELF"}, "hdfc"},
+		{"other account", models.ClassifiedTransaction{Narration: "NEFT DR-SBIN0000001-RAVI",
+			NarrationFields: &models.NarrationFields{Rail: "NEFT", Account: "XXXXXXXX9999"}}, ""},
+		// The account of a REV-UPI narration is the user's own, not the payee's
+		{"rev-upi", models.ClassifiedTransaction{Narration: "REV-UPI-50100123451725-SHOP@OKSBI-REFUND",
+			UPI: &models.UPIDetails{VPA: "SHOP@OKSBI", Account: "50100123451725"}}, ""},
+	}
+	for _, tt := range tests {
+		override, ok := set.OwnAccount(tt.txn)
+		if override.ID != tt.expected || ok != (tt.expected != "") {
+			t.Errorf("%s: expected %q, got %q (%v)", tt.name, tt.expected, override.ID, ok)
+		}
+	}
+
+	names := map[string]string{"hdfc": "HDFC savings", "icici": "XXXXXX1725", "vpa": "me@okicici"}
+	for _, rule := range set.rules {
+		if expected, ok := names[rule.override.ID]; ok && rule.override.AccountName() != expected {
+			t.Errorf("%s: expected name %q, got %q", rule.override.ID, expected, rule.override.AccountName())
+		}
+	}
+	if _, ok := set.Match("UPI-ME-ME@OKICICI-ICIC0000001-512345678901-SELF"); ok {
+		t.Error("expected account overrides to be left to OwnAccount")
+	}
+}
+
+func TestStorePersistsPerUser(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "overrides.json")
+	store, err := NewStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := store.Add(Override{TenantID: "acme", UserID: "alice", Type: TypeMerchant, Match: "SIMPL", Category: "Loan"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Add(Override{TenantID: "acme", UserID: "bob", Type: TypeMerchant, Match: "SIMPL", Category: "Shopping"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Add(Override{Type: TypeMerchant, Match: "SIMPL", Category: "Loan"}); err == nil {
+		t.Error("expected an override without a user to be rejected")
+	}
+
+	reopened, err := NewStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := reopened.List("acme", "alice")
+	if len(list) != 1 || list[0].ID != created.ID || list[0].Category != "Loan" {
+		t.Fatalf("expected alice's override to persist, got %+v", list)
+	}
+	if removed, _ := reopened.Remove("acme", "bob", created.ID); removed {
+		t.Error("expected bob not to remove alice's override")
+	}
+	if removed, err := reopened.Remove("acme", "alice", created.ID); !removed || err != nil {
+		t.Errorf("expected alice's override to be removed, got %v, %v", removed, err)
+	}
+
+	reopened, err = NewStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reopened.List("acme", "alice")) != 0 || len(reopened.List("acme", "bob")) != 1 {
+		t.Errorf("expected only bob's override after removal, got %+v", reopened.List("acme", "bob"))
+	}
+}
+
+func TestStoreKeepsCategoriesOutsideTheTaxonomy(t *testing.T) {
+	// Stored under an earlier taxonomy that had BNPL
+	path := filepath.Join(t.TempDir(), "overrides.json")
+	data := `[{"id": "ovr_simpl", "tenantId": "acme", "userId": "alice", "type": "merchant", "match": "SIMPL", "category": "BNPL"}]`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	store, err := NewStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set := store.For("acme", "alice"); set.Len() != 1 {
+		t.Errorf("expected the stored override to apply, got %d overrides", set.Len())
+	}
+
+	created, err := store.Add(Override{TenantID: "acme", UserID: "alice", Type: TypeMerchant, Match: "LAZYPAY", Category: "loan_emi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Category != "Loan" {
+		t.Errorf("expected the alias to resolve to Loan, got %s", created.Category)
+	}
+}
+
+func TestHandlerScopesToCaller(t *testing.T) {
+	store, err := NewStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The subject stands in for the authenticated user the server's Caller returns
+	handler := Handler(store, func(r *http.Request) (string, string, *slog.Logger) {
+		return "acme", r.Header.Get("X-Subject"), slog.Default()
+	})
+	call := func(subject, method, target, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, target, strings.NewReader(body))
+		r.Header.Set("X-Subject", subject)
+		rec := httptest.NewRecorder()
+		handler(rec, r)
+		return rec
+	}
+
+	rec := call("alice", http.MethodPost, "/api/overrides",
+		`{"type":"vpa","match":"landlord@okaxis","beneficiary":"Landlord","category":"Rent","userId":"mallory"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+	list := store.List("acme", "alice")
+	if len(list) != 1 || list[0].UserID != "alice" {
+		t.Fatalf("expected the override to belong to the caller, got %+v", list)
+	}
+
+	if rec := call("bob", http.MethodGet, "/api/overrides", ""); !strings.Contains(rec.Body.String(), `"overrides":[]`) {
+		t.Errorf("expected bob to see no overrides, got %s", rec.Body)
+	}
+	if rec := call("bob", http.MethodDelete, "/api/overrides?id="+list[0].ID, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 deleting another user's override, got %d", rec.Code)
+	}
+	if rec := call("alice", http.MethodPost, "/api/overrides", `{"type":"pattern","match":"("}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid override, got %d", rec.Code)
+	}
+	if rec := call("alice", http.MethodDelete, "/api/overrides?id="+list[0].ID, ""); rec.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,133 @@
+package overrides
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"classify/statement_analysis_engine_rules/fileutil"
+	"classify/statement_analysis_engine_rules/ids"
+)
+
+// Store holds every user's overrides, optionally persisted to a JSON file
+type Store struct {
+	mu        sync.RWMutex
+	path      string // Empty keeps overrides in memory only
+	overrides map[string]*Override
+}
+
+// NewStore opens the store; an empty path keeps overrides in memory and a missing file starts empty
+func NewStore(path string) (*Store, error) {
+	s := &Store{path: path, overrides: make(map[string]*Override)}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overrides: %w", err)
+	}
+	var list []Override
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse overrides: %w", err)
+	}
+	for i := range list {
+		o := list[i]
+		if err := o.validate(); err != nil {
+			return nil, fmt.Errorf("override %s: %w", o.ID, err)
+		}
+		s.overrides[o.ID] = &o
+	}
+	return s, nil
+}
+
+// Add validates and stores o, generating an ID when missing
+func (s *Store) Add(o Override) (Override, error) {
+	if err := o.Validate(); err != nil {
+		return Override{}, err
+	}
+	if o.TenantID == "" || o.UserID == "" {
+		return Override{}, fmt.Errorf("override must have a tenant and user")
+	}
+	if o.ID == "" {
+		o.ID = ids.New("ovr_")
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = time.Now().UTC()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.overrides[o.ID]; exists {
+		return Override{}, fmt.Errorf("override %s already exists", o.ID)
+	}
+	stored := o
+	s.overrides[o.ID] = &stored
+	if err := s.save(); err != nil {
+		delete(s.overrides, o.ID)
+		return Override{}, err
+	}
+	return o, nil
+}
+
+// Remove deletes a user's override, reporting whether it existed
+func (s *Store) Remove(tenantID, userID, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.overrides[id]
+	if !ok || o.TenantID != tenantID || o.UserID != userID {
+		return false, nil
+	}
+	delete(s.overrides, id)
+	if err := s.save(); err != nil {
+		s.overrides[id] = o
+		return false, err
+	}
+	return true, nil
+}
+
+// List returns a user's overrides ordered by creation time
+func (s *Store) List(tenantID, userID string) []Override {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]Override, 0)
+	for _, o := range s.overrides {
+		if o.TenantID == tenantID && o.UserID == userID {
+			result = append(result, *o)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result
+}
+
+// For returns a user's overrides for the classifier
+func (s *Store) For(tenantID, userID string) *Set {
+	return NewSet(s.List(tenantID, userID))
+}
+
+// save writes all overrides to the file (temp file and rename); callers hold mu
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	list := make([]Override, 0, len(s.overrides))
+	for _, o := range s.overrides {
+		list = append(list, *o)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := fileutil.WriteAtomic(s.path, data); err != nil {
+		return fmt.Errorf("failed to save overrides: %w", err)
+	}
+	return nil
+}
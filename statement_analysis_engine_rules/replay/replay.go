@@ -0,0 +1,343 @@
+// Package replay reclassifies a stored corpus under two rule packs and reports what changed
+//
+// The before side is either another pack or the classifications stored with the
+// corpus (their ClassificationMetadata.RuleVersion names the version). The Diff
+// lists every transaction whose category, method or merchant changed, the
+// category transition matrix and the amount-weighted impact on the category and
+// monthly summaries, so a rule release can be gated on it.
+//
+// Replay swaps the process-wide active rules while it runs (see
+// utils.SetActiveRules); do not run it next to live classification.
+package replay
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/analytics"
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Statement is one account statement of the corpus
+// Transactions are reclassified from their narration and amounts; their stored
+// classification is only read when it is the before side
+type Statement struct {
+	Name         string                         `json:"name"` // File name or other identifier
+	CustomerName string                         `json:"customerName,omitempty"`
+	Transactions []models.ClassifiedTransaction `json:"transactions"`
+}
+
+// Diff is what changed between two classifications of a corpus
+type Diff struct {
+	BeforeVersion string           `json:"beforeVersion"` // Rule version(s) of the before side, comma-separated when mixed
+	AfterVersion  string           `json:"afterVersion"`
+	Statements    int              `json:"statements"`
+	Transactions  int              `json:"transactions"`
+	Changed       int              `json:"changed"`       // Transactions whose category, method or merchant changed
+	Recategorized int              `json:"recategorized"` // Transactions whose category changed
+	AmountMoved   float64          `json:"amountMoved"`   // Withdrawals plus deposits of the recategorized transactions
+	Changes       []Change         `json:"changes"`
+	Transitions   []Transition     `json:"transitions"` // Category transition matrix, one cell per before/after pair
+	Categories    []CategoryImpact `json:"categories"`  // Every category of the summary tree, in before-then-after order
+	Months        []MonthImpact    `json:"months"`
+}
+
+// Change is one transaction classified differently
+type Change struct {
+	Statement  string         `json:"statement"`
+	Row        int            `json:"row"` // 1-based position in the statement
+	Date       string         `json:"date"`
+	Narration  string         `json:"narration"`
+	Withdrawal float64        `json:"withdrawal"`
+	Deposit    float64        `json:"deposit"`
+	Fields     []string       `json:"fields"` // Which of category, method and merchant changed
+	Before     Classification `json:"before"`
+	After      Classification `json:"after"`
+}
+
+// Classification is the part of a classified transaction the diff compares
+type Classification struct {
+	Category    string  `json:"category"`
+	Method      string  `json:"method"`
+	Merchant    string  `json:"merchant"`
+	Confidence  float64 `json:"confidence"`
+	Reason      string  `json:"reason"`
+	RuleVersion string  `json:"ruleVersion"`
+}
+
+// Transition is one cell of the category transition matrix
+type Transition struct {
+	From         string  `json:"from"`
+	To           string  `json:"to"`
+	Transactions int     `json:"transactions"`
+	Amount       float64 `json:"amount"` // Withdrawals plus deposits
+}
+
+// CategoryImpact compares one category of the summary tree (see analytics.CalculateCategorySummary)
+type CategoryImpact struct {
+	Category       string  `json:"category"` // Taxonomy path, e.g. Food/Dining
+	SpentBefore    float64 `json:"spentBefore"`
+	SpentAfter     float64 `json:"spentAfter"`
+	SpentDelta     float64 `json:"spentDelta"`
+	ReceivedBefore float64 `json:"receivedBefore"`
+	ReceivedAfter  float64 `json:"receivedAfter"`
+	ReceivedDelta  float64 `json:"receivedDelta"`
+	CountBefore    int     `json:"countBefore"`
+	CountAfter     int     `json:"countAfter"`
+}
+
+// MonthImpact compares one month of one statement (see analytics.CalculateMonthlySummary)
+type MonthImpact struct {
+	Statement         string  `json:"statement"`
+	Month             string  `json:"month"`
+	IncomeBefore      float64 `json:"incomeBefore"`
+	IncomeAfter       float64 `json:"incomeAfter"`
+	IncomeDelta       float64 `json:"incomeDelta"`
+	ExpenseBefore     float64 `json:"expenseBefore"`
+	ExpenseAfter      float64 `json:"expenseAfter"`
+	ExpenseDelta      float64 `json:"expenseDelta"`
+	TopCategoryBefore string  `json:"topCategoryBefore"`
+	TopCategoryAfter  string  `json:"topCategoryAfter"`
+}
+
+// Run classifies the corpus under before and after and diffs the results
+// A nil before uses the classifications stored in the corpus
+func Run(corpus []Statement, before, after *rulepack.Pack) (*Diff, error) {
+	if after == nil {
+		return nil, fmt.Errorf("replay needs an after rule pack")
+	}
+	var beforeSide [][]models.ClassifiedTransaction
+	if before == nil {
+		beforeSide = make([][]models.ClassifiedTransaction, len(corpus))
+		for i, statement := range corpus {
+			beforeSide[i] = statement.Transactions
+		}
+	} else {
+		classified, err := classifyAll(corpus, before)
+		if err != nil {
+			return nil, fmt.Errorf("before pack: %w", err)
+		}
+		beforeSide = classified
+	}
+	afterSide, err := classifyAll(corpus, after)
+	if err != nil {
+		return nil, fmt.Errorf("after pack: %w", err)
+	}
+	return Compare(corpus, beforeSide, afterSide), nil
+}
+
+// classifyAll classifies every statement with pack active, then restores the previous rules
+func classifyAll(corpus []Statement, pack *rulepack.Pack) ([][]models.ClassifiedTransaction, error) {
+	rules, err := pack.Compile()
+	if err != nil {
+		return nil, err
+	}
+	previous := utils.SetActiveRules(rules)
+	defer utils.SetActiveRules(previous)
+
+	classified := make([][]models.ClassifiedTransaction, len(corpus))
+	for i, statement := range corpus {
+		transactions := make([]models.ClassifiedTransaction, len(statement.Transactions))
+		for j, txn := range statement.Transactions {
+			transactions[j] = classifier.ConvertFromTxtTransaction(txn.Date, txn.Narration, txn.ChequeRefNo, txn.ValueDate,
+				txn.WithdrawalAmt, txn.DepositAmt, txn.ClosingBalance)
+		}
+		classified[i] = classifier.ClassifyTransactions(transactions, statement.CustomerName, nil)
+	}
+	return classified, nil
+}
+
+// Compare diffs two classifications of the corpus, statement by statement and row by row
+func Compare(corpus []Statement, before, after [][]models.ClassifiedTransaction) *Diff {
+	diff := &Diff{
+		BeforeVersion: versions(before),
+		AfterVersion:  versions(after),
+		Statements:    len(corpus),
+		Changes:       make([]Change, 0),
+		Transitions:   make([]Transition, 0),
+		Categories:    make([]CategoryImpact, 0),
+		Months:        make([]MonthImpact, 0),
+	}
+
+	transitions := make(map[[2]string]*Transition)
+	var allBefore, allAfter []models.ClassifiedTransaction
+	for i, statement := range corpus {
+		for row := range before[i] {
+			b, a := before[i][row], after[i][row]
+			diff.Transactions++
+			amount := b.WithdrawalAmt + b.DepositAmt
+
+			key := [2]string{categoryOf(b), categoryOf(a)}
+			cell, ok := transitions[key]
+			if !ok {
+				cell = &Transition{From: key[0], To: key[1]}
+				transitions[key] = cell
+			}
+			cell.Transactions++
+			cell.Amount += amount
+
+			var fields []string
+			if key[0] != key[1] {
+				fields = append(fields, "category")
+				diff.Recategorized++
+				diff.AmountMoved += amount
+			}
+			if b.Method != a.Method {
+				fields = append(fields, "method")
+			}
+			if b.Merchant != a.Merchant {
+				fields = append(fields, "merchant")
+			}
+			if len(fields) == 0 {
+				continue
+			}
+			diff.Changed++
+			diff.Changes = append(diff.Changes, Change{
+				Statement:  statement.Name,
+				Row:        row + 1,
+				Date:       b.Date,
+				Narration:  b.Narration,
+				Withdrawal: b.WithdrawalAmt,
+				Deposit:    b.DepositAmt,
+				Fields:     fields,
+				Before:     classificationOf(b),
+				After:      classificationOf(a),
+			})
+		}
+		allBefore = append(allBefore, before[i]...)
+		allAfter = append(allAfter, after[i]...)
+		diff.Months = append(diff.Months, monthImpacts(statement.Name, before[i], after[i])...)
+	}
+	diff.AmountMoved = round(diff.AmountMoved)
+
+	for _, cell := range transitions {
+		cell.Amount = round(cell.Amount)
+		diff.Transitions = append(diff.Transitions, *cell)
+	}
+	sort.Slice(diff.Transitions, func(i, j int) bool {
+		if diff.Transitions[i].From != diff.Transitions[j].From {
+			return diff.Transitions[i].From < diff.Transitions[j].From
+		}
+		return diff.Transitions[i].To < diff.Transitions[j].To
+	})
+
+	diff.Categories = categoryImpacts(
+		analytics.CalculateCategorySummary(allBefore).Tree,
+		analytics.CalculateCategorySummary(allAfter).Tree,
+	)
+	return diff
+}
+
+// versions lists the distinct rule versions of a classification
+func versions(classified [][]models.ClassifiedTransaction) string {
+	seen := make(map[string]bool)
+	var list []string
+	for _, transactions := range classified {
+		for _, txn := range transactions {
+			if version := txn.ClassificationMetadata.RuleVersion; !seen[version] {
+				seen[version] = true
+				list = append(list, version)
+			}
+		}
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
+
+func categoryOf(txn models.ClassifiedTransaction) string {
+	if txn.Category == "" {
+		return "Other"
+	}
+	return txn.Category
+}
+
+func classificationOf(txn models.ClassifiedTransaction) Classification {
+	return Classification{
+		Category:    categoryOf(txn),
+		Method:      txn.Method,
+		Merchant:    txn.Merchant,
+		Confidence:  txn.ClassificationMetadata.Confidence,
+		Reason:      txn.ClassificationMetadata.Reason,
+		RuleVersion: txn.ClassificationMetadata.RuleVersion,
+	}
+}
+
+// categoryImpacts pairs the nodes of two summary trees by taxonomy path
+func categoryImpacts(before, after []models.CategoryNode) []CategoryImpact {
+	var order []string
+	impacts := make(map[string]*CategoryImpact)
+	impact := func(path string) *CategoryImpact {
+		if c, ok := impacts[path]; ok {
+			return c
+		}
+		c := &CategoryImpact{Category: path}
+		impacts[path] = c
+		order = append(order, path)
+		return c
+	}
+	walk(before, "", func(path string, node models.CategoryNode) {
+		c := impact(path)
+		c.SpentBefore, c.ReceivedBefore, c.CountBefore = node.Spent, node.Received, node.Count
+	})
+	walk(after, "", func(path string, node models.CategoryNode) {
+		c := impact(path)
+		c.SpentAfter, c.ReceivedAfter, c.CountAfter = node.Spent, node.Received, node.Count
+	})
+
+	list := make([]CategoryImpact, 0, len(order))
+	for _, path := range order {
+		c := impacts[path]
+		c.SpentBefore, c.SpentAfter = round(c.SpentBefore), round(c.SpentAfter)
+		c.ReceivedBefore, c.ReceivedAfter = round(c.ReceivedBefore), round(c.ReceivedAfter)
+		c.SpentDelta = round(c.SpentAfter - c.SpentBefore)
+		c.ReceivedDelta = round(c.ReceivedAfter - c.ReceivedBefore)
+		list = append(list, *c)
+	}
+	return list
+}
+
+// walk visits every node of a summary tree with its slash-separated path
+func walk(nodes []models.CategoryNode, parent string, visit func(path string, node models.CategoryNode)) {
+	for _, node := range nodes {
+		path := node.Category
+		if parent != "" {
+			path = parent + "/" + node.Category
+		}
+		visit(path, node)
+		walk(node.Children, path, visit)
+	}
+}
+
+// monthImpacts pairs the monthly summaries of one statement by month
+func monthImpacts(statement string, before, after []models.ClassifiedTransaction) []MonthImpact {
+	afterByMonth := make(map[string]models.MonthlySummary)
+	for _, month := range analytics.CalculateMonthlySummary(after) {
+		afterByMonth[month.Month] = month
+	}
+	var impacts []MonthImpact
+	for _, b := range analytics.CalculateMonthlySummary(before) {
+		a := afterByMonth[b.Month]
+		impacts = append(impacts, MonthImpact{
+			Statement:         statement,
+			Month:             b.Month,
+			IncomeBefore:      round(b.Income),
+			IncomeAfter:       round(a.Income),
+			IncomeDelta:       round(a.Income - b.Income),
+			ExpenseBefore:     round(b.Expense),
+			ExpenseAfter:      round(a.Expense),
+			ExpenseDelta:      round(a.Expense - b.Expense),
+			TopCategoryBefore: b.TopCategory,
+			TopCategoryAfter:  a.TopCategory,
+		})
+	}
+	return impacts
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,92 @@
+package replay_test
+
+import (
+	"reflect"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/replay"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+const afterPack = `{
+  "version": "v9.0.0-replay",
+  "name": "replay",
+  "categoryRules": [
+    {"id": "ganesh-travel", "category": "Travel", "keywords": ["MAHA GANESH TRADERS"], "confidence": 0.9}
+  ]
+}`
+
+func testCorpus() []replay.Statement {
+	return []replay.Statement{{
+		Name:         "april.txt",
+		CustomerName: "RAHUL VERMA",
+		Transactions: []models.ClassifiedTransaction{
+			classifier.ConvertFromTxtTransaction("04/04/25", "UPI-MAHA GANESH TRADERS-PAYTMQR641Q4E@PTYS-YESB0PTMUPI-102596879732-UPI", "", "04/04/25", 210, 0, 9790),
+			classifier.ConvertFromTxtTransaction("05/04/25", "UPI-MAHA GANESH TRADERS-PAYTMQR6D4KH9@PTYS-YESB0PTMUPI-108852480113-UPI", "", "05/04/25", 300, 0, 9490),
+			classifier.ConvertFromTxtTransaction("06/04/25", "UPI-SWIGGY-SWIGGY.STORES@ICICI-ICIC0DC0099-123456789012-PAYMENT", "", "06/04/25", 450, 0, 9040),
+		},
+	}}
+}
+
+func TestRun(t *testing.T) {
+	after, err := rulepack.Parse([]byte(afterPack), "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	active := utils.ActiveRules()
+
+	diff, err := replay.Run(testCorpus(), rulepack.Default(), after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if utils.ActiveRules() != active {
+		t.Errorf("expected the active rules to be restored")
+	}
+
+	if diff.AfterVersion != "v9.0.0-replay" || diff.BeforeVersion != rulepack.Default().Version {
+		t.Errorf("expected versions %s -> v9.0.0-replay, got %s -> %s", rulepack.Default().Version, diff.BeforeVersion, diff.AfterVersion)
+	}
+	if diff.Transactions != 3 || diff.Recategorized != 2 || diff.AmountMoved != 510 {
+		t.Fatalf("expected 2 of 3 recategorized moving 510, got %d of %d moving %v", diff.Recategorized, diff.Transactions, diff.AmountMoved)
+	}
+	change := diff.Changes[0]
+	if change.Row != 1 || change.Before.Category != "Groceries" || change.After.Category != "Travel" || change.After.RuleVersion != "v9.0.0-replay" {
+		t.Errorf("unexpected change %+v", change)
+	}
+
+	expected := []replay.Transition{
+		{From: "Food_Delivery", To: "Food_Delivery", Transactions: 1, Amount: 450},
+		{From: "Groceries", To: "Travel", Transactions: 2, Amount: 510},
+	}
+	if !reflect.DeepEqual(diff.Transitions, expected) {
+		t.Errorf("expected %+v, got %+v", expected, diff.Transitions)
+	}
+
+	deltas := make(map[string]float64)
+	for _, c := range diff.Categories {
+		deltas[c.Category] = c.SpentDelta
+	}
+	if deltas["Food/Groceries"] != -510 || deltas["Transport/Travel"] != 510 || deltas["Food/Food_Delivery"] != 0 {
+		t.Errorf("unexpected category deltas %v", deltas)
+	}
+	if len(diff.Months) != 1 || diff.Months[0].TopCategoryBefore != "Groceries" || diff.Months[0].TopCategoryAfter != "Travel" {
+		t.Errorf("unexpected months %+v", diff.Months)
+	}
+}
+
+func TestRunAgainstStoredClassifications(t *testing.T) {
+	corpus := testCorpus()
+	corpus[0].Transactions = classifier.ClassifyTransactions(corpus[0].Transactions, corpus[0].CustomerName, nil)
+	corpus[0].Transactions[2].Category = "Dining" // Stored under an older rule
+
+	diff, err := replay.Run(corpus, nil, rulepack.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.Recategorized != 1 || diff.Changes[0].Row != 3 || diff.Changes[0].Before.Category != "Dining" || diff.Changes[0].After.Category != "Food_Delivery" {
+		t.Errorf("expected row 3 Dining -> Food_Delivery, got %+v", diff.Changes)
+	}
+}
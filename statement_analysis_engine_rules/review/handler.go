@@ -0,0 +1,137 @@
+package review
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"classify/statement_analysis_engine_rules/overrides"
+)
+
+// ListResponse is returned by GET /api/review
+type ListResponse struct {
+	Success bool   `json:"success"`
+	Items   []Item `json:"items"`
+}
+
+// CorrectionResponse is returned by POST /api/review/corrections
+type CorrectionResponse struct {
+	Success    bool       `json:"success"`
+	Correction Correction `json:"correction"`
+}
+
+// CorrectionListResponse is returned by GET /api/review/corrections
+type CorrectionListResponse struct {
+	Success     bool         `json:"success"`
+	Corrections []Correction `json:"corrections"`
+}
+
+// ReportResponse is returned by GET /api/review/report
+type ReportResponse struct {
+	Success bool         `json:"success"`
+	Rules   []RuleReport `json:"rules"`
+}
+
+// ErrorResponse is returned when a review request fails
+type ErrorResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+}
+
+// Handler serves the caller's review queue; caller returns the authenticated user
+//
+//	GET    ?status=pending|corrected|dismissed (default pending; "all" for every item)
+//	DELETE ?id=... dismiss an item without correcting it
+func Handler(queue *Queue, caller overrides.Caller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tenantID, userID, logger := caller(r)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			status := r.URL.Query().Get("status")
+			switch status {
+			case "":
+				status = StatusPending
+			case "all":
+				status = ""
+			case StatusPending, StatusCorrected, StatusDismissed:
+			default:
+				writeError(w, http.StatusBadRequest, "unknown status "+status)
+				return
+			}
+			json.NewEncoder(w).Encode(ListResponse{Success: true, Items: queue.List(tenantID, userID, status)})
+
+		case http.MethodDelete:
+			if err := queue.Dismiss(tenantID, userID, r.URL.Query().Get("id")); err != nil {
+				writeFailure(w, logger, err)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	}
+}
+
+// CorrectionHandler records corrections of the user caller returns
+//
+//	GET  list the caller's corrections
+//	POST {itemId or narration, category}
+func CorrectionHandler(queue *Queue, caller overrides.Caller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tenantID, userID, logger := caller(r)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(CorrectionListResponse{Success: true, Corrections: queue.Corrections(tenantID, userID)})
+
+		case http.MethodPost:
+			var req CorrectionRequest
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(&req); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid JSON body")
+				return
+			}
+			correction, err := queue.Correct(tenantID, userID, req)
+			if err != nil {
+				writeFailure(w, logger, err)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(CorrectionResponse{Success: true, Correction: correction})
+
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	}
+}
+
+// ReportHandler reports which rules the caller's tenant corrects most often
+func ReportHandler(queue *Queue, caller overrides.Caller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tenantID, _, _ := caller(r)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ReportResponse{Success: true, Rules: queue.Report(tenantID)})
+	}
+}
+
+// writeFailure maps queue errors to 404, 400 or (failed to save) 500
+func writeFailure(w http.ResponseWriter, logger *slog.Logger, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrInvalid):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.Error("failed to save review queue", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "failed to save review queue")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Success: false, Error: message})
+}
@@ -0,0 +1,461 @@
+// Package review collects low-confidence and "Other" classifications into a per-user review queue
+// Corrections are recorded against the narration fingerprint (utils.FingerprintNarration) and saved
+// as fingerprint overrides, so they apply to every matching transaction from then on
+package review
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"classify/statement_analysis_engine_rules/fileutil"
+	"classify/statement_analysis_engine_rules/ids"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+	"classify/statement_analysis_engine_rules/taxonomy"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Item statuses
+const (
+	StatusPending   = "pending"
+	StatusCorrected = "corrected"
+	StatusDismissed = "dismissed"
+)
+
+// DefaultThreshold queues transactions classified with less confidence than this
+const DefaultThreshold = 0.6
+
+// Errors returned by the queue; other errors come from saving it
+var (
+	ErrNotFound = errors.New("review item not found")
+	ErrInvalid  = errors.New("invalid correction")
+)
+
+// Item is one narration pattern awaiting review; repeated narrations share an item
+type Item struct {
+	ID                string    `json:"id"`
+	TenantID          string    `json:"tenantId"`
+	UserID            string    `json:"userId"`
+	Fingerprint       string    `json:"fingerprint"`
+	Narration         string    `json:"narration"` // Latest occurrence
+	Date              string    `json:"date"`
+	Amount            float64   `json:"amount"`
+	Category          string    `json:"category"`
+	Merchant          string    `json:"merchant,omitempty"`
+	Confidence        float64   `json:"confidence"`
+	Rule              string    `json:"rule"` // ClassificationMetadata.Reason of the deciding rule
+	RuleVersion       string    `json:"ruleVersion"`
+	Occurrences       int       `json:"occurrences"` // Distinct transactions seen with this narration pattern
+	TransactionRefs   []string  `json:"transactionRefs"`
+	Status            string    `json:"status"`
+	CorrectedCategory string    `json:"correctedCategory,omitempty"`
+	FirstSeen         time.Time `json:"firstSeen"`
+	LastSeen          time.Time `json:"lastSeen"`
+}
+
+// CorrectionRequest corrects a queued item, or any narration when ItemID is empty
+type CorrectionRequest struct {
+	ItemID    string `json:"itemId,omitempty"`
+	Narration string `json:"narration,omitempty"`
+	Category  string `json:"category"` // A category (or alias) of the category taxonomy
+}
+
+// Correction records what the user changed and which rule was wrong
+type Correction struct {
+	ID               string    `json:"id"`
+	TenantID         string    `json:"tenantId"`
+	UserID           string    `json:"userId"`
+	Fingerprint      string    `json:"fingerprint"`
+	Narration        string    `json:"narration"`
+	PreviousCategory string    `json:"previousCategory,omitempty"`
+	Category         string    `json:"category"`
+	Rule             string    `json:"rule,omitempty"`
+	RuleVersion      string    `json:"ruleVersion,omitempty"`
+	OverrideID       string    `json:"overrideId"`  // Fingerprint override applied to future classifications
+	Occurrences      int       `json:"occurrences"` // Queued transactions resolved by the correction
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+// RuleReport aggregates the corrections of one rule
+type RuleReport struct {
+	Rule           string         `json:"rule"`
+	Corrections    int            `json:"corrections"`
+	Transactions   int            `json:"transactions"` // Queued transactions the corrections resolved
+	Users          int            `json:"users"`
+	FromCategories map[string]int `json:"fromCategories"`
+	ToCategories   map[string]int `json:"toCategories"`
+}
+
+// Queue holds review items and corrections, optionally persisted to a JSON file
+type Queue struct {
+	mu          sync.RWMutex
+	path        string // Empty keeps the queue in memory only
+	threshold   float64
+	overrides   *overrides.Store
+	items       map[string]*Item  // ID -> item
+	index       map[string]string // tenant/user/fingerprint -> item ID
+	corrections []Correction
+}
+
+type queueFile struct {
+	Items       []Item       `json:"items"`
+	Corrections []Correction `json:"corrections"`
+}
+
+// NewQueue opens the queue; corrections are saved as fingerprint overrides in store
+// An empty path keeps the queue in memory, a missing file starts empty and threshold <= 0 uses DefaultThreshold
+func NewQueue(path string, threshold float64, store *overrides.Store) (*Queue, error) {
+	if store == nil {
+		return nil, fmt.Errorf("review queue needs an overrides store")
+	}
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+	q := &Queue{
+		path:        path,
+		threshold:   threshold,
+		overrides:   store,
+		items:       make(map[string]*Item),
+		index:       make(map[string]string),
+		corrections: make([]Correction, 0),
+	}
+	if path == "" {
+		return q, nil
+	}
+	file, err := readQueueFile(path)
+	if err != nil {
+		return nil, err
+	}
+	for i := range file.Items {
+		item := file.Items[i]
+		q.items[item.ID] = &item
+		q.index[key(item.TenantID, item.UserID, item.Fingerprint)] = item.ID
+	}
+	q.corrections = append(q.corrections, file.Corrections...)
+	return q, nil
+}
+
+// ReadCorrections returns every correction in a saved queue file, across tenants and users
+// It is used to train the statistical fallback model offline
+func ReadCorrections(path string) ([]Correction, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to read review queue: %w", err)
+	}
+	file, err := readQueueFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return file.Corrections, nil
+}
+
+// readQueueFile reads a saved queue; a missing file is an empty queue
+func readQueueFile(path string) (queueFile, error) {
+	var file queueFile
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return file, fmt.Errorf("failed to read review queue: %w", err)
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return file, fmt.Errorf("failed to parse review queue: %w", err)
+	}
+	return file, nil
+}
+
+// NeedsReview reports whether a classified transaction should be queued:
+// "Other" or below the confidence threshold, and not already decided by the user
+func (q *Queue) NeedsReview(txn models.ClassifiedTransaction) bool {
+	for _, keyword := range txn.ClassificationMetadata.MatchedKeywords {
+		if keyword == "USER_OVERRIDE" {
+			return false
+		}
+	}
+	return txn.Category == "Other" || txn.ClassificationMetadata.Confidence < q.threshold
+}
+
+// Collect queues the transactions that need review and returns how many new items were created
+// Items already queued count another occurrence; dismissed items stay dismissed
+func (q *Queue) Collect(tenantID, userID string, txns []models.ClassifiedTransaction) (int, error) {
+	now := time.Now().UTC()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	created, changed := 0, false
+	for _, txn := range txns {
+		if !q.NeedsReview(txn) {
+			continue
+		}
+		fingerprint := utils.FingerprintNarration(txn.Narration)
+		if fingerprint == "" {
+			continue
+		}
+		k := key(tenantID, userID, fingerprint)
+		if id, ok := q.index[k]; ok {
+			item := q.items[id]
+			ref := transactionRef(txn)
+			if item.Status != StatusPending || contains(item.TransactionRefs, ref) {
+				continue
+			}
+			item.TransactionRefs = append(item.TransactionRefs, ref)
+			item.Occurrences = len(item.TransactionRefs)
+			item.LastSeen = now
+			item.Narration, item.Date, item.Amount = txn.Narration, txn.Date, amount(txn)
+			changed = true
+			continue
+		}
+		item := &Item{
+			ID:              ids.New("rev_"),
+			TenantID:        tenantID,
+			UserID:          userID,
+			Fingerprint:     fingerprint,
+			Narration:       txn.Narration,
+			Date:            txn.Date,
+			Amount:          amount(txn),
+			Category:        txn.Category,
+			Merchant:        txn.Merchant,
+			Confidence:      txn.ClassificationMetadata.Confidence,
+			Rule:            txn.ClassificationMetadata.Reason,
+			RuleVersion:     txn.ClassificationMetadata.RuleVersion,
+			Occurrences:     1,
+			TransactionRefs: []string{transactionRef(txn)},
+			Status:          StatusPending,
+			FirstSeen:       now,
+			LastSeen:        now,
+		}
+		q.items[item.ID] = item
+		q.index[k] = item.ID
+		created++
+		changed = true
+	}
+	if !changed {
+		return 0, nil
+	}
+	return created, q.save()
+}
+
+// List returns a user's items with the given status (all when empty), most frequent first
+func (q *Queue) List(tenantID, userID, status string) []Item {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	result := make([]Item, 0)
+	for _, item := range q.items {
+		if item.TenantID == tenantID && item.UserID == userID && (status == "" || item.Status == status) {
+			result = append(result, *item)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Occurrences != result[j].Occurrences {
+			return result[i].Occurrences > result[j].Occurrences
+		}
+		return result[i].FirstSeen.Before(result[j].FirstSeen)
+	})
+	return result
+}
+
+// Dismiss marks a pending item as reviewed without a correction
+func (q *Queue) Dismiss(tenantID, userID, id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	item, ok := q.items[id]
+	if !ok || item.TenantID != tenantID || item.UserID != userID {
+		return ErrNotFound
+	}
+	item.Status = StatusDismissed
+	return q.save()
+}
+
+// Correct records a correction and saves it as a fingerprint override, replacing any earlier
+// correction of the same narration; the queued item is marked corrected
+func (q *Queue) Correct(tenantID, userID string, req CorrectionRequest) (Correction, error) {
+	category := strings.TrimSpace(req.Category)
+	if category == "" {
+		return Correction{}, fmt.Errorf("%w: category is required", ErrInvalid)
+	}
+	category, ok := taxonomy.Active().Lookup(category)
+	if !ok {
+		return Correction{}, fmt.Errorf("%w: unknown category %q: not in the category taxonomy", ErrInvalid, strings.TrimSpace(req.Category))
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var item *Item
+	fingerprint := ""
+	switch {
+	case req.ItemID != "":
+		found, ok := q.items[req.ItemID]
+		if !ok || found.TenantID != tenantID || found.UserID != userID {
+			return Correction{}, ErrNotFound
+		}
+		item, fingerprint = found, found.Fingerprint
+	case strings.TrimSpace(req.Narration) != "":
+		fingerprint = utils.FingerprintNarration(req.Narration)
+		if fingerprint == "" {
+			return Correction{}, fmt.Errorf("%w: narration has nothing to fingerprint once dates and references are removed", ErrInvalid)
+		}
+		if id, ok := q.index[key(tenantID, userID, fingerprint)]; ok {
+			item = q.items[id]
+		}
+	default:
+		return Correction{}, fmt.Errorf("%w: itemId or narration is required", ErrInvalid)
+	}
+
+	correction := Correction{
+		ID:          ids.New("cor_"),
+		TenantID:    tenantID,
+		UserID:      userID,
+		Fingerprint: fingerprint,
+		Narration:   req.Narration,
+		Category:    category,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if item != nil {
+		correction.Narration = item.Narration
+		correction.PreviousCategory = item.Category
+		correction.Rule = item.Rule
+		correction.RuleVersion = item.RuleVersion
+		correction.Occurrences = item.Occurrences
+	}
+
+	// Future classifications: one fingerprint override per narration pattern. The new override
+	// is stored before the previous ones go, so a failure never leaves the pattern without one
+	previous := make([]string, 0, 1)
+	for _, existing := range q.overrides.List(tenantID, userID) {
+		if existing.Type == overrides.TypeFingerprint && existing.Match == fingerprint {
+			previous = append(previous, existing.ID)
+		}
+	}
+	override, err := q.overrides.Add(overrides.Override{
+		TenantID: tenantID,
+		UserID:   userID,
+		Type:     overrides.TypeFingerprint,
+		Match:    fingerprint,
+		Category: category,
+	})
+	if err != nil {
+		return Correction{}, err
+	}
+	for _, id := range previous {
+		if _, err := q.overrides.Remove(tenantID, userID, id); err != nil {
+			// Roll back to the previous overrides
+			q.overrides.Remove(tenantID, userID, override.ID)
+			return Correction{}, err
+		}
+	}
+	correction.OverrideID = override.ID
+
+	// Past classifications: the queued occurrences are resolved
+	if item != nil {
+		item.Status = StatusCorrected
+		item.CorrectedCategory = category
+	}
+	q.corrections = append(q.corrections, correction)
+	return correction, q.save()
+}
+
+// Corrections returns a user's corrections, oldest first
+func (q *Queue) Corrections(tenantID, userID string) []Correction {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	result := make([]Correction, 0)
+	for _, c := range q.corrections {
+		if c.TenantID == tenantID && c.UserID == userID {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Report aggregates a tenant's corrections by the rule that was corrected, most corrected first
+// Corrections of narrations that were never queued have no rule and are reported under ""
+func (q *Queue) Report(tenantID string) []RuleReport {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	byRule := make(map[string]*RuleReport)
+	users := make(map[string]map[string]bool)
+	for _, c := range q.corrections {
+		if c.TenantID != tenantID {
+			continue
+		}
+		report, ok := byRule[c.Rule]
+		if !ok {
+			report = &RuleReport{Rule: c.Rule, FromCategories: make(map[string]int), ToCategories: make(map[string]int)}
+			byRule[c.Rule] = report
+			users[c.Rule] = make(map[string]bool)
+		}
+		report.Corrections++
+		report.Transactions += c.Occurrences
+		if c.PreviousCategory != "" {
+			report.FromCategories[c.PreviousCategory]++
+		}
+		report.ToCategories[c.Category]++
+		users[c.Rule][c.UserID] = true
+	}
+
+	result := make([]RuleReport, 0, len(byRule))
+	for rule, report := range byRule {
+		report.Users = len(users[rule])
+		result = append(result, *report)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Corrections != result[j].Corrections {
+			return result[i].Corrections > result[j].Corrections
+		}
+		return result[i].Rule < result[j].Rule
+	})
+	return result
+}
+
+// save writes the queue to the file (temp file and rename); callers hold mu
+func (q *Queue) save() error {
+	if q.path == "" {
+		return nil
+	}
+	file := queueFile{Items: make([]Item, 0, len(q.items)), Corrections: q.corrections}
+	for _, item := range q.items {
+		file.Items = append(file.Items, *item)
+	}
+	sort.Slice(file.Items, func(i, j int) bool { return file.Items[i].FirstSeen.Before(file.Items[j].FirstSeen) })
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := fileutil.WriteAtomic(q.path, data); err != nil {
+		return fmt.Errorf("failed to save review queue: %w", err)
+	}
+	return nil
+}
+
+func key(tenantID, userID, fingerprint string) string {
+	return tenantID + "/" + userID + "/" + fingerprint
+}
+
+// transactionRef identifies a transaction so re-analysing a statement does not count it twice
+func transactionRef(txn models.ClassifiedTransaction) string {
+	return fmt.Sprintf("%s|%s|%.2f", txn.Date, txn.ChequeRefNo, amount(txn))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func amount(txn models.ClassifiedTransaction) float64 {
+	if txn.WithdrawalAmt > txn.DepositAmt {
+		return txn.WithdrawalAmt
+	}
+	return txn.DepositAmt
+}
@@ -0,0 +1,174 @@
+package review
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/overrides"
+)
+
+func classify(set *overrides.Set, rows ...[2]string) []models.ClassifiedTransaction {
+	txns := make([]models.ClassifiedTransaction, 0, len(rows))
+	for _, row := range rows {
+		txns = append(txns, classifier.ConvertFromTxtTransaction(row[0], row[1], "0000"+row[0][:2], row[0], 630, 0, 9000))
+	}
+	return classifier.ClassifyTransactions(txns, "TEST USER", set)
+}
+
+func newQueue(t *testing.T, path string) (*Queue, *overrides.Store) {
+	t.Helper()
+	store, err := overrides.NewStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	queue, err := NewQueue(path, 0, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return queue, store
+}
+
+func TestCollect(t *testing.T) {
+	queue, _ := newQueue(t, "")
+	txns := classify(nil,
+		[2]string{"01/12/25", "UPI-BILAL-PAYTMQR6AG3JT@PTYS-YESB0PTMUPI-102719899086-UPI"},
+		[2]string{"05/12/25", "UPI-BILAL-PAYTMQR6AG3JT@PTYS-YESB0PTMUPI-102799999999-UPI"},
+		[2]string{"06/12/25", "UPI-SWIGGY-SWIGGY@ICICI-ICIC0DC0099-123456789012-UPI"},
+	)
+	if txns[0].Category != "Other" || txns[2].Category == "Other" {
+		t.Fatalf("unexpected fixture categories %s, %s", txns[0].Category, txns[2].Category)
+	}
+
+	created, err := queue.Collect("acme", "alice", txns)
+	if err != nil || created != 1 {
+		t.Fatalf("expected 1 new item, got %d (%v)", created, err)
+	}
+	// Analysing the same statement again does not count the transactions twice
+	if created, _ := queue.Collect("acme", "alice", txns); created != 0 {
+		t.Errorf("expected no new items on re-analysis, got %d", created)
+	}
+
+	items := queue.List("acme", "alice", StatusPending)
+	if len(items) != 1 || items[0].Occurrences != 2 || items[0].Rule == "" {
+		t.Fatalf("expected one item seen twice with its rule, got %+v", items)
+	}
+	if len(queue.List("acme", "bob", "")) != 0 {
+		t.Error("expected other users not to see alice's queue")
+	}
+}
+
+func TestCorrectionPropagates(t *testing.T) {
+	queue, store := newQueue(t, "")
+	past := classify(nil, [2]string{"01/12/25", "UPI-BILAL-PAYTMQR6AG3JT@PTYS-YESB0PTMUPI-102719899086-UPI"})
+	if _, err := queue.Collect("acme", "alice", past); err != nil {
+		t.Fatal(err)
+	}
+	item := queue.List("acme", "alice", StatusPending)[0]
+
+	correction, err := queue.Correct("acme", "alice", CorrectionRequest{ItemID: item.ID, Category: "Groceries"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if correction.PreviousCategory != "Other" || correction.Rule != item.Rule || correction.OverrideID == "" {
+		t.Errorf("expected the correction to record the rule and override, got %+v", correction)
+	}
+	if got := queue.List("acme", "alice", StatusCorrected); len(got) != 1 || got[0].CorrectedCategory != "Groceries" {
+		t.Errorf("expected the queued item to be corrected, got %+v", got)
+	}
+
+	// A later transaction with the same narration pattern (new date and reference) is classified with the correction
+	future := classify(store.For("acme", "alice"), [2]string{"20/12/25", "UPI-BILAL-PAYTMQR6AG3JT@PTYS-YESB0PTMUPI-104400000001-UPI"})
+	if future[0].Category != "Groceries" || !strings.HasPrefix(future[0].ClassificationMetadata.Reason, "User override") {
+		t.Errorf("expected the correction to apply, got %s (%s)", future[0].Category, future[0].ClassificationMetadata.Reason)
+	}
+	if created, _ := queue.Collect("acme", "alice", future); created != 0 {
+		t.Errorf("expected corrected transactions not to be queued again, got %d", created)
+	}
+	if other := classify(store.For("acme", "bob"), [2]string{"20/12/25", future[0].Narration}); other[0].Category != "Other" {
+		t.Errorf("expected bob's classification to be unchanged, got %s", other[0].Category)
+	}
+
+	// Correcting again replaces the earlier override, in the taxonomy's spelling
+	if _, err := queue.Correct("acme", "alice", CorrectionRequest{Narration: future[0].Narration, Category: "dining"}); err != nil {
+		t.Fatal(err)
+	}
+	if list := store.List("acme", "alice"); len(list) != 1 || list[0].Category != "Dining" {
+		t.Errorf("expected a single override for the narration, got %+v", list)
+	}
+
+	// A failed correction keeps the earlier override
+	if _, err := queue.Correct("acme", "alice", CorrectionRequest{Narration: future[0].Narration, Category: "BNPL"}); !errors.Is(err, ErrInvalid) {
+		t.Errorf("expected ErrInvalid for a category outside the taxonomy, got %v", err)
+	}
+	if list := store.List("acme", "alice"); len(list) != 1 || list[0].Category != "Dining" {
+		t.Errorf("expected the earlier override to remain, got %+v", list)
+	}
+}
+
+func TestCorrectErrors(t *testing.T) {
+	queue, _ := newQueue(t, "")
+	tests := []struct {
+		name     string
+		request  CorrectionRequest
+		expected error
+	}{
+		{"missing category", CorrectionRequest{Narration: "UPI-BILAL"}, ErrInvalid},
+		{"unknown category", CorrectionRequest{Narration: "UPI-BILAL", Category: "BNPL"}, ErrInvalid},
+		{"missing target", CorrectionRequest{Category: "Dining"}, ErrInvalid},
+		{"unknown item", CorrectionRequest{ItemID: "rev_missing", Category: "Dining"}, ErrNotFound},
+		{"nothing to fingerprint", CorrectionRequest{Narration: "12/12/2025 123456789", Category: "Dining"}, ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := queue.Correct("acme", "alice", tt.request); !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "review.json")
+	queue, _ := newQueue(t, path)
+	for _, user := range []string{"alice", "bob"} {
+		txns := classify(nil,
+			[2]string{"01/12/25", "UPI-BILAL-PAYTMQR6AG3JT@PTYS-YESB0PTMUPI-102719899086-UPI"},
+			[2]string{"02/12/25", "UPI-KULDEEP-PAYTMQR69YV4V@PTYS-YESB0PTMUPI-103343302139-UPI"},
+		)
+		if _, err := queue.Collect("acme", user, txns); err != nil {
+			t.Fatal(err)
+		}
+		for _, item := range queue.List("acme", user, StatusPending) {
+			if _, err := queue.Correct("acme", user, CorrectionRequest{ItemID: item.ID, Category: "Groceries"}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if _, err := queue.Correct("acme", "alice", CorrectionRequest{Narration: "POS CAFE COFFEE DAY", Category: "Dining"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The report survives a restart
+	reopened, err := NewQueue(path, 0, queue.overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := reopened.Report("acme")
+	if len(report) != 2 {
+		t.Fatalf("expected 2 rules, got %+v", report)
+	}
+	top := report[0]
+	if top.Corrections != 4 || top.Users != 2 || top.Transactions != 4 || top.FromCategories["Other"] != 4 || top.ToCategories["Groceries"] != 4 {
+		t.Errorf("unexpected top rule %+v", top)
+	}
+	if report[1].Rule != "" || report[1].Corrections != 1 {
+		t.Errorf("expected the unqueued correction under an empty rule, got %+v", report[1])
+	}
+	if len(reopened.Report("other")) != 0 {
+		t.Error("expected other tenants to see no corrections")
+	}
+}
@@ -0,0 +1,333 @@
+// Package rulepack loads classification rules from versioned JSON packs
+//
+// A pack holds the data the classifier used to compile in: known merchants,
+// canonical merchant aliases, intent keywords, keyword/regex category rules and
+// the keyword groups of the built-in category patterns.
+// The built-in pack (default.json) is active at startup; Activate or a Reloader
+// swaps in another pack without a restart, and its version is recorded in
+// every ClassificationMetadata.RuleVersion.
+package rulepack
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Pack is a versioned set of classification rules
+type Pack struct {
+	Version            string              `json:"version"` // Recorded as ClassificationMetadata.RuleVersion
+	Name               string              `json:"name"`
+	Description        string              `json:"description,omitempty"`
+	Merchants          []Merchant          `json:"merchants"`
+	CanonicalMerchants []CanonicalMerchant `json:"canonicalMerchants"`
+	IntentKeywords     []IntentKeyword     `json:"intentKeywords"`
+	CategoryRules      []CategoryRule      `json:"categoryRules"`
+	KeywordGroups      []KeywordGroup      `json:"keywordGroups,omitempty"`
+}
+
+// Merchant is a known merchant; any alias found in the narration assigns its category
+type Merchant struct {
+	Name       string   `json:"name"`
+	Category   string   `json:"category"`
+	Aliases    []string `json:"aliases"`
+	Confidence float64  `json:"confidence"`
+	Priority   int      `json:"priority,omitempty"` // Higher is checked first; ties keep file order
+}
+
+// CanonicalMerchant maps merchant name variations to one canonical name
+type CanonicalMerchant struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Aliases  []string `json:"aliases"`
+}
+
+// IntentKeyword is supporting evidence for a category
+type IntentKeyword struct {
+	Keyword    string  `json:"keyword"`
+	Category   string  `json:"category"`
+	Confidence float64 `json:"confidence"`
+}
+
+// CategoryRule assigns a category when any keyword (substring) or regex matches the narration
+// Pack rules are checked before the built-in category patterns
+type CategoryRule struct {
+	ID         string   `json:"id"`
+	Category   string   `json:"category"`
+	Keywords   []string `json:"keywords,omitempty"`
+	Regexes    []string `json:"regexes,omitempty"` // RE2 syntax, matched against the upper-cased narration
+	Priority   int      `json:"priority,omitempty"`
+	Confidence float64  `json:"confidence"`
+	Reason     string   `json:"reason,omitempty"`
+}
+
+// KeywordGroup is a keyword list of the built-in category patterns, looked up by ID
+// The pattern layer decides how each group is used; a pack can only change the keywords.
+// Groups a pack leaves out keep the built-in keywords, and a group listed without
+// keywords is turned off.
+type KeywordGroup struct {
+	ID          string   `json:"id"`
+	Category    string   `json:"category,omitempty"` // Category the group assigns; empty for groups that are only signals
+	Description string   `json:"description,omitempty"`
+	Keywords    []string `json:"keywords"` // Substrings of the upper-cased narration and merchant
+}
+
+//go:embed default.json
+var defaultPack []byte
+
+// Default returns the built-in pack
+func Default() *Pack {
+	pack, err := Parse(defaultPack, "json")
+	if err != nil {
+		panic(fmt.Sprintf("rulepack: built-in pack is invalid: %v", err))
+	}
+	return pack
+}
+
+func init() {
+	if err := Activate(Default()); err != nil {
+		panic(fmt.Sprintf("rulepack: built-in pack is invalid: %v", err))
+	}
+}
+
+// LoadFile reads a JSON pack
+func LoadFile(path string) (*Pack, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return nil, fmt.Errorf("rule pack %s: YAML packs are not supported, convert it to JSON", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rule pack: %w", err)
+	}
+	pack, err := Parse(data, "json")
+	if err != nil {
+		return nil, fmt.Errorf("rule pack %s: %w", path, err)
+	}
+	return pack, nil
+}
+
+// Parse decodes a pack in the given format (only "json") and validates it
+func Parse(data []byte, format string) (*Pack, error) {
+	if format != "json" {
+		return nil, fmt.Errorf("unsupported rule pack format %q", format)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.DisallowUnknownFields()
+	pack := &Pack{}
+	if err := decoder.Decode(pack); err != nil {
+		return nil, fmt.Errorf("failed to parse rule pack: %w", err)
+	}
+	return pack, pack.Validate()
+}
+
+// Validate reports every problem in the pack
+func (p *Pack) Validate() error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	if p.Version == "" {
+		fail("version is required")
+	}
+	for i, m := range p.Merchants {
+		if m.Name == "" || m.Category == "" {
+			fail("merchants[%d]: name and category are required", i)
+		}
+		if len(nonEmpty(m.Aliases)) == 0 {
+			fail("merchants[%d] %s: at least one alias is required", i, m.Name)
+		}
+		if m.Confidence <= 0 || m.Confidence > 1 {
+			fail("merchants[%d] %s: confidence must be in (0, 1], got %v", i, m.Name, m.Confidence)
+		}
+	}
+	keys := make(map[string]bool)
+	for i, c := range p.CanonicalMerchants {
+		key := strings.ToUpper(strings.TrimSpace(c.Key))
+		if key == "" || c.Name == "" {
+			fail("canonicalMerchants[%d]: key and name are required", i)
+		}
+		if keys[key] {
+			fail("canonicalMerchants[%d]: duplicate key %q", i, c.Key)
+		}
+		keys[key] = true
+	}
+	for i, k := range p.IntentKeywords {
+		if strings.TrimSpace(k.Keyword) == "" || k.Category == "" {
+			fail("intentKeywords[%d]: keyword and category are required", i)
+		}
+		if k.Confidence <= 0 || k.Confidence > 1 {
+			fail("intentKeywords[%d] %s: confidence must be in (0, 1], got %v", i, k.Keyword, k.Confidence)
+		}
+	}
+	ids := make(map[string]bool)
+	for i, r := range p.CategoryRules {
+		if r.ID == "" || r.Category == "" {
+			fail("categoryRules[%d]: id and category are required", i)
+		}
+		if ids[r.ID] {
+			fail("categoryRules[%d]: duplicate id %q", i, r.ID)
+		}
+		ids[r.ID] = true
+		if len(nonEmpty(r.Keywords)) == 0 && len(r.Regexes) == 0 {
+			fail("categoryRules[%d] %s: keywords or regexes are required", i, r.ID)
+		}
+		for _, expr := range r.Regexes {
+			if _, err := regexp.Compile(expr); err != nil {
+				fail("categoryRules[%d] %s: invalid regex %q: %v", i, r.ID, expr, err)
+			}
+		}
+		if r.Confidence <= 0 || r.Confidence > 1 {
+			fail("categoryRules[%d] %s: confidence must be in (0, 1], got %v", i, r.ID, r.Confidence)
+		}
+	}
+	groups := make(map[string]bool)
+	for i, g := range p.KeywordGroups {
+		if g.ID == "" {
+			fail("keywordGroups[%d]: id is required", i)
+			continue
+		}
+		if groups[g.ID] {
+			fail("keywordGroups[%d]: duplicate id %q", i, g.ID)
+		}
+		groups[g.ID] = true
+		if _, ok := builtinGroups()[g.ID]; !ok {
+			fail("keywordGroups[%d]: unknown id %q", i, g.ID)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid rule pack: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Compile validates the pack and converts it to the classifier's rule set
+func (p *Pack) Compile() (*utils.RuleSet, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	rules := &utils.RuleSet{
+		Version:            p.Version,
+		Name:               p.Name,
+		KnownMerchants:     make([]utils.KnownMerchant, 0, len(p.Merchants)),
+		CanonicalMerchants: make(map[string]utils.CanonicalMerchant, len(p.CanonicalMerchants)),
+		IntentKeywords:     make([]utils.IntentKeyword, 0, len(p.IntentKeywords)),
+		CategoryRules:      make([]utils.CategoryRule, 0, len(p.CategoryRules)),
+		KeywordGroups:      make(map[string][]string, len(builtinGroups())),
+	}
+
+	merchants := append([]Merchant(nil), p.Merchants...)
+	sort.SliceStable(merchants, func(i, j int) bool { return merchants[i].Priority > merchants[j].Priority })
+	for _, m := range merchants {
+		rules.KnownMerchants = append(rules.KnownMerchants, utils.KnownMerchant{
+			Patterns:   upper(m.Aliases),
+			Name:       m.Name,
+			Category:   m.Category,
+			Confidence: m.Confidence,
+		})
+	}
+	for _, c := range p.CanonicalMerchants {
+		rules.CanonicalMerchants[strings.ToUpper(strings.TrimSpace(c.Key))] = utils.CanonicalMerchant{
+			Aliases:  upper(c.Aliases),
+			Name:     c.Name,
+			Category: c.Category,
+		}
+	}
+	for _, k := range p.IntentKeywords {
+		rules.IntentKeywords = append(rules.IntentKeywords, utils.IntentKeyword{
+			Keyword:    strings.ToUpper(k.Keyword),
+			Category:   k.Category,
+			Confidence: k.Confidence,
+		})
+	}
+
+	categoryRules := append([]CategoryRule(nil), p.CategoryRules...)
+	sort.SliceStable(categoryRules, func(i, j int) bool { return categoryRules[i].Priority > categoryRules[j].Priority })
+	for _, r := range categoryRules {
+		rule := utils.CategoryRule{
+			ID:         r.ID,
+			Category:   r.Category,
+			Keywords:   upper(r.Keywords),
+			Priority:   r.Priority,
+			Confidence: r.Confidence,
+			Reason:     r.Reason,
+		}
+		if rule.Reason == "" {
+			rule.Reason = "Rule pack rule " + r.ID
+		}
+		for _, expr := range r.Regexes {
+			rule.Patterns = append(rule.Patterns, regexp.MustCompile(expr))
+		}
+		rules.CategoryRules = append(rules.CategoryRules, rule)
+	}
+
+	for id, keywords := range builtinGroups() {
+		rules.KeywordGroups[id] = keywords
+	}
+	for _, g := range p.KeywordGroups {
+		rules.KeywordGroups[g.ID] = upper(g.Keywords)
+	}
+	return rules, nil
+}
+
+// builtinGroups returns the upper-cased keywords of the built-in pack's groups by ID
+// Decoded without Validate, which looks the IDs up here
+var builtinGroups = sync.OnceValue(func() map[string][]string {
+	var pack Pack
+	if err := json.Unmarshal(defaultPack, &pack); err != nil {
+		panic(fmt.Sprintf("rulepack: built-in pack is invalid: %v", err))
+	}
+	groups := make(map[string][]string, len(pack.KeywordGroups))
+	for _, g := range pack.KeywordGroups {
+		groups[g.ID] = upper(g.Keywords)
+	}
+	return groups
+})
+
+// Activate compiles the pack and makes it the rule set for all subsequent classifications
+// An invalid pack is rejected and the active rules stay in place
+func Activate(p *Pack) error {
+	rules, err := p.Compile()
+	if err != nil {
+		return err
+	}
+	previous := utils.SetActiveRules(rules)
+	if previous != nil && previous.Version != rules.Version {
+		slog.Info("rule pack activated",
+			slog.String("name", rules.Name),
+			slog.String("version", rules.Version),
+			slog.String("previous_version", previous.Version),
+		)
+	}
+	return nil
+}
+
+func nonEmpty(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func upper(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range nonEmpty(values) {
+		result = append(result, strings.ToUpper(v))
+	}
+	return result
+}
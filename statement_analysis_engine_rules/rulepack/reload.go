@@ -0,0 +1,92 @@
+package rulepack
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader activates the pack at a path and re-activates it whenever the file changes
+type Reloader struct {
+	path     string
+	interval time.Duration
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+// NewReloader watches path, checking for changes every interval (default 10s)
+func NewReloader(path string, interval time.Duration) *Reloader {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return &Reloader{path: path, interval: interval}
+}
+
+// Load reads, validates and activates the pack now
+// On error the active rules are left unchanged
+func (r *Reloader) Load() (*Pack, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return nil, err
+	}
+	pack, err := LoadFile(r.path)
+	if err != nil {
+		return nil, err
+	}
+	if err := Activate(pack); err != nil {
+		return nil, err
+	}
+	r.modTime, r.size = info.ModTime(), info.Size()
+	return pack, nil
+}
+
+// changed reports whether the file differs from the last loaded version
+func (r *Reloader) changed() bool {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !info.ModTime().Equal(r.modTime) || info.Size() != r.size
+}
+
+// Run polls the file until ctx is done; a pack that fails validation is logged and skipped
+func (r *Reloader) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			pack, err := r.Load()
+			if err != nil {
+				slog.Error("rule pack reload failed, keeping the active rules",
+					slog.String("path", r.path), slog.Any("error", err))
+				r.skip()
+				continue
+			}
+			slog.Info("rule pack reloaded", slog.String("path", r.path), slog.String("version", pack.Version))
+		}
+	}
+}
+
+// skip records the current file state so a broken pack is reported once, not on every poll
+func (r *Reloader) skip() {
+	if info, err := os.Stat(r.path); err == nil {
+		r.mu.Lock()
+		r.modTime, r.size = info.ModTime(), info.Size()
+		r.mu.Unlock()
+	}
+}
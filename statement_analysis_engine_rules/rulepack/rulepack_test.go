@@ -0,0 +1,245 @@
+package rulepack_test
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/rulepack"
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+const customJSON = `{
+  "version": "v2.0.0-test",
+  "name": "custom",
+  "merchants": [
+    {"name": "Acme Coffee", "category": "Dining", "aliases": ["ACMECOFFEE", "ACME COFFEE"], "confidence": 0.9},
+    {"name": "Zomato", "category": "Food_Delivery", "aliases": ["ZOMATO"], "confidence": 0.9}
+  ],
+  "canonicalMerchants": [{"key": "acme", "name": "Acme Coffee Ltd", "category": "Dining", "aliases": ["ACMECOFFEE"]}],
+  "categoryRules": [
+    {"id": "pet-care", "category": "Pet_Care", "keywords": ["PETSHOP"], "regexes": ["VET\\s*CLINIC\\s*\\d+"],
+     "priority": 5, "confidence": 0.85, "reason": "Pet care: shop or vet"}
+  ]
+}`
+
+// restoreDefault reactivates the built-in pack after a test swaps rules
+func restoreDefault(t *testing.T) {
+	t.Cleanup(func() {
+		if err := rulepack.Activate(rulepack.Default()); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestDefaultPack(t *testing.T) {
+	pack := rulepack.Default()
+	if pack.Version == "" || len(pack.Merchants) == 0 || len(pack.CanonicalMerchants) == 0 || len(pack.IntentKeywords) == 0 {
+		t.Fatalf("expected a populated built-in pack, got version %q with %d merchants", pack.Version, len(pack.Merchants))
+	}
+	if utils.RuleVersion() != pack.Version {
+		t.Errorf("expected the built-in pack to be active, got version %q", utils.RuleVersion())
+	}
+	if name, category, _ := utils.DetectKnownMerchant("UPI-SWIGGY-SWIGGY@ICICI", ""); name != "Swiggy" || category != "Food_Delivery" {
+		t.Errorf("expected Swiggy/Food_Delivery, got %s/%s", name, category)
+	}
+	if name, _ := utils.CanonicalizeMerchant("ZERODHA BROKING LTD"); name != "Zerodha" {
+		t.Errorf("expected Zerodha, got %s", name)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		pack     string
+		expected string
+	}{
+		{"missing version", `{"name": "x"}`, "version is required"},
+		{"unknown field", `{"version": "v1", "merchant": []}`, "unknown field"},
+		{"no aliases", `{"version": "v1", "merchants": [{"name": "A", "category": "Shopping", "confidence": 0.5}]}`, "at least one alias"},
+		{"bad confidence", `{"version": "v1", "intentKeywords": [{"keyword": "X", "category": "Y", "confidence": 1.5}]}`, "confidence must be in (0, 1]"},
+		{"bad regex", `{"version": "v1", "categoryRules": [{"id": "r", "category": "C", "regexes": ["("], "confidence": 0.5}]}`, "invalid regex"},
+		{"duplicate rule", `{"version": "v1", "categoryRules": [{"id": "r", "category": "C", "keywords": ["A"], "confidence": 0.5},
+			{"id": "r", "category": "C", "keywords": ["B"], "confidence": 0.5}]}`, "duplicate id"},
+		{"duplicate canonical key", `{"version": "v1", "canonicalMerchants": [{"key": "A", "name": "A"}, {"key": "a", "name": "B"}]}`, "duplicate key"},
+		{"unknown keyword group", `{"version": "v1", "keywordGroups": [{"id": "pets", "keywords": ["PETSHOP"]}]}`, "unknown id"},
+		{"duplicate keyword group", `{"version": "v1", "keywordGroups": [{"id": "fuel", "keywords": ["A"]}, {"id": "fuel", "keywords": ["B"]}]}`, "duplicate id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := rulepack.Parse([]byte(tt.pack), "json")
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestLoadFileRejectsYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(path, []byte("version: v1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rulepack.LoadFile(path); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("expected YAML packs to be rejected, got %v", err)
+	}
+}
+
+// TestKeywordGroupsCoverClassifier checks that every keyword group ID the category patterns use is in the built-in pack
+func TestKeywordGroupsCoverClassifier(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "../rules/category_rules.go", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := make(map[string]bool)
+	for _, g := range rulepack.Default().KeywordGroups {
+		groups[g.ID] = len(g.Keywords) > 0
+	}
+	ids := 0
+	ast.Inspect(file, func(n ast.Node) bool {
+		decl, ok := n.(*ast.GenDecl)
+		if !ok || decl.Tok != token.CONST || decl.Doc == nil || !strings.Contains(decl.Doc.Text(), "keywordGroups") {
+			return true
+		}
+		for _, spec := range decl.Specs {
+			for _, value := range spec.(*ast.ValueSpec).Values {
+				id, err := strconv.Unquote(value.(*ast.BasicLit).Value)
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids++
+				if !groups[id] {
+					t.Errorf("expected keyword group %q in the built-in pack", id)
+				}
+			}
+		}
+		return false
+	})
+	if ids == 0 {
+		t.Fatal("expected the keyword group IDs of rules/category_rules.go")
+	}
+}
+
+func TestKeywordGroupOverrides(t *testing.T) {
+	restoreDefault(t)
+	classify := func(narration string) string {
+		txn := classifier.ConvertFromTxtTransaction("01/12/25", narration, "0000123456789012", "01/12/25", 450, 0, 9550)
+		return classifier.ClassifyTransactions([]models.ClassifiedTransaction{txn}, "TEST USER", nil)[0].Category
+	}
+	const narration = "POS 4591XXXXXXXX1234 GREENLEAF 42"
+	if category := classify(narration); category == "Groceries" {
+		t.Fatalf("expected %s not to be groceries with the built-in pack", narration)
+	}
+
+	// Groceries gets a new keyword, religious_charitable is turned off and every other group keeps the built-in keywords
+	pack, err := rulepack.Parse([]byte(`{"version": "v2.0.0-groups", "keywordGroups": [
+		{"id": "groceries", "category": "Groceries", "keywords": ["greenleaf"]},
+		{"id": "religious_charitable", "keywords": []}
+	]}`), "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rulepack.Activate(pack); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		narration string
+		category  string
+	}{
+		{narration, "Groceries"},
+		{"UPI-SHRI RAM MANDIR-MANDIR@OKSBI-SBIN0001234-123456789012-UPI", "Other"},
+		{"POS 4591XXXXXXXX1234 CAFE BLUE TOKAI", "Dining"},
+	}
+	for _, tt := range tests {
+		if category := classify(tt.narration); category != tt.category {
+			t.Errorf("%s: expected %s, got %s", tt.narration, tt.category, category)
+		}
+	}
+}
+
+func TestActivatedPackClassifies(t *testing.T) {
+	restoreDefault(t)
+	pack, err := rulepack.Parse([]byte(customJSON), "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rulepack.Activate(pack); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		narration string
+		category  string
+	}{
+		{"UPI-ACME COFFEE-ACMECOFFEE@YBL-YESB0YBLUPI-123456789012-COFFEE", "Dining"},
+		{"UPI-HAPPY PETSHOP-PETSHOP@OKSBI-SBIN0001234-123456789012-UPI", "Pet_Care"},
+		{"POS 4591XXXXXXXX1234 VET CLINIC 42", "Pet_Care"},
+		{"UPI-ZOMATO-ZOMATO@HDFC-HDFC0000001-123456789012-ORDER", "Food_Delivery"},
+	}
+	for _, tt := range tests {
+		txn := classifier.ConvertFromTxtTransaction("01/12/25", tt.narration, "0000123456789012", "01/12/25", 450, 0, 9550)
+		classified := classifier.ClassifyTransactions([]models.ClassifiedTransaction{txn}, "TEST USER", nil)[0]
+		if classified.Category != tt.category {
+			t.Errorf("%s: expected %s, got %s (%s)", tt.narration, tt.category, classified.Category, classified.ClassificationMetadata.Reason)
+		}
+		if classified.ClassificationMetadata.RuleVersion != "v2.0.0-test" {
+			t.Errorf("%s: expected rule version v2.0.0-test, got %q", tt.narration, classified.ClassificationMetadata.RuleVersion)
+		}
+	}
+}
+
+func TestReloader(t *testing.T) {
+	restoreDefault(t)
+	path := filepath.Join(t.TempDir(), "rules.json")
+	write := func(body string, modTime time.Time) {
+		if err := os.WriteFile(path, []byte(body), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitForVersion := func(expected string) {
+		deadline := time.Now().Add(2 * time.Second)
+		for utils.RuleVersion() != expected && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+		}
+		if utils.RuleVersion() != expected {
+			t.Fatalf("expected rule version %s, got %s", expected, utils.RuleVersion())
+		}
+	}
+
+	start := time.Now().Add(-time.Hour)
+	write(`{"version": "v1", "name": "reload"}`, start)
+	reloader := rulepack.NewReloader(path, 10*time.Millisecond)
+	if _, err := reloader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	waitForVersion("v1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.Run(ctx)
+
+	write(`{"version": "v2", "name": "reload"}`, start.Add(time.Minute))
+	waitForVersion("v2")
+
+	// A broken pack is rejected and the previous rules stay active
+	write(`{"version": "v3", "merchants": [{"name": "Broken"}]}`, start.Add(2*time.Minute))
+	time.Sleep(100 * time.Millisecond)
+	if utils.RuleVersion() != "v2" {
+		t.Errorf("expected invalid pack to be skipped, got version %s", utils.RuleVersion())
+	}
+
+	write(`{"version": "v4", "name": "reload"}`, start.Add(3*time.Minute))
+	waitForVersion("v4")
+}
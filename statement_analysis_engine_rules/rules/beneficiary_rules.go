@@ -3,19 +3,44 @@ package rules
 import (
 	"regexp"
 	"strings"
+
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Beneficiary patterns, compiled once
+var (
+	// IMPS-REF-MR NAME-BANK
+	titledBeneficiaryPattern = regexp.MustCompile(`(?:IMPS|NEFT|RTGS)[\s]*(?:CR|DR)?[- ]+(?:[^-]+-)?(?:MR|MRS|MS|MR\.|MRS\.|MS\.)[\s]+([A-Z\s]+?)(?:-|@|$|BANK|SBIN|HDFC|ICICI|AXIS|PNB|SBI|PUNB)`)
+	// RTGS CR-IFSC-NAME-NAME-REF
+	rtgsBeneficiaryPattern = regexp.MustCompile(`RTGS[\s]*(?:CR|DR)[- ]+[A-Z0-9]+-([A-Z\s]+?)-[A-Z\s]+`)
+	// Name between method and bank code
+	transferBeneficiaryPattern = regexp.MustCompile(`(?:IMPS|NEFT|RTGS)[\s]*(?:CR|DR)?[- ]+[^-]+-([A-Z\s]+?)-[A-Z]{4}`)
+	// P:REF BANK SALARY FOR MONTH YEAR
+	salaryBankPattern     = regexp.MustCompile(`(?:P:[A-Z0-9]+\s+)?([A-Z\s]+?)\s+BANK\s+SALARY`)
+	salaryEmployerPattern = regexp.MustCompile(`(?:SALARY|SAL)[\s]+(?:FOR|FROM)[\s]+([A-Z\s]+)`)
+	emiLenderPattern      = regexp.MustCompile(`(?:EMI|LOAN|INSTALLMENT)[\s]+(?:FOR|OF)[\s]+([A-Z\s]+)`)
+	// ACH C/D- MERCHANT-REF
+	achMerchantPattern   = regexp.MustCompile(`ACH[\s]*(?:C|D|CR|DR)[-\s]+([A-Z\s]+?)(?:-|LIMITED|LTD|PVT|PRIVATE|INSURA|SECURITIES)`)
+	achFallbackPattern   = regexp.MustCompile(`ACH[\s]*(?:C|D|CR|DR)[-\s]+([A-Z\s]+?)(?:-|$)`)
+	recurringNamePattern = regexp.MustCompile(`(?:FOR|TO|FROM)[\s]+([A-Z\s]+?)(?:-|LIMITED|LTD|MONTHLY|INSTALLMENT)`)
 )
 
 // ExtractBeneficiary extracts beneficiary name from narration
 func ExtractBeneficiary(narration string, method string) string {
 	narration = strings.TrimSpace(narration)
 
+	// IMPS/NEFT/RTGS/NACH/ACH narrations that follow their rail's template name the counterparty
+	// in a field of their own; the patterns below are the fallback for everything else
+	if fields, ok := utils.ParseNarrationFields(narration, false); ok && fields.Name != "" {
+		return fields.Name
+	}
+
 	// For IMPS/NEFT/RTGS transactions
 	if method == "IMPS" || method == "NEFT" || method == "RTGS" {
 		// HDFC IMPS format: IMPS-REF-NAME-BANK-ACCOUNT-PURPOSE
 		// Example: "IMPS-409612502129-MR  SUDHIR  KUMAR-SBIN-XXXXXXXXXXXX8121-REQPAY"
 		// Pattern 1: Extract name after MR/MRS/MS prefix
-		re := regexp.MustCompile(`(?:IMPS|NEFT|RTGS)[\s]*(?:CR|DR)?[- ]+(?:[^-]+-)?(?:MR|MRS|MS|MR\.|MRS\.|MS\.)[\s]+([A-Z\s]+?)(?:-|@|$|BANK|SBIN|HDFC|ICICI|AXIS|PNB|SBI|PUNB)`)
-		matches := re.FindStringSubmatch(strings.ToUpper(narration))
+		matches := titledBeneficiaryPattern.FindStringSubmatch(strings.ToUpper(narration))
 		if len(matches) > 1 {
 			beneficiary := strings.TrimSpace(matches[1])
 			// Clean up
@@ -30,8 +55,7 @@ func ExtractBeneficiary(narration string, method string) string {
 		// Example: "RTGS CR-PUNB0041010-RAKESH CHANDRA SATI-KALPIT SHARMA-PUNBR52024042317337926"
 		// Pattern 2: Extract first name after IFSC (for RTGS)
 		if method == "RTGS" {
-			re = regexp.MustCompile(`RTGS[\s]*(?:CR|DR)[- ]+[A-Z0-9]+-([A-Z\s]+?)-[A-Z\s]+`)
-			matches = re.FindStringSubmatch(strings.ToUpper(narration))
+			matches = rtgsBeneficiaryPattern.FindStringSubmatch(strings.ToUpper(narration))
 			if len(matches) > 1 {
 				beneficiary := strings.TrimSpace(matches[1])
 				if len(beneficiary) > 0 && len(beneficiary) < 50 {
@@ -41,8 +65,7 @@ func ExtractBeneficiary(narration string, method string) string {
 		}
 
 		// Pattern 3: Extract name between method and bank code (generic fallback)
-		re = regexp.MustCompile(`(?:IMPS|NEFT|RTGS)[\s]*(?:CR|DR)?[- ]+[^-]+-([A-Z\s]+?)-[A-Z]{4}`)
-		matches = re.FindStringSubmatch(strings.ToUpper(narration))
+		matches = transferBeneficiaryPattern.FindStringSubmatch(strings.ToUpper(narration))
 		if len(matches) > 1 {
 			beneficiary := strings.TrimSpace(matches[1])
 			// Remove MR/MRS/MS prefix if present
@@ -55,14 +78,18 @@ func ExtractBeneficiary(narration string, method string) string {
 		}
 	}
 
-	// For UPI transactions, extract payee name
+	// For UPI transactions, the counterparty name from the parsed narration, else its VPA or account
+	// (VPAs are only found in narrations that were not normalized)
 	if method == "UPI" {
-		merchant, payee := ExtractUPIDetails(narration)
-		if merchant != "" {
-			return merchant
-		}
-		if payee != "" {
-			return payee
+		if upi, ok := utils.ParseUPI(narration, false); ok {
+			switch {
+			case upi.Name != "":
+				return upi.Name
+			case upi.VPA != "":
+				return upi.VPA
+			case upi.Account != "":
+				return upi.Account
+			}
 		}
 	}
 
@@ -70,8 +97,7 @@ func ExtractBeneficiary(narration string, method string) string {
 	// HDFC format: P:REF BANK SALARY FOR MONTH YEAR (e.g., "P:K16675 HDFC BANK SALARY FOR APR 2024")
 	if strings.Contains(strings.ToUpper(narration), "SALARY") {
 		// Extract bank name from salary narration
-		re := regexp.MustCompile(`(?:P:[A-Z0-9]+\s+)?([A-Z\s]+?)\s+BANK\s+SALARY`)
-		matches := re.FindStringSubmatch(strings.ToUpper(narration))
+		matches := salaryBankPattern.FindStringSubmatch(strings.ToUpper(narration))
 		if len(matches) > 1 {
 			bankName := strings.TrimSpace(matches[1])
 			if bankName != "" {
@@ -79,8 +105,7 @@ func ExtractBeneficiary(narration string, method string) string {
 			}
 		}
 		// Fallback: Extract employer name
-		re = regexp.MustCompile(`(?:SALARY|SAL)[\s]+(?:FOR|FROM)[\s]+([A-Z\s]+)`)
-		matches = re.FindStringSubmatch(strings.ToUpper(narration))
+		matches = salaryEmployerPattern.FindStringSubmatch(strings.ToUpper(narration))
 		if len(matches) > 1 {
 			return strings.TrimSpace(matches[1])
 		}
@@ -88,8 +113,7 @@ func ExtractBeneficiary(narration string, method string) string {
 
 	// For EMI/Loan transactions
 	if method == "EMI" {
-		re := regexp.MustCompile(`(?:EMI|LOAN|INSTALLMENT)[\s]+(?:FOR|OF)[\s]+([A-Z\s]+)`)
-		matches := re.FindStringSubmatch(strings.ToUpper(narration))
+		matches := emiLenderPattern.FindStringSubmatch(strings.ToUpper(narration))
 		if len(matches) > 1 {
 			return strings.TrimSpace(matches[1])
 		}
@@ -111,8 +135,7 @@ func ExtractBeneficiary(narration string, method string) string {
 	// Examples: "ACH D- TP ACH MAXLIFEINSURA-1424041803", "ACH C- ICICI SECURITIES LIM-3614387"
 	if method == "ACH" {
 		// Pattern 1: ACH C/D- MERCHANT-REF
-		re := regexp.MustCompile(`ACH[\s]*(?:C|D|CR|DR)[-\s]+([A-Z\s]+?)(?:-|LIMITED|LTD|PVT|PRIVATE|INSURA|SECURITIES)`)
-		matches := re.FindStringSubmatch(strings.ToUpper(narration))
+		matches := achMerchantPattern.FindStringSubmatch(strings.ToUpper(narration))
 		if len(matches) > 1 {
 			merchant := strings.TrimSpace(matches[1])
 			// Clean up common prefixes
@@ -122,8 +145,7 @@ func ExtractBeneficiary(narration string, method string) string {
 			}
 		}
 		// Pattern 2: Fallback - extract after ACH C/D
-		re = regexp.MustCompile(`ACH[\s]*(?:C|D|CR|DR)[-\s]+([A-Z\s]+?)(?:-|$)`)
-		matches = re.FindStringSubmatch(strings.ToUpper(narration))
+		matches = achFallbackPattern.FindStringSubmatch(strings.ToUpper(narration))
 		if len(matches) > 1 {
 			merchant := strings.TrimSpace(matches[1])
 			if len(merchant) > 0 && len(merchant) < 50 {
@@ -173,8 +195,7 @@ func ExtractRecurringDetails(narration string) (name string, pattern string) {
 	narration = strings.ToUpper(narration)
 
 	// Extract name from common patterns
-	re := regexp.MustCompile(`(?:FOR|TO|FROM)[\s]+([A-Z\s]+?)(?:-|LIMITED|LTD|MONTHLY|INSTALLMENT)`)
-	matches := re.FindStringSubmatch(narration)
+	matches := recurringNamePattern.FindStringSubmatch(narration)
 	if len(matches) > 1 {
 		name = strings.TrimSpace(matches[1])
 	}
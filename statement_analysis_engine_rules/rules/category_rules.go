@@ -1,11 +1,23 @@
 package rules
 
 import (
+	_ "classify/statement_analysis_engine_rules/rulepack" // Installs the built-in rule pack
 	"classify/statement_analysis_engine_rules/utils"
 	"regexp"
+	"sort"
 	"strings"
 )
 
+// Category patterns, compiled once
+var (
+	// EMI 4452581
+	emiAccountPattern = regexp.MustCompile(`EMI\s*\d+`)
+	// RTGS DR-BANKCODE-BENEFICIARY NAME-REF
+	transferIFSCNamePattern = regexp.MustCompile(`(?:IMPS|NEFT|RTGS)\s+(?:DR|CR)?-?([A-Z]{4}\d+)-([A-Z\s]+?)-`)
+	// IMPS-REF-NAME-BANK
+	transferRefNamePattern = regexp.MustCompile(`(?:IMPS|NEFT)-(\d+)-([A-Z\s]+?)-[A-Z]{4}`)
+)
+
 // CategoryResult contains category classification with metadata
 type CategoryResult struct {
 	Category        string
@@ -15,6 +27,64 @@ type CategoryResult struct {
 	Channel         string
 	RuleVersion     string
 	Reason          string
+	Layer           string // Layer that decided the category: LayerKnownMerchant, LayerPackRule, LayerPatterns or LayerDefault
+	Group           string // Keyword group of the rule pack whose keyword decided a LayerPatterns category, if any
+}
+
+// Layers of ClassifyCategoryWithMetadata, recorded in CategoryResult.Layer
+const (
+	LayerKnownMerchant = "known_merchant" // Known merchant of the rule pack
+	LayerPackRule      = "pack_rule"      // Category rule of the rule pack
+	LayerPatterns      = "patterns"       // Built-in keyword and regex patterns
+	LayerDefault       = "default"        // Nothing matched
+)
+
+// groupMatches looks the keyword groups of ClassifyCategoryWithMetadata up in the rule pack
+// and remembers the keywords First and In returned, so the result can name its group
+type groupMatches struct {
+	utils.Matches
+	pack *utils.RuleSet
+	hits []groupHit
+}
+
+type groupHit struct {
+	id      string
+	keyword string
+}
+
+// Any reports whether the text contains any keyword of the group
+func (f *groupMatches) Any(id string) bool {
+	return f.Matches.Any(f.pack.Group(id))
+}
+
+// First returns the first keyword of the group, in list order, that the text contains
+func (f *groupMatches) First(id string) (string, bool) {
+	keyword, ok := f.Matches.First(f.pack.Group(id))
+	if ok {
+		f.hits = append(f.hits, groupHit{id, keyword})
+	}
+	return keyword, ok
+}
+
+// In returns the keywords of the group the text contains, in list order
+func (f *groupMatches) In(id string) []string {
+	keywords := f.Matches.In(f.pack.Group(id))
+	for _, keyword := range keywords {
+		f.hits = append(f.hits, groupHit{id, keyword})
+	}
+	return keywords
+}
+
+// group returns the group of the latest keyword First or In returned among keywords
+func (f *groupMatches) group(keywords []string) string {
+	for i := len(f.hits) - 1; i >= 0; i-- {
+		for _, keyword := range keywords {
+			if f.hits[i].keyword == keyword {
+				return f.hits[i].id
+			}
+		}
+	}
+	return ""
 }
 
 // ClassifyCategory classifies the transaction category based on narration
@@ -30,19 +100,73 @@ func ClassifyCategoryWithAmount(narration string, merchant string, amount float6
 	return result.Category
 }
 
+// Keyword groups of ClassifyCategoryWithMetadata, by ID in the rule pack (keywordGroups)
+// The keywords live in the pack; the logic below decides how each group is used
+const (
+	foodDeliveryPatterns        = "food_delivery"
+	diningPatterns              = "dining"
+	travelPatterns              = "travel"
+	fuelPatterns                = "fuel"
+	shoppingPatterns            = "shopping"
+	groceriesPatterns           = "groceries"
+	billGateways                = "bill_gateways"
+	genericGateways             = "generic_gateways"
+	electricityPatterns         = "electricity"
+	gasPatterns                 = "gas"
+	waterPatterns               = "water"
+	telecomPatterns             = "telecom"
+	dthPatterns                 = "dth"
+	tollPatterns                = "toll"
+	governmentPatterns          = "government"
+	insuranceCategoryPatterns   = "insurance"
+	creditCardPatterns          = "credit_card"
+	loanKeywords                = "loan_keywords"
+	autoDebitPatterns           = "auto_debit"
+	bankLoanPatterns            = "bank_loan"
+	nbfcLoanPatterns            = "nbfc_loan"
+	loanTypePatterns            = "loan_type"
+	loanOverduePatterns         = "loan_overdue"
+	loanGatewayPatterns         = "loan_gateway"
+	loanAmbiguousPatterns       = "loan_ambiguous"
+	loanEmiPatterns             = "loan_emi"
+	housingPatterns             = "housing"
+	taxCategoryPatterns         = "tax"
+	billsPatterns               = "bills"
+	healthcarePatterns          = "healthcare"
+	educationPatterns           = "education"
+	entertainmentPatterns       = "entertainment"
+	religiousCharitablePatterns = "religious_charitable"
+	autoPartsPatterns           = "auto_parts"
+	investmentCategoryPatterns  = "investment"
+	dividendCategoryPatterns    = "dividend"
+	salaryIncomePatterns        = "salary_income"
+	excludeFromBills            = "exclude_from_bills"
+	investmentInsurancePatterns = "investment_insurance"
+	investmentCompanies         = "investment_companies"
+)
+
 // ClassifyCategoryWithMetadata classifies category and returns metadata (for explainability)
 func ClassifyCategoryWithMetadata(narration string, merchant string, amount float64) CategoryResult {
+	return ClassifyCategoryWithUPIKind(narration, merchant, amount, "")
+}
+
+// ClassifyCategoryWithUPIKind is ClassifyCategoryWithMetadata for a transaction whose UPI
+// counterparty kind (utils.UPIPerson, utils.UPIBusiness...) is known; "" when it is not
+func ClassifyCategoryWithUPIKind(narration string, merchant string, amount float64, upiKind string) CategoryResult {
+	pack := utils.ActiveRules()
 	result := CategoryResult{
 		Category:        "Other",
 		Confidence:      0.0,
 		MatchedKeywords: make([]string, 0),
-		RuleVersion:     utils.RuleVersion,
+		RuleVersion:     pack.Version,
+		Layer:           LayerDefault,
 	}
 
 	originalNarration := narration
 	narration = strings.ToUpper(narration)
 	merchant = strings.ToUpper(merchant)
 	combined := narration + " " + merchant
+	found := &groupMatches{Matches: pack.Match(combined), pack: pack}
 
 	// Tokenize narration for better pattern matching
 	tokens := utils.Tokenize(originalNarration)
@@ -76,12 +200,17 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 	// ========================================================================
 	// Detect intent keywords - they support but don't override merchant
 	intentScores := utils.DetectIntentKeywords(originalNarration)
-	// Store intent keywords for explainability
+	// Store intent keywords for explainability (sorted, so the metadata is stable between runs)
+	intentCategories := make([]string, 0, len(intentScores))
 	for category, score := range intentScores {
 		if score > 0 {
-			matchedKeywords = append(matchedKeywords, category+"_INTENT")
+			intentCategories = append(intentCategories, category)
 		}
 	}
+	sort.Strings(intentCategories)
+	for _, category := range intentCategories {
+		matchedKeywords = append(matchedKeywords, category+"_INTENT")
+	}
 
 	// ========================================================================
 	// LAYER 6: PATTERN & AMOUNT HEURISTICS (Tie-breakers)
@@ -93,8 +222,14 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 	}
 
 	// Helper function to return CategoryResult with category
+	// layer is the layer of the next return; only the known merchant and pack rule returns change it
+	layer := LayerPatterns
 	returnCategory := func(category string, confidence float64, reason string, keywords ...string) CategoryResult {
 		resultCopy := result
+		resultCopy.Layer = layer
+		if layer == LayerPatterns {
+			resultCopy.Group = found.group(keywords)
+		}
 		resultCopy.Category = category
 		resultCopy.Confidence = confidence
 		resultCopy.Reason = reason
@@ -161,27 +296,11 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 		}
 		
 		if knownMerchantConfidence >= 0.9 && !strings.Contains(combined, "EMI") && !strings.Contains(combined, "LOAN") && !isLargeGasPayment {
+			layer = LayerKnownMerchant
 			return returnCategory(knownMerchantCategory, knownMerchantConfidence, "Known merchant detected: "+knownMerchantName, knownMerchantName)
 		}
 	}
 
-	// Food Delivery patterns (comprehensive - ONLINE ONLY, NOT POS)
-	foodDeliveryPatterns := []string{
-		// Primary Food Apps
-		"ZOMATO", "ZOMATOONLINE", "ZOMATOINDIA", "ZOMATOORDER", "ZMT",
-		"SWIGGY", "SWIGGYINSTAMART", "SWIGGYONLINE", "SWIGGYORDER",
-		"FAASOS", "EATSURE", "BOX8", "REVOLVEEATSURE",
-		// Gateway combinations (key indicator for online delivery)
-		"PAYUZOMATO", "RAZPZOMATO", "PAYUSWIGGY", "RAZPSWIGGY",
-		"PAYUDOMINOS", "RAZPMCDONALDS", "AMAZONPAYZOMATO",
-		// Generic
-		"UBER EATS", "FOODPANDA", "FOOD DELIVERY", "ONLINE FOOD ORDER",
-		// Catering services (from "Other" transactions)
-		"CATERERS", "CATERING", "BALAJI CATERERS",
-		// Vending machines (from "Other" transactions)
-		"VENDING", "SHREEVENDING",
-	}
-
 	// Check tokens for wallet-based food delivery
 	wallet := utils.DetectWallet(tokens)
 	if wallet != "" {
@@ -193,537 +312,15 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 		}
 	}
 
-	// Dining patterns (POS signals - restaurants, cafes, NOT delivery)
-	// IMPORTANT: Do NOT include DAIRY here - Dairy shops are Groceries, not Dining
-	diningPatterns := []string{
-		// POS indicators (key signal for dining vs delivery)
-		"POS RESTAURANT", "POS CAFE", "POS DINING",
-		// Restaurant/Cafe names (when not with delivery gateways)
-		"RESTAURANT", "CAFE", "DINING",
-		"FOOD COURT", "EATERY", "BAKERY", "COFFEE",
-		// Sweets shops (ready-to-eat sweets from shops are dining)
-		"BANSAL BIKANER SWEET", "BIKANER SWEET",
-		"AGGARWAL SWEETS", "AGGARWAL FOOD", "AGGARWAL SWEET",
-		"SWEET SHOP", "SWEETS SHOP",
-		"STARBUCKS", "CAFE COFFEE DAY", "CCD",
-		"BARBEQUENATION",
-		// POS + restaurant chain (dining, not delivery)
-		"POS DOMINOS", "POS MCDONALDS", "POS KFC",
-		"POS PIZZAHUT", "POS BURGERKING", "POS SUBWAY",
-		// Restaurants/Cafes (from "Other" transactions)
-		"EATSOME", "MEGAPOLISSANGRIA", "SANGRIA",
-		"SNACKS CENT", "SNACKS",
-		"GODAVARI SNACKS", "GODAVARI",
-		// Dining establishments (from classification issues)
-		"BAMRADA SONS", "BAMRADA",
-		"SPECIAL CHAT CENTER", "SPECIAL CHAT", "CHAT CENTER",
-		"MUSKAN BAKERS", "MUSKAN BAKERS AND CO",
-		"ROSIER FOODS", "ROSIER",
-		"PANCHAITEA", "PANCHAI TEA",
-		// Beverage vendors (from 2025 data)
-		"LASSI WALE", "LASSI", "JUICE", "JUICE WALE",
-	}
-
-	// Travel patterns (comprehensive)
-	travelPatterns := []string{
-		// Cab & Local Travel
-		"UBER", "UBERTRIP", "UBERINDIA", "UBERBV", "PAYUUBER",
-		"OLA", "OLACABS", "OLAMONEY", "OLATRIP", "RAPIDO",
-		// Railways
-		"IRCTC", "IRCTCIPAY", "RAZPIRCTC", "PAYUIRCTC", "RAZPIRCTCIPAY",
-		// Bus Booking
-		"REDBUS", "ABHIBUS", "YATRAGENIE",
-		// Flight Booking
-		"MAKEMYTRIP", "MMT", "MMTFLIGHT", "MMTHOTEL",
-		"GOIBIBO", "GOIBIBOFLIGHT", "IBIBO",
-		"YATRA", "YATRADOTCOM", "CLEARTRIP",
-		// Hotels / Stays
-		"OYO", "OYOROOMS", "TREEBO", "FABHOTELS", "AIRBNB",
-		// Moving/Relocation services (from "Other" transactions)
-		"PACKER", "MOVER", "PACKER MOVER", "PACKING", "MOVING",
-		"RELOCATION", "SHIFTING",
-		// Generic
-		"TRAVEL", "FLIGHT", "HOTEL", "CAB", "TAXI", "BOOKING",
-		"ONLINE TRAVEL PAYMENT",
-	}
-
-	// Fuel / Petrol / Diesel / EV patterns (separate from travel)
-	fuelPatterns := []string{
-		// PSU Oil Companies
-		"IOCL", "INDIANOIL", "INDIAN OIL",
-		"BPCL", "BHARATPETROLEUM", "BHARAT PET",
-		"HPCL", "HINDUSTANPETROLEUM", "HIND PET",
-		// Private Fuel Companies
-		"RELIANCE PETROLEUM", "RELIANCE PETROL", "RELIANCE",
-		"SHELL", "ESSAR", "NAYARA ENERGY",
-		// EV Charging
-		"TATA POWER EV", "ATHER ENERGY",
-		// FASTag (Fuel/Toll mix)
-		"FASTAG", "ICICIFASTAG", "HDFCBANKFASTAG",
-		"PAYTMFASTAG", "NHAI",
-		// Generic
-		"PETROL", "DIESEL", "FUEL", "PETROL PUMP", "SERVICE STATION",
-		"GAS STATION",
-		// Service stations (from classification issues)
-		"DAUJI SERVICE STATIO", "DAUJI SERVICE", "SERVICE STATIO",
-		"PHOOL SERVICE STATIO", "PHOOL SERVICE",
-	}
-
-	// Shopping patterns (E-commerce & Retail)
-	shoppingPatterns := []string{
-		// E-commerce platforms
-		"AMAZON", "AMAZONPAY", "FLIPKART", "FLIPKARTIN",
-		"MYNTRA", "AJIO", "MEESHO", "NYkaa",
-		// Retail / POS
-		"POS AMAZON", "POS FLIPKART", "POS RETAIL",
-		"POS STORE", "POS PURCHASE",
-		// Fashion / Lifestyle
-		"ZARA", "HNM", "PANTALOONS", "LIFESTYLE",
-		// Generic shopping
-		"SHOPPING", "MALL", "STORE", "SHOP",
-		"JEWELLERY", "TANISHQ", "MALABAR", "PC JEWELLER",
-		"ELECTRONICS", "CROMA", "RELIANCE DIGITAL",
-		"Vijay Sales", "GREAT EASTERN", "SHOPPERS STOP",
-		"SIMPL", "SIMPL TECHNOLOGI", "GETSIMPL", // Simpl buy now pay later
-		// Clothing stores (from "Other" transactions)
-		"NEW LOOK", "RANGOLI HOSIERY", "NEW BOMBAY GENTS PAR",
-		"MEGA INNERWEARS", "GIFT GALLERY", "INNERWEARS",
-		"HOSIERY", "GENTS PAR", "GENTS", "CLOTHING",
-		// Auto parts/accessories (from "Other" transactions)
-		"JAIN AUTO", "AUTO AND ACCESS", "AUTO ACCESS",
-		"AUTO PARTS", "AUTO ACCESSORIES",
-		"ASB AUTOMOBILES", "AUTOMOBILES", "AUTO MOBILES",
-		// Auto services and vehicle maintenance (from 2025 data)
-		"AUTO CARE", "RIDE N REPAIR", "RIDE AND REPAIR",
-		"SCOOTER AGENCY", "SHIVA SCOOTER", "VEHICLE SERVICE",
-		"BIKE SERVICE", "SCOOTER SERVICE", "CAR SERVICE",
-		// Jewellery stores
-		"JEWELLERS", "JEWELLERY", "JEWELRY", "KAMLA JI JEWELLERS", "KUMAR JEWELLERS",
-		// Shoes
-		"WELCO SHOES", "SHOES", "FOOTWEAR",
-		// Beauty salons/products
-		"FINAL TOUCH BEAUTY", "BEAUTY", "BEAUTY PARLOUR", "BEAUTY PARLOR",
-		"SALON", "SALOON", "SPA",
-		// Supply stores
-		"ALPHABULK SUPPLY", "SUPPLY SOL", "SUPPLY SOLUTION",
-		// Technology/services (if not bills)
-		"PARVIOM TECHNOLOGIES", "PARVIOM",
-		// Trading companies and stores (from classification issues)
-		"TRADING COM", "TRADING",
-		"TRADING COMPA",
-		"STATIONERY", "STATIONARY",
-		"BIKANERVALA", "BIKANERVALA PRIVATE",
-		"BOMBAY WATCH COMPANY", "BOMBAY WATCH",
-		"VENDING BROTHERS", "BROTHERS PVT",
-		// Local businesses with generic names (from "Other" transactions)
-		"ENTERPRISE", "ENTERPRISES", "INDUSTRIA",
-		// Examples: A Y ENTERPRISE, KALIKA ENTERPRISES, AAYUSH ENTERPRISES, 
-		// DAYALS ENTERPRISES, RUKMANI ENTERPRISES, RAW REAPS ENTERPRISE,
-		// CHANDIGARH INDUSTRIA
-		// Home services (from 2025 data)
-		"INTERIORS", "TULSI INTERIORS", "INTERIOR DESIGN",
-		"FURNITURE", "HOME DECOR", "FURNISHING",
-	}
-
-	// Groceries patterns (Online & Offline)
-	// IMPORTANT: Dairy shops sell milk, paneer, etc. - these are groceries, NOT dining
-	groceriesPatterns := []string{
-		// Online Groceries
-		"BIGBASKET", "BBNOW", "GROFERS", "BLINKIT",
-		"JIO MART", "JIOMART", "AMAZONFRESH",
-		"ZEPTO", "ZEPTO MARKETPLACE", "ZEPTO MARKETPLACE PR", // Grocery delivery app
-		// Offline Grocery / Kirana
-		"POS GROCERY", "POS SUPERMARKET",
-		"DMART", "RELIANCE SMART", "MORE SUPERMARKET",
-		"RELIANCE FRESH", "SPENCERS", "BIG BAZAAR",
-		// Generic
-		"GROCERY", "GROCERIES", "SUPERMARKET", "KIRANA", "GENERAL STORE",
-		// Vegetables and Fruits
-		"VEGETABLE", "VEGETABLES", "VEG", "FRUIT", "FRUITS",
-		"VEGETABLE SHOP", "FRUIT SHOP", "VEGETABLE MARKET",
-		"FRUIT MARKET", "VEGETABLE VENDOR", "FRUIT VENDOR",
-		// Dairy shops (milk, paneer, curd, etc. - these are grocery stores, NOT restaurants)
-		"DAIRY", "DAIRY SHOP", "DAIRY STORE", "DAIRY AND SWEE", "DAIRY AND SWEET",
-		"ANKIT DAIRY", "MILK", "MILK SHOP", "DOODH", "PANEER",
-		// Smart bazar / marketplaces
-		"SMART BAZAR", "SMART BAZAAR", "MAYUR SMART BAZAR",
-		// Provision stores (from 2025 data)
-		"PROVISION", "PROVISIONS", "PROVISION STOR", "PROVISION STORE",
-		// Agricultural/farm products
-		"KISANKONNECT", "KISAN KONNECT", "FARM", "AGRICULTURAL",
-		// Local traders and markets (from classification issues)
-		"TRADERS",
-		"SUPER MARKET",
-		"KHOA PANEER",
-	}
-
-	// Universal Bill Payment Aggregators/Gateways
-	// IMPORTANT: Only include actual bill payment aggregators, NOT generic payment gateways
-	// Generic gateways like PAYTM, GPAY, PHONEPE, AMAZONPAY are used for ALL payment types
-	// EXCEPTION: PAYTM UTILITY / PAYTM ECOMMERCE-UTILITYPAYTM are bill payments
-	billGateways := []string{
-		"BILLDESK", "BILLDK", "BILLDESKPG", "BDGPAY", "BBPS", // Actual bill payment aggregators
-		"WHDF", "SBIPG", "AXISPG", "ICICIPG", "KOTAKPG", "YESPG", // Bank-specific bill payment gateways
-		"PAYGOV", // Government payment gateway
-		// Note: PAYU, RAZORPAY, RAZP, CCAVENUE can be used for bills but also for other payments
-		// Only classify as bill if combined with actual bill keywords
-	}
-
-	// Generic payment gateways (used for ALL payment types, not just bills)
-	// These should NOT trigger bill payment detection alone
-	genericGateways := []string{
-		"PAYTM", "GPAY", "PHONEPE", "AMAZONPAY", "PAYU", "RAZORPAY", "RAZP",
-		"CCAVENUE", "VYAPAR", "BHARATPE", "BAJAJPAY", "MOBIKWIK",
-	}
-
-	// Electricity Bill Patterns
-	electricityPatterns := []string{
-		"ELECTRICITY", "BSESR", "BSES", "TATAPOWER", "TORRENTPOWER",
-		"MSEB", "MSEDCL", "UPPCL", "DVVNL", "BSESYAMUNA", "BSESRAJDHANI",
-		"MAHARASHTRA STATE EL", "MAHARASHTRA STATE ELECTRICITY",
-		"EL", "POWER", "DISCOM",
-	}
-
-	// Gas (PNG/LPG) Patterns
-	gasPatterns := []string{
-		"GAS", "INDRAPRASTHAGA", "IGL", "MGL", "ADANIGAS", "GUJGAS",
-		"HPGAS", "BPCL GAS", "LPG",
-	}
-
-	// Water Bill Patterns
-	waterPatterns := []string{
-		"WATER", "DELHIJALBOARD", "BWSSB", "MCGM", "JAL BOARD",
-		"WATER BOARD", "WATER SUPPLY",
-	}
-
-	// Telecom & Internet Patterns
-	telecomPatterns := []string{
-		"PHONE", "MOBILE", "BROADBAND", "INTERNET", "AIRTEL", "JIO",
-		"VODAFONE", "VODAFONE IDEA", "VODAFONE IDEA LTD", "VILPOSMNG", // VODAFONE IDEA LTD pattern
-		"IDEA", "BSNL", "ACTFIBERNET", "HATHWAY", "TIKONA",
-		"RECHARGE", "PREPAID", "POSTPAID", "TELECOM",
-	}
-
-	// DTH/TV Patterns
-	dthPatterns := []string{
-		"DTH", "CABLE", "TATASKY", "AIRTELDTH", "DISH", "SUNTV",
-		"VIDEOCON D2H", "D2H",
-	}
-
-	// Transport & Toll Patterns
-	tollPatterns := []string{
-		"FASTAG", "NHAI", "TOLL", "PAYTMFASTAG", "ICICIFASTAG",
-		"HDFCBANKFASTAG", "AXISFASTAG", "SBIFASTAG",
-	}
-
-	// Government Payment Patterns
-	governmentPatterns := []string{
-		"PAYGOV", "GOVT", "GOVERNMENT", "GST", "INCOMETAX", "PASSPORT",
-		"CHALLAN", "TRAFFIC CHALLAN", "ROAD TAX", "PROPERTY TAX",
-		"PROFESSIONAL TAX",
-	}
-
-	// Insurance Premium Patterns
-	insurancePatterns := []string{
-		"INSURANCE", "PREMIUM", "LIC", "HDFC LIFE", "HLIC", "HLIC_INST", "HLIC INST",
-		"MAXLIFE", "SBI LIFE", "ICICI PRUDENTIAL", "BAJAJ ALLIANZ",
-		"STANDARDLIFE", "SBILIFE", "ICICIPRULIFE",
-	}
-
-	// Credit Card Payment Patterns
-	creditCardPatterns := []string{
-		"CREDITCARD", "CREDIT CARD", "CARDBILL", "CARDPAYMENT",
-		"HDFCCARD", "SBICARD", "AXISCARD", "ICICICARD", "KOTAKCARD",
-	}
-
-	// Loan EMI Patterns (comprehensive - banking-industry-grade)
-	// Universal Loan EMI Keywords
-	loanKeywords := []string{
-		"EMI", "LOAN", "INSTALMENT", "INSTALLMENT",
-		"SI", "ECS", "NACH", "AUTO DEBIT", "MANDATE",
-	}
-
-	// Auto-Debit Modes (Critical Signals)
-	autoDebitPatterns := []string{
-		"ECS EMI", "NACH EMI", "SI EMI", "AUTO EMI", "MANDATE EMI",
-	}
-
-	// Bank Loan EMI Narrations
-	bankLoanPatterns := []string{
-		// HDFC Bank / HDFC Ltd
-		"ECS EMI HDFC LTD", "HDFC LOAN EMI", "HDFC HOME LOAN EMI",
-		"HDFCBANK EMI", "HDFC LTD EMI", "HDFCLOAN",
-		// ICICI Bank
-		"ICICI LOAN EMI", "ICICI HOME LOAN EMI", "ECS EMI ICICI",
-		"ICICI PERSONAL LOAN EMI", "ICICILOAN",
-		// SBI
-		"SBI LOAN EMI", "SBI HOME LOAN EMI", "ECS EMI SBI",
-		"SBI PERSONAL LOAN EMI", "SBILOAN",
-		// Axis Bank
-		"AXIS LOAN EMI", "AXIS BANK EMI", "NACH EMI AXIS", "AXISLOAN",
-		// Kotak Bank
-		"KOTAK LOAN EMI", "KOTAK BANK EMI", "KOTAKLOAN",
-		// Other Banks
-		"IDFC LOAN EMI", "YES BANK EMI", "PNB LOAN EMI",
-		"IDFCLOAN", "YESBANK", "PNBLOAN",
-	}
-
-	// NBFC Loan EMI Narrations
-	nbfcLoanPatterns := []string{
-		// Bajaj Finserv
-		"BAJAJ FINSERV EMI", "BAJAJ FIN EMI", "BAJAJ FINANCE",
-		"BAJAJFINSERV", "BAJAJFIN",
-		// Tata Capital
-		"TATA CAPITAL EMI", "TATACAPITAL",
-		// HDB Financial
-		"HDB EMI", "HDB FINANCIAL EMI", "HDBFINANCIAL",
-		// Home Credit
-		"HOME CREDIT EMI", "HOMECREDIT",
-		// Aditya Birla Finance
-		"ADITYA BIRLA EMI", "ABFL EMI", "ADITYABIRLA",
-		// L&T Finance
-		"LT FINANCE EMI", "LTF EMI", "LTFINANCE",
-	}
-
-	// Loan Type-Specific Narrations
-	loanTypePatterns := []string{
-		// Home Loan
-		"HOME LOAN EMI", "HL EMI", "HOUSING LOAN EMI",
-		// Vehicle Loan
-		"CAR LOAN EMI", "AUTO LOAN EMI", "VEHICLE LOAN EMI",
-		// Personal Loan
-		"PERSONAL LOAN EMI", "PL EMI",
-		// Education Loan
-		"EDUCATION LOAN EMI", "STUDENT LOAN EMI",
-		// Business Loan
-		"BUSINESS LOAN EMI", "MSME LOAN EMI",
-	}
-
-	// Overdue / Penalty / Recovery Narrations
-	loanOverduePatterns := []string{
-		"OVERDUE LOAN RECOVERED", "EMI RECOVERY", "LOAN PENALTY",
-		"LATE PAYMENT FEE LOAN", "OVERDUE LOAN", "LOAN RECOVERED",
-		"REPAYMENT",
-	}
-
-	// BillDesk / PayU Based Loan Payments
-	loanGatewayPatterns := []string{
-		"BILLDKHDFCLOAN", "BILLDKBAJAJFINSERV", "PAYUHDFCHOMELOAN",
-		"BILLDKICICILOAN", "BILLDKSBILOAN", "BILLDKAXISLOAN",
-	}
-
-	// Ambiguous but Real Narrations
-	loanAmbiguousPatterns := []string{
-		"LOAN PAYMENT", "FINANCE PAYMENT", "INSTALLMENT PAID",
-		"MONTHLY INSTALLMENT",
-	}
-
-	// Combined Loan EMI Patterns (for matching)
-	loanEmiPatterns := []string{
-		// Universal keywords
-		"EMI", "LOAN", "INSTALMENT", "INSTALLMENT",
-		// Auto-debit
-		"ECS EMI", "NACH EMI", "SI EMI", "AUTO EMI", "MANDATE EMI",
-		// Banks
-		"HDFC LOAN", "ICICI LOAN", "SBI LOAN", "AXIS LOAN", "KOTAK LOAN",
-		"HDFCLOAN", "ICICILOAN", "SBILOAN", "AXISLOAN", "KOTAKLOAN",
-		"HDFC BANK EMI", "ICICI BANK EMI", "SBI BANK EMI",
-		// NBFCs
-		"BAJAJ FINSERV", "BAJAJ FIN", "BAJAJFINSERV", "BAJAJFIN",
-		"TATA CAPITAL", "HDB FINANCIAL", "HOME CREDIT",
-		"ADITYA BIRLA", "LT FINANCE",
-		// Loan types
-		"HOME LOAN", "CAR LOAN", "AUTO LOAN", "VEHICLE LOAN",
-		"PERSONAL LOAN", "EDUCATION LOAN", "BUSINESS LOAN",
-		// Overdue/Recovery
-		"OVERDUE LOAN", "EMI RECOVERY", "LOAN RECOVERED", "REPAYMENT",
-		// Gateways
-		"BILLDKHDFCLOAN", "BILLDKBAJAJFINSERV", "PAYUHDFCHOMELOAN",
-		// Ambiguous
-		"LOAN PAYMENT", "FINANCE PAYMENT", "INSTALLMENT PAID",
-	}
-
-	// Housing/Maintenance Patterns
-	housingPatterns := []string{
-		"MAINTENANCE", "SOCIETY", "APARTMENT", "ASSOCIATION",
-		"HOUSING", "SOCIETY MAINTENANCE",
-		"RENT", "RENT FOR MONTH", "HOUSE RENT", "RENTAL",
-		"MONTHLY RENT", "RENT PAYMENT",
-	}
-
-	// Tax Payment Patterns
-	taxPatterns := []string{
-		"TAX", "GST PAYMENT", "INCOME TAX", "PROPERTY TAX",
-		"PROFESSIONAL TAX", "ROAD TAX", "TRAFFIC CHALLAN",
-	}
-
-	// Combined Bills & Utilities patterns (for backward compatibility)
-	billsPatterns := []string{
-		"ELECTRICITY", "WATER", "GAS", "PHONE", "INTERNET",
-		"MOBILE", "BROADBAND", "DTH", "CABLE", "INSURANCE",
-		"PREMIUM", "LIC", "HDFC LIFE", "HLIC", "HLIC_INST", "HLIC INST",
-		"MAXLIFE", "SBI LIFE", "ICICI PRUDENTIAL", "BAJAJ ALLIANZ",
-		"PVVNL", "IGL", "AIRTEL", "JIO", "VODAFONE", "BSNL",
-		"RECHARGE", "PREPAID", "POSTPAID", "BILL",
-		"BILLDK", "WHDF", // BillDesk gateway indicators
-		"MAHARASHTRA STATE EL", "MAHARASHTRA STATE ELECTRICITY",
-		"MSEDCL", "MAHARASHTRA STATE", "EL", // Electricity board patterns
-		// Wallets (typically used for bills/recharges)
-		"MOBIKWIK",
-		// Business services (often bill payments)
-		"BUSINESS SOL", "BUSINESS SERVICE", "EROCKET",
-		// Farm/agricultural services (often bill payments)
-		"FARMWORK", "FARM WORK", "AGRICULTURAL",
-		// Bank charges and fees (from classification issues)
-		"DC INTL POS TXN MARKUP", "INTL POS TXN MARKUP", "POS TXN MARKUP",
-		"BANK CHARGES", "SERVICE CHARGE", "ANNUAL FEE", "MAINTENANCE CHARGE",
-		// Software & Technology subscriptions (from 2025 data)
-		"CLAUDE.AI", "CLAUDE AI", "ANTHROPIC", "CURSOR", "AI POWERED IDE",
-		"GOOGLE CLOUD", "GOOGLECLOUD", "AWS", "AZURE", "CLOUD COMPUTING",
-		"SOFTWARE SUBSCRIPTION", "SAAS",
-	}
-
-	// Healthcare patterns
-	healthcarePatterns := []string{
-		"HOSPITAL", "CLINIC", "PHARMACY", "MEDICINE",
-		"APOLLO", "FORTIS", "MAX", "MEDICOS", "MEDICAL",
-		"HEALTH", "DOCTOR", "LAB", "DIAGNOSTIC",
-		"MEDICAL STORE",
-		"HOSPITALS", "SHIVALIK HOSPITAL",
-		"RANVEER MEDICAL", "MAYUR MEDICAL", "SHREE CHINTAMANI MED",
-		"PHARMACY", "CHEMIST", "CHITRANSH PHARMACY",
-		"PATANJALI CHIKITSALY", "HEALTHPLIX",
-		"DR ", "DR.", // Doctor prefix
-		// Pharmacies and medical stores (from classification issues)
-		"CHEMISTS",
-		"MEDICO",
-		// Medical imaging and health services (from 2025 data)
-		"MOLECULAR IMAGING", "IMAGING", "RADIOLOGY", "SCAN",
-		"AROGYA", "AROGYALAXMI", // "Arogya" = Health in Sanskrit
-	}
-
-	// Education patterns
-	educationPatterns := []string{
-		"SCHOOL", "COLLEGE", "UNIVERSITY", "TUITION",
-		"EDUCATION", "COURSE", "TRAINING", "INSTITUTE",
-		// Online education platforms (from classification issues)
-		"PHYSICSWALLAH", "PHYSICSWALLAH PVT LT", "PHYSICSWALLAH PVT",
-	}
-
-	// Entertainment patterns
-	// IMPORTANT: "YT" alone matches "PAYTM" - use specific patterns only
-	entertainmentPatterns := []string{
-		"MOVIE", "CINEMA", "THEATER", "NETFLIX", "AMAZON PRIME",
-		"DISNEY", "HOTSTAR", "SPOTIFY", "MUSIC", "GAME",
-		"PLAYSTORE", "GOOGLE PLAY",
-		// YouTube - use specific patterns, NOT just "YT" (matches PAYTM)
-		"YOUTUBE", "YOUTUBE PREMIUM", "YOUTUBEPREMIUM",
-		"YOUTUBE MUSIC", "YOUTUBEMUSIC", "YT PREMIUM", "YTPREMIUM",
-		// Streaming services
-		"ZEE5", "ZEE 5", "ZEE5SUBSCRIPTION",
-		"SONY PICTURES", "SONY PICTURES NETWOR", "SONYPICTURESNETWORK",
-		"SONYLIV", "SONY LIV", // SonyLIV subscription service
-		// Gaming (from "Other" transactions)
-		"GAMING", "GAME BUSINESS", "GAMING BUSINESS",
-		"JD DIGITAL", "DIGITAL", "ARTS", "VRT ARTS",
-		// Audio services (from "Other" transactions)
-		"AUDIOKRAFT", "AUDIOKRAFTSERVICE", "AUDIO SERVICE",
-		"AUDIO", "SOUND", "RECORDING",
-		// Parks and recreation (from classification issues)
-		"PARKS", "PARKS",
-		// Fitness and gym (from "Other" transactions)
-		"FITNESS", "GYM", "GYMNASIUM", "YOGA", "ZUMBA",
-		"WAY2FITNESS", "GOLD GYM", "ANYTIME FITNESS",
-		"CROSSFIT", "WORKOUT", "STRENGTH",
-		// Audio content platforms (from 2025 data)
-		"KUKUFM", "KUKU FM", "AUDIBLE", "PODCAST",
-		"QUICK TV", "QUICKTV",
-	}
-
-	// Religious and charitable organizations
-	religiousCharitablePatterns := []string{
-		"TEMPLE", "MANDIR", "CHURCH", "MOSQUE", "GURUDWARA",
-		"GAYATRI", "VEDMATA", "SANATAN", "SANATANA",
-		"SAMITI", "TRUST", "CHARITABLE", "DONATION",
-		"RAMAKRISHNA", "ISKCON", "TIRUMALA", "TIRUPATI",
-		"DARGAH", "SHRINE", "RELIGIOUS",
-	}
-
-	// Auto parts and services
-	autoPartsPatterns := []string{
-		"BATTERY", "TYRE", "TYRES", "AUTO PARTS", "AUTOPARTS",
-		"GARAGE", "CAR SERVICE", "CAR REPAIR", "PUNCTURE",
-		"MECHANIC", "OIL CHANGE", "SPARE PARTS", "SPAREPARTS",
-		"CAR WASH", "CARWASH",
-	}
-
-	// Investment patterns (Mutual Funds, Stocks, NPS, Insurance, Crypto)
-	investmentPatterns := []string{
-		// Mutual Funds
-		"MUTUAL FUND", "MF SIP", "SIP INSTALLMENT",
-		"GROWW", "COIN", "UPSTOX", "KITE",
-		// Stocks / Trading
-		"NSE", "BSE", "STOCK PURCHASE", "SECURITIES BUY",
-		"STOCK", "SHARE", "DEMAT",
-		// NPS / Pension
-		"BILLDKNPSTRUST", "NATIONAL PENSION SYSTEM",
-		"NPS CONTRIBUTION", "NPS", "PPF", "ELSS",
-		// Recurring Deposits / Fixed Deposits
-		"RD", "FD", "SIP", "RD INSTALLMENT",
-		// Clearing Corporations
-		"INDIAN CLEARING CORPORATION", "INDIAN CLEARING CORPORATION LIMITED",
-		"INDIAN C LEARING CORPORATION", "INDIAN C LEARING CORPORATION LIMITED", // Handle typo with space
-		"NSDL", "CDSL", "CLEARING CORPORATION",
-		// Stock Broking Companies
-		"ZERODHA", "ZERODHA BROKING", "ZERODHA BROKING LTD", "ZERODHABROKING",
-		"BROKING", "BROKING LTD", "HSL SEC", "HSL", "SEC",
-		"ANGEL BROKING", "ICICI SECURITIES", "HDFC SECURITIES", "KOTAK SECURITIES",
-		"SHAREKHAN", "MOTILAL OSWAL", "IIFL", "5PAISA",
-		// Insurance (Investment-type)
-		"HDFCLIFE", "ICICIPRULIFE", "SBILIFE", "LIC",
-		"MAXLIFE", "INSURANCE PREMIUM",
-		// Crypto Exchanges (Indian)
-		"WAZIRX", "WAZIRXIN", "ZANMAI", "ZANMAI LABS", "ZANMAILABS",
-		"COINDCX", "NEBULAS", "NEBULAS TECHNOLOGIES", "NEBULASTECHNOLOGIES", "DCX",
-		"COINSWITCH", "COINSWITCHKUBER", "BITCIPHER", "BITCIPHER LABS",
-		"ZEBPAY", "ZEB IT SERVICE", "ZEBITSERVICE",
-		"UNOCOIN", "UNOCOMMERCE",
-		// Crypto Exchanges (International)
-		"BINANCE", "BINANCEPAY", "BIFINANCE",
-		"COINBASE", "CB PAY", "CBPAY",
-		"KRAKEN", "PAYWARD",
-		"CRYPTOCOM", "FORIS",
-		"KUCOIN", "MEK GLOBAL", "MEKGLOBAL",
-		"BITSTAMP",
-		// Crypto Gateway Combinations
-		"PAYUWAZIRX", "PAYUZANMAI", "PAYUCOINDCX", "PAYUNEBULAS",
-		"RAZPZANMAILABS", "RAZPNEBULASTECHNOLOGIES", "RAZPCOINSWITCH", "RAZPBITCIPHER",
-		"CCAVENUEBITCIPHER", "CCAVENUEZANMAI",
-		// Crypto Transfer Patterns
-		"FUND TRANSFER TO ZANMAI", "NEFT TO NEBULAS", "IMPS BITCIPHER",
-		"IMPS FROM ZANMAI", "NEFT FROM COINDCX", "CRYPTO WITHDRAWAL",
-		// Crypto Generic Patterns (only if combined with known entities)
-		"CRYPTO", "CRYPTOCURRENCY", "DIGITAL ASSET", "VIRTUAL ASSET",
-		// Generic
-		"INVESTMENT",
-	}
-
-	// Dividend patterns (income from investments)
-	dividendPatterns := []string{
-		"DIV", "DIVIDEND", "DIVIDEND CREDIT", "DIV CR",
-	}
-
-	// Priority -1: Check Salary/Income (HIGHEST PRIORITY - before all other categories)
-	// Salary detection has very high confidence and should be checked first
-	salaryIncomePatterns := []string{
-		"SALARY", "SAL FOR", "PAYROLL", "WAGES", "BONUS",
-		"HDFC BANK SALARY", "ICICI BANK SALARY", "SBI SALARY", "AXIS BANK SALARY",
-		"SALARY FOR", "SAL CREDIT", "SALARY CREDIT",
-	}
-	for _, pattern := range salaryIncomePatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Income", 0.98, "Salary/Income detected", pattern)
-		}
+	// Priority -2: Category rules from the active rule pack (data, highest priority first)
+	// New keywords and regexes ship as pack updates; the Go patterns below remain the fallback
+	if packRule, matched, found := utils.MatchCategoryRule(combined); found {
+		layer = LayerPackRule
+		return returnCategory(packRule.Category, packRule.Confidence, packRule.Reason, matched)
+	}
+
+	if pattern, ok := found.First(salaryIncomePatterns); ok {
+		return returnCategory("Income", 0.98, "Salary/Income detected", pattern)
 	}
 
 	// Note: Refund detection is handled in classifier.go where we have access to deposit/withdrawal amounts
@@ -741,67 +338,49 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 		}
 		// Check for loan account number pattern (numbers after EMI)
 		// Pattern: "EMI" followed by numbers (like "EMI 4452581")
-		emiPattern := regexp.MustCompile(`EMI\s*\d+`)
-		if emiPattern.MatchString(combined) {
+		if emiAccountPattern.MatchString(combined) {
 			return returnCategory("Loan", 0.90, "EMI with account number pattern detected", "EMI")
 		}
 	}
 
 	hasLoanKeyword := false
-	for _, keyword := range loanKeywords {
-		if strings.Contains(combined, keyword) {
-			hasLoanKeyword = true
-			break
-		}
+	if found.Any(loanKeywords) {
+		hasLoanKeyword = true
 	}
 
 	if hasLoanKeyword {
 		// Check for auto-debit patterns (highest confidence)
-		for _, pattern := range autoDebitPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Loan", 0.95, "Auto-debit loan pattern detected: "+pattern, pattern)
-			}
+		if pattern, ok := found.First(autoDebitPatterns); ok {
+			return returnCategory("Loan", 0.95, "Auto-debit loan pattern detected: "+pattern, pattern)
 		}
 
 		// Check for bank/NBFC names (high confidence)
-		for _, pattern := range bankLoanPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Loan", 0.90, "Bank loan pattern detected: "+pattern, pattern)
-			}
+		if pattern, ok := found.First(bankLoanPatterns); ok {
+			return returnCategory("Loan", 0.90, "Bank loan pattern detected: "+pattern, pattern)
 		}
-		for _, pattern := range nbfcLoanPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Loan", 0.90, "NBFC loan pattern detected: "+pattern, pattern)
-			}
+		if pattern, ok := found.First(nbfcLoanPatterns); ok {
+			return returnCategory("Loan", 0.90, "NBFC loan pattern detected: "+pattern, pattern)
 		}
 
 		// Check for loan type patterns
-		for _, pattern := range loanTypePatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Loan", 0.85, "Loan type pattern detected: "+pattern, pattern)
-			}
+		if pattern, ok := found.First(loanTypePatterns); ok {
+			return returnCategory("Loan", 0.85, "Loan type pattern detected: "+pattern, pattern)
 		}
 
 		// Check for overdue/recovery patterns
-		for _, pattern := range loanOverduePatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Loan", 0.85, "Loan overdue/recovery pattern detected: "+pattern, pattern)
-			}
+		if pattern, ok := found.First(loanOverduePatterns); ok {
+			return returnCategory("Loan", 0.85, "Loan overdue/recovery pattern detected: "+pattern, pattern)
 		}
 
 		// Check for gateway-based loan payments
-		for _, pattern := range loanGatewayPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Loan", 0.90, "Gateway-based loan payment detected: "+pattern, pattern)
-			}
+		if pattern, ok := found.First(loanGatewayPatterns); ok {
+			return returnCategory("Loan", 0.90, "Gateway-based loan payment detected: "+pattern, pattern)
 		}
 
 		// Check for ambiguous loan patterns (if EMI or LOAN keyword present)
 		if strings.Contains(combined, "EMI") || strings.Contains(combined, "LOAN") {
-			for _, pattern := range loanAmbiguousPatterns {
-				if strings.Contains(combined, pattern) {
-					return returnCategory("Loan", 0.75, "Ambiguous loan pattern detected: "+pattern, pattern)
-				}
+			if pattern, ok := found.First(loanAmbiguousPatterns); ok {
+				return returnCategory("Loan", 0.75, "Ambiguous loan pattern detected: "+pattern, pattern)
 			}
 			// If EMI or LOAN keyword + ECS/NACH/SI, it's likely a loan
 			if strings.Contains(combined, "ECS") || strings.Contains(combined, "NACH") ||
@@ -856,16 +435,12 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 	hasPOS := strings.Contains(combined, "POS")
 	if hasPOS {
 		// Check if it's a restaurant/cafe (dining)
-		for _, pattern := range diningPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Dining", 0.80, "POS transaction at restaurant/cafe", "POS", pattern)
-			}
+		if pattern, ok := found.First(diningPatterns); ok {
+			return returnCategory("Dining", 0.80, "POS transaction at restaurant/cafe", "POS", pattern)
 		}
 		// Check if it's grocery (POS GROCERY)
-		for _, pattern := range groceriesPatterns {
-			if strings.Contains(combined, "POS") && strings.Contains(combined, pattern) {
-				return returnCategory("Groceries", 0.80, "POS grocery transaction", "POS", pattern)
-			}
+		if pattern, ok := found.First(groceriesPatterns); ok && strings.Contains(combined, "POS") {
+			return returnCategory("Groceries", 0.80, "POS grocery transaction", "POS", pattern)
 		}
 		// Check if it's shopping (POS RETAIL, POS STORE, etc.)
 		if strings.Contains(combined, "POS RETAIL") || strings.Contains(combined, "POS STORE") ||
@@ -878,10 +453,8 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 	// Priority 2: Check Food Delivery (ONLINE only, not POS)
 	// Exclude POS transactions from food delivery
 	if !hasPOS {
-		for _, pattern := range foodDeliveryPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Food_Delivery", 0.90, "Food delivery app detected (online)", pattern)
-			}
+		if pattern, ok := found.First(foodDeliveryPatterns); ok {
+			return returnCategory("Food_Delivery", 0.90, "Food delivery app detected (online)", pattern)
 		}
 		// Also check tokens for food delivery apps
 		for _, token := range tokens {
@@ -898,29 +471,23 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 
 	// Priority 2.5: Check Groceries BEFORE Dining (Dairy shops should be Groceries, not Dining)
 	// Dairy shops sell milk, paneer, curd, etc. - these are grocery stores
-	for _, pattern := range groceriesPatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Groceries", 0.80, "Grocery/Dairy store detected", pattern)
-		}
+	if pattern, ok := found.First(groceriesPatterns); ok {
+		return returnCategory("Groceries", 0.80, "Grocery/Dairy store detected", pattern)
 	}
 
 	// Priority 3: Check Dining (non-POS restaurants/cafes)
 	// IMPORTANT: This comes AFTER Groceries check so Dairy shops are not misclassified
-	for _, pattern := range diningPatterns {
-		if strings.Contains(combined, pattern) {
-			// Make sure it's not a delivery gateway
-			if !strings.Contains(combined, "PAYU") && !strings.Contains(combined, "RAZP") &&
-				!strings.Contains(combined, "ZOMATO") && !strings.Contains(combined, "SWIGGY") {
-				return returnCategory("Dining", 0.75, "Restaurant/cafe detected (non-POS)", pattern)
-			}
+	for _, pattern := range found.In(diningPatterns) {
+		// Make sure it's not a delivery gateway
+		if !strings.Contains(combined, "PAYU") && !strings.Contains(combined, "RAZP") &&
+			!strings.Contains(combined, "ZOMATO") && !strings.Contains(combined, "SWIGGY") {
+			return returnCategory("Dining", 0.75, "Restaurant/cafe detected (non-POS)", pattern)
 		}
 	}
 
 	// Check Fuel (separate category, before Travel)
-	for _, pattern := range fuelPatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Fuel", 0.85, "Fuel expense detected", pattern)
-		}
+	if pattern, ok := found.First(fuelPatterns); ok {
+		return returnCategory("Fuel", 0.85, "Fuel expense detected", pattern)
 	}
 	// Also check tokens for fuel patterns
 	for _, token := range tokens {
@@ -932,10 +499,8 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 	}
 
 	// Check Travel (comprehensive patterns)
-	for _, pattern := range travelPatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Travel", 0.85, "Travel expense detected", pattern)
-		}
+	if pattern, ok := found.First(travelPatterns); ok {
+		return returnCategory("Travel", 0.85, "Travel expense detected", pattern)
 	}
 	// Also check tokens for travel apps
 	for _, token := range tokens {
@@ -948,10 +513,8 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 	}
 
 	// Check Shopping (enhanced with tokenization)
-	for _, pattern := range shoppingPatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Shopping", 0.80, "Shopping expense detected", pattern)
-		}
+	if pattern, ok := found.First(shoppingPatterns); ok {
+		return returnCategory("Shopping", 0.80, "Shopping expense detected", pattern)
 	}
 	// Also check tokens for shopping-related merchants
 	for _, token := range tokens {
@@ -971,10 +534,8 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 
 	// Check Healthcare (before Bills to avoid misclassification)
 	// Medical/pharmacy transactions should NOT be classified as bills
-	for _, pattern := range healthcarePatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Healthcare", 0.80, "Healthcare expense detected", pattern)
-		}
+	if pattern, ok := found.First(healthcarePatterns); ok {
+		return returnCategory("Healthcare", 0.80, "Healthcare expense detected", pattern)
 	}
 	// Also check tokens for healthcare merchants
 	for _, token := range tokens {
@@ -1025,11 +586,8 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 	// Exclude generic payment gateways - they're used for ALL payment types
 	hasBillGateway := false
 	hasGenericGateway := false
-	for _, gw := range genericGateways {
-		if strings.Contains(combined, gw) {
-			hasGenericGateway = true
-			break
-		}
+	if found.Any(genericGateways) {
+		hasGenericGateway = true
 	}
 	// Only check bill gateways if no generic gateway is present (to avoid false positives)
 	// OR if BILLDESK is present (strong bill payment indicator)
@@ -1037,36 +595,15 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 		if hasBillDesk {
 			hasBillGateway = true
 		} else {
-			for _, gw := range billGateways {
-				if strings.Contains(combined, gw) {
-					hasBillGateway = true
-					break
-				}
+			if found.Any(billGateways) {
+				hasBillGateway = true
 			}
 		}
 	}
 
-	// Step 2: Exclude merchants that are clearly NOT bills (check FIRST before bill detection)
-	// These merchants should be classified in their respective categories, not as bills
-	excludeFromBills := []string{
-		"FOOD", "SWEET", "RESTAURANT", "CAFE", "DINING", "EATERY", "BAKERY",
-		"MEDICAL", "MEDICOS", "PHARMACY", "CLINIC", "HOSPITAL", "HEALTH",
-		"SALOON", "SALON", "BEAUTY", "SPA",
-		"SUPER MARKET", "MARKET", "GROCERY", "GROCERIES", "KIRANA",
-		"JEWELLERS", "JEWELLERY", "WATCH", "SHOP", "STORE", "MALL",
-		"TEA", "COFFEE", "SNACKS", "DAIRY",
-		"TRADERS", "TRADING", "ENTERPRISE", "BUSINESS",
-		"CHIKITSALY", "CHEMISTS", "MED", // Medical abbreviations
-		"BAZAR", "BAZAAR", "MARKETPLACE", // Marketplaces
-		"INN", "HOTEL", // Hotels/restaurants
-	}
-
 	hasExcludedMerchant := false
-	for _, exclude := range excludeFromBills {
-		if strings.Contains(combined, exclude) {
-			hasExcludedMerchant = true
-			break
-		}
+	if found.Any(excludeFromBills) {
+		hasExcludedMerchant = true
 	}
 
 	// Step 3: Check for explicit bill/utility keywords (required for classification)
@@ -1082,42 +619,30 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 
 	// Check for utility-specific patterns (electricity, gas, water, telecom) - these are actual utilities
 	hasActualUtility := false
-	for _, pattern := range electricityPatterns {
-		if strings.Contains(combined, pattern) {
-			hasActualUtility = true
-			hasBillKeyword = true // Treat as bill keyword
-			break
-		}
+	if found.Any(electricityPatterns) {
+		hasActualUtility = true
+		hasBillKeyword = true // Treat as bill keyword
 	}
 	if !hasActualUtility {
-		for _, pattern := range gasPatterns {
-			if strings.Contains(combined, pattern) {
-				hasActualUtility = true
-				// Only treat as bill keyword if amount is reasonable for a gas bill (< ₹25,000)
-				// Large amounts to gas companies are likely investments/share purchases
-				if amount == 0 || amount <= 25000 {
-					hasBillKeyword = true
-				}
-				break
+		if found.Any(gasPatterns) {
+			hasActualUtility = true
+			// Only treat as bill keyword if amount is reasonable for a gas bill (< ₹25,000)
+			// Large amounts to gas companies are likely investments/share purchases
+			if amount == 0 || amount <= 25000 {
+				hasBillKeyword = true
 			}
 		}
 	}
 	if !hasActualUtility {
-		for _, pattern := range waterPatterns {
-			if strings.Contains(combined, pattern) {
-				hasActualUtility = true
-				hasBillKeyword = true
-				break
-			}
+		if found.Any(waterPatterns) {
+			hasActualUtility = true
+			hasBillKeyword = true
 		}
 	}
 	if !hasActualUtility {
-		for _, pattern := range telecomPatterns {
-			if strings.Contains(combined, pattern) {
-				hasActualUtility = true
-				hasBillKeyword = true
-				break
-			}
+		if found.Any(telecomPatterns) {
+			hasActualUtility = true
+			hasBillKeyword = true
 		}
 	}
 
@@ -1154,29 +679,18 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 			// Only classify as bill if gateway is combined with specific utility patterns
 			// Check for utility-specific patterns
 			hasUtilityPattern := false
-			for _, pattern := range electricityPatterns {
-				if strings.Contains(combined, pattern) {
-					hasUtilityPattern = true
-					break
-				}
+			if found.Any(electricityPatterns) {
+				hasUtilityPattern = true
 			}
-		if !hasUtilityPattern {
-			for _, pattern := range gasPatterns {
-				if strings.Contains(combined, pattern) {
-					// Only treat as utility pattern if amount is reasonable for a gas bill
-					if amount == 0 || amount <= 25000 {
-						hasUtilityPattern = true
-					}
-					break
+			if !hasUtilityPattern && found.Any(gasPatterns) {
+				// Only treat as utility pattern if amount is reasonable for a gas bill
+				if amount == 0 || amount <= 25000 {
+					hasUtilityPattern = true
 				}
 			}
-		}
 			if !hasUtilityPattern {
-				for _, pattern := range telecomPatterns {
-					if strings.Contains(combined, pattern) {
-						hasUtilityPattern = true
-						break
-					}
+				if found.Any(telecomPatterns) {
+					hasUtilityPattern = true
 				}
 			}
 			if hasUtilityPattern {
@@ -1190,10 +704,8 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 		// Check for specific utility types
 
 		// Electricity
-		for _, pattern := range electricityPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.90, "Electricity bill payment detected", pattern)
-			}
+		if pattern, ok := found.First(electricityPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.90, "Electricity bill payment detected", pattern)
 		}
 
 		// Gas
@@ -1204,106 +716,72 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 			// Additional check: Gas bills are typically small (< ₹25,000)
 			// If amount > ₹25,000, it's likely an investment/share purchase, not a gas bill
 			if amount > 0 && amount <= 25000 {
-				for _, pattern := range gasPatterns {
-					if strings.Contains(combined, pattern) {
-						return returnCategory("Bills_Utilities", 0.90, "Gas bill payment detected", pattern)
-					}
+				if pattern, ok := found.First(gasPatterns); ok {
+					return returnCategory("Bills_Utilities", 0.90, "Gas bill payment detected", pattern)
 				}
 			}
 		}
 
 		// Water
-		for _, pattern := range waterPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.90, "Water bill payment detected", pattern)
-			}
+		if pattern, ok := found.First(waterPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.90, "Water bill payment detected", pattern)
 		}
 
 		// Telecom
-		for _, pattern := range telecomPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.90, "Telecom bill payment detected", pattern)
-			}
+		if pattern, ok := found.First(telecomPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.90, "Telecom bill payment detected", pattern)
 		}
 
 		// DTH
-		for _, pattern := range dthPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.90, "DTH bill payment detected", pattern)
-			}
+		if pattern, ok := found.First(dthPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.90, "DTH bill payment detected", pattern)
 		}
 
 		// Toll/Fastag
-		for _, pattern := range tollPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.85, "Toll/Fastag payment detected", pattern)
-			}
+		if pattern, ok := found.First(tollPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.85, "Toll/Fastag payment detected", pattern)
 		}
 
 		// Government payments
-		for _, pattern := range governmentPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.85, "Government payment detected", pattern)
-			}
+		if pattern, ok := found.First(governmentPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.85, "Government payment detected", pattern)
 		}
 
-		// Insurance
-		// IMPORTANT: Check for investment-type insurance first (ULIP, Endowment, etc.)
-		// Investment-type insurance should be classified as "Investment", not "Bills_Utilities"
-		investmentInsurancePatterns := []string{
-			"ULIP", "ENDOWMENT", "WHOLE LIFE", "MONEY BACK",
-			"RETIREMENT", "PENSION PLAN", "SAVINGS PLAN",
-		}
 		hasInvestmentInsurance := false
-		for _, pattern := range investmentInsurancePatterns {
-			if strings.Contains(combined, pattern) {
-				hasInvestmentInsurance = true
-				break
-			}
+		if found.Any(investmentInsurancePatterns) {
+			hasInvestmentInsurance = true
 		}
 
 		// If it's investment-type insurance, classify as Investment
 		if hasInvestmentInsurance {
-			for _, pattern := range insurancePatterns {
-				if strings.Contains(combined, pattern) {
-					return returnCategory("Investment", 0.90, "Investment-type insurance premium detected", pattern)
-				}
+			if pattern, ok := found.First(insuranceCategoryPatterns); ok {
+				return returnCategory("Investment", 0.90, "Investment-type insurance premium detected", pattern)
 			}
 		}
 
 		// Regular insurance (term, health) - classify as Bills_Utilities
-		for _, pattern := range insurancePatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.90, "Insurance premium payment detected", pattern)
-			}
+		if pattern, ok := found.First(insuranceCategoryPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.90, "Insurance premium payment detected", pattern)
 		}
 
 		// Credit Card
-		for _, pattern := range creditCardPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.90, "Credit card bill payment detected", pattern)
-			}
+		if pattern, ok := found.First(creditCardPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.90, "Credit card bill payment detected", pattern)
 		}
 
 		// Loan EMI
-		for _, pattern := range loanEmiPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.90, "Loan EMI payment detected", pattern)
-			}
+		if pattern, ok := found.First(loanEmiPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.90, "Loan EMI payment detected", pattern)
 		}
 
 		// Housing/Maintenance
-		for _, pattern := range housingPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.85, "Housing/maintenance bill detected", pattern)
-			}
+		if pattern, ok := found.First(housingPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.85, "Housing/maintenance bill detected", pattern)
 		}
 
 		// Tax payments
-		for _, pattern := range taxPatterns {
-			if strings.Contains(combined, pattern) {
-				return returnCategory("Bills_Utilities", 0.85, "Tax payment detected", pattern)
-			}
+		if pattern, ok := found.First(taxCategoryPatterns); ok {
+			return returnCategory("Bills_Utilities", 0.85, "Tax payment detected", pattern)
 		}
 
 		// Default: Only classify as Bills_Utilities if we have high confidence
@@ -1345,27 +823,25 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 		}
 		
 		if !isLargeGasUtilityPayment {
-			for _, pattern := range billsPatterns {
-				if strings.Contains(combined, pattern) {
-					// IMPORTANT: Skip large amounts to gas utilities (> ₹25,000) - they're investments, not bills
-					// IGL, MGL, etc. are publicly traded companies - large payments are share purchases
-					if amount > 25000 && (pattern == "GAS" || pattern == "IGL" || pattern == "MGL" || pattern == "INDRAPRASTHAGA") {
-						// Skip this pattern - will be classified as Investment later
-						continue
-					}
-					
-					// Double-check: make sure it's not a false positive
-					// If pattern is too generic (like "UTILITY" which appears in many places), require more context
-					if pattern == "UTILITY" || pattern == "BILL" {
-						// For generic patterns, require additional bill-related context
-						if strings.Contains(combined, "PAYMENT") || strings.Contains(combined, "BILL") ||
-							strings.Contains(combined, "RECHARGE") || hasBillGateway {
-							return returnCategory("Bills_Utilities", 0.80, "Bill payment pattern detected", pattern)
-						}
-					} else {
-						// For specific patterns (like "ELECTRICITY", "AIRTEL", etc.), classify directly
+			for _, pattern := range found.In(billsPatterns) {
+				// IMPORTANT: Skip large amounts to gas utilities (> ₹25,000) - they're investments, not bills
+				// IGL, MGL, etc. are publicly traded companies - large payments are share purchases
+				if amount > 25000 && (pattern == "GAS" || pattern == "IGL" || pattern == "MGL" || pattern == "INDRAPRASTHAGA") {
+					// Skip this pattern - will be classified as Investment later
+					continue
+				}
+				
+				// Double-check: make sure it's not a false positive
+				// If pattern is too generic (like "UTILITY" which appears in many places), require more context
+				if pattern == "UTILITY" || pattern == "BILL" {
+					// For generic patterns, require additional bill-related context
+					if strings.Contains(combined, "PAYMENT") || strings.Contains(combined, "BILL") ||
+						strings.Contains(combined, "RECHARGE") || hasBillGateway {
 						return returnCategory("Bills_Utilities", 0.80, "Bill payment pattern detected", pattern)
 					}
+				} else {
+					// For specific patterns (like "ELECTRICITY", "AIRTEL", etc.), classify directly
+					return returnCategory("Bills_Utilities", 0.80, "Bill payment pattern detected", pattern)
 				}
 			}
 		}
@@ -1395,17 +871,13 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 	}
 
 	// Check Healthcare
-	for _, pattern := range healthcarePatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Healthcare", 0.75, "Healthcare expense detected", pattern)
-		}
+	if pattern, ok := found.First(healthcarePatterns); ok {
+		return returnCategory("Healthcare", 0.75, "Healthcare expense detected", pattern)
 	}
 
 	// Check Education
-	for _, pattern := range educationPatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Education", 0.75, "Education expense detected", pattern)
-		}
+	if pattern, ok := found.First(educationPatterns); ok {
+		return returnCategory("Education", 0.75, "Education expense detected", pattern)
 	}
 
 	// Check for subscription patterns (SOLD BY pattern)
@@ -1450,36 +922,28 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 		return returnCategory("Entertainment", 0.85, "YouTube subscription detected", "YOUTUBE")
 	}
 	// Check other entertainment patterns
-	for _, pattern := range entertainmentPatterns {
+	for _, pattern := range found.In(entertainmentPatterns) {
 		// Skip "YT" pattern (already handled above, and it matches PAYTM)
 		if pattern == "YT" {
 			continue
 		}
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Entertainment", 0.75, "Entertainment expense detected", pattern)
-		}
+		return returnCategory("Entertainment", 0.75, "Entertainment expense detected", pattern)
 	}
 
 	// Check Religious and Charitable organizations
-	for _, pattern := range religiousCharitablePatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Bills_Utilities", 0.70, "Religious/charitable donation", pattern)
-		}
+	if pattern, ok := found.First(religiousCharitablePatterns); ok {
+		return returnCategory("Bills_Utilities", 0.70, "Religious/charitable donation", pattern)
 	}
 
 	// Check Auto Parts and Services
-	for _, pattern := range autoPartsPatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Shopping", 0.75, "Auto parts/service expense", pattern)
-		}
+	if pattern, ok := found.First(autoPartsPatterns); ok {
+		return returnCategory("Shopping", 0.75, "Auto parts/service expense", pattern)
 	}
 
 	// Check Dividend (income from investments) - should be classified as INCOME, not Investment
 	// Dividends are returns on investments, hence income
-	for _, pattern := range dividendPatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Income", 0.90, "Dividend income detected", pattern)
-		}
+	if pattern, ok := found.First(dividendCategoryPatterns); ok {
+		return returnCategory("Income", 0.90, "Dividend income detected", pattern)
 	}
 
 	// Check for large payments to utility companies (likely share purchases/investments, not bills)
@@ -1598,25 +1062,14 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 			}
 		}
 		
-		// Investment companies (generic - known investment-related companies)
-		investmentCompanies := []string{
-			"BAJAJ FINANCE", "BAJAJS", "BAJAJ FINSERV",
-			"ZERODHA", "UPSTOX", "GROWW", "COIN", "5PAISA",
-			"ICICI SECURITIES", "HDFC SECURITIES", "KOTAK SECURITIES",
-			"SHAREKHAN", "MOTILAL OSWAL", "IIFL", "ANGEL BROKING",
-		}
-		for _, company := range investmentCompanies {
-			if strings.Contains(combined, company) {
-				return returnCategory("Investment", 0.90, "Investment company detected: "+company, company)
-			}
+		if company, ok := found.First(investmentCompanies); ok {
+			return returnCategory("Investment", 0.90, "Investment company detected: "+company, company)
 		}
 	}
 
 	// Priority 2: Check standard investment patterns
-	for _, pattern := range investmentPatterns {
-		if strings.Contains(combined, pattern) {
-			return returnCategory("Investment", 0.90, "Investment detected", pattern)
-		}
+	if pattern, ok := found.First(investmentCategoryPatterns); ok {
+		return returnCategory("Investment", 0.90, "Investment detected", pattern)
 	}
 
 	// Check for card charges (international transaction markup, etc.)
@@ -1678,21 +1131,15 @@ func ClassifyCategoryWithMetadata(narration string, merchant string, amount floa
 		strings.Contains(combined, "PAYMENT FROM") || strings.Contains(combined, "FROM PHONE")
 	
 	if strings.Contains(combined, "UPI") && (amount > 5000 || hasPaymentFromPhone) {
-		// Check if it doesn't have known merchant patterns
-		hasKnownMerchantPattern := strings.Contains(combined, "@") || 
-			strings.Contains(combined, "PAY") && (strings.Contains(combined, "AMAZON") || 
-			strings.Contains(combined, "GOOGLE") || strings.Contains(combined, "PAYTM"))
-		
-		// If no known merchant pattern and has common name patterns or PAYMENT FROM PHONE
-		// Generic pattern: Detect common Indian name prefixes and surnames (works for all customers)
-		hasNamePattern := strings.Contains(combined, "MR ") || strings.Contains(combined, "MRS ") || 
-			strings.Contains(combined, "MS ") || strings.Contains(combined, "KUMAR") || 
-			strings.Contains(combined, "SHARMA") || strings.Contains(combined, "SINGH") ||
-			strings.Contains(combined, "PATEL") || strings.Contains(combined, "GUPTA") ||
-			strings.Contains(combined, "REDDY") || strings.Contains(combined, "RAO") ||
-			strings.Contains(combined, "NAIR") || strings.Contains(combined, "IYER")
-		
-		if (hasNamePattern || hasPaymentFromPhone) && !hasKnownMerchantPattern {
+		// Check if it doesn't have known merchant patterns: a merchant or aggregator VPA, or
+		// a raw VPA whose kind the caller didn't resolve
+		hasKnownMerchantPattern := upiKind == utils.UPIBusiness || upiKind == utils.UPIAggregator ||
+			upiKind == "" && strings.Contains(combined, "@") ||
+			strings.Contains(combined, "PAY") && (strings.Contains(combined, "AMAZON") ||
+				strings.Contains(combined, "GOOGLE") || strings.Contains(combined, "PAYTM"))
+
+		// A personal VPA (refined with the whole statement) or PAYMENT FROM PHONE
+		if (upiKind == utils.UPIPerson || hasPaymentFromPhone) && !hasKnownMerchantPattern {
 			confidence := 0.80
 			if hasPaymentFromPhone {
 				confidence = 0.90 // Higher confidence for explicit "PAYMENT FROM PHONE"
@@ -1763,8 +1210,7 @@ func ExtractMerchantName(narration string) string {
 	// Handles: UPI-CHANDRA KANT BHARDWA-Q309399912@YBL-...
 	// Handles: UPI-SONA FUEL CENTRE-PAYTMQR...@PAYTM-...
 	// Handles: UPI-PVVNL ELECTRICITY BI-PAYTM-PTMBBP@PAYTM-...
-	re := regexp.MustCompile(`UPI-([^-@]+?)(?:-|@|$)`)
-	matches := re.FindStringSubmatch(narration)
+	matches := upiNamePattern.FindStringSubmatch(narration)
 	if len(matches) > 1 {
 		merchant := strings.TrimSpace(matches[1])
 		// Clean up common suffixes
@@ -1779,8 +1225,7 @@ func ExtractMerchantName(narration string) string {
 	// Try to extract from IMPS/NEFT/RTGS format
 	// Pattern: RTGS DR-BANKCODE-BENEFICIARY NAME-REF or IMPS-REF-BENEFICIARY-BANK
 	// First try: Extract beneficiary name (skip bank code)
-	re = regexp.MustCompile(`(?:IMPS|NEFT|RTGS)\s+(?:DR|CR)?-?([A-Z]{4}\d+)-([A-Z\s]+?)-`)
-	matches = re.FindStringSubmatch(narration)
+	matches = transferIFSCNamePattern.FindStringSubmatch(narration)
 	if len(matches) > 2 {
 		beneficiary := strings.TrimSpace(matches[2])
 		// Clean up
@@ -1791,8 +1236,7 @@ func ExtractMerchantName(narration string) string {
 	}
 
 	// Alternative: IMPS-REF-NAME-BANK format
-	re = regexp.MustCompile(`(?:IMPS|NEFT)-(\d+)-([A-Z\s]+?)-[A-Z]{4}`)
-	matches = re.FindStringSubmatch(narration)
+	matches = transferRefNamePattern.FindStringSubmatch(narration)
 	if len(matches) > 2 {
 		beneficiary := strings.TrimSpace(matches[2])
 		// Remove MR/MRS/MS prefix
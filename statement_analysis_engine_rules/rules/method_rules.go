@@ -3,63 +3,71 @@ package rules
 import (
 	"regexp"
 	"strings"
+
+	"classify/statement_analysis_engine_rules/utils"
 )
 
-// ClassifyMethod classifies the transaction method based on narration
-func ClassifyMethod(narration string) string {
-	narration = strings.ToUpper(narration)
+// UPI patterns, compiled once (also used by ExtractMerchantName)
+var (
+	// UPI-MERCHANT/PERSON NAME-VPA@BANK-REF-UPI
+	upiNamePattern = regexp.MustCompile(`UPI-([^-@]+?)(?:-|@|$)`)
+)
+
+// Keyword lists of ClassifyMethod and IsBillPayment, matched in one scan of the narration
+var (
+	methodKeywords = utils.NewMatcher()
 
 	// UPI Reversal patterns (check before regular UPI)
 	// Pattern: REV-UPI-08821130001725-KALPIT.COOL2006@OKHDFCBANK-209945000965-UPI
-	upiReversalPatterns := []string{
+	upiReversalPatterns = methodKeywords.Group(
 		"REV-UPI", "REV UPI", "REV-UPI-", "REV UPI-",
 		"UPI REVERSAL", "UPI REV", "UPI REFUND",
-	}
+	)
 
 	// UPI patterns
-	upiPatterns := []string{
+	upiPatterns = methodKeywords.Group(
 		"UPI-", "UPI ", "UPI/", "UPI@", "UPIINTENT", "UPI TRANSACTION",
 		"PAYTM", "PHONEPE", "GOOGLEPAY", "BHIM", "AMAZONPAY",
 		"@YBL", "@PAYTM", "@OK", "@OKAXIS", "@OKHDFC", "@OKICICI", "@AXL", "@IBL", "@PTYES",
 		"@IDFCFIRST", "@OKAXIS", "@OKAXIS", "@HDFCBANK", "@AXISBANK",
-	}
+	)
 
 	// IMPS Reversal patterns (check before regular IMPS)
 	// Pattern: REV-IMPS-112900179557-KALPIT KUMAR SHARMA-PYTM-XXXXXXXXXXXX8734-WAZIRX
-	impsReversalPatterns := []string{
+	impsReversalPatterns = methodKeywords.Group(
 		"REV-IMPS", "REV IMPS", "REV-IMPS-", "REV IMPS-",
 		"IMPS REVERSAL", "IMPS REV", "IMPS REFUND",
-	}
+	)
 
 	// IMPS patterns (including ICICI codes)
-	impsPatterns := []string{
+	impsPatterns = methodKeywords.Group(
 		"IMPS-", "IMPS ", "IMPS/", "INSTANT PAYMENT",
 		"MMT", "MMT-", "MMT ", // Mobile Money Transfer (Insta FT - IMPS)
-	}
+	)
 
 	// NEFT patterns (including ICICI codes)
 	// Pattern: NEFT CR-YESB0000001-ZERODHA BROKING LIMITED NSE CLIENT-KALPIT KUMAR SHARMA
-	neftPatterns := []string{
+	neftPatterns = methodKeywords.Group(
 		"NEFT-", "NEFT ", "NEFT/", "NATIONAL ELECTRONIC FUND TRANSFER",
 		"NEFT CR", "NEFT CR-", "NEFT CR ", // NEFT Credit
 		"NEFT DR", "NEFT DR-", "NEFT DR ", // NEFT Debit
 		"N CHG", "N-CHG", // NEFT Charges
-	}
+	)
 
 	// RTGS patterns (HDFC format: RTGS CR/DR-IFSC-NAME-NAME-REF)
-	rtgsPatterns := []string{
+	rtgsPatterns = methodKeywords.Group(
 		"RTGS", "REAL TIME GROSS SETTLEMENT",
 		"RTGS CR", "RTGS DR", "RTGS CR-", "RTGS DR-",
-	}
-	
+	)
+
 	// Self-Transfer patterns (ICICI internal transfers)
-	selfTransferPatterns := []string{
+	selfTransferPatterns = methodKeywords.Group(
 		"INF-", "INF ", "INF/", "INTERNET FUND TRANSFER IN LINKED ACCOUNTS",
 		"INFT-", "INFT ", "INFT/", "INTERNAL FUND TRANSFER",
-	}
+	)
 
 	// Investment/Savings patterns (exclude these from EMI)
-	investmentPatterns := []string{
+	investmentPatterns = methodKeywords.Group(
 		"RD", "FD", "SIP", "RECURRING DEPOSIT", "FIXED DEPOSIT",
 		"MUTUAL FUND", "INVESTMENT", "PPF", "ELSS", "RD INSTALLMENT",
 		"INDIAN CLEARING CORPORATION", "INDIAN CLEARING CORPORATION LIMITED",
@@ -73,142 +81,152 @@ func ClassifyMethod(narration string) string {
 		"SHAREKHAN", "MOTILAL OSWAL", "IIFL", "5PAISA",
 		"EBA", "EBA-", "EBA ", // ICICI Direct transactions (ICICI-specific - used as fallback)
 		"SGB", "SGB-", "SGB ", "SOVEREIGN GOLD BOND", // Sovereign Gold Bond
-	}
+	)
 
 	// EMI patterns (loans/repayments - exclude investments)
 	// Pattern: EMI 4452581 CHQ S44525810472 04214452581
-	emiPatterns := []string{
+	emiPatterns = methodKeywords.Group(
 		"EMI", "LOAN", "REPAYMENT",
 		"HOME LOAN", "PERSONAL LOAN", "CAR LOAN", "EDUCATION LOAN",
 		"LOAN INSTALLMENT", "LOAN EMI", "LOAN REPAYMENT",
 		"EMI CHQ", "EMI CHEQUE", // EMI with cheque reference
 		"LNPY", "LNPY-", "LNPY ", "LINKED LOAN PAYMENT", // ICICI loan payment code (ICICI-specific - used as fallback)
-	}
+	)
 
 	// ACH patterns (HDFC format: ACH C/D- MERCHANT-REF)
 	// Examples: "ACH D- TP ACH MAXLIFEINSURA-1424041803", "ACH C- ICICI SECURITIES LIM-3614387"
-	achPatterns := []string{
+	achPatterns = methodKeywords.Group(
 		"ACH", "AUTOMATED CLEARING HOUSE",
 		"ACH C-", "ACH D-", "ACH C ", "ACH D ", "ACH CR", "ACH DR",
 		"ACH C", "ACH D", // Handle cases without dash/space
-	}
+	)
 
 	// ATM Withdrawal patterns (check before Debit Card)
-	atmWithdrawalPatterns := []string{
+	atmWithdrawalPatterns = methodKeywords.Group(
 		"EAW", "ATW", "NWD", "ATM WITHDRAWAL", "ATM CASH WITHDRAWAL",
 		"ELECTRONIC ATM WITHDRAWAL", "ATM CASH",
 		"VAT", "MAT", "NFS", "CCWD", // ICICI ATM codes (ICICI-specific - used as fallback)
-	}
+	)
 
 	// POS Reversal/Refund patterns (check before regular POS)
-	posReversalPatterns := []string{
+	posReversalPatterns = methodKeywords.Group(
 		"CRV POS", "CRV POS ", "CRV POS-", "POS REVERSAL", "POS REFUND",
 		"REVERSAL POS", "REFUND POS", "CARD REVERSAL", "CARD REFUND",
-	}
+	)
 
 	// International Card Markup/Charges patterns
-	intlMarkupPatterns := []string{
+	intlMarkupPatterns = methodKeywords.Group(
 		"INTL POS", "INTL POS TXN MARKUP", "DC INTL POS", ".DC INTL POS",
 		"INTERNATIONAL POS", "FOREIGN TRANSACTION", "FX MARKUP", "FOREIGN EXCHANGE",
-	}
+	)
 
 	// Debit Card patterns
-	debitCardPatterns := []string{
+	debitCardPatterns = methodKeywords.Group(
 		"DC", "POS", "DEBIT CARD", "ATM", "CASH WITHDRAWAL",
 		"SWIPE", "CARD TRANSACTION", "VISA", "MASTERCARD",
 		"VPS", "IPS", "VPS-", "IPS-", // ICICI debit card transaction codes (ICICI-specific - used as fallback)
-	}
+	)
 
 	// Net Banking patterns
-	netBankingPatterns := []string{
+	netBankingPatterns = methodKeywords.Group(
 		"NET BANKING", "ONLINE BANKING", "INTERNET BANKING",
 		"IB ", "IB-", "IB/", "ONLINE TRANSFER",
-	}
+	)
 
 	// Salary patterns (HDFC format: P:REF BANK SALARY FOR MONTH YEAR)
-	salaryPatterns := []string{
+	salaryPatterns = methodKeywords.Group(
 		"SALARY", "SAL FOR", "PAYROLL", "WAGES", "BONUS",
 		"P:", // HDFC salary prefix (P:K16675 HDFC BANK SALARY FOR APR 2024)
-	}
+	)
 
 	// Interest patterns
-	interestPatterns := []string{
+	interestPatterns = methodKeywords.Group(
 		"INTEREST", "INTEREST PAID", "INTEREST CREDIT",
-	}
+	)
 
 	// Dividend patterns
-	dividendPatterns := []string{
+	dividendPatterns = methodKeywords.Group(
 		"DIV", "DIVIDEND", "DIVIDEND CREDIT", "DIV CR",
-	}
+	)
 
 	// Insurance premium patterns (check before other methods)
-	insurancePatterns := []string{
+	insurancePatterns = methodKeywords.Group(
 		"HLIC", "HLIC_INST", "HLIC INST", "HDFC LIFE", "LIC", "INSURANCE",
 		"PREMIUM", "MAXLIFE", "SBI LIFE", "ICICI PRUDENTIAL", "BAJAJ ALLIANZ",
-	}
+	)
 
 	// Check patterns
-	checkPatterns := []string{
+	checkPatterns = methodKeywords.Group(
 		"CHQ", "CHEQUE", "CHEQUE NO",
 		"LCCBRN CMS", "UCCBRN CMS", // ICICI cheque collection codes (ICICI-specific - used as fallback)
-	}
-	
+	)
+
 	// Bill payment patterns (ICICI-specific - used as fallback after generic patterns)
 	// NOTE: These are ICICI bank-specific transaction codes. They are checked AFTER generic patterns
 	// (UPI, IMPS, NEFT, etc.) to ensure generic patterns take priority for all banks.
-	billPaymentPatterns := []string{
+	billPaymentPatterns = methodKeywords.Group(
 		"BBPS", "BBPS-", "BBPS ", "BHARAT BILL PAYMENT",
 		"BPAY", "BPAY-", "BPAY ", "BILL PAYMENT",
 		"RCHG", "RCHG-", "RCHG ", "RECHARGE",
 		"TOP", "TOP-", "TOP ", "MOBILE RECHARGE",
 		"BIL", "BIL-", "BIL ", "INTERNET BILL PAYMENT",
 		"PAVC", "PAVC-", "PAVC ", "PAY ANY VISA CREDIT CARD",
-	}
-	
+	)
+
 	// Online shopping patterns (ICICI-specific - used as fallback after generic patterns)
 	// NOTE: ICICI-specific code. Checked after generic UPI patterns.
-	onlineShoppingPatterns := []string{
+	onlineShoppingPatterns = methodKeywords.Group(
 		"ONL", "ONL-", "ONL ", "ONLINE SHOPPING",
-	}
-	
+	)
+
 	// Tax payment patterns (ICICI-specific - used as fallback after generic patterns)
 	// NOTE: ICICI-specific codes. Checked after generic patterns.
-	taxPatterns := []string{
+	taxPatterns = methodKeywords.Group(
 		"DTAX", "DTAX-", "DTAX ", "DIRECT TAX",
 		"IDTX", "IDTX-", "IDTX ", "INDIRECT TAX",
-	}
+	)
+
+	billPatterns = methodKeywords.Group(
+		"BILL", "RECHARGE", "PREPAID", "POSTPAID",
+		"ELECTRICITY", "WATER", "GAS", "PHONE", "INTERNET",
+		"INSURANCE", "PREMIUM", "LIC", "HDFC LIFE", "HLIC", "HLIC_INST", "HLIC INST",
+		"MAXLIFE", "SBI LIFE", "ICICI PRUDENTIAL", "BAJAJ ALLIANZ",
+		"PVVNL", "IGL", "AIRTEL", "JIO", "VODAFONE",
+		// ICICI-specific bill payment codes
+		"BBPS", "BPAY", "RCHG", "TOP", "BIL-", "PAVC",
+	)
+)
+
+// ClassifyMethod classifies the transaction method based on narration
+func ClassifyMethod(narration string) string {
+	narration = strings.ToUpper(narration)
+	found := methodKeywords.Match(narration)
 
 	// Check for self-transfer patterns FIRST (ICICI internal transfers)
 	// INF/INFT are internal fund transfers within ICICI Bank (linked accounts)
-	for _, pattern := range selfTransferPatterns {
-		if strings.Contains(narration, pattern) {
-			return "Self_Transfer"
-		}
+	if found.Any(selfTransferPatterns) {
+		return "Self_Transfer"
 	}
 	
 	// Check for insurance premium (before RD to catch HLIC_INST)
 	// Insurance premiums should be classified as "Insurance" method
-	for _, pattern := range insurancePatterns {
-		if strings.Contains(narration, pattern) {
-			// Additional check: if it contains "INST" or "INSTALLMENT", it's likely insurance premium
-			if strings.Contains(narration, "INST") || strings.Contains(narration, "INSTALLMENT") ||
-				strings.Contains(narration, "PREMIUM") {
-				return "Insurance"
-			}
-			// Also return Insurance if it's clearly an insurance company
-			if strings.Contains(narration, "HLIC") || strings.Contains(narration, "HDFC LIFE") ||
-				strings.Contains(narration, "LIC") || strings.Contains(narration, "MAXLIFE") ||
-				strings.Contains(narration, "SBI LIFE") {
-				return "Insurance"
-			}
+	if found.Any(insurancePatterns) {
+		// Additional check: if it contains "INST" or "INSTALLMENT", it's likely insurance premium
+		if strings.Contains(narration, "INST") || strings.Contains(narration, "INSTALLMENT") ||
+			strings.Contains(narration, "PREMIUM") {
+			return "Insurance"
+		}
+		// Also return Insurance if it's clearly an insurance company
+		if strings.Contains(narration, "HLIC") || strings.Contains(narration, "HDFC LIFE") ||
+			strings.Contains(narration, "LIC") || strings.Contains(narration, "MAXLIFE") ||
+			strings.Contains(narration, "SBI LIFE") {
+			return "Insurance"
 		}
 	}
 
 	// Check for dividends (income) - should be classified as "Dividend"
-	for _, pattern := range dividendPatterns {
-		if strings.Contains(narration, pattern) {
-			return "Dividend"
-		}
+	if found.Any(dividendPatterns) {
+		return "Dividend"
 	}
 
 	// Check for Indian Clearing Corporation (investment-related)
@@ -295,38 +313,28 @@ func ClassifyMethod(narration string) string {
 	}
 
 	// Check UPI Reversal (before regular UPI)
-	for _, pattern := range upiReversalPatterns {
-		if strings.Contains(narration, pattern) {
-			return "UPIReversal"
-		}
+	if found.Any(upiReversalPatterns) {
+		return "UPIReversal"
 	}
 
 	// Check UPI
-	for _, pattern := range upiPatterns {
-		if strings.Contains(narration, pattern) {
-			return "UPI"
-		}
+	if found.Any(upiPatterns) {
+		return "UPI"
 	}
 
 	// Check IMPS Reversal (before regular IMPS)
-	for _, pattern := range impsReversalPatterns {
-		if strings.Contains(narration, pattern) {
-			return "IMPSReversal"
-		}
+	if found.Any(impsReversalPatterns) {
+		return "IMPSReversal"
 	}
 
 	// Check IMPS
-	for _, pattern := range impsPatterns {
-		if strings.Contains(narration, pattern) {
-			return "IMPS"
-		}
+	if found.Any(impsPatterns) {
+		return "IMPS"
 	}
 
 	// Check NEFT
-	for _, pattern := range neftPatterns {
-		if strings.Contains(narration, pattern) {
-			return "NEFT"
-		}
+	if found.Any(neftPatterns) {
+		return "NEFT"
 	}
 
 	// Check RTGS (HDFC format: RTGS CR/DR-IFSC-NAME-NAME-REF)
@@ -335,20 +343,12 @@ func ClassifyMethod(narration string) string {
 		return "RTGS"
 	}
 	// Check other RTGS patterns
-	for _, pattern := range rtgsPatterns {
-		if strings.Contains(narration, pattern) {
-			return "RTGS"
-		}
+	if found.Any(rtgsPatterns) {
+		return "RTGS"
 	}
 
 	// Check for other investment patterns (but don't set method, let category handle it)
-	isInvestment := false
-	for _, pattern := range investmentPatterns {
-		if strings.Contains(narration, pattern) {
-			isInvestment = true
-			break
-		}
-	}
+	isInvestment := found.Any(investmentPatterns)
 
 	// Check EMI (only if not an investment)
 	// EMI requires explicit loan-related keywords, not just "INSTALLMENT"
@@ -360,55 +360,39 @@ func ClassifyMethod(narration string) string {
 
 		// Only classify as EMI if it has loan-related keywords
 		// Don't match standalone "INSTALLMENT" (could be RD, FD, etc.)
-		if hasLoanKeyword {
-	for _, pattern := range emiPatterns {
-		if strings.Contains(narration, pattern) {
+		if hasLoanKeyword && found.Any(emiPatterns) {
 			return "EMI"
-				}
-			}
 		}
 	}
 
 	// Check ACH
-	for _, pattern := range achPatterns {
-		if strings.Contains(narration, pattern) {
-			return "ACH"
-		}
+	if found.Any(achPatterns) {
+		return "ACH"
 	}
 
 	// Check ATM Withdrawal (before Debit Card to catch EAW/ATW)
-	for _, pattern := range atmWithdrawalPatterns {
-		if strings.Contains(narration, pattern) {
-			return "ATMWithdrawal"
-		}
+	if found.Any(atmWithdrawalPatterns) {
+		return "ATMWithdrawal"
 	}
 
 	// Check POS Reversal/Refund (before regular POS)
-	for _, pattern := range posReversalPatterns {
-		if strings.Contains(narration, pattern) {
-			return "CardReversal"
-		}
+	if found.Any(posReversalPatterns) {
+		return "CardReversal"
 	}
 
 	// Check International Card Markup/Charges (before regular POS)
-	for _, pattern := range intlMarkupPatterns {
-		if strings.Contains(narration, pattern) {
-			return "CardCharges"
-		}
+	if found.Any(intlMarkupPatterns) {
+		return "CardCharges"
 	}
 
 	// Check Debit Card
-	for _, pattern := range debitCardPatterns {
-		if strings.Contains(narration, pattern) {
-			return "DebitCard"
-		}
+	if found.Any(debitCardPatterns) {
+		return "DebitCard"
 	}
 
 	// Check Net Banking
-	for _, pattern := range netBankingPatterns {
-		if strings.Contains(narration, pattern) {
-			return "NetBanking"
-		}
+	if found.Any(netBankingPatterns) {
+		return "NetBanking"
 	}
 
 	// Check Salary (HDFC format: P:REF BANK SALARY FOR MONTH YEAR)
@@ -417,55 +401,41 @@ func ClassifyMethod(narration string) string {
 		return "Salary"
 	}
 	// Check other salary patterns
-	for _, pattern := range salaryPatterns {
-		if strings.Contains(narration, pattern) {
-			return "Salary"
-		}
+	if found.Any(salaryPatterns) {
+		return "Salary"
 	}
 
 	// Check Interest
-	for _, pattern := range interestPatterns {
-		if strings.Contains(narration, pattern) {
-			return "Interest"
-		}
+	if found.Any(interestPatterns) {
+		return "Interest"
 	}
 
 	// Check Dividend (if not already matched above)
-	for _, pattern := range dividendPatterns {
-		if strings.Contains(narration, pattern) {
-			return "Dividend"
-		}
+	if found.Any(dividendPatterns) {
+		return "Dividend"
 	}
 
 	// Check Cheque
-	for _, pattern := range checkPatterns {
-		if strings.Contains(narration, pattern) {
-			return "Cheque"
-		}
+	if found.Any(checkPatterns) {
+		return "Cheque"
 	}
 	
 	// Check Bill Payment (ICICI-specific codes)
 	// BBPS, BPAY, RCHG, TOP, BIL, PAVC
-	for _, pattern := range billPaymentPatterns {
-		if strings.Contains(narration, pattern) {
-			return "BillPaid"
-		}
+	if found.Any(billPaymentPatterns) {
+		return "BillPaid"
 	}
 	
 	// Check Online Shopping (ICICI-specific code)
 	// ONL = Online shopping transaction (payment on third party website)
-	for _, pattern := range onlineShoppingPatterns {
-		if strings.Contains(narration, pattern) {
-			return "OnlineShopping"
-		}
+	if found.Any(onlineShoppingPatterns) {
+		return "OnlineShopping"
 	}
 	
 	// Check Tax Payment (ICICI-specific codes)
 	// DTAX = Direct Tax, IDTX = Indirect Tax
-	for _, pattern := range taxPatterns {
-		if strings.Contains(narration, pattern) {
-			return "TaxPayment"
-		}
+	if found.Any(taxPatterns) {
+		return "TaxPayment"
 	}
 
 	// Default to Other
@@ -474,64 +444,19 @@ func ClassifyMethod(narration string) string {
 
 // IsBillPayment checks if transaction is a bill payment
 func IsBillPayment(narration string) bool {
-	narration = strings.ToUpper(narration)
-	billPatterns := []string{
-		"BILL", "RECHARGE", "PREPAID", "POSTPAID",
-		"ELECTRICITY", "WATER", "GAS", "PHONE", "INTERNET",
-		"INSURANCE", "PREMIUM", "LIC", "HDFC LIFE", "HLIC", "HLIC_INST", "HLIC INST",
-		"MAXLIFE", "SBI LIFE", "ICICI PRUDENTIAL", "BAJAJ ALLIANZ",
-		"PVVNL", "IGL", "AIRTEL", "JIO", "VODAFONE",
-		// ICICI-specific bill payment codes
-		"BBPS", "BPAY", "RCHG", "TOP", "BIL-", "PAVC",
-	}
-
-	for _, pattern := range billPatterns {
-		if strings.Contains(narration, pattern) {
-			return true
-		}
-	}
-	return false
+	return methodKeywords.Match(strings.ToUpper(narration)).Any(billPatterns)
 }
 
-// ExtractUPIDetails extracts merchant/payee name from UPI narration
-// Handles multiple UPI formats:
-// - UPI-MERCHANT-VPA@BANK-REF-UPI (standard format)
-// - UPI-PERSON NAME-VPA@BANK-REF-UPI (P2P format)
-// - UPI-MERCHANT-PAYTMQR...@PAYTM-BANK-REF-UPI (QR code format)
+// ExtractUPIDetails extracts the merchant/payee name and the VPA user (the part before @)
+// from a UPI narration; see utils.ParseUPI for the formats
 func ExtractUPIDetails(narration string) (merchant string, payee string) {
-	narration = strings.TrimSpace(narration)
-	
-	// UPI format: UPI-MERCHANT/PERSON NAME-VPA@BANK-REF-UPI
-	// Extract merchant/person name (between UPI- and first - or @)
-	// Handle cases where name might contain spaces or hyphens
-	re := regexp.MustCompile(`UPI-([^-@]+?)(?:-|@|$)`)
-	matches := re.FindStringSubmatch(narration)
-	if len(matches) > 1 {
-		merchant = strings.TrimSpace(matches[1])
-		// Clean up common suffixes that might be part of merchant name
-		merchant = strings.TrimSuffix(merchant, " -")
-		merchant = strings.TrimSuffix(merchant, "-")
-	}
-
-	// Extract payee from UPI ID (VPA before @)
-	// Format: VPA@BANK or PAYTMQR...@PAYTM
-	re = regexp.MustCompile(`([^@\s]+)@`)
-	matches = re.FindStringSubmatch(narration)
-	if len(matches) > 1 {
-		payee = strings.TrimSpace(matches[1])
-		// For QR codes, extract merchant name from QR data if possible
-		if strings.HasPrefix(payee, "PAYTMQR") {
-			// QR code format - merchant name is in the narration before QR
-			if merchant == "" {
-				// Try to extract from QR pattern
-				re2 := regexp.MustCompile(`UPI-([^-]+)-PAYTMQR`)
-				matches2 := re2.FindStringSubmatch(narration)
-				if len(matches2) > 1 {
-					merchant = strings.TrimSpace(matches2[1])
-				}
-			}
-		}
+	upi, ok := utils.ParseUPI(narration, false)
+	if !ok {
+		return "", ""
 	}
-
-	return merchant, payee
+	payee, _, _ = strings.Cut(upi.VPA, "@")
+	if payee == "" {
+		payee = upi.Account
+	}
+	return upi.Name, payee
 }
@@ -0,0 +1,157 @@
+// Package taxonomy groups the categories the classifier emits into a parent/child hierarchy
+//
+// The hierarchy shapes summaries (analytics.CalculateCategorySummary) and lists the
+// categories the LLM fallback and new user overrides may assign; it never changes a rule
+// classification. The built-in taxonomy (default.json) is active at
+// startup; Activate swaps in one loaded from a config file. Categories missing from
+// the taxonomy, such as those added by a rule pack or a user override stored under an
+// earlier taxonomy, are reported as top-level categories of their own.
+package taxonomy
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Taxonomy is a versioned category hierarchy
+type Taxonomy struct {
+	Version    string     `json:"version"`
+	Categories []Category `json:"categories"`
+
+	parents map[string]string // category -> parent (empty for top level)
+	names   map[string]string // upper-cased name or alias -> category
+	order   []string          // categories, depth first
+}
+
+// Category is a node in the hierarchy; a parent may also be a category the classifier emits
+type Category struct {
+	Name     string     `json:"name"`
+	Aliases  []string   `json:"aliases,omitempty"` // Other spellings of the category, e.g. LOAN_EMI for Loan
+	Children []Category `json:"children,omitempty"`
+}
+
+//go:embed default.json
+var defaultTaxonomy []byte
+
+// Default returns the built-in taxonomy
+func Default() *Taxonomy {
+	t, err := Parse(defaultTaxonomy)
+	if err != nil {
+		panic(fmt.Sprintf("taxonomy: built-in taxonomy is invalid: %v", err))
+	}
+	return t
+}
+
+// LoadFile reads a JSON taxonomy
+func LoadFile(path string) (*Taxonomy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read taxonomy: %w", err)
+	}
+	t, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("taxonomy %s: %w", path, err)
+	}
+	return t, nil
+}
+
+// Parse decodes and validates a JSON taxonomy
+// Every name and alias must be unique across the whole tree, ignoring case
+func Parse(data []byte) (*Taxonomy, error) {
+	var t Taxonomy
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("invalid taxonomy: %w", err)
+	}
+	if len(t.Categories) == 0 {
+		return nil, fmt.Errorf("invalid taxonomy: no categories")
+	}
+	t.parents = make(map[string]string)
+	t.names = make(map[string]string)
+	if err := t.index(t.Categories, ""); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// index records the parent and spellings of every category below parent
+func (t *Taxonomy) index(categories []Category, parent string) error {
+	for _, category := range categories {
+		if strings.TrimSpace(category.Name) == "" {
+			return fmt.Errorf("invalid taxonomy: category without a name under %q", parent)
+		}
+		for _, name := range append([]string{category.Name}, category.Aliases...) {
+			key := strings.ToUpper(name)
+			if existing, ok := t.names[key]; ok && existing != category.Name {
+				return fmt.Errorf("invalid taxonomy: %q is used by both %s and %s", name, existing, category.Name)
+			}
+			t.names[key] = category.Name
+		}
+		if _, ok := t.parents[category.Name]; ok {
+			return fmt.Errorf("invalid taxonomy: %s appears twice", category.Name)
+		}
+		t.parents[category.Name] = parent
+		t.order = append(t.order, category.Name)
+		if err := t.index(category.Children, category.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Canonical returns the taxonomy name for a category or one of its aliases (ignoring case)
+// Unknown categories are returned unchanged
+func (t *Taxonomy) Canonical(category string) string {
+	if name, ok := t.Lookup(category); ok {
+		return name
+	}
+	return category
+}
+
+// Lookup returns the taxonomy name for a category or one of its aliases (ignoring case),
+// and whether the taxonomy has it
+func (t *Taxonomy) Lookup(category string) (string, bool) {
+	name, ok := t.names[strings.ToUpper(category)]
+	return name, ok
+}
+
+// Names returns every category of the hierarchy, parents before their children
+func (t *Taxonomy) Names() []string {
+	return append([]string(nil), t.order...)
+}
+
+// Path returns the categories from the top level down to category (canonical names)
+// An unknown category is its own top-level path
+func (t *Taxonomy) Path(category string) []string {
+	name := t.Canonical(category)
+	if _, ok := t.parents[name]; !ok {
+		return []string{name}
+	}
+	var path []string
+	for ; name != ""; name = t.parents[name] {
+		path = append([]string{name}, path...)
+	}
+	return path
+}
+
+var active atomic.Pointer[Taxonomy]
+
+func init() {
+	active.Store(Default())
+}
+
+// Active returns the taxonomy used for summaries
+func Active() *Taxonomy {
+	return active.Load()
+}
+
+// Activate makes t the taxonomy for all subsequent summaries; nil restores the built-in one
+func Activate(t *Taxonomy) {
+	if t == nil {
+		t = Default()
+	}
+	active.Store(t)
+}
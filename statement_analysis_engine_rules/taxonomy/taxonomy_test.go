@@ -0,0 +1,107 @@
+package taxonomy_test
+
+import (
+	"reflect"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/analytics"
+	"classify/statement_analysis_engine_rules/models"
+	"classify/statement_analysis_engine_rules/taxonomy"
+)
+
+func TestPath(t *testing.T) {
+	tax := taxonomy.Default()
+	tests := []struct {
+		category string
+		expected []string
+	}{
+		{"Dining", []string{"Food", "Dining"}},
+		{"LOAN_EMI", []string{"Finance", "Loan"}},
+		{"investments", []string{"Finance", "Investment"}},
+		{"Rent", []string{"Bills_Utilities", "Rent"}},
+		{"Shopping", []string{"Shopping"}},
+		{"Pet_Care", []string{"Pet_Care"}},
+	}
+	for _, tt := range tests {
+		if got := tax.Path(tt.category); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.category, tt.expected, got)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tax, err := taxonomy.Parse([]byte(`{"version": "custom-1", "categories": [
+		{"name": "Essentials", "children": [{"name": "Groceries"}, {"name": "Bills_Utilities", "aliases": ["Utilities"]}]},
+		{"name": "Lifestyle", "children": [{"name": "Dining"}, {"name": "Entertainment"}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tax.Path("utilities"); !reflect.DeepEqual(got, []string{"Essentials", "Bills_Utilities"}) {
+		t.Errorf("expected the alias to resolve, got %v", got)
+	}
+
+	invalid := []string{
+		`{"version": "x", "categories": []}`,
+		`{"categories": [{"name": "Food", "children": [{"name": "food"}]}]}`,
+		`{"categories": [{"name": "Loan"}, {"name": "Finance", "aliases": ["LOAN"]}]}`,
+		`{"categories": [{"name": ""}]}`,
+		`{"categories": {}}`,
+	}
+	for _, data := range invalid {
+		if _, err := taxonomy.Parse([]byte(data)); err == nil {
+			t.Errorf("expected an error for %s", data)
+		}
+	}
+}
+
+func TestCategorySummaryTree(t *testing.T) {
+	transactions := []models.ClassifiedTransaction{
+		{Category: "Dining", WithdrawalAmt: 300},
+		{Category: "Groceries", WithdrawalAmt: 700},
+		{Category: "Income", DepositAmt: 50000},
+		{Category: "LOAN_EMI", WithdrawalAmt: 12000},
+		{Category: "Pet_Care", WithdrawalAmt: 900},
+		{Category: "", WithdrawalAmt: 100},
+	}
+	summary := analytics.CalculateCategorySummary(transactions)
+	expected := []models.CategoryNode{
+		{Category: "Food", Spent: 1000, Count: 2, Children: []models.CategoryNode{
+			{Category: "Dining", Spent: 300, Count: 1},
+			{Category: "Groceries", Spent: 700, Count: 1},
+		}},
+		{Category: "Finance", Spent: 12000, Count: 1, Children: []models.CategoryNode{
+			{Category: "Loan", Spent: 12000, Count: 1},
+		}},
+		{Category: "Inflows", Received: 50000, Count: 1, Children: []models.CategoryNode{
+			{Category: "Income", Received: 50000, Count: 1},
+		}},
+		{Category: "Other", Spent: 100, Count: 1},
+		{Category: "Pet_Care", Spent: 900, Count: 1},
+	}
+	if !reflect.DeepEqual(summary.Tree, expected) {
+		t.Errorf("expected %+v, got %+v", expected, summary.Tree)
+	}
+	if summary.TaxonomyVersion != "v1" {
+		t.Errorf("expected the built-in taxonomy version, got %q", summary.TaxonomyVersion)
+	}
+
+	// The flat fields keep their operational-expense meaning
+	if summary.Dining != 300 || summary.Groceries != 700 || summary.Loan != 12000 {
+		t.Errorf("expected the flat fields to be unchanged, got %+v", summary)
+	}
+
+	custom, err := taxonomy.Parse([]byte(`{"version": "custom-2", "categories": [{"name": "Essentials", "children": [{"name": "Groceries"}, {"name": "Loan"}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taxonomy.Activate(custom)
+	defer taxonomy.Activate(nil)
+	summary = analytics.CalculateCategorySummary(transactions[:2])
+	expected = []models.CategoryNode{
+		{Category: "Essentials", Spent: 700, Count: 1, Children: []models.CategoryNode{{Category: "Groceries", Spent: 700, Count: 1}}},
+		{Category: "Dining", Spent: 300, Count: 1},
+	}
+	if !reflect.DeepEqual(summary.Tree, expected) || summary.TaxonomyVersion != "custom-2" {
+		t.Errorf("expected the custom taxonomy, got %+v (%s)", summary.Tree, summary.TaxonomyVersion)
+	}
+}
@@ -0,0 +1,99 @@
+package textmodel
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"classify/statement_analysis_engine_rules/utils"
+)
+
+// Feature prefixes for the non-token features
+const (
+	methodPrefix = "METHOD:"
+	flowPrefix   = "FLOW:"
+	amountPrefix = "AMOUNT:"
+)
+
+// amountBuckets are the upper bounds of the amount feature buckets (INR)
+var amountBuckets = []struct {
+	limit float64
+	name  string
+}{
+	{100, "LT100"},
+	{500, "LT500"},
+	{2000, "LT2K"},
+	{10000, "LT10K"},
+	{50000, "LT50K"},
+	{100000, "LT1L"},
+}
+
+// Features converts a transaction into model features: the narration tokens from
+// utils.Tokenize (without reference numbers), the payment method, the direction and an amount bucket
+// amount is positive for debits and negative for credits; 0 leaves out the direction and amount
+func Features(narration, method string, amount float64) []string {
+	tokens := utils.Tokenize(utils.NormalizeNarration(narration))
+	features := make([]string, 0, len(tokens)+3)
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		if !informative(token) || seen[token] {
+			continue
+		}
+		seen[token] = true
+		features = append(features, token)
+	}
+
+	if method != "" {
+		features = append(features, methodPrefix+strings.ToUpper(method))
+	}
+	if amount == 0 {
+		return features
+	}
+	if amount < 0 {
+		features = append(features, flowPrefix+"CREDIT")
+		amount = -amount
+	} else {
+		features = append(features, flowPrefix+"DEBIT")
+	}
+	bucket := "GE1L"
+	for _, b := range amountBuckets {
+		if amount < b.limit {
+			bucket = b.name
+			break
+		}
+	}
+	return append(features, amountPrefix+bucket)
+}
+
+// noise are tokens that say how money moved, not what it was for: channels
+// (already the METHOD feature), UPI handles, the payment apps behind QR codes and stop words
+var noise = map[string]bool{
+	"UPI": true, "IMPS": true, "NEFT": true, "RTGS": true, "POS": true, "NWD": true, "ACH": true,
+	"PTYS": true, "PTY": true, "TYS": true, "YBL": true, "IBL": true, "AXL": true, "APL": true,
+	"OKAXIS": true, "OKSBI": true, "OKICICI": true, "OKHDFCBANK": true, "OKBIZAXIS": true,
+	"PAYTM": true, "PAYTMQR": true, "GPAY": true, "PHONEPE": true, "BHARATPE": true, "YES": true,
+	"AND": true, "THE": true, "FOR": true,
+}
+
+// ifsc matches bank branch codes (YESB0PTMUPI, UTIB0000123) and their fragments
+var ifsc = regexp.MustCompile(`^[A-Z]{4}0[A-Z0-9]{0,6}$|^0[A-Z]{3,}`)
+
+// informative drops reference numbers, masked account numbers, bank codes and other noise
+func informative(token string) bool {
+	if len(token) < 3 || noise[token] || ifsc.MatchString(token) || strings.HasPrefix(token, "PAYTMQR") {
+		return false
+	}
+	letters, digits := 0, 0
+	for _, r := range token {
+		switch {
+		case unicode.IsLetter(r):
+			letters++
+		case unicode.IsDigit(r):
+			digits++
+		}
+	}
+	if strings.Contains(token, "XXXX") {
+		return false
+	}
+	return letters >= 2 && digits <= letters
+}
@@ -0,0 +1,257 @@
+// Package textmodel is a multinomial Naive Bayes text classifier used as a fallback
+// when the category rules match nothing
+//
+// A model is trained offline ("stmtctl train") from labeled transactions, review
+// corrections and confidently rule-classified statements, saved as JSON and
+// activated at startup. The classifier consults the active model only for "Other"
+// transactions below the model's threshold, and records the prediction in
+// ClassificationMetadata.Model.
+package textmodel
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"classify/statement_analysis_engine_rules/fileutil"
+)
+
+// Defaults applied when a model does not set its own thresholds
+const (
+	// DefaultThreshold is the rule confidence below which an "Other" transaction is passed to the model
+	// P2P transfers the rules deliberately leave as "Other" score 0.8 or more
+	DefaultThreshold = 0.75
+	// DefaultMinProbability is the lowest model probability that replaces the rule category
+	DefaultMinProbability = 0.6
+	// minExamples is the fewest examples a category needs to be learned
+	minExamples = 2
+)
+
+// Example is one labeled transaction
+// Amount is positive for debits and negative for credits (0 if unknown)
+type Example struct {
+	Narration string  `json:"narration"`
+	Method    string  `json:"method,omitempty"`
+	Amount    float64 `json:"amount,omitempty"`
+	Category  string  `json:"category"`
+}
+
+// Class holds the training counts for one category
+type Class struct {
+	Category string         `json:"category"`
+	Examples int            `json:"examples"`
+	Total    int            `json:"total"`    // Sum of all feature counts
+	Features map[string]int `json:"features"` // Feature -> number of examples containing it
+}
+
+// Model is a trained classifier
+type Model struct {
+	Version        string    `json:"version"`
+	TrainedAt      time.Time `json:"trainedAt"`
+	Threshold      float64   `json:"threshold"`      // Rule confidence below which the model is consulted
+	MinProbability float64   `json:"minProbability"` // Lowest probability that is applied
+	Examples       int       `json:"examples"`
+	Vocabulary     int       `json:"vocabulary"`
+	Classes        []Class   `json:"classes"`
+}
+
+// Prediction is the model's best category for a transaction
+type Prediction struct {
+	Category    string
+	Probability float64
+	Features    []string // The features that favoured the category most, strongest first
+}
+
+// Train builds a model from examples; categories with fewer than two examples are dropped
+func Train(examples []Example, version string) (*Model, error) {
+	byCategory := make(map[string]*Class)
+	vocabulary := make(map[string]bool)
+	for _, example := range examples {
+		category := strings.TrimSpace(example.Category)
+		if category == "" {
+			continue
+		}
+		features := Features(example.Narration, example.Method, example.Amount)
+		if len(features) == 0 {
+			continue
+		}
+		class, ok := byCategory[category]
+		if !ok {
+			class = &Class{Category: category, Features: make(map[string]int)}
+			byCategory[category] = class
+		}
+		class.Examples++
+		for _, feature := range features {
+			class.Features[feature]++
+			class.Total++
+		}
+	}
+
+	model := &Model{
+		Version:        version,
+		TrainedAt:      time.Now().UTC(),
+		Threshold:      DefaultThreshold,
+		MinProbability: DefaultMinProbability,
+	}
+	for _, class := range byCategory {
+		if class.Examples < minExamples {
+			continue
+		}
+		model.Classes = append(model.Classes, *class)
+		model.Examples += class.Examples
+		for feature := range class.Features {
+			vocabulary[feature] = true
+		}
+	}
+	if len(model.Classes) < 2 {
+		return nil, fmt.Errorf("need at least two categories with %d or more examples, got %d", minExamples, len(model.Classes))
+	}
+	sort.Slice(model.Classes, func(i, j int) bool { return model.Classes[i].Category < model.Classes[j].Category })
+	model.Vocabulary = len(vocabulary)
+	if model.Version == "" {
+		model.Version = model.TrainedAt.Format("20060102T150405Z")
+	}
+	return model, nil
+}
+
+// Predict returns the most probable category for the features
+// ok is false when none of the features were seen in training
+func (m *Model) Predict(features []string) (Prediction, bool) {
+	known := make([]string, 0, len(features))
+	for _, feature := range features {
+		for _, class := range m.Classes {
+			if class.Features[feature] > 0 {
+				known = append(known, feature)
+				break
+			}
+		}
+	}
+	if len(known) == 0 || len(m.Classes) == 0 {
+		return Prediction{}, false
+	}
+
+	// log P(c) + sum log P(f|c) with Laplace smoothing
+	scores := make([]float64, len(m.Classes))
+	best := 0
+	for i, class := range m.Classes {
+		score := math.Log(float64(class.Examples) / float64(m.Examples))
+		for _, feature := range known {
+			score += m.logLikelihood(class, feature)
+		}
+		scores[i] = score
+		if score > scores[best] {
+			best = i
+		}
+	}
+
+	// Softmax relative to the best score for numerical stability
+	var sum float64
+	for _, score := range scores {
+		sum += math.Exp(score - scores[best])
+	}
+
+	winner := m.Classes[best]
+	evidence := make([]string, 0, len(known))
+	strength := make(map[string]float64, len(known))
+	for _, feature := range known {
+		if winner.Features[feature] == 0 {
+			continue
+		}
+		// How much more likely the feature is under the winner than on average
+		var others float64
+		for _, class := range m.Classes {
+			others += m.logLikelihood(class, feature)
+		}
+		strength[feature] = m.logLikelihood(winner, feature) - others/float64(len(m.Classes))
+		evidence = append(evidence, feature)
+	}
+	sort.SliceStable(evidence, func(i, j int) bool { return strength[evidence[i]] > strength[evidence[j]] })
+	if len(evidence) > 3 {
+		evidence = evidence[:3]
+	}
+
+	return Prediction{Category: winner.Category, Probability: 1 / sum, Features: evidence}, true
+}
+
+func (m *Model) logLikelihood(class Class, feature string) float64 {
+	return math.Log(float64(class.Features[feature]+1) / float64(class.Total+m.Vocabulary))
+}
+
+// Validate checks a loaded model and fills in default thresholds
+func (m *Model) Validate() error {
+	if len(m.Classes) < 2 {
+		return fmt.Errorf("model %q has %d categories, need at least two", m.Version, len(m.Classes))
+	}
+	for _, class := range m.Classes {
+		if class.Category == "" || class.Examples <= 0 {
+			return fmt.Errorf("model %q has an empty category", m.Version)
+		}
+	}
+	if m.Threshold <= 0 {
+		m.Threshold = DefaultThreshold
+	}
+	if m.MinProbability <= 0 {
+		m.MinProbability = DefaultMinProbability
+	}
+	if m.Threshold > 1 || m.MinProbability > 1 {
+		return fmt.Errorf("model %q thresholds must be between 0 and 1", m.Version)
+	}
+	return nil
+}
+
+// Save writes the model as JSON (temp file plus rename, so readers never see a partial model)
+func (m *Model) Save(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteAtomic(path, data); err != nil {
+		return fmt.Errorf("failed to save model: %w", err)
+	}
+	return nil
+}
+
+// LoadFile reads and validates a saved model
+func LoadFile(path string) (*Model, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read model: %w", err)
+	}
+	var model Model
+	if err := json.Unmarshal(data, &model); err != nil {
+		return nil, fmt.Errorf("failed to parse model %s: %w", path, err)
+	}
+	if err := model.Validate(); err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
+
+var active atomic.Pointer[Model]
+
+// Active returns the model the classifier consults (nil when none is active)
+func Active() *Model {
+	return active.Load()
+}
+
+// Activate makes the model the fallback for all subsequent classifications; nil disables the fallback
+func Activate(m *Model) error {
+	if m != nil {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+		slog.Info("category model activated",
+			slog.String("version", m.Version),
+			slog.Int("categories", len(m.Classes)),
+			slog.Int("examples", m.Examples),
+		)
+	}
+	active.Store(m)
+	return nil
+}
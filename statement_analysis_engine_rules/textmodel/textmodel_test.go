@@ -0,0 +1,143 @@
+package textmodel_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/classifier"
+	"classify/statement_analysis_engine_rules/textmodel"
+)
+
+// examples labels Paytm QR payments at two kinds of shop
+var examples = []textmodel.Example{
+	{Narration: "UPI-SHARMA BHANDAR-PAYTMQR1AB2CD@PTYS-YESB0PTMUPI-101111111111-UPI", Method: "UPI", Amount: 320, Category: "Groceries"},
+	{Narration: "UPI-GUPTA GENERAL BHANDAR-PAYTMQR3EF4GH@PTYS-YESB0PTMUPI-101111111112-UPI", Method: "UPI", Amount: 150, Category: "Groceries"},
+	{Narration: "UPI-BALAJI BHANDAR-PAYTMQR5IJ6KL@PTYS-YESB0PTMUPI-101111111113-UPI", Method: "UPI", Amount: 480, Category: "Groceries"},
+	{Narration: "UPI-MOHAN CHAI WALA-PAYTMQR7MN8OP@PTYS-YESB0PTMUPI-101111111114-UPI", Method: "UPI", Amount: 40, Category: "Dining"},
+	{Narration: "UPI-SHIV CHAI CORNER-PAYTMQR9QR0ST@PTYS-YESB0PTMUPI-101111111115-UPI", Method: "UPI", Amount: 30, Category: "Dining"},
+	{Narration: "UPI-ANNAPURNA BHOJANALAYA-PAYTMQR2UV3WX@PTYS-YESB0PTMUPI-101111111116-UPI", Method: "UPI", Amount: 60, Category: "Dining"},
+	{Narration: "UPI-ONE OFF-PAYTMQR4YZ5AB@PTYS-YESB0PTMUPI-101111111117-UPI", Method: "UPI", Amount: 60, Category: "Travel"},
+}
+
+func TestFeatures(t *testing.T) {
+	tests := []struct {
+		narration string
+		method    string
+		amount    float64
+		expected  []string
+	}{
+		{"UPI-SHARMA KIRANA-PAYTMQR1AB2CD@PTYS-YESB0PTMUPI-101111111111-UPI", "UPI", 320,
+			[]string{"SHARMA", "KIRANA", "METHOD:UPI", "FLOW:DEBIT", "AMOUNT:LT500"}},
+		{"NEFT CR-XXXXXX2035-ACME LTD", "NEFT", -150000, []string{"ACME", "LTD", "METHOD:NEFT", "FLOW:CREDIT", "AMOUNT:GE1L"}},
+		{"UPI-RAMESH-RAMESH.K@OKAXIS-UTIB0000123-512345678901-RENT", "", 0, []string{"RAMESH", "RENT"}},
+		{"12345678", "", 0, []string{}},
+	}
+	for _, tt := range tests {
+		if got := textmodel.Features(tt.narration, tt.method, tt.amount); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.narration, tt.expected, got)
+		}
+	}
+}
+
+func TestTrainAndPredict(t *testing.T) {
+	model, err := textmodel.Train(examples, "test-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(model.Classes) != 2 || model.Examples != 6 {
+		t.Fatalf("expected the single Travel example to be dropped, got %d classes from %d examples", len(model.Classes), model.Examples)
+	}
+
+	tests := []struct {
+		narration string
+		amount    float64
+		expected  string
+	}{
+		{"UPI-VERMA BHANDAR-PAYTMQR8CD9EF@PTYS-YESB0PTMUPI-102222222221-UPI", 260, "Groceries"},
+		{"UPI-RAJU CHAI-PAYTMQR1GH2IJ@PTYS-YESB0PTMUPI-102222222222-UPI", 20, "Dining"},
+	}
+	for _, tt := range tests {
+		prediction, ok := model.Predict(textmodel.Features(tt.narration, "UPI", tt.amount))
+		if !ok || prediction.Category != tt.expected || prediction.Probability <= 0.5 || prediction.Probability > 1 {
+			t.Errorf("%s: expected %s, got %+v (%v)", tt.narration, tt.expected, prediction, ok)
+		}
+		if len(prediction.Features) == 0 || len(prediction.Features) > 3 {
+			t.Errorf("%s: expected up to 3 supporting features, got %v", tt.narration, prediction.Features)
+		}
+	}
+	if _, ok := model.Predict([]string{"NEVER", "SEEN"}); ok {
+		t.Error("expected no prediction from unseen features")
+	}
+
+	if _, err := textmodel.Train(examples[:3], ""); err == nil {
+		t.Error("expected training on a single category to fail")
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	model, err := textmodel.Train(examples, "test-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "model.json")
+	if err := model.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := textmodel.LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Version != "test-2" || !reflect.DeepEqual(loaded.Classes, model.Classes) {
+		t.Errorf("expected the saved model back, got %+v", loaded)
+	}
+
+	loaded.MinProbability = 1.5
+	if err := textmodel.Activate(loaded); err == nil {
+		t.Error("expected an out-of-range threshold to be rejected")
+	}
+}
+
+func TestClassifierFallback(t *testing.T) {
+	model, err := textmodel.Train(examples, "test-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown := classifier.ConvertFromTxtTransaction("01/12/25", "UPI-VERMA BHANDAR-PAYTMQR8CD9EF@PTYS-YESB0PTMUPI-102222222221-UPI", "0000102222222221", "01/12/25", 260, 0, 9000)
+	known := classifier.ConvertFromTxtTransaction("01/12/25", "UPI-SWIGGY-SWIGGY@ICICI-ICIC0DC0099-123456789012-UPI", "0000123456789012", "01/12/25", 400, 0, 9000)
+
+	before := classifier.ClassifyTransaction(unknown, "TEST USER", nil)
+	if before.Category != "Other" || before.ClassificationMetadata.Model != nil {
+		t.Fatalf("expected the rules to leave the fixture as Other, got %s", before.Category)
+	}
+
+	if err := textmodel.Activate(model); err != nil {
+		t.Fatal(err)
+	}
+	defer textmodel.Activate(nil)
+
+	after := classifier.ClassifyTransaction(unknown, "TEST USER", nil)
+	metadata := after.ClassificationMetadata
+	if after.Category != "Groceries" || metadata.Model == nil || !metadata.Model.Applied || metadata.Model.Version != "test-3" {
+		t.Fatalf("expected the model to classify the transaction, got %s (%+v)", after.Category, metadata.Model)
+	}
+	if metadata.Confidence != metadata.Model.Probability || !strings.HasPrefix(metadata.Reason, "Statistical model test-3") {
+		t.Errorf("expected the model probability and reason in the metadata, got %v %q", metadata.Confidence, metadata.Reason)
+	}
+
+	// Transactions the rules classify are never passed to the model
+	if rule := classifier.ClassifyTransaction(known, "TEST USER", nil); rule.Category == "Groceries" || rule.ClassificationMetadata.Model != nil {
+		t.Errorf("expected the rule category to stand, got %s (%+v)", rule.Category, rule.ClassificationMetadata.Model)
+	}
+
+	// Below the minimum probability the prediction is recorded but not applied
+	strict := *model
+	strict.MinProbability = 1
+	if err := textmodel.Activate(&strict); err != nil {
+		t.Fatal(err)
+	}
+	if unsure := classifier.ClassifyTransaction(unknown, "TEST USER", nil); unsure.Category != "Other" || unsure.ClassificationMetadata.Model == nil || unsure.ClassificationMetadata.Model.Applied {
+		t.Errorf("expected an unapplied prediction, got %s (%+v)", unsure.Category, unsure.ClassificationMetadata.Model)
+	}
+}
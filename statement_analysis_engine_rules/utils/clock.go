@@ -0,0 +1,67 @@
+package utils
+
+import "time"
+
+// Clock abstracts the wall clock so that analytics can be anchored to a fixed date
+// Re-running an old statement must give the same answers, so nothing in the
+// analysis pipeline should call time.Now() directly
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock reads the real wall clock
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock is the default clock backed by time.Now()
+var SystemClock Clock = systemClock{}
+
+// FixedClock always returns the same instant (use for tests and replays)
+type FixedClock struct {
+	T time.Time
+}
+
+// Now returns the fixed instant
+func (c FixedClock) Now() time.Time {
+	return c.T
+}
+
+// NewFixedClock creates a clock frozen at t
+func NewFixedClock(t time.Time) FixedClock {
+	return FixedClock{T: t}
+}
+
+// LatestDate returns the latest parseable date among dateStrs
+// Returns false if none of the dates could be parsed
+func LatestDate(dateStrs ...string) (time.Time, bool) {
+	var latest time.Time
+	for _, dateStr := range dateStrs {
+		t, _ := ParseDate(dateStr)
+		if t.IsZero() {
+			continue
+		}
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest, !latest.IsZero()
+}
+
+// ResolveAsOf picks the reference date for relative computations
+// Priority: explicit asOf > statement end date (latest transaction date) > clock
+// Never substitute the wall clock while a date is available - that makes re-runs non-deterministic
+func ResolveAsOf(asOf time.Time, clock Clock, dateStrs ...string) time.Time {
+	if !asOf.IsZero() {
+		return asOf
+	}
+	if latest, ok := LatestDate(dateStrs...); ok {
+		return latest
+	}
+	if clock == nil {
+		clock = SystemClock
+	}
+	return clock.Now()
+}
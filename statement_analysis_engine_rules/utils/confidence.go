@@ -2,9 +2,6 @@ package utils
 
 import "strings"
 
-// RuleVersion is the current version of classification rules
-const RuleVersion = "v1.3.0"
-
 // CalculateConfidence calculates confidence score for classification
 // Returns value between 0.0 and 1.0
 func CalculateConfidence(
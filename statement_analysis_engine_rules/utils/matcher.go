@@ -0,0 +1,215 @@
+package utils
+
+import (
+	"strings"
+	"sync"
+)
+
+// Matcher finds every keyword of a dictionary in one pass over a text (Aho-Corasick)
+//
+// Rules register their keyword lists as groups while their package initializes
+// and the automaton is built on the first Match, so each narration is scanned once
+// instead of once per keyword. Keywords are compared byte for byte: callers
+// upper-case the text exactly as they did for strings.Contains.
+type Matcher struct {
+	once     sync.Once
+	built    bool
+	keywords []string       // Distinct keywords; the index is the keyword id
+	ids      map[string]int // Keyword -> id
+	groups   [][]int        // Keyword ids of each group, in list order
+
+	classes [256]int32 // Byte -> alphabet class; class 0 is every byte no keyword uses
+	width   int32      // Number of classes
+	next    []int32    // Transitions with failures resolved: next[state*width+class]
+	outputs [][]int32  // Ids of the keywords ending at each state, suffixes included
+	empty   []int32    // Ids of empty keywords, found in every text
+}
+
+// KeywordGroup is a keyword list registered with a Matcher
+type KeywordGroup struct {
+	matcher *Matcher
+	index   int
+}
+
+// NewMatcher returns an empty matcher; register keyword lists with Group
+func NewMatcher() *Matcher {
+	return &Matcher{ids: make(map[string]int)}
+}
+
+// Group registers a keyword list checked as a unit (see Matches.Any and Matches.First)
+// Groups must be registered before the first Match
+func (m *Matcher) Group(keywords ...string) KeywordGroup {
+	if m.built {
+		panic("utils: Matcher.Group called after the first Match")
+	}
+	ids := make([]int, len(keywords))
+	for i, keyword := range keywords {
+		id, ok := m.ids[keyword]
+		if !ok {
+			id = len(m.keywords)
+			m.ids[keyword] = id
+			m.keywords = append(m.keywords, keyword)
+		}
+		ids[i] = id
+	}
+	m.groups = append(m.groups, ids)
+	return KeywordGroup{matcher: m, index: len(m.groups) - 1}
+}
+
+// Keywords returns the keywords of the group in list order
+func (g KeywordGroup) Keywords() []string {
+	ids := g.matcher.groups[g.index]
+	keywords := make([]string, len(ids))
+	for i, id := range ids {
+		keywords[i] = g.matcher.keywords[id]
+	}
+	return keywords
+}
+
+// build compiles the registered keywords into a deterministic automaton
+func (m *Matcher) build() {
+	m.built = true
+	m.width = 1
+	for _, keyword := range m.keywords {
+		for i := 0; i < len(keyword); i++ {
+			if m.classes[keyword[i]] == 0 {
+				m.classes[keyword[i]] = m.width
+				m.width++
+			}
+		}
+	}
+
+	// Trie; -1 marks a missing transition
+	m.next = make([]int32, m.width)
+	for i := range m.next {
+		m.next[i] = -1
+	}
+	m.outputs = [][]int32{nil}
+	for id, keyword := range m.keywords {
+		if keyword == "" {
+			m.empty = append(m.empty, int32(id))
+			continue
+		}
+		state := int32(0)
+		for i := 0; i < len(keyword); i++ {
+			slot := state*m.width + m.classes[keyword[i]]
+			if m.next[slot] < 0 {
+				m.next[slot] = int32(len(m.outputs))
+				m.outputs = append(m.outputs, nil)
+				for c := int32(0); c < m.width; c++ {
+					m.next = append(m.next, -1)
+				}
+			}
+			state = m.next[slot]
+		}
+		m.outputs[state] = append(m.outputs[state], int32(id))
+	}
+
+	// Breadth-first, resolve missing transitions through the failure links
+	fail := make([]int32, len(m.outputs))
+	queue := make([]int32, 0, len(m.outputs))
+	for c := int32(0); c < m.width; c++ {
+		if target := m.next[c]; target < 0 {
+			m.next[c] = 0
+		} else {
+			queue = append(queue, target)
+		}
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for c := int32(0); c < m.width; c++ {
+			slot := state*m.width + c
+			target := m.next[slot]
+			if target < 0 {
+				m.next[slot] = m.next[fail[state]*m.width+c]
+				continue
+			}
+			fail[target] = m.next[fail[state]*m.width+c]
+			m.outputs[target] = append(m.outputs[target], m.outputs[fail[target]]...)
+			queue = append(queue, target)
+		}
+	}
+}
+
+// Match scans text once and records which keywords it contains
+func (m *Matcher) Match(text string) Matches {
+	m.once.Do(m.build)
+	found := make([]uint64, (len(m.keywords)+63)/64)
+	for _, id := range m.empty {
+		found[id>>6] |= 1 << (id & 63)
+	}
+	state := int32(0)
+	for i := 0; i < len(text); i++ {
+		state = m.next[state*m.width+m.classes[text[i]]]
+		for _, id := range m.outputs[state] {
+			found[id>>6] |= 1 << (id & 63)
+		}
+	}
+	return Matches{matcher: m, text: text, found: found}
+}
+
+// Matches is the set of a matcher's keywords found in one text
+type Matches struct {
+	matcher *Matcher
+	text    string
+	found   []uint64
+}
+
+func (f Matches) has(id int) bool {
+	return f.found[id>>6]&(1<<(id&63)) != 0
+}
+
+// Has reports whether the text contains keyword
+// A keyword the matcher does not know falls back to strings.Contains
+func (f Matches) Has(keyword string) bool {
+	if id, ok := f.matcher.ids[keyword]; ok {
+		return f.has(id)
+	}
+	return strings.Contains(f.text, keyword)
+}
+
+// Any reports whether the text contains any keyword of the group
+func (f Matches) Any(g KeywordGroup) bool {
+	_, ok := f.First(g)
+	return ok
+}
+
+// First returns the first keyword of the group, in list order, that the text contains
+func (f Matches) First(g KeywordGroup) (string, bool) {
+	if g.matcher != f.matcher {
+		for _, keyword := range g.Keywords() {
+			if strings.Contains(f.text, keyword) {
+				return keyword, true
+			}
+		}
+		return "", false
+	}
+	for _, id := range f.matcher.groups[g.index] {
+		if f.has(id) {
+			return f.matcher.keywords[id], true
+		}
+	}
+	return "", false
+}
+
+// In returns the keywords of the group the text contains, in list order
+// It replaces `for _, k := range list { if strings.Contains(text, k) { ... } }` loops
+// whose body needs more than the first hit
+func (f Matches) In(g KeywordGroup) []string {
+	var keywords []string
+	if g.matcher != f.matcher {
+		for _, keyword := range g.Keywords() {
+			if strings.Contains(f.text, keyword) {
+				keywords = append(keywords, keyword)
+			}
+		}
+		return keywords
+	}
+	for _, id := range f.matcher.groups[g.index] {
+		if f.has(id) {
+			keywords = append(keywords, f.matcher.keywords[id])
+		}
+	}
+	return keywords
+}
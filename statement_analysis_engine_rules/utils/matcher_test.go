@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMatcherAgreesWithContains(t *testing.T) {
+	m := NewMatcher()
+	food := m.Group("SWIGGY", "SWIGGYINSTAMART", "ZOMATO", "ZMT")
+	rails := m.Group("UPI-", "UPI", "NEFT", "IMPS", "")
+	overlapping := m.Group("ABAB", "BABA", "ABA", "BA", "A")
+	words := [][]string{food.Keywords(), rails.Keywords(), overlapping.Keywords()}
+
+	texts := []string{
+		"UPI-SWIGGYINSTAMART-SWIGGY.STORES@ICICI-ICIC0DC0099-123-UPI",
+		"NEFT CR-YESB0000001-ZERODHA BROKING LIMITED",
+		"POS 416021XXXXXX1234 ZMT FOODS",
+		"ABABABA",
+		"XBAX",
+		"",
+	}
+	for _, text := range texts {
+		found := m.Match(text)
+		for _, list := range words {
+			for _, keyword := range list {
+				if found.Has(keyword) != strings.Contains(text, keyword) {
+					t.Errorf("%q in %q: expected %v, got %v", keyword, text, strings.Contains(text, keyword), found.Has(keyword))
+				}
+			}
+		}
+	}
+}
+
+func TestMatchesGroupOrder(t *testing.T) {
+	m := NewMatcher()
+	g := m.Group("INSTAMART", "SWIGGY", "ZOMATO", "SWIGGYINSTAMART")
+	other := NewMatcher().Group("ZOMATO", "SWIGGY")
+
+	found := m.Match("UPI-SWIGGYINSTAMART-ORDER")
+	if keyword, ok := found.First(g); !ok || keyword != "INSTAMART" {
+		t.Errorf("expected INSTAMART, got %q", keyword)
+	}
+	if expected := []string{"INSTAMART", "SWIGGY", "SWIGGYINSTAMART"}; !reflect.DeepEqual(found.In(g), expected) {
+		t.Errorf("expected %v, got %v", expected, found.In(g))
+	}
+	// A group of another matcher falls back to strings.Contains
+	if keyword, ok := found.First(other); !ok || keyword != "SWIGGY" {
+		t.Errorf("expected SWIGGY, got %q", keyword)
+	}
+	if !found.Has("ORDER") || found.Has("ZOMATO") {
+		t.Errorf("expected ORDER and not ZOMATO")
+	}
+}
+
+// BenchmarkMatcher compares one scan against a strings.Contains per keyword, for a dictionary the size of the rule lists
+func BenchmarkMatcher(b *testing.B) {
+	keywords := make([]string, 0, 1000)
+	for i := 0; i < cap(keywords); i++ {
+		keywords = append(keywords, fmt.Sprintf("MERCHANT%03dX", i))
+	}
+	m := NewMatcher()
+	g := m.Group(keywords...)
+	narration := "UPI-ANKIT DAIRY AND SWEE-VYAPAR.170819826526@HDFCBANK-HDFC0MERUPI-100000000001-UPI"
+
+	b.Run("Matcher", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m.Match(narration).Any(g)
+		}
+	})
+	b.Run("Contains", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, keyword := range keywords {
+				if strings.Contains(narration, keyword) {
+					break
+				}
+			}
+		}
+	})
+}
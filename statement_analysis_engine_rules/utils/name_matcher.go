@@ -11,26 +11,24 @@ import (
 // - Missing middle name (e.g., "KALPIT KUMAR SHARMA" vs "KALPIT SHARMA")
 // Returns true if all words in shorterName appear in longerName (case-insensitive)
 func MatchNames(name1, name2 string) bool {
-	if name1 == "" || name2 == "" {
-		return false
-	}
+	return MatchNameWords(NameMatchWords(name1), NameMatchWords(name2))
+}
 
+// NameMatchWords returns the words of a name that MatchNames compares
+// Callers comparing one name against many split it once and use MatchNameWords
+func NameMatchWords(name string) []string {
 	// Normalize names: remove common prefixes and extra spaces
-	name1 = normalizeName(name1)
-	name2 = normalizeName(name2)
-
-	if name1 == "" || name2 == "" {
-		return false
+	name = normalizeName(name)
+	if name == "" {
+		return nil
 	}
 
-	// Split into words
-	words1 := strings.Fields(strings.ToUpper(name1))
-	words2 := strings.Fields(strings.ToUpper(name2))
-
-	// Remove common words that don't help with matching
-	words1 = removeCommonWords(words1)
-	words2 = removeCommonWords(words2)
+	// Split into words, then remove common words that don't help with matching
+	return removeCommonWords(strings.Fields(strings.ToUpper(name)))
+}
 
+// MatchNameWords is MatchNames over words from NameMatchWords
+func MatchNameWords(words1, words2 []string) bool {
 	if len(words1) == 0 || len(words2) == 0 {
 		return false
 	}
@@ -86,15 +84,16 @@ func allWordsMatch(shorter, longer []string) bool {
 		return false
 	}
 
-	// Create a map of words in longer name for quick lookup
-	longerMap := make(map[string]bool)
-	for _, word := range longer {
-		longerMap[word] = true
-	}
-
-	// Check if all words in shorter name are in longer name
+	// Names have a few words, so a scan beats building a lookup map
 	for _, word := range shorter {
-		if !longerMap[word] {
+		found := false
+		for _, candidate := range longer {
+			if candidate == word {
+				found = true
+				break
+			}
+		}
+		if !found {
 			return false
 		}
 	}
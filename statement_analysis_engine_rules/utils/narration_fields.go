@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+var (
+	// Rail keyword, optional direction and the delimiter of the template:
+	// "NEFT CR-", "RTGS DR-", "IMPS-", "ACH D- ", "NACH-DR-", "IMPS/P2A/"
+	railPrefixPattern = regexp.MustCompile(`^(IMPS|NEFT|RTGS|NACH|ACH)\b\s*([-/])?\s*(?:(CR|DR|C|D)\b)?\s*([-/])?\s*`)
+	// NEFT/RTGS UTR: bank code, a letter or digit, then mostly digits (YESBN12025060706283534)
+	utrPattern = regexp.MustCompile(`^[A-Z]{4}[A-Z0-9]\d{8,}[A-Z0-9]*$`)
+	// NACH mandate reference (UMRN): bank code and 16 digits
+	umrnPattern = regexp.MustCompile(`^[A-Z]{4}\d{16}$`)
+	// Masked counterparty account (XXXXXXX2950)
+	maskedAccountField = regexp.MustCompile(`^[X*]+\d{2,}$`)
+	// ACH/NACH transaction reference
+	achReferencePattern = regexp.MustCompile(`^\d{5,}$`)
+	// Chq./Ref.No. column text that the statement layout spilled into the narration
+	bledReferencePattern = regexp.MustCompile(`\s{2,}([A-Z0-9]{16})\s`)
+	titlePattern         = regexp.MustCompile(`^(?:MR|MRS|MS|DR|SMT|SHRI)\.?\s+`)
+)
+
+// Fields that only mark the transfer type (IMPS/P2A/...)
+var narrationMarkers = map[string]bool{"P2A": true, "P2P": true, "P2M": true, "CR": true, "DR": true}
+
+// ParseNarrationFields parses an IMPS, NEFT, RTGS, NACH or ACH narration (dash- or
+// slash-separated). Fields are recognized by shape - IFSC, UTR, RRN, UMRN, masked account,
+// bank code - and the first remaining text field is the counterparty name:
+//
+//	IMPS-<rrn>-<name>-<bank code>-<account>-<remark>
+//	NEFT CR-<ifsc>-<remitter>-<remark>-<beneficiary>-<utr>
+//	RTGS DR-<ifsc>-<beneficiary>-<remark>-<utr>-<reference>
+//	ACH D- <name>-<reference>
+//	NACH DR-<umrn>-<name>-<reference>
+//
+// credit sets the direction when the narration has no CR/DR; ok is false for other narrations.
+// The narration must not be normalized, which drops the / of slash templates
+func ParseNarrationFields(narration string, credit bool) (models.NarrationFields, bool) {
+	upper := strings.ToUpper(strings.TrimSpace(narration))
+	m := railPrefixPattern.FindStringSubmatchIndex(upper)
+	if m == nil {
+		return models.NarrationFields{}, false
+	}
+	rail := upper[m[2]:m[3]]
+	fields := models.NarrationFields{Rail: rail, Direction: "DR"}
+	switch {
+	case m[6] >= 0:
+		if strings.HasPrefix(upper[m[6]:m[7]], "C") {
+			fields.Direction = "CR"
+		}
+	case credit:
+		fields.Direction = "CR"
+	}
+	delimiter := "-"
+	if m[4] >= 0 {
+		delimiter = upper[m[4]:m[5]]
+	} else if m[8] >= 0 {
+		delimiter = upper[m[8]:m[9]]
+	}
+
+	var remarks []string
+	for _, field := range strings.Split(dropBledReference(upper[m[1]:]), delimiter) {
+		field = spacePattern.ReplaceAllString(strings.TrimSpace(field), " ")
+		// Codes never contain spaces, so spaces in them come from wrapped statement lines
+		code := compactField(field)
+		switch {
+		case code == "" || narrationMarkers[code]:
+		case fields.IFSC == "" && ifscPattern.MatchString(code):
+			fields.IFSC = code
+		case fields.MandateID == "" && umrnPattern.MatchString(code):
+			fields.MandateID = code
+		case fields.Reference == "" && isRailReference(rail, code):
+			fields.Reference = code
+		case fields.Account == "" && maskedAccountField.MatchString(code):
+			fields.Account = code
+		case fields.Bank == "" && len(code) == 4 && ifscBanks[code] != "":
+			fields.Bank = ifscBanks[code]
+		case fields.Name == "" && strings.ContainsAny(field, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"):
+			fields.Name = counterpartyName(field)
+		default:
+			remarks = append(remarks, field)
+		}
+	}
+	if fields.IFSC != "" {
+		fields.Bank = ifscBanks[fields.IFSC[:4]]
+	}
+	fields.Remark = strings.Join(remarks, " "+delimiter+" ")
+	return fields, true
+}
+
+// isRailReference reports whether a field is the rail's transaction reference
+func isRailReference(rail, code string) bool {
+	switch rail {
+	case "IMPS":
+		return rrnPattern.MatchString(code)
+	case "NEFT", "RTGS":
+		return utrPattern.MatchString(code)
+	default:
+		return achReferencePattern.MatchString(code)
+	}
+}
+
+// counterpartyName drops titles and the third-party prefix of ACH debits ("TP ACH MAXLIFEINSURA")
+func counterpartyName(field string) string {
+	field = strings.TrimPrefix(field, "TP ACH ")
+	return titlePattern.ReplaceAllString(field, "")
+}
+
+// dropBledReference removes Chq./Ref.No. column text printed inside the narration
+// It is kept only when it does not recur as part of the narration (the full UTR)
+func dropBledReference(narration string) string {
+	for _, m := range bledReferencePattern.FindAllStringSubmatchIndex(narration, -1) {
+		if strings.Contains(compactField(narration[m[1]:]), narration[m[2]:m[3]]) {
+			return narration[:m[0]] + " " + narration[m[1]:]
+		}
+	}
+	return narration
+}
+
+// SameAccountNumber reports whether two account numbers can be the same account; either may be
+// masked (XXXXXXX2950) or only its last digits, in which case the digits shown must agree
+func SameAccountNumber(a, b string) bool {
+	da, db := accountDigits(a), accountDigits(b)
+	if len(da) < 4 || len(db) < 4 {
+		return false
+	}
+	if isFullAccountNumber(a) && isFullAccountNumber(b) {
+		return strings.TrimLeft(da, "0") == strings.TrimLeft(db, "0")
+	}
+	if len(da) > len(db) {
+		da, db = db, da
+	}
+	return strings.HasSuffix(db, da)
+}
+
+// accountDigits returns the digits an account number shows
+func accountDigits(account string) string {
+	var digits strings.Builder
+	for _, c := range account {
+		if c >= '0' && c <= '9' {
+			digits.WriteRune(c)
+		}
+	}
+	return digits.String()
+}
+
+// isFullAccountNumber reports whether an account number is complete: unmasked and at least 9 digits
+func isFullAccountNumber(account string) bool {
+	return !strings.ContainsAny(strings.ToUpper(account), "X*") && len(accountDigits(account)) >= 9
+}
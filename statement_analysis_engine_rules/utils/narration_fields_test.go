@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func TestParseNarrationFields(t *testing.T) {
+	tests := []struct {
+		narration string
+		credit    bool
+		expected  models.NarrationFields
+	}{
+		{
+			"IMPS-511521974412-KALPIT KUMAR SHARMA-ID FB-XXXXXXX2950-IMPSTXN", false,
+			models.NarrationFields{Rail: "IMPS", Direction: "DR", Reference: "511521974412", Name: "KALPIT KUMAR SHARMA",
+				Account: "XXXXXXX2950", Bank: "IDFC First Bank", Remark: "IMPSTXN"},
+		},
+		{
+			"IMPS-509110523236-MR  KALPIT KUMAR SHA-IDFB-XXXXXXX2950-IMPSTXN", true,
+			models.NarrationFields{Rail: "IMPS", Direction: "CR", Reference: "509110523236", Name: "KALPIT KUMAR SHA",
+				Account: "XXXXXXX2950", Bank: "IDFC First Bank", Remark: "IMPSTXN"},
+		},
+		{
+			"NEFT CR-YESB0000001-ZERODHA BROKING LTD-  YESBN12025060706 DSCNB A/C-KALPIT KUMAR SHARMA-YESBN12025 060706283534", true,
+			models.NarrationFields{Rail: "NEFT", Direction: "CR", Reference: "YESBN12025060706283534", Name: "ZERODHA BROKING LTD",
+				IFSC: "YESB0000001", Bank: "Yes Bank", Remark: "DSCNB A/C - KALPIT KUMAR SHARMA"},
+		},
+		{
+			"RTGS DR-SBIN0001234-RAMESH TRADERS-PAYMENT-HDFCR52025010112345678", false,
+			models.NarrationFields{Rail: "RTGS", Direction: "DR", Reference: "HDFCR52025010112345678", Name: "RAMESH TRADERS",
+				IFSC: "SBIN0001234", Bank: "State Bank of India", Remark: "PAYMENT"},
+		},
+		{
+			"ACH D- TP ACH MAXLIFEINSURA-1788982428", false,
+			models.NarrationFields{Rail: "ACH", Direction: "DR", Reference: "1788982428", Name: "MAXLIFEINSURA"},
+		},
+		{
+			"IMPS/P2A/512345678901/RAVI KUMAR/HDFC/RENT", false,
+			models.NarrationFields{Rail: "IMPS", Direction: "DR", Reference: "512345678901", Name: "RAVI KUMAR",
+				Bank: "HDFC Bank", Remark: "RENT"},
+		},
+		{
+			"NACH DR-UTIB7021234567890123-BAJAJ FINANCE LTD-98765432", false,
+			models.NarrationFields{Rail: "NACH", Direction: "DR", Reference: "98765432", Name: "BAJAJ FINANCE LTD",
+				MandateID: "UTIB7021234567890123"},
+		},
+	}
+	for _, tt := range tests {
+		fields, ok := ParseNarrationFields(tt.narration, tt.credit)
+		if !ok || !reflect.DeepEqual(fields, tt.expected) {
+			t.Errorf("%s: expected %+v, got %+v (%v)", tt.narration, tt.expected, fields, ok)
+		}
+	}
+
+	for _, narration := range []string{"ACHIEVE THINGS PVT LTD", "UPI-CRED CLUB-CRED.CLUB@AXISB-UTIB0000114-560310517642-UPI", "POS 416021XXXXXX4032 AMAZON"} {
+		if _, ok := ParseNarrationFields(narration, false); ok {
+			t.Errorf("%s: expected no rail template", narration)
+		}
+	}
+}
@@ -7,6 +7,20 @@ import (
 	"strings"
 )
 
+// Patterns removed by NormalizeNarrationForFingerprint, compiled once
+var (
+	// Dates: DD-MM-YYYY or DD/MM/YYYY, DD-MMM-YYYY, MMM DD, YYYY and YYYY-MM-DD
+	fingerprintDatePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\d{1,2}[-/]\d{1,2}[-/]\d{2,4}`),
+		regexp.MustCompile(`\d{1,2}[-/]\w{3}[-/]\d{2,4}`),
+		regexp.MustCompile(`\w{3}\s+\d{1,2},?\s+\d{4}`),
+		regexp.MustCompile(`\d{4}[-/]\d{1,2}[-/]\d{1,2}`),
+	}
+	fingerprintRefPattern           = regexp.MustCompile(`\d{8,}`)       // Reference numbers (8+ digits)
+	fingerprintMaskedAccountPattern = regexp.MustCompile(`X{6,}\d{4}`)   // Masked accounts: XXXXXXXXXXXX1234
+	fingerprintTxnIDPattern         = regexp.MustCompile(`\b[A-Z]\d{4,}\b`) // Transaction IDs such as A54152, K16675
+)
+
 // NormalizeNarrationForFingerprint normalizes narration by removing dates, numbers, and reference IDs
 // This creates a stable fingerprint for recurring payment detection
 // Example: "ACH D STAFF LOAN EMI REC 12-DEC-2024" → "ACH D STAFF LOAN EMI REC"
@@ -18,35 +32,12 @@ func NormalizeNarrationForFingerprint(narration string) string {
 	// Convert to uppercase for consistency
 	normalized := strings.ToUpper(strings.TrimSpace(narration))
 
-	// Remove dates (various formats)
-	// Pattern: DD-MM-YYYY, DD/MM/YYYY, DD-MMM-YYYY, etc.
-	datePatterns := []*regexp.Regexp{
-		regexp.MustCompile(`\d{1,2}[-/]\d{1,2}[-/]\d{2,4}`),           // DD-MM-YYYY or DD/MM/YYYY
-		regexp.MustCompile(`\d{1,2}[-/]\w{3}[-/]\d{2,4}`),            // DD-MMM-YYYY
-		regexp.MustCompile(`\w{3}\s+\d{1,2},?\s+\d{4}`),              // MMM DD, YYYY
-		regexp.MustCompile(`\d{4}[-/]\d{1,2}[-/]\d{1,2}`),            // YYYY-MM-DD
-	}
-
-	for _, pattern := range datePatterns {
+	for _, pattern := range fingerprintDatePatterns {
 		normalized = pattern.ReplaceAllString(normalized, "")
 	}
-
-	// Remove reference numbers and IDs (long sequences of digits)
-	// Pattern: sequences of 8+ digits (likely reference numbers)
-	refPattern := regexp.MustCompile(`\d{8,}`)
-	normalized = refPattern.ReplaceAllString(normalized, "")
-
-	// Remove account numbers (masked: XXXXXXXXXXXX1234)
-	maskedAccountPattern := regexp.MustCompile(`X{6,}\d{4}`)
-	normalized = maskedAccountPattern.ReplaceAllString(normalized, "")
-
-	// Remove transaction IDs (alphanumeric codes)
-	// Pattern: sequences like A54152, K16675, etc.
-	txnIdPattern := regexp.MustCompile(`\b[A-Z]\d{4,}\b`)
-	normalized = txnIdPattern.ReplaceAllString(normalized, "")
-
-	// Clean up multiple spaces
-	spacePattern := regexp.MustCompile(`\s+`)
+	normalized = fingerprintRefPattern.ReplaceAllString(normalized, "")
+	normalized = fingerprintMaskedAccountPattern.ReplaceAllString(normalized, "")
+	normalized = fingerprintTxnIDPattern.ReplaceAllString(normalized, "")
 	normalized = spacePattern.ReplaceAllString(normalized, " ")
 
 	// Trim and return
@@ -6,6 +6,11 @@ import (
 	"strings"
 )
 
+var (
+	spacePattern       = regexp.MustCompile(`\s+`)
+	specialCharPattern = regexp.MustCompile(`[^\w\s-]`) // Characters that don't add meaning
+)
+
 // NormalizeNarration normalizes narration text for better matching
 // CRITICAL: Also removes account statement footer text that contaminates classification
 func NormalizeNarration(narration string) string {
@@ -70,10 +75,10 @@ func NormalizeNarration(narration string) string {
 	
 	// Remove extra spaces
 	narration = strings.TrimSpace(narration)
-	narration = regexp.MustCompile(`\s+`).ReplaceAllString(narration, " ")
+	narration = spacePattern.ReplaceAllString(narration, " ")
 
 	// Remove special characters that don't add meaning
-	narration = regexp.MustCompile(`[^\w\s-]`).ReplaceAllString(narration, " ")
+	narration = specialCharPattern.ReplaceAllString(narration, " ")
 
 	return strings.TrimSpace(narration)
 }
@@ -87,6 +87,11 @@ func IsPersonToPersonTransfer(narration string, merchant string, amount float64)
 		return true
 	}
 
+	// UPI narrations name the counterparty's VPA, which tells persons from merchants
+	if upi, ok := ParseUPI(narration, false); ok && upi.Kind != UPIUnknown {
+		return upi.Kind == UPIPerson
+	}
+
 	// Check if merchant is a person name (common patterns)
 	// Person names typically don't contain business keywords
 	businessKeywords := []string{
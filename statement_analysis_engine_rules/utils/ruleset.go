@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// RuleSet is the classification data of the active rule pack (see package rulepack)
+// A RuleSet is immutable once active; reloading swaps in a new one
+type RuleSet struct {
+	Version            string
+	Name               string
+	KnownMerchants     []KnownMerchant              // Layer 4, checked in order
+	CanonicalMerchants map[string]CanonicalMerchant // Key -> canonical merchant
+	IntentKeywords     []IntentKeyword              // Layer 5
+	CategoryRules      []CategoryRule               // Highest priority first
+	KeywordGroups      map[string][]string          // Keyword lists of the built-in category patterns by ID
+
+	index     sync.Once
+	keywords  *Matcher       // Every keyword of the pack, scanned once per text
+	merchants []KeywordGroup // Patterns of KnownMerchants[i]
+	intents   []KeywordGroup // Keyword of IntentKeywords[i]
+	rules     []KeywordGroup // Keywords of CategoryRules[i]
+	groups    map[string]KeywordGroup
+	none      KeywordGroup // Stands in for unknown group IDs
+}
+
+// matcher returns the pack's keyword matcher, built on first use
+func (r *RuleSet) matcher() *Matcher {
+	r.index.Do(func() {
+		r.keywords = NewMatcher()
+		for _, merchant := range r.KnownMerchants {
+			r.merchants = append(r.merchants, r.keywords.Group(upperAll(merchant.Patterns)...))
+		}
+		for _, intent := range r.IntentKeywords {
+			r.intents = append(r.intents, r.keywords.Group(intent.Keyword))
+		}
+		for _, rule := range r.CategoryRules {
+			r.rules = append(r.rules, r.keywords.Group(rule.Keywords...))
+		}
+		ids := make([]string, 0, len(r.KeywordGroups))
+		for id := range r.KeywordGroups {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		r.groups = make(map[string]KeywordGroup, len(ids))
+		for _, id := range ids {
+			r.groups[id] = r.keywords.Group(r.KeywordGroups[id]...)
+		}
+		r.none = r.keywords.Group()
+	})
+	return r.keywords
+}
+
+// Match scans text once for every keyword of the pack; text must be upper-cased
+func (r *RuleSet) Match(text string) Matches {
+	return r.matcher().Match(text)
+}
+
+// Group returns the keyword group with the given ID; an unknown ID is a group without keywords
+func (r *RuleSet) Group(id string) KeywordGroup {
+	r.matcher()
+	if group, ok := r.groups[id]; ok {
+		return group
+	}
+	return r.none
+}
+
+func upperAll(values []string) []string {
+	upper := make([]string, len(values))
+	for i, value := range values {
+		upper[i] = strings.ToUpper(value)
+	}
+	return upper
+}
+
+// CategoryRule assigns a category when any of its keywords or patterns matches the narration
+type CategoryRule struct {
+	ID         string
+	Category   string
+	Keywords   []string         // Upper-case substrings
+	Patterns   []*regexp.Regexp // Matched against the upper-cased narration
+	Priority   int
+	Confidence float64
+	Reason     string
+}
+
+var activeRules atomic.Pointer[RuleSet]
+
+// ActiveRules returns the rule set in use (empty until a pack is activated)
+func ActiveRules() *RuleSet {
+	if rules := activeRules.Load(); rules != nil {
+		return rules
+	}
+	return &RuleSet{}
+}
+
+// SetActiveRules swaps in rules for all subsequent classifications and returns the previous set
+func SetActiveRules(rules *RuleSet) *RuleSet {
+	return activeRules.Swap(rules)
+}
+
+// RuleVersion returns the version of the active rule pack, recorded in ClassificationMetadata.RuleVersion
+func RuleVersion() string {
+	return ActiveRules().Version
+}
+
+// MatchCategoryRule returns the highest-priority pack rule matching the narration and the keyword or pattern that matched
+func MatchCategoryRule(narration string) (CategoryRule, string, bool) {
+	upper := strings.ToUpper(narration)
+	rules := ActiveRules()
+	found := rules.matcher().Match(upper)
+	for i, rule := range rules.CategoryRules {
+		if keyword, ok := found.First(rules.rules[i]); ok {
+			return rule, keyword, true
+		}
+		for _, pattern := range rule.Patterns {
+			if match := pattern.FindString(upper); match != "" {
+				return rule, match, true
+			}
+		}
+	}
+	return CategoryRule{}, "", false
+}
+
+// RuleHit is a rule of the active pack that matched a narration
+type RuleHit struct {
+	Index   int      // Position of the rule in its RuleSet list
+	Matched []string // Keywords, aliases or pattern sources that matched, in rule order
+}
+
+// CategoryRuleHits returns every pack rule matching the narration, highest priority first
+// MatchCategoryRule returns the first of them; the rest are shadowed
+func CategoryRuleHits(narration string) []RuleHit {
+	upper := strings.ToUpper(narration)
+	rules := ActiveRules()
+	found := rules.matcher().Match(upper)
+	var hits []RuleHit
+	for i, rule := range rules.CategoryRules {
+		matched := found.In(rules.rules[i])
+		for _, pattern := range rule.Patterns {
+			if pattern.MatchString(upper) {
+				matched = append(matched, pattern.String())
+			}
+		}
+		if len(matched) > 0 {
+			hits = append(hits, RuleHit{Index: i, Matched: matched})
+		}
+	}
+	return hits
+}
@@ -5,11 +5,12 @@ import (
 	"strings"
 )
 
+var tokenSeparators = regexp.MustCompile(`[/\-_\s]+`)
+
 // Tokenize splits narration by known separators
 func Tokenize(narration string) []string {
 	// Replace separators with space, then split
-	separators := regexp.MustCompile(`[/\-_\s]+`)
-	normalized := separators.ReplaceAllString(narration, " ")
+	normalized := tokenSeparators.ReplaceAllString(narration, " ")
 
 	// Split by spaces and filter empty strings
 	parts := strings.Fields(normalized)
@@ -30,41 +31,41 @@ func ExtractGateway(narration string) string {
 	upper := strings.ToUpper(narration)
 
 	// Gateway patterns (order matters - more specific first)
-	gatewayPatterns := map[string]string{
+	gatewayPatterns := []struct{ pattern, gateway string }{
 		// Bill Payment Gateways
-		"WHDF":     "BillDesk",
-		"BILLDK":   "BillDesk",
-		"BILLDESK": "BillDesk",
-		"BILLDESKPG": "BillDesk", // BillDesk payment gateway (e.g., BILLDESKPG.UPPCL@HDFCBANK)
-		"BDGPAY":   "BillDesk", // BillDesk variant (e.g., BDGPAY.MSEDCL@HDFCBANK)
-		"PAYU":     "PayU",
-		"RAZP":     "Razorpay",
-		"RAZORPAY": "Razorpay",
-		"CCAVENUE": "CCAvenue",
-		"BBPS":     "BBPS",
-		"PAYGOV":   "PayGov",
-		"VYAPAR":   "VYAPAR", // VYAPAR payment gateway (e.g., VYAPAR.170819826526@HDFCBANK)
-		"SBIPG":    "SBI Payment Gateway",
-		"AXISPG":   "Axis Payment Gateway",
-		"ICICIPG":  "ICICI Payment Gateway",
-		"KOTAKPG":  "Kotak Payment Gateway",
-		"YESPG":    "Yes Bank Payment Gateway",
-		"PYTM":     "Paytm Bank", // Paytm Payments Bank (e.g., PYTM-XXXXXXXXXXXX8734)
+		{"WHDF", "BillDesk"},
+		{"BILLDK", "BillDesk"},
+		{"BILLDESK", "BillDesk"},
+		{"BILLDESKPG", "BillDesk"}, // BillDesk payment gateway (e.g., BILLDESKPG.UPPCL@HDFCBANK)
+		{"BDGPAY", "BillDesk"},     // BillDesk variant (e.g., BDGPAY.MSEDCL@HDFCBANK)
+		{"PAYU", "PayU"},
+		{"RAZP", "Razorpay"},
+		{"RAZORPAY", "Razorpay"},
+		{"CCAVENUE", "CCAvenue"},
+		{"BBPS", "BBPS"},
+		{"PAYGOV", "PayGov"},
+		{"VYAPAR", "VYAPAR"}, // VYAPAR payment gateway (e.g., VYAPAR.170819826526@HDFCBANK)
+		{"SBIPG", "SBI Payment Gateway"},
+		{"AXISPG", "Axis Payment Gateway"},
+		{"ICICIPG", "ICICI Payment Gateway"},
+		{"KOTAKPG", "Kotak Payment Gateway"},
+		{"YESPG", "Yes Bank Payment Gateway"},
+		{"PYTM", "Paytm Bank"}, // Paytm Payments Bank (e.g., PYTM-XXXXXXXXXXXX8734)
 		// Other payment methods
-		"UPI":         "UPI",
-		"NET BANKING": "NetBanking",
-		"IB ":         "NetBanking",
-		"IB-":         "NetBanking",
-		"ECS":         "ECS",
-		"IMPS":        "IMPS",
-		"NEFT":        "NEFT",
-		"RTGS":        "RTGS",
-		"ACH":         "ACH",
+		{"UPI", "UPI"},
+		{"NET BANKING", "NetBanking"},
+		{"IB ", "NetBanking"},
+		{"IB-", "NetBanking"},
+		{"ECS", "ECS"},
+		{"IMPS", "IMPS"},
+		{"NEFT", "NEFT"},
+		{"RTGS", "RTGS"},
+		{"ACH", "ACH"},
 	}
 
-	for pattern, gateway := range gatewayPatterns {
-		if strings.Contains(upper, pattern) {
-			return gateway
+	for _, p := range gatewayPatterns {
+		if strings.Contains(upper, p.pattern) {
+			return p.gateway
 		}
 	}
 
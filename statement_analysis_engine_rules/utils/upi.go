@@ -0,0 +1,300 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+// UPI counterparty kinds (models.UPIDetails.Kind)
+const (
+	UPIPerson     = "Person"     // Personal VPA: P2P
+	UPIAggregator = "Aggregator" // VPA issued by a merchant aggregator or QR acquirer (Paytm QR, BharatPe, Razorpay...): P2M
+	UPIBusiness   = "Business"   // Merchant's own VPA: P2M
+	UPIUnknown    = "Unknown"
+)
+
+// UPI counterparty roles (models.UPIDetails.Direction)
+const (
+	UPIPayee = "payee" // We paid the counterparty
+	UPIPayer = "payer" // The counterparty paid us
+)
+
+var (
+	upiPrefixPattern = regexp.MustCompile(`^(?:REV-)?UPI-`)
+	upiVPAPattern    = regexp.MustCompile(`^[A-Z0-9._-]+@[A-Z][A-Z0-9]*$`)
+	ifscPattern      = regexp.MustCompile(`^[A-Z]{4}0[A-Z0-9]{6}$`)
+	rrnPattern       = regexp.MustCompile(`^\d{12}$`)
+	maskedAccount    = regexp.MustCompile(`^[X*]*\d{3,}$`)
+	phoneLocalPart   = regexp.MustCompile(`^(?:91)?\d{10}(?:-\d)?$`)
+	phonePeQRPattern = regexp.MustCompile(`^Q\d{9}$`)
+)
+
+// Local-part prefixes and suffixes of VPAs that aggregators issue to the merchants they onboard
+var (
+	aggregatorPrefixes = []string{
+		"PAYTMQR", "PAYTM.", "BHARATPE", "VYAPAR.", "PINELABS.", "GPAY-", "MAB.", "MSWIPE.", "BILLDESKPG.",
+		"BAJAJPAY.", "IBKPOS.", "AMZNPL",
+	}
+	aggregatorSuffixes = []string{".RZP", ".PAYU", ".CASHFREE", ".EASEBUZZ", ".BILLDESK", ".CCAVENUE", ".EAZYPAY"}
+)
+
+// upiHandleBanks maps VPA handles to the bank behind them
+// Kind is the kind of VPA the handle issues: consumer app handles issue personal VPAs,
+// aggregator handles merchant VPAs, and a bank's own handle mostly business VPAs
+var upiHandleBanks = map[string]struct {
+	Bank string
+	Kind string
+}{
+	"OKHDFCBANK": {"HDFC Bank", UPIPerson},
+	"OKAXIS":     {"Axis Bank", UPIPerson},
+	"OKSBI":      {"State Bank of India", UPIPerson},
+	"OKICICI":    {"ICICI Bank", UPIPerson},
+	"YBL":        {"Yes Bank", UPIPerson},
+	"IBL":        {"IndusInd Bank", UPIPerson},
+	"AXL":        {"Axis Bank", UPIPerson},
+	"PAYTM":      {"Paytm Payments Bank", UPIPerson},
+	"PTYES":      {"Yes Bank", UPIPerson},
+	"PTAXIS":     {"Axis Bank", UPIPerson},
+	"PTHDFC":     {"HDFC Bank", UPIPerson},
+	"PTSBI":      {"State Bank of India", UPIPerson},
+	"APL":        {"Axis Bank", UPIPerson},
+	"YAPL":       {"Yes Bank", UPIPerson},
+	"RAPL":       {"RBL Bank", UPIPerson},
+	"UPI":        {"", UPIPerson},
+	"OKBIZAXIS":  {"Axis Bank", UPIAggregator},
+	"OKBIZICICI": {"ICICI Bank", UPIAggregator},
+	"PTYS":       {"Yes Bank", UPIAggregator},
+	"PTYBL":      {"Yes Bank", UPIAggregator},
+	"PTY":        {"Yes Bank", UPIAggregator},
+	"BARODAMPAY": {"Bank of Baroda", UPIAggregator},
+	"UNITYPE":    {"Unity Small Finance Bank", UPIAggregator},
+	"FBPE":       {"Federal Bank", UPIAggregator},
+	"JIOPARTNER": {"Jio Payments Bank", UPIAggregator},
+	"AXISBANK":   {"Axis Bank", UPIBusiness},
+	"AXISB":      {"Axis Bank", UPIBusiness},
+	"ICICI":      {"ICICI Bank", UPIBusiness},
+	"HDFCBANK":   {"HDFC Bank", UPIBusiness},
+	"HDFC":       {"HDFC Bank", UPIBusiness},
+	"SBI":        {"State Bank of India", UPIBusiness},
+	"KOTAK":      {"Kotak Mahindra Bank", UPIBusiness},
+	"YESBANK":    {"Yes Bank", UPIBusiness},
+	"YESBANKLTD": {"Yes Bank", UPIBusiness},
+	"INDUS":      {"IndusInd Bank", UPIBusiness},
+	"AUBANK":     {"AU Small Finance Bank", UPIBusiness},
+	"IDFCBANK":   {"IDFC First Bank", UPIBusiness},
+	"AIRTEL":     {"Airtel Payments Bank", UPIBusiness},
+	"MAIRTEL":    {"Airtel Payments Bank", UPIBusiness},
+}
+
+// ifscBanks maps the bank code of an IFSC (its first four letters) to the bank
+var ifscBanks = map[string]string{
+	"HDFC": "HDFC Bank", "ICIC": "ICICI Bank", "UTIB": "Axis Bank", "SBIN": "State Bank of India",
+	"YESB": "Yes Bank", "PUNB": "Punjab National Bank", "IOBA": "Indian Overseas Bank",
+	"UBIN": "Union Bank of India", "KKBK": "Kotak Mahindra Bank", "CNRB": "Canara Bank",
+	"FDRL": "Federal Bank", "AIRP": "Airtel Payments Bank", "JIOP": "Jio Payments Bank",
+	"UNBA": "Unity Small Finance Bank", "AUBL": "AU Small Finance Bank", "IDFB": "IDFC First Bank", "BARB": "Bank of Baroda",
+	"INDB": "IndusInd Bank", "PYTM": "Paytm Payments Bank", "BKID": "Bank of India",
+	"MAHB": "Bank of Maharashtra", "RATN": "RBL Bank", "IBKL": "IDBI Bank", "CBIN": "Central Bank of India",
+}
+
+// Words in a counterparty name that only businesses use (names are truncated, hence PRIV and LIMITE)
+var businessNameWords = []string{
+	"PVT", "PRIV", "PRIVATE", "LTD", "LIMITE", "LIMITED", "LLP", "INC", "CORP", "COMPANY", "STORE", "STORES", "SHOP", "MART", "MARKET",
+	"TRADERS", "TRADING", "ENTERPRISE", "ENTERPRISES", "SERVICES", "SOLUTIONS", "TECHNOLOGIES", "HOTEL",
+	"RESTAURANT", "CAFE", "BAKERY", "PHARMACY", "MEDICAL", "MEDICOS", "HOSPITAL", "CLINIC", "FINSERV",
+	"AGENCY", "AGENCIES", "CENTRE", "CENTER", "STATION", "SWEETS", "DAIRY", "ELECTRICALS", "INDUSTRIES",
+	"AUTOMOBILES", "INTERIORS", "FOOD", "FOODS",
+}
+
+// ParseUPI parses a UPI narration (HDFC layout, wrapped lines allowed):
+//
+//	UPI-<name>-<vpa>-<ifsc>-<reference>-<remark>
+//	UPI-<masked account>-<ifsc>-<reference>-<remark>
+//	REV-UPI-<account>-<vpa>-<reference>-<remark>
+//
+// credit tells the counterparty's role; ok is false when the narration is not UPI.
+// The narration must not be normalized, which drops the @ of the VPA
+func ParseUPI(narration string, credit bool) (models.UPIDetails, bool) {
+	upper := strings.ToUpper(strings.TrimSpace(narration))
+	prefix := upiPrefixPattern.FindString(upper)
+	if prefix == "" {
+		return models.UPIDetails{}, false
+	}
+	details := models.UPIDetails{Direction: UPIPayee}
+	if credit {
+		details.Direction = UPIPayer
+	}
+
+	rest := upper[len(prefix):]
+	var fields []string
+	if at := strings.IndexByte(rest, '@'); at >= 0 {
+		// The name is the first field; the VPA runs from the second field to the end of the
+		// field holding the @, since VPAs may contain hyphens (ZOMATO-ORDER@PTYBL)
+		start := 0
+		if dash := strings.IndexByte(rest[:at], '-'); dash >= 0 {
+			details.Name, details.Account = upiParty(rest[:dash])
+			start = dash + 1
+		}
+		end := len(rest)
+		if dash := strings.IndexByte(rest[at:], '-'); dash >= 0 {
+			end = at + dash
+		}
+		// Wrapped statement lines put spaces inside the VPA ("PAYTMQR6AQSV7@P TYS")
+		if vpa := compactField(rest[start:end]); upiVPAPattern.MatchString(vpa) {
+			details.VPA = vpa
+			details.Handle = vpa[strings.IndexByte(vpa, '@')+1:]
+		}
+		if end < len(rest) {
+			fields = strings.Split(rest[end+1:], "-")
+		}
+	} else {
+		fields = strings.Split(rest, "-")
+		details.Name, details.Account = upiParty(fields[0])
+		fields = fields[1:]
+	}
+
+	// IFSC and reference, then everything after the reference is the remark
+	for i, field := range fields {
+		compacted := compactField(field)
+		switch {
+		case details.IFSC == "" && details.Reference == "" && ifscPattern.MatchString(compacted):
+			details.IFSC = compacted
+		case details.Reference == "" && rrnPattern.MatchString(compacted):
+			details.Reference = compacted
+			if remark := strings.TrimSpace(strings.Join(fields[i+1:], "-")); compactField(remark) != "UPI" {
+				details.Remark = spacePattern.ReplaceAllString(remark, " ")
+			}
+		default:
+			continue
+		}
+		if details.Reference != "" {
+			break
+		}
+	}
+
+	details.PSPBank = upiBank(details.Handle, details.IFSC)
+	details.Kind, details.KindBasis = ClassifyVPA(details.VPA, details.Name)
+	if details.VPA == "" && details.Account != "" {
+		details.Kind, details.KindBasis = UPIPerson, "account transfer"
+	}
+	return details, true
+}
+
+// ClassifyVPA tells a person's VPA from a merchant's by the VPA and the counterparty name
+// It returns the kind and the pattern that decided it
+func ClassifyVPA(vpa, name string) (string, string) {
+	local, handle, _ := strings.Cut(strings.ToUpper(vpa), "@")
+	for _, prefix := range aggregatorPrefixes {
+		if strings.HasPrefix(local, prefix) {
+			return UPIAggregator, "aggregator VPA " + strings.TrimSuffix(prefix, ".") + "*"
+		}
+	}
+	for _, suffix := range aggregatorSuffixes {
+		if strings.HasSuffix(local, suffix) {
+			return UPIAggregator, "aggregator VPA *" + strings.TrimPrefix(suffix, ".")
+		}
+	}
+	if handle == "YBL" && phonePeQRPattern.MatchString(local) {
+		return UPIAggregator, "PhonePe merchant QR"
+	}
+	bank, known := upiHandleBanks[handle]
+	if known && bank.Kind == UPIAggregator {
+		return UPIAggregator, "aggregator handle @" + handle
+	}
+	if phoneLocalPart.MatchString(local) {
+		return UPIPerson, "mobile number VPA"
+	}
+	if word, ok := businessWord(name); ok {
+		return UPIBusiness, "business name " + word
+	}
+	if known, _, _ := DetectKnownMerchant(name, strings.NewReplacer(".", " ", "-", " ").Replace(local)); known != "" {
+		return UPIBusiness, "known merchant " + known
+	}
+	if known {
+		return bank.Kind, "handle @" + handle
+	}
+	return UPIUnknown, ""
+}
+
+// businessWord returns the first business word in a counterparty name
+func businessWord(name string) (string, bool) {
+	for _, word := range strings.Fields(strings.ToUpper(name)) {
+		for _, business := range businessNameWords {
+			if word == business {
+				return word, true
+			}
+		}
+	}
+	return "", false
+}
+
+// upiBank returns the bank behind a VPA handle, or else the bank of the IFSC
+func upiBank(handle, ifsc string) string {
+	if bank := upiHandleBanks[handle].Bank; bank != "" {
+		return bank
+	}
+	if len(ifsc) >= 4 {
+		return ifscBanks[ifsc[:4]]
+	}
+	return ""
+}
+
+// upiParty reads the first field of a UPI narration, a counterparty name or an account number
+func upiParty(field string) (name, account string) {
+	if compacted := compactField(field); maskedAccount.MatchString(compacted) {
+		return "", compacted
+	}
+	return spacePattern.ReplaceAllString(strings.TrimSpace(field), " "), ""
+}
+
+// compactField drops the spaces of a field that cannot contain any (VPA, IFSC, reference)
+func compactField(field string) string {
+	return strings.Join(strings.Fields(field), "")
+}
+
+// VPAStats refines the kind of UPI counterparties with how often VPAs and names recur
+// across transactions: a VPA paid under several names belongs to an aggregator, and a
+// name paid through several personal-looking VPAs is a merchant rotating QR codes
+type VPAStats struct {
+	names map[string]map[string]bool // VPA -> names seen with it
+	vpas  map[string]map[string]bool // name -> VPAs seen with it
+}
+
+// Distinct names per VPA, or VPAs per name, that mark a merchant
+const vpaFrequencyThreshold = 3
+
+// NewVPAStats returns empty VPA statistics
+func NewVPAStats() *VPAStats {
+	return &VPAStats{names: make(map[string]map[string]bool), vpas: make(map[string]map[string]bool)}
+}
+
+// Add records the VPA and name of a parsed UPI narration
+func (s *VPAStats) Add(details models.UPIDetails) {
+	if details.VPA == "" || details.Name == "" {
+		return
+	}
+	addPair(s.names, details.VPA, details.Name)
+	addPair(s.vpas, details.Name, details.VPA)
+}
+
+// Refine returns details with Kind updated from the recorded frequencies
+func (s *VPAStats) Refine(details models.UPIDetails) models.UPIDetails {
+	if details.VPA == "" || details.Kind == UPIAggregator {
+		return details
+	}
+	if len(s.names[details.VPA]) >= vpaFrequencyThreshold {
+		details.Kind, details.KindBasis = UPIAggregator, "VPA shared by several names"
+	} else if (details.Kind == UPIPerson || details.Kind == UPIUnknown) && len(s.vpas[details.Name]) >= vpaFrequencyThreshold {
+		details.Kind, details.KindBasis = UPIBusiness, "name paid through several VPAs"
+	}
+	return details
+}
+
+func addPair(index map[string]map[string]bool, key, value string) {
+	if index[key] == nil {
+		index[key] = make(map[string]bool)
+	}
+	index[key][value] = true
+}
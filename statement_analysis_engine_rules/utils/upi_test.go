@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+func TestParseUPI(t *testing.T) {
+	tests := []struct {
+		narration string
+		credit    bool
+		expected  models.UPIDetails
+	}{
+		{
+			"UPI-SHYAM MEDICOS-PAYTMQR6AQSV7@PTYS-YES B0PTMUPI-102426356501-UPI", false,
+			models.UPIDetails{Name: "SHYAM MEDICOS", VPA: "PAYTMQR6AQSV7@PTYS", Handle: "PTYS", IFSC: "YESB0PTMUPI", PSPBank: "Yes Bank",
+				Reference: "102426356501", Direction: UPIPayee, Kind: UPIAggregator, KindBasis: "aggregator VPA PAYTMQR*"},
+		},
+		{
+			"UPI-ZOMATO LIMITED-ZOMATO-ORDER@PTYBL-YESB0PTMUPI-123456789012-ZOMATO ORDER", false,
+			models.UPIDetails{Name: "ZOMATO LIMITED", VPA: "ZOMATO-ORDER@PTYBL", Handle: "PTYBL", IFSC: "YESB0PTMUPI", PSPBank: "Yes Bank",
+				Reference: "123456789012", Remark: "ZOMATO ORDER", Direction: UPIPayee, Kind: UPIAggregator, KindBasis: "aggregator handle @PTYBL"},
+		},
+		{
+			"UPI-ABHISHEK GROVER-ABHISHEK.GROVER12-2@ OKHDFCBANK-HDFC0000794-571780581490-UPI", true,
+			models.UPIDetails{Name: "ABHISHEK GROVER", VPA: "ABHISHEK.GROVER12-2@OKHDFCBANK", Handle: "OKHDFCBANK", IFSC: "HDFC0000794", PSPBank: "HDFC Bank",
+				Reference: "571780581490", Direction: UPIPayer, Kind: UPIPerson, KindBasis: "handle @OKHDFCBANK"},
+		},
+		{
+			"UPI-ATUL KUMAR MITTAL HU-9810020619-2@YB L-YESB0001273-104803867152-UPI", false,
+			models.UPIDetails{Name: "ATUL KUMAR MITTAL HU", VPA: "9810020619-2@YBL", Handle: "YBL", IFSC: "YESB0001273", PSPBank: "Yes Bank",
+				Reference: "104803867152", Direction: UPIPayee, Kind: UPIPerson, KindBasis: "mobile number VPA"},
+		},
+		{
+			"UPI-XXXXXXXX3286-ICIC0003458-10653249355 2-UPI", false,
+			models.UPIDetails{Account: "XXXXXXXX3286", IFSC: "ICIC0003458", PSPBank: "ICICI Bank", Reference: "106532493552",
+				Direction: UPIPayee, Kind: UPIPerson, KindBasis: "account transfer"},
+		},
+		{
+			"REV-UPI-08821130001725-KALPIT.COOL2006@OKHDFCBANK-209945000965-UPI", true,
+			models.UPIDetails{VPA: "KALPIT.COOL2006@OKHDFCBANK", Handle: "OKHDFCBANK", Account: "08821130001725", PSPBank: "HDFC Bank",
+				Reference: "209945000965", Direction: UPIPayer, Kind: UPIPerson, KindBasis: "handle @OKHDFCBANK"},
+		},
+		{
+			"UPI-CRED CLUB-CRED.CLUB@AXISB-UTIB000011 4-560310517642-PAYMENT ON CRED", false,
+			models.UPIDetails{Name: "CRED CLUB", VPA: "CRED.CLUB@AXISB", Handle: "AXISB", IFSC: "UTIB0000114", PSPBank: "Axis Bank",
+				Reference: "560310517642", Remark: "PAYMENT ON CRED", Direction: UPIPayee, Kind: UPIBusiness, KindBasis: "handle @AXISB"},
+		},
+	}
+	for _, tt := range tests {
+		details, ok := ParseUPI(tt.narration, tt.credit)
+		if !ok || !reflect.DeepEqual(details, tt.expected) {
+			t.Errorf("%s: expected %+v, got %+v (%v)", tt.narration, tt.expected, details, ok)
+		}
+	}
+
+	if _, ok := ParseUPI("IMPS-509110523236-MR  KALPIT KUMAR SHA-IDFB-XXXXXXX2950-IMPSTXN", false); ok {
+		t.Errorf("expected IMPS narrations not to parse as UPI")
+	}
+}
+
+func TestClassifyVPA(t *testing.T) {
+	tests := []struct {
+		vpa      string
+		name     string
+		expected string
+	}{
+		{"Q155417609@YBL", "RAM KUMAR", UPIAggregator},
+		{"GPAY-11244185985@OKBIZAXIS", "M J STORE", UPIAggregator},
+		{"BLINKITJKB.RZP@MAIRTEL", "BLINKIT", UPIAggregator},
+		{"7838333924@AXL", "PARAS NAGPAL", UPIPerson},
+		{"RAMESH.K@OKAXIS", "RAMESH KUMAR", UPIPerson},
+		{"PETSHOP@OKSBI", "HAPPY PETSHOP STORE", UPIBusiness},
+		{"SWIGGY.STORES@ICICI", "SWIGGY", UPIBusiness},
+		{"SOMEONE@NEWBANK", "SOMEONE", UPIUnknown},
+	}
+	for _, tt := range tests {
+		if kind, basis := ClassifyVPA(tt.vpa, tt.name); kind != tt.expected {
+			t.Errorf("%s: expected %s, got %s (%s)", tt.vpa, tt.expected, kind, basis)
+		}
+	}
+}
+
+func TestVPAStatsRefine(t *testing.T) {
+	stats := NewVPAStats()
+	var rotating, shared models.UPIDetails
+	for _, narration := range []string{
+		"UPI-DARSHAN TEOTIA-DARSHAN01@YBL-YESB0YBLUPI-100000000001-UPI",
+		"UPI-DARSHAN TEOTIA-DARSHAN02@YBL-YESB0YBLUPI-100000000002-UPI",
+		"UPI-DARSHAN TEOTIA-DARSHAN03@YBL-YESB0YBLUPI-100000000003-UPI",
+		"UPI-ANIL-COUNTER@OKSBI-SBIN0000001-100000000004-UPI",
+		"UPI-BINA-COUNTER@OKSBI-SBIN0000001-100000000005-UPI",
+		"UPI-CHETAN-COUNTER@OKSBI-SBIN0000001-100000000006-UPI",
+	} {
+		details, _ := ParseUPI(narration, false)
+		stats.Add(details)
+		if details.Name == "DARSHAN TEOTIA" {
+			rotating = details
+		} else {
+			shared = details
+		}
+	}
+	if refined := stats.Refine(rotating); refined.Kind != UPIBusiness {
+		t.Errorf("expected a name paid through three VPAs to be a business, got %+v", refined)
+	}
+	if refined := stats.Refine(shared); refined.Kind != UPIAggregator {
+		t.Errorf("expected a VPA shared by three names to be an aggregator, got %+v", refined)
+	}
+	single, _ := ParseUPI("UPI-RAMESH-RAMESH.K@OKAXIS-UTIB0000123-512345678901-RENT", false)
+	if refined := stats.Refine(single); refined.Kind != UPIPerson {
+		t.Errorf("expected a one-off personal VPA to stay a person, got %+v", refined)
+	}
+}
+
+func TestIsPersonToPersonTransferUPI(t *testing.T) {
+	tests := []struct {
+		narration string
+		merchant  string
+		expected  bool
+	}{
+		// Person-like shop owner names paid through merchant QR codes
+		{"UPI-RAM KUMAR-Q155417609@YBL-YESB0YBLUPI-111223349946-UPI", "RAM KUMAR", false},
+		{"UPI-ASHOK KUMAR-PAYTMQR66QW9C@PTYS-YESB0PTMUPI-111911572600-UPI", "ASHOK KUMAR", false},
+		{"UPI-PARAS NAGPAL-7838333924@AXL-UBIN0542857-565927529339-UPI", "PARAS NAGPAL", true},
+		{"UPI-KEWAL-KEWALSHIVAM@AXL-KKBK0000209-529881434139-UPI", "KEWAL", true},
+	}
+	for _, tt := range tests {
+		if got := IsPersonToPersonTransfer(tt.narration, tt.merchant, 500); got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.narration, tt.expected, got)
+		}
+	}
+}
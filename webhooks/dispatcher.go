@@ -0,0 +1,318 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"classify/metrics"
+)
+
+// Deliveries counts delivery attempts by event type and outcome (delivered, retry, dead_letter)
+var Deliveries = metrics.Default.NewCounter("webhook_deliveries_total",
+	"Webhook delivery attempts by event type and outcome", "event", "outcome")
+
+// Config tunes delivery
+type Config struct {
+	MaxAttempts    int           // Attempts before dead-lettering (default 5)
+	InitialBackoff time.Duration // Wait after the first failure, doubled each retry (default 1s)
+	MaxBackoff     time.Duration // Backoff cap (default 1m)
+	Timeout        time.Duration // Per-attempt HTTP timeout (default 10s)
+	Workers        int           // Concurrent deliveries (default 4)
+	QueueSize      int           // Pending deliveries before new ones are dead-lettered (default 1000)
+	HTTPClient     *http.Client  // nil = client with Timeout that refuses private and local addresses
+	DeadLetter     DeadLetterSink
+
+	// AllowPrivateTargets lets the default client deliver to loopback and private networks (local development and tests only)
+	AllowPrivateTargets bool
+}
+
+// DefaultConfig returns the default delivery settings
+func DefaultConfig() *Config {
+	return &Config{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		Timeout:        10 * time.Second,
+		Workers:        4,
+		QueueSize:      1000,
+	}
+}
+
+// DeadLetter records a delivery that was given up on
+type DeadLetter struct {
+	SubscriptionID string    `json:"subscriptionId"`
+	URL            string    `json:"url"`
+	Event          Event     `json:"event"`
+	Attempts       int       `json:"attempts"`
+	LastStatus     int       `json:"lastStatus,omitempty"`
+	LastError      string    `json:"lastError"`
+	FailedAt       time.Time `json:"failedAt"`
+}
+
+// DeadLetterSink stores failed deliveries for inspection or replay
+type DeadLetterSink interface {
+	Record(DeadLetter) error
+}
+
+// FileDeadLetterLog appends dead letters to a JSON-lines file
+type FileDeadLetterLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileDeadLetterLog creates a sink writing to path
+func NewFileDeadLetterLog(path string) *FileDeadLetterLog {
+	return &FileDeadLetterLog{path: path}
+}
+
+// Record implements DeadLetterSink
+func (f *FileDeadLetterLog) Record(dl DeadLetter) error {
+	line, err := json.Marshal(dl)
+	if err != nil {
+		return fmt.Errorf("failed to encode dead letter: %w", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open dead-letter log: %w", err)
+	}
+	defer file.Close()
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// logDeadLetters is the default sink: an error log entry per dead letter (payload omitted)
+type logDeadLetters struct{}
+
+func (logDeadLetters) Record(dl DeadLetter) error {
+	slog.Error("webhook delivery dead-lettered",
+		slog.String("subscription_id", dl.SubscriptionID),
+		slog.String("event_id", dl.Event.ID),
+		slog.String("event", dl.Event.Type),
+		slog.Int("attempts", dl.Attempts),
+		slog.Int("last_status", dl.LastStatus),
+		slog.String("error", dl.LastError),
+	)
+	return nil
+}
+
+type delivery struct {
+	sub   Subscription
+	event Event
+}
+
+// Dispatcher fans events out to matching subscriptions in the background
+type Dispatcher struct {
+	registry *Registry
+	config   *Config
+	client   *http.Client
+	queue    chan delivery
+	stop     chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.RWMutex
+	closed   bool
+}
+
+// NewDispatcher starts the delivery workers (nil config = DefaultConfig)
+func NewDispatcher(registry *Registry, config *Config) *Dispatcher {
+	defaults := DefaultConfig()
+	if config == nil {
+		config = defaults
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaults.MaxAttempts
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaults.MaxBackoff
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+	if config.Workers <= 0 {
+		config.Workers = defaults.Workers
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaults.QueueSize
+	}
+	if config.DeadLetter == nil {
+		config.DeadLetter = logDeadLetters{}
+	}
+	client := config.HTTPClient
+	if client == nil && config.AllowPrivateTargets {
+		client = &http.Client{Timeout: config.Timeout}
+	} else if client == nil {
+		client = guardedClient(config.Timeout)
+	}
+
+	d := &Dispatcher{
+		registry: registry,
+		config:   config,
+		client:   client,
+		queue:    make(chan delivery, config.QueueSize),
+		stop:     make(chan struct{}),
+	}
+	for i := 0; i < config.Workers; i++ {
+		d.wg.Add(1)
+		go d.worker()
+	}
+	return d
+}
+
+// Registry returns the subscriptions the dispatcher delivers to
+func (d *Dispatcher) Registry() *Registry {
+	return d.registry
+}
+
+// Publish queues event for every matching subscription and returns immediately
+func (d *Dispatcher) Publish(event Event) int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return 0
+	}
+
+	subs := d.registry.Matching(event)
+	for _, sub := range subs {
+		select {
+		case d.queue <- delivery{sub: sub, event: event}:
+		default:
+			d.deadLetter(delivery{sub: sub, event: event}, 0, 0, fmt.Errorf("delivery queue full"))
+		}
+	}
+	return len(subs)
+}
+
+// Close stops accepting events and waits for queued deliveries until ctx is done
+// Deliveries still pending when ctx expires are abandoned mid-backoff
+func (d *Dispatcher) Close(ctx context.Context) error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil
+	}
+	d.closed = true
+	close(d.queue)
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		close(d.stop)
+		return ctx.Err()
+	}
+}
+
+func (d *Dispatcher) worker() {
+	defer d.wg.Done()
+	for job := range d.queue {
+		d.deliver(job)
+	}
+}
+
+// deliver retries job with exponential backoff; 4xx responses other than 408/429 are not retried
+func (d *Dispatcher) deliver(job delivery) {
+	body, err := json.Marshal(job.event)
+	if err != nil {
+		d.deadLetter(job, 0, 0, fmt.Errorf("failed to encode event: %w", err))
+		return
+	}
+
+	backoff := d.config.InitialBackoff
+	var status int
+	for attempt := 1; attempt <= d.config.MaxAttempts; attempt++ {
+		status, err = d.send(job, body, attempt)
+		if err == nil {
+			Deliveries.Inc(job.event.Type, "delivered")
+			return
+		}
+		if !retryable(status) || attempt == d.config.MaxAttempts {
+			d.deadLetter(job, attempt, status, err)
+			return
+		}
+
+		Deliveries.Inc(job.event.Type, "retry")
+		select {
+		case <-time.After(backoff):
+		case <-d.stop:
+			d.deadLetter(job, attempt, status, fmt.Errorf("dispatcher stopped: %w", err))
+			return
+		}
+		backoff *= 2
+		if backoff > d.config.MaxBackoff {
+			backoff = d.config.MaxBackoff
+		}
+	}
+}
+
+func (d *Dispatcher) send(job delivery, body []byte, attempt int) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "classify-webhooks/1")
+	req.Header.Set(EventIDHeader, job.event.ID)
+	req.Header.Set(EventTypeHeader, job.event.Type)
+	req.Header.Set(AttemptHeader, strconv.Itoa(attempt))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(job.sub.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver returned HTTP %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+func retryable(status int) bool {
+	if status == 0 || status >= 500 {
+		return true
+	}
+	return status == http.StatusRequestTimeout || status == http.StatusTooManyRequests
+}
+
+func (d *Dispatcher) deadLetter(job delivery, attempts, status int, cause error) {
+	Deliveries.Inc(job.event.Type, "dead_letter")
+	dl := DeadLetter{
+		SubscriptionID: job.sub.ID,
+		URL:            job.sub.URL,
+		Event:          job.event,
+		Attempts:       attempts,
+		LastStatus:     status,
+		LastError:      cause.Error(),
+		FailedAt:       time.Now().UTC(),
+	}
+	if err := d.config.DeadLetter.Record(dl); err != nil {
+		slog.Error("failed to record webhook dead letter",
+			slog.String("subscription_id", job.sub.ID), slog.Any("error", err))
+	}
+}
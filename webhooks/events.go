@@ -0,0 +1,87 @@
+package webhooks
+
+import (
+	"os"
+	"time"
+
+	"classify/statement_analysis_engine_rules/models"
+)
+
+// AnalysisCompletedData is the payload of analysis.completed (summary figures only, no transactions)
+type AnalysisCompletedData struct {
+	AccountNumberMasked string  `json:"accountNumberMasked"`
+	StatementPeriod     string  `json:"statementPeriod"`
+	TransactionCount    int     `json:"transactionCount"`
+	TotalIncome         float64 `json:"totalIncome"`
+	TotalExpense        float64 `json:"totalExpense"`
+	NetSavings          float64 `json:"netSavings"`
+	AnomalyCount        int     `json:"anomalyCount"`
+	RiskScore           float64 `json:"riskScore"`
+}
+
+// AnomalyDetectedData is the payload of anomaly.detected
+type AnomalyDetectedData struct {
+	AccountNumberMasked string               `json:"accountNumberMasked"`
+	Anomaly             models.AnomalyDetail `json:"anomaly"`
+}
+
+// NotifyAnalysis publishes analysis.completed plus one anomaly.detected per anomaly in response
+// Subscriptions only receive anomalies at or above their MinSeverity
+func NotifyAnalysis(d *Dispatcher, tenantID string, response models.ClassifyResponse) {
+	if d == nil {
+		return
+	}
+	summary := response.AccountSummary
+	d.Publish(NewEvent(EventAnalysisCompleted, tenantID, AnalysisCompletedData{
+		AccountNumberMasked: summary.AccountNumberMasked,
+		StatementPeriod:     summary.StatementPeriod,
+		TransactionCount:    len(response.Transactions),
+		TotalIncome:         summary.TotalIncome,
+		TotalExpense:        summary.TotalExpense,
+		NetSavings:          summary.NetSavings,
+		AnomalyCount:        response.AnomalyDetection.AnomalyCount,
+		RiskScore:           response.AnomalyDetection.RiskScore,
+	}))
+
+	for _, anomaly := range response.AnomalyDetection.Anomalies {
+		event := NewEvent(EventAnomalyDetected, tenantID, AnomalyDetectedData{
+			AccountNumberMasked: summary.AccountNumberMasked,
+			Anomaly:             anomaly,
+		})
+		event.Severity = anomaly.Severity
+		d.Publish(event)
+	}
+}
+
+// NewFromEnv builds a dispatcher from the environment, or returns nil when webhooks are not configured:
+//
+//	WEBHOOK_SUBSCRIPTIONS=subs.json        JSON array of subscriptions loaded at startup
+//	WEBHOOK_DEAD_LETTER_LOG=dead.jsonl     append failed deliveries here (default: error log)
+//	WEBHOOKS_ENABLED=true                  enable with no preloaded subscriptions (API-managed only)
+//	WEBHOOK_ALLOW_PRIVATE_TARGETS=true     deliver to loopback and private networks (local development only)
+func NewFromEnv() (*Dispatcher, error) {
+	path := os.Getenv("WEBHOOK_SUBSCRIPTIONS")
+	enabled := os.Getenv("WEBHOOKS_ENABLED")
+	if path == "" && enabled != "true" && enabled != "1" {
+		return nil, nil
+	}
+
+	registry := NewRegistry()
+	allowPrivate := os.Getenv("WEBHOOK_ALLOW_PRIVATE_TARGETS")
+	registry.AllowPrivateTargets = allowPrivate == "true" || allowPrivate == "1"
+	if path != "" {
+		if err := registry.load(path); err != nil {
+			return nil, err
+		}
+	}
+
+	config := DefaultConfig()
+	config.AllowPrivateTargets = registry.AllowPrivateTargets
+	if deadLetterPath := os.Getenv("WEBHOOK_DEAD_LETTER_LOG"); deadLetterPath != "" {
+		config.DeadLetter = NewFileDeadLetterLog(deadLetterPath)
+	}
+	if timeout, err := time.ParseDuration(os.Getenv("WEBHOOK_TIMEOUT")); err == nil && timeout > 0 {
+		config.Timeout = timeout
+	}
+	return NewDispatcher(registry, config), nil
+}
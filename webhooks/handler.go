@@ -0,0 +1,78 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"classify/auth"
+)
+
+// SubscriptionListResponse is returned by GET /api/webhooks (secrets omitted)
+type SubscriptionListResponse struct {
+	Success       bool           `json:"success"`
+	Subscriptions []Subscription `json:"subscriptions"`
+}
+
+// SubscriptionResponse is returned by POST /api/webhooks, the only response that includes the secret
+type SubscriptionResponse struct {
+	Success      bool         `json:"success"`
+	Subscription Subscription `json:"subscription"`
+}
+
+// ErrorResponse is returned when a subscription request fails
+type ErrorResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+}
+
+// Handler manages the caller's subscriptions; it must run after auth.Middleware
+//
+//	GET    list subscriptions
+//	POST   create {url, events, minSeverity, secret?}; the secret is returned only here
+//	DELETE ?id=... remove a subscription
+func Handler(registry *Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tenantID := auth.TenantID(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			subs := registry.List(tenantID)
+			for i := range subs {
+				subs[i].Secret = ""
+			}
+			json.NewEncoder(w).Encode(SubscriptionListResponse{Success: true, Subscriptions: subs})
+
+		case http.MethodPost:
+			var sub Subscription
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(&sub); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid JSON body")
+				return
+			}
+			sub.ID = ""
+			sub.TenantID = tenantID
+			created, err := registry.Add(sub)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(SubscriptionResponse{Success: true, Subscription: created})
+
+		case http.MethodDelete:
+			if !registry.Remove(tenantID, r.URL.Query().Get("id")) {
+				writeError(w, http.StatusNotFound, "subscription not found")
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Success: false, Error: message})
+}
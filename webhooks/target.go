@@ -0,0 +1,65 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// lookupIPAddr resolves webhook hosts (replaced in tests)
+var lookupIPAddr = net.DefaultResolver.LookupIPAddr
+
+// blockedIP reports whether ip is on the server's own network: loopback, private (RFC 1918, fc00::/7),
+// link-local (169.254.0.0/16 holds cloud metadata endpoints), carrier-grade NAT, multicast or unspecified
+func blockedIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil && ip4[0] == 100 && ip4[1]&0xc0 == 64 {
+		return true // 100.64.0.0/10
+	}
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified()
+}
+
+// checkTarget resolves host and rejects it when any of its addresses is blocked
+func checkTarget(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if blockedIP(ip) {
+			return fmt.Errorf("webhook host %s is a private or local address", host)
+		}
+		return nil
+	}
+	addrs, err := lookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("cannot resolve webhook host %s: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if blockedIP(addr.IP) {
+			return fmt.Errorf("webhook host %s resolves to a private or local address", host)
+		}
+	}
+	return nil
+}
+
+// guardedClient is an HTTP client that refuses to connect to blocked addresses
+// The check runs on the address actually dialed, so DNS changes after Validate and redirects cannot reach them
+func guardedClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || blockedIP(ip) {
+				return fmt.Errorf("webhook delivery to %s blocked: private or local address", host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // A proxy would dial on the client's behalf, past the check
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
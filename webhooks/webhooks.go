@@ -0,0 +1,238 @@
+// Package webhooks delivers signed event notifications (analysis completion, anomalies) to subscriber URLs
+// Deliveries are retried with exponential backoff and recorded in a dead-letter log when they keep failing
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"classify/statement_analysis_engine_rules/ids"
+)
+
+// Event types
+const (
+	EventAnalysisCompleted = "analysis.completed"
+	EventAnomalyDetected   = "anomaly.detected"
+)
+
+// DefaultMinSeverity is applied to anomaly subscriptions that do not choose one
+const DefaultMinSeverity = "HIGH"
+
+var knownEvents = map[string]bool{
+	EventAnalysisCompleted: true,
+	EventAnomalyDetected:   true,
+}
+
+// Same ordering as the anomaly engine's severities
+var severityRank = map[string]int{
+	"INFO":     0,
+	"LOW":      1,
+	"MEDIUM":   2,
+	"HIGH":     3,
+	"CRITICAL": 4,
+}
+
+// Event is the JSON body POSTed to subscribers
+type Event struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	TenantID  string      `json:"tenantId"`
+	CreatedAt time.Time   `json:"createdAt"`
+	Severity  string      `json:"severity,omitempty"` // Anomaly events only
+	Data      interface{} `json:"data"`
+}
+
+// NewEvent creates an event with a random ID
+func NewEvent(eventType, tenantID string, data interface{}) Event {
+	return Event{
+		ID:        ids.New(""),
+		Type:      eventType,
+		TenantID:  tenantID,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+}
+
+// Subscription sends the chosen events of one tenant to URL
+type Subscription struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenantId"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Secret      string    `json:"secret,omitempty"`      // HMAC-SHA256 key for X-Webhook-Signature
+	MinSeverity string    `json:"minSeverity,omitempty"` // Lowest anomaly severity delivered (default HIGH)
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// Validate checks the URL, events and severity, filling in defaults
+// The URL's host must not resolve to a private or local address (see Registry.AllowPrivateTargets)
+func (s *Subscription) Validate() error {
+	return s.validate(false)
+}
+
+func (s *Subscription) validate(allowPrivate bool) error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook URL %q: must be an absolute http(s) URL", s.URL)
+	}
+	if !allowPrivate {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := checkTarget(ctx, u.Hostname()); err != nil {
+			return err
+		}
+	}
+	if len(s.Events) == 0 {
+		return fmt.Errorf("subscription must list at least one event")
+	}
+	for _, event := range s.Events {
+		if !knownEvents[event] {
+			return fmt.Errorf("unknown event %q", event)
+		}
+	}
+	if s.MinSeverity == "" {
+		s.MinSeverity = DefaultMinSeverity
+	}
+	s.MinSeverity = strings.ToUpper(s.MinSeverity)
+	if _, ok := severityRank[s.MinSeverity]; !ok {
+		return fmt.Errorf("unknown severity %q", s.MinSeverity)
+	}
+	return nil
+}
+
+// Matches reports whether event should be delivered to this subscription
+// Anomaly events are filtered by MinSeverity; one without a severity counts as the lowest
+func (s *Subscription) Matches(event Event) bool {
+	if s.TenantID != event.TenantID {
+		return false
+	}
+	subscribed := false
+	for _, e := range s.Events {
+		if e == event.Type {
+			subscribed = true
+			break
+		}
+	}
+	if !subscribed {
+		return false
+	}
+	if event.Type == EventAnomalyDetected {
+		// Unknown and missing severities rank 0 (INFO)
+		return severityRank[strings.ToUpper(event.Severity)] >= severityRank[s.MinSeverity]
+	}
+	return true
+}
+
+// Registry holds subscriptions in memory
+type Registry struct {
+	mu   sync.RWMutex
+	subs map[string]*Subscription
+
+	// AllowPrivateTargets accepts URLs on loopback and private networks (local development and tests only)
+	AllowPrivateTargets bool
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{subs: make(map[string]*Subscription)}
+}
+
+// LoadRegistry reads a JSON array of subscriptions (e.g. from WEBHOOK_SUBSCRIPTIONS)
+func LoadRegistry(path string) (*Registry, error) {
+	registry := NewRegistry()
+	if err := registry.load(path); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+func (r *Registry) load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read webhook subscriptions: %w", err)
+	}
+	var subs []Subscription
+	if err := json.Unmarshal(data, &subs); err != nil {
+		return fmt.Errorf("failed to parse webhook subscriptions: %w", err)
+	}
+	for _, sub := range subs {
+		if _, err := r.Add(sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add validates and stores sub, generating an ID and secret when missing
+func (r *Registry) Add(sub Subscription) (Subscription, error) {
+	if err := sub.validate(r.AllowPrivateTargets); err != nil {
+		return Subscription{}, err
+	}
+	if sub.TenantID == "" {
+		return Subscription{}, fmt.Errorf("subscription must have a tenant")
+	}
+	if sub.ID == "" {
+		sub.ID = ids.New("wh_")
+	}
+	if sub.Secret == "" {
+		sub.Secret = ids.New("whsec_")
+	}
+	if sub.CreatedAt.IsZero() {
+		sub.CreatedAt = time.Now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.subs[sub.ID]; exists {
+		return Subscription{}, fmt.Errorf("subscription %s already exists", sub.ID)
+	}
+	stored := sub
+	r.subs[sub.ID] = &stored
+	return sub, nil
+}
+
+// Remove deletes a tenant's subscription, reporting whether it existed
+func (r *Registry) Remove(tenantID, id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sub, ok := r.subs[id]
+	if !ok || sub.TenantID != tenantID {
+		return false
+	}
+	delete(r.subs, id)
+	return true
+}
+
+// List returns a tenant's subscriptions ordered by creation time
+func (r *Registry) List(tenantID string) []Subscription {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]Subscription, 0)
+	for _, sub := range r.subs {
+		if sub.TenantID == tenantID {
+			result = append(result, *sub)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result
+}
+
+// Matching returns the subscriptions event should be delivered to
+func (r *Registry) Matching(event Event) []Subscription {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]Subscription, 0)
+	for _, sub := range r.subs {
+		if sub.Matches(event) {
+			result = append(result, *sub)
+		}
+	}
+	return result
+}
@@ -0,0 +1,254 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type memoryDeadLetters struct {
+	mu      sync.Mutex
+	letters []DeadLetter
+}
+
+func (m *memoryDeadLetters) Record(dl DeadLetter) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.letters = append(m.letters, dl)
+	return nil
+}
+
+func testConfig(sink DeadLetterSink) *Config {
+	return &Config{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Timeout:        time.Second,
+		Workers:        1,
+		DeadLetter:     sink,
+
+		AllowPrivateTargets: true, // httptest receivers listen on loopback
+	}
+}
+
+// fakeDNS resolves hosts from a table for the duration of the test
+func fakeDNS(t *testing.T, hosts map[string]string) {
+	previous := lookupIPAddr
+	lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		if ip, ok := hosts[host]; ok {
+			return []net.IPAddr{{IP: net.ParseIP(ip)}}, nil
+		}
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	t.Cleanup(func() { lookupIPAddr = previous })
+}
+
+func TestDeliverySignedWithRetry(t *testing.T) {
+	var calls int32
+	received := make(chan error, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first attempt to exercise the retry path
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		received <- Verify("s3cret", r.Header.Get(SignatureHeader), r.Header.Get(TimestampHeader), body, time.Minute, time.Now())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	registry := NewRegistry()
+	registry.AllowPrivateTargets = true
+	if _, err := registry.Add(Subscription{TenantID: "acme", URL: receiver.URL, Events: []string{EventAnalysisCompleted}, Secret: "s3cret"}); err != nil {
+		t.Fatal(err)
+	}
+	sink := &memoryDeadLetters{}
+	d := NewDispatcher(registry, testConfig(sink))
+
+	if n := d.Publish(NewEvent(EventAnalysisCompleted, "acme", map[string]int{"transactions": 3})); n != 1 {
+		t.Fatalf("expected 1 matching subscription, got %d", n)
+	}
+	select {
+	case err := <-received:
+		if err != nil {
+			t.Errorf("expected a valid signature, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+	if err := d.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+	if len(sink.letters) != 0 {
+		t.Errorf("expected no dead letters, got %d", len(sink.letters))
+	}
+}
+
+func TestDeadLetterAfterRepeatedFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		attempts int
+	}{
+		{"server errors are retried", http.StatusInternalServerError, 3},
+		{"client errors are not retried", http.StatusGone, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			registry := NewRegistry()
+			registry.AllowPrivateTargets = true
+			registry.Add(Subscription{TenantID: "acme", URL: receiver.URL, Events: []string{EventAnalysisCompleted}})
+			sink := &memoryDeadLetters{}
+			d := NewDispatcher(registry, testConfig(sink))
+			d.Publish(NewEvent(EventAnalysisCompleted, "acme", nil))
+			d.Close(context.Background())
+
+			if int(calls) != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, calls)
+			}
+			if len(sink.letters) != 1 {
+				t.Fatalf("expected 1 dead letter, got %d", len(sink.letters))
+			}
+			if dl := sink.letters[0]; dl.Attempts != tt.attempts || dl.LastStatus != tt.status {
+				t.Errorf("expected attempts=%d status=%d, got %d %d", tt.attempts, tt.status, dl.Attempts, dl.LastStatus)
+			}
+		})
+	}
+}
+
+func TestSubscriptionMatching(t *testing.T) {
+	fakeDNS(t, map[string]string{"example.com": "93.184.215.14"})
+	sub := Subscription{TenantID: "acme", URL: "https://example.com/hook", Events: []string{EventAnomalyDetected, EventAnalysisCompleted}}
+	if err := sub.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		tenant   string
+		event    string
+		severity string
+		expected bool
+	}{
+		{"acme", EventAnomalyDetected, "CRITICAL", true},
+		{"acme", EventAnomalyDetected, "HIGH", true},
+		{"acme", EventAnomalyDetected, "MEDIUM", false}, // below the default minimum
+		{"acme", EventAnomalyDetected, "", false},       // no severity ranks lowest
+		{"acme", EventAnalysisCompleted, "", true},      // not an anomaly, so not filtered by severity
+		{"globex", EventAnomalyDetected, "CRITICAL", false},
+	}
+	for _, tt := range tests {
+		event := NewEvent(tt.event, tt.tenant, nil)
+		event.Severity = tt.severity
+		if got := sub.Matches(event); got != tt.expected {
+			t.Errorf("%s/%s/%s: expected %v, got %v", tt.tenant, tt.event, tt.severity, tt.expected, got)
+		}
+	}
+
+	unsubscribed := Subscription{TenantID: "acme", MinSeverity: "INFO", Events: []string{EventAnalysisCompleted}}
+	if unsubscribed.Matches(NewEvent(EventAnomalyDetected, "acme", nil)) {
+		t.Error("expected an unsubscribed event type not to match")
+	}
+}
+
+func TestValidateRejectsPrivateTargets(t *testing.T) {
+	fakeDNS(t, map[string]string{"hooks.example.com": "93.184.215.14", "internal.example.com": "10.0.0.7"})
+	tests := []struct {
+		url      string
+		expected string // Empty when valid
+	}{
+		{"https://hooks.example.com/h", ""},
+		{"https://93.184.215.14/h", ""},
+		{"http://127.0.0.1:8080/h", "private or local"},
+		{"http://localhost/h", "cannot resolve"},
+		{"http://169.254.169.254/latest/meta-data", "private or local"},
+		{"http://192.168.1.10/h", "private or local"},
+		{"http://[::1]/h", "private or local"},
+		{"http://[fd00::1]/h", "private or local"},
+		{"http://100.64.0.1/h", "private or local"},
+		{"https://internal.example.com/h", "resolves to a private"},
+	}
+	for _, tt := range tests {
+		sub := Subscription{TenantID: "acme", URL: tt.url, Events: []string{EventAnalysisCompleted}}
+		err := sub.Validate()
+		if tt.expected == "" && err != nil {
+			t.Errorf("%s: expected valid, got %v", tt.url, err)
+		}
+		if tt.expected != "" && (err == nil || !strings.Contains(err.Error(), tt.expected)) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.url, tt.expected, err)
+		}
+	}
+
+	registry := NewRegistry()
+	if _, err := registry.Add(Subscription{TenantID: "acme", URL: "http://127.0.0.1/h", Events: []string{EventAnalysisCompleted}}); err == nil {
+		t.Error("expected the registry to reject a loopback URL by default")
+	}
+	registry.AllowPrivateTargets = true
+	if _, err := registry.Add(Subscription{TenantID: "acme", URL: "http://127.0.0.1/h", Events: []string{EventAnalysisCompleted}}); err != nil {
+		t.Errorf("expected AllowPrivateTargets to accept a loopback URL, got %v", err)
+	}
+}
+
+// TestDeliveryBlockedAtDial checks that the default client refuses private addresses even when
+// the subscription got past Validate (e.g. its host later resolved somewhere else)
+func TestDeliveryBlockedAtDial(t *testing.T) {
+	var calls int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer receiver.Close()
+
+	registry := NewRegistry()
+	registry.AllowPrivateTargets = true
+	registry.Add(Subscription{TenantID: "acme", URL: receiver.URL, Events: []string{EventAnalysisCompleted}})
+	sink := &memoryDeadLetters{}
+	config := testConfig(sink)
+	config.AllowPrivateTargets = false
+	d := NewDispatcher(registry, config)
+	d.Publish(NewEvent(EventAnalysisCompleted, "acme", nil))
+	d.Close(context.Background())
+
+	if calls != 0 {
+		t.Errorf("expected no request to reach the loopback receiver, got %d", calls)
+	}
+	if len(sink.letters) != 1 || !strings.Contains(sink.letters[0].LastError, "blocked") {
+		t.Errorf("expected a blocked delivery to be dead-lettered, got %+v", sink.letters)
+	}
+}
+
+func TestVerifyRejectsTampering(t *testing.T) {
+	now := time.Unix(1765900000, 0)
+	body := []byte(`{"id":"1"}`)
+	signature := Sign("s3cret", now.Unix(), body)
+	timestamp := "1765900000"
+
+	if err := Verify("s3cret", signature, timestamp, body, time.Minute, now); err != nil {
+		t.Errorf("expected valid signature, got %v", err)
+	}
+	if err := Verify("s3cret", signature, timestamp, []byte(`{"id":"2"}`), time.Minute, now); err == nil {
+		t.Errorf("expected tampered body to fail")
+	}
+	if err := Verify("other", signature, timestamp, body, time.Minute, now); err == nil {
+		t.Errorf("expected wrong secret to fail")
+	}
+	if err := Verify("s3cret", signature, timestamp, body, time.Minute, now.Add(time.Hour)); err == nil {
+		t.Errorf("expected stale timestamp to fail")
+	}
+}